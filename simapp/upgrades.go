@@ -4,6 +4,8 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+	epochingtypes "github.com/cosmos/cosmos-sdk/x/epoching/types"
 	"github.com/cosmos/cosmos-sdk/x/group"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
@@ -15,26 +17,48 @@ import (
 // when an application is migrating from Cosmos SDK version v0.45.x to v0.46.x.
 const UpgradeName = "v045-to-v046"
 
+// UpgradeNameV047 defines the on-chain upgrade name for the sample simapp upgrade
+// from v046 to v047, adding the stores of the modules which moved their state out
+// of x/params or were introduced since v046.
+const UpgradeNameV047 = "v046-to-v047"
+
 func (app SimApp) RegisterUpgradeHandlers() {
-	app.UpgradeKeeper.SetUpgradeHandler(UpgradeName,
-		func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
-			return app.mm.RunMigrations(ctx, app.configurator, fromVM)
-		})
+	for _, name := range []string{UpgradeName, UpgradeNameV047} {
+		app.UpgradeKeeper.SetUpgradeHandler(name,
+			func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+				return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+			})
+	}
 
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
 	if err != nil {
 		panic(err)
 	}
 
-	if upgradeInfo.Name == UpgradeName && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
-		storeUpgrades := storetypes.StoreUpgrades{
+	if app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return
+	}
+
+	var storeUpgrades *storetypes.StoreUpgrades
+	switch upgradeInfo.Name {
+	case UpgradeName:
+		storeUpgrades = &storetypes.StoreUpgrades{
 			Added: []string{
 				group.ModuleName,
 				nft.ModuleName,
 			},
 		}
+	case UpgradeNameV047:
+		storeUpgrades = &storetypes.StoreUpgrades{
+			Added: []string{
+				crisistypes.StoreKey,
+				epochingtypes.StoreKey,
+			},
+		}
+	}
 
+	if storeUpgrades != nil {
 		// configure store loader that checks if version == upgradeHeight and applies store upgrades
-		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, storeUpgrades))
 	}
 }
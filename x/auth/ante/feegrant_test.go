@@ -65,6 +65,22 @@ func (suite *AnteTestSuite) TestDeductFeesNoDelegation() {
 	})
 	suite.Require().NoError(err)
 
+	// fee pool funded by `addr2` with an allowance to `addr4`, fees are paid from the pool account
+	pool, err := app.FeeGrantKeeper.CreateFeePool(ctx, addr2, "")
+	suite.Require().NoError(err)
+	err = app.FeeGrantKeeper.DepositFeePool(ctx, pool.Id, addr2, sdk.NewCoins(sdk.NewInt64Coin("atom", 500)))
+	suite.Require().NoError(err)
+	err = app.FeeGrantKeeper.GrantPoolAllowance(ctx, pool.Id, addr2, addr4, &feegrant.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 500)),
+	})
+	suite.Require().NoError(err)
+
+	// empty fee pool with an allowance to `addr3`
+	emptyPool, err := app.FeeGrantKeeper.CreateFeePool(ctx, addr2, "")
+	suite.Require().NoError(err)
+	err = app.FeeGrantKeeper.GrantPoolAllowance(ctx, emptyPool.Id, addr2, addr3, &feegrant.BasicAllowance{})
+	suite.Require().NoError(err)
+
 	cases := map[string]struct {
 		signerKey  cryptotypes.PrivKey
 		signer     sdk.AccAddress
@@ -130,6 +146,20 @@ func (suite *AnteTestSuite) TestDeductFeesNoDelegation() {
 			fee:        50,
 			valid:      false,
 		},
+		"valid fee pool grant": {
+			signerKey:  priv4,
+			signer:     addr4,
+			feeAccount: sdk.MustAccAddressFromBech32(pool.Address),
+			fee:        50,
+			valid:      true,
+		},
+		"fee pool cannot cover allowed fee grant": {
+			signerKey:  priv3,
+			signer:     addr3,
+			feeAccount: sdk.MustAccAddressFromBech32(emptyPool.Address),
+			fee:        50,
+			valid:      false,
+		},
 	}
 
 	for name, stc := range cases {
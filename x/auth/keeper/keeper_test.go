@@ -20,8 +20,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
-	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
-	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	dbm "github.com/tendermint/tm-db"
 )
 
@@ -143,8 +142,8 @@ func TestSupply_ValidatePermissions(t *testing.T) {
 
 	cdc := simapp.MakeTestEncodingConfig().Codec
 	keeper := keeper.NewAccountKeeper(
-		cdc, app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName),
-		types.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix,
+		cdc, app.GetKey(types.StoreKey), types.ProtoBaseAccount, maccPerms,
+		sdk.Bech32MainPrefix, types.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	err := keeper.ValidatePermissions(multiPermAcc)
@@ -161,14 +160,10 @@ func TestSupply_ValidatePermissions(t *testing.T) {
 
 func TestInitGenesis(tt *testing.T) {
 	authKey := sdk.NewKVStoreKey(types.StoreKey)
-	paramsKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
-	paramsTKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
 	newCtx := func(t *testing.T) sdk.Context {
 		db := dbm.NewMemDB()
 		cms := store.NewCommitMultiStore(db)
 		cms.MountStoreWithDB(authKey, storetypes.StoreTypeIAVL, db)
-		cms.MountStoreWithDB(paramsKey, storetypes.StoreTypeIAVL, db)
-		cms.MountStoreWithDB(paramsTKey, storetypes.StoreTypeTransient, db)
 		err := cms.LoadLatestVersion()
 		if err != nil {
 			panic(err)
@@ -177,10 +172,9 @@ func TestInitGenesis(tt *testing.T) {
 	}
 	newAccountKeeper := func(t *testing.T) keeper.AccountKeeper {
 		encConf := simapp.MakeTestEncodingConfig()
-		paramsKeeper := paramskeeper.NewKeeper(encConf.Codec, encConf.Amino, paramsKey, paramsTKey)
 		return keeper.NewAccountKeeper(
-			encConf.Codec, authKey, paramsKeeper.Subspace(types.ModuleName),
-			types.ProtoBaseAccount, map[string][]string{}, sdk.Bech32MainPrefix,
+			encConf.Codec, authKey, types.ProtoBaseAccount, map[string][]string{},
+			sdk.Bech32MainPrefix, types.NewModuleAddress(govtypes.ModuleName).String(),
 		)
 	}
 
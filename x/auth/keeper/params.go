@@ -6,12 +6,20 @@ import (
 )
 
 // SetParams sets the auth module's parameters.
+// CONTRACT: This method performs no validation of the parameters.
 func (ak AccountKeeper) SetParams(ctx sdk.Context, params types.Params) {
-	ak.paramSubspace.SetParamSet(ctx, &params)
+	store := ctx.KVStore(ak.key)
+	bz := ak.cdc.MustMarshal(&params)
+	store.Set(types.ParamsKey, bz)
 }
 
 // GetParams gets the auth module's parameters.
 func (ak AccountKeeper) GetParams(ctx sdk.Context) (params types.Params) {
-	ak.paramSubspace.GetParamSet(ctx, &params)
-	return
+	store := ctx.KVStore(ak.key)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+	ak.cdc.MustUnmarshal(bz, &params)
+	return params
 }
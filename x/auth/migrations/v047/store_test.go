@@ -0,0 +1,35 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v047auth "github.com/cosmos/cosmos-sdk/x/auth/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	storeKey := sdk.NewKVStoreKey(types.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	legacySubspace := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, storeKey, tKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	params := types.DefaultParams()
+	legacySubspace.SetParamSet(ctx, &params)
+
+	// Run migrations.
+	require.NoError(t, v047auth.MigrateStore(ctx, storeKey, legacySubspace, encCfg.Codec))
+
+	// Make sure the params are now in the module store.
+	var res types.Params
+	bz := ctx.KVStore(storeKey).Get(types.ParamsKey)
+	require.NoError(t, encCfg.Codec.Unmarshal(bz, &res))
+	require.Equal(t, params, res)
+}
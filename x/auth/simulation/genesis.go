@@ -22,7 +22,8 @@ const (
 )
 
 // RandomGenesisAccounts defines the default RandomGenesisAccountsFn used on the SDK.
-// It creates a slice of BaseAccount, ContinuousVestingAccount and DelayedVestingAccount.
+// It creates a slice of BaseAccount, ContinuousVestingAccount, DelayedVestingAccount
+// and ClawbackVestingAccount.
 func RandomGenesisAccounts(simState *module.SimulationState) types.GenesisAccounts {
 	genesisAccs := make(types.GenesisAccounts, len(simState.Accounts))
 	for i, acc := range simState.Accounts {
@@ -49,16 +50,36 @@ func RandomGenesisAccounts(simState *module.SimulationState) types.GenesisAccoun
 
 		bva := vestingtypes.NewBaseVestingAccount(bacc, initialVesting, endTime)
 
-		if simState.Rand.Intn(100) < 50 {
+		switch n := simState.Rand.Intn(100); {
+		case n < 40:
 			genesisAccs[i] = vestingtypes.NewContinuousVestingAccountRaw(bva, startTime)
-		} else {
+		case n < 80:
 			genesisAccs[i] = vestingtypes.NewDelayedVestingAccountRaw(bva)
+		default:
+			genesisAccs[i] = randomClawbackVestingAccount(simState, bacc, initialVesting, startTime, endTime)
 		}
 	}
 
 	return genesisAccs
 }
 
+// randomClawbackVestingAccount returns a ClawbackVestingAccount funded by a
+// random account, vesting in two periods and unlocking at the end time.
+func randomClawbackVestingAccount(simState *module.SimulationState, bacc *types.BaseAccount, initialVesting sdk.Coins, startTime, endTime int64) *vestingtypes.ClawbackVestingAccount {
+	funder, _ := simulation.RandomAcc(simState.Rand, simState.Accounts)
+
+	length := endTime - startTime
+	firstLength := simState.Rand.Int63n(length) + 1
+	firstAmount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initialVesting.AmountOf(sdk.DefaultBondDenom).QuoRaw(2)))
+	vestingPeriods := vestingtypes.Periods{
+		{Length: firstLength, Amount: firstAmount},
+		{Length: length - firstLength, Amount: initialVesting.Sub(firstAmount...)},
+	}
+	lockupPeriods := vestingtypes.Periods{{Length: length, Amount: initialVesting}}
+
+	return vestingtypes.NewClawbackVestingAccount(bacc, funder.Address, initialVesting, startTime, lockupPeriods, vestingPeriods)
+}
+
 // GenMaxMemoChars randomized MaxMemoChars
 func GenMaxMemoChars(r *rand.Rand) uint64 {
 	return uint64(simulation.RandIntBetween(r, 100, 200))
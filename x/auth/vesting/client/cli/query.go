@@ -0,0 +1,152 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// Query flags
+const (
+	FlagTime  = "time"
+	FlagLimit = "limit"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	vestingQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the vesting module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	vestingQueryCmd.AddCommand(
+		GetCmdQueryVestingBalances(),
+		GetCmdQueryUnlockEvents(),
+	)
+
+	return vestingQueryCmd
+}
+
+// GetCmdQueryVestingBalances returns cmd to query the vested, unvested and
+// locked coins of a vesting account.
+func GetCmdQueryVestingBalances() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balances [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the vested, unvested and locked coins of a vesting account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the vested, unvested and locked coins of a vesting account, at the
+current block time or at the time given with --%s (RFC3339).
+
+Example:
+$ %s query vesting balances [address] --%s 2030-01-01T00:00:00Z
+`, FlagTime, version.AppName, FlagTime),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			t, err := parseTimeFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VestingBalances(
+				cmd.Context(),
+				&types.QueryVestingBalancesRequest{Address: addr.String(), Time: t},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagTime, "", "The RFC3339 time at which the balances are projected, defaults to the current block time")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryUnlockEvents returns cmd to query the upcoming unlock events of a
+// vesting account.
+func GetCmdQueryUnlockEvents() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unlock-events [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the upcoming unlock events of a vesting account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the upcoming unlock events of a vesting account, after the current
+block time or after the time given with --%s (RFC3339).
+
+Example:
+$ %s query vesting unlock-events [address] --%s 10
+`, FlagTime, version.AppName, FlagLimit),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			t, err := parseTimeFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			limit, err := cmd.Flags().GetUint32(FlagLimit)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.UnlockEvents(
+				cmd.Context(),
+				&types.QueryUnlockEventsRequest{Address: addr.String(), Time: t, Limit: limit},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagTime, "", "The RFC3339 time after which the events are returned, defaults to the current block time")
+	cmd.Flags().Uint32(FlagLimit, 0, "The maximum number of events returned, all the events if zero")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parseTimeFlag(cmd *cobra.Command) (*time.Time, error) {
+	s, err := cmd.Flags().GetString(FlagTime)
+	if err != nil || s == "" {
+		return nil, err
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
@@ -18,6 +18,9 @@ import (
 // Transaction command flags
 const (
 	FlagDelayed = "delayed"
+	FlagLockup  = "lockup"
+	FlagVesting = "vesting"
+	FlagDest    = "dest"
 )
 
 // GetTxCmd returns vesting module's transaction commands.
@@ -34,6 +37,9 @@ func GetTxCmd() *cobra.Command {
 		NewMsgCreateVestingAccountCmd(),
 		NewMsgCreatePermanentLockedAccountCmd(),
 		NewMsgCreatePeriodicVestingAccountCmd(),
+		NewMsgCreateClawbackVestingAccountCmd(),
+		NewMsgClawbackCmd(),
+		NewMsgAddPeriodicVestingGrantCmd(),
 	)
 
 	return txCmd
@@ -131,6 +137,37 @@ type InputPeriod struct {
 	Length int64  `json:"length_seconds"`
 }
 
+func (vestingData VestingData) parsePeriods() ([]types.Period, error) {
+	var periods []types.Period
+
+	for i, p := range vestingData.Periods {
+
+		amount, err := sdk.ParseCoinsNormalized(p.Coins)
+		if err != nil {
+			return nil, err
+		}
+
+		if p.Length < 0 {
+			return nil, fmt.Errorf("invalid period length of %d in period %d, length must be greater than 0", p.Length, i)
+		}
+		period := types.Period{Length: p.Length, Amount: amount}
+		periods = append(periods, period)
+	}
+	return periods, nil
+}
+
+func readVestingData(path string) (VestingData, error) {
+	var vestingData VestingData
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return vestingData, err
+	}
+
+	err = json.Unmarshal(contents, &vestingData)
+	return vestingData, err
+}
+
 // NewMsgCreatePeriodicVestingAccountCmd returns a CLI command handler for creating a
 // MsgCreatePeriodicVestingAccountCmd transaction.
 func NewMsgCreatePeriodicVestingAccountCmd() *cobra.Command {
@@ -166,35 +203,170 @@ func NewMsgCreatePeriodicVestingAccountCmd() *cobra.Command {
 				return err
 			}
 
-			contents, err := os.ReadFile(args[1])
+			vestingData, err := readVestingData(args[1])
 			if err != nil {
 				return err
 			}
 
-			var vestingData VestingData
+			periods, err := vestingData.parsePeriods()
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreatePeriodicVestingAccount(clientCtx.GetFromAddress(), toAddr, vestingData.StartTime, periods)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
 
-			err = json.Unmarshal(contents, &vestingData)
+// NewMsgCreateClawbackVestingAccountCmd returns a CLI command handler for creating a
+// MsgCreateClawbackVestingAccount transaction.
+func NewMsgCreateClawbackVestingAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-clawback-vesting-account [to_address] --lockup [lockup_periods_json_file] --vesting [vesting_periods_json_file]",
+		Short: "Create a new vesting account whose unvested tokens can be clawed back by the funder.",
+		Long: `Create a new vesting account funded by the sender, who can claw back the tokens that are not vested yet.
+The account has separate lockup and vesting schedules, read from files in the same format as for
+create-periodic-vesting-account, and tokens are spendable once both vested and unlocked. The start
+time of the files must match, and at least one of them must be provided: the tokens are all
+unlocked without lockup schedule, and all vested without vesting schedule.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			var periods []types.Period
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
 
-			for i, p := range vestingData.Periods {
+			var (
+				startTime                     int64
+				lockupPeriods, vestingPeriods []types.Period
+			)
+			for _, schedule := range []struct {
+				flag    string
+				periods *[]types.Period
+			}{{FlagLockup, &lockupPeriods}, {FlagVesting, &vestingPeriods}} {
+				path, _ := cmd.Flags().GetString(schedule.flag)
+				if path == "" {
+					continue
+				}
 
-				amount, err := sdk.ParseCoinsNormalized(p.Coins)
+				vestingData, err := readVestingData(path)
 				if err != nil {
 					return err
 				}
 
-				if p.Length < 0 {
-					return fmt.Errorf("invalid period length of %d in period %d, length must be greater than 0", p.Length, i)
+				if startTime != 0 && startTime != vestingData.StartTime {
+					return fmt.Errorf("lockup and vesting start times must match")
+				}
+				startTime = vestingData.StartTime
+
+				if *schedule.periods, err = vestingData.parsePeriods(); err != nil {
+					return err
 				}
-				period := types.Period{Length: p.Length, Amount: amount}
-				periods = append(periods, period)
 			}
 
-			msg := types.NewMsgCreatePeriodicVestingAccount(clientCtx.GetFromAddress(), toAddr, vestingData.StartTime, periods)
+			msg := types.NewMsgCreateClawbackVestingAccount(clientCtx.GetFromAddress(), toAddr, startTime, lockupPeriods, vestingPeriods)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagLockup, "", "Path to the file describing the lockup schedule")
+	cmd.Flags().String(FlagVesting, "", "Path to the file describing the vesting schedule")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgClawbackCmd returns a CLI command handler for creating a MsgClawback
+// transaction.
+func NewMsgClawbackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [address]",
+		Short: "Transfer the unvested tokens of a clawback vesting account back to its funder.",
+		Long: `Transfer the unvested tokens of a clawback vesting account back to its funder, or to the
+address given by the '--dest' flag. The tokens are taken from the balance of the account, then from
+its unbonding delegations and delegations, which are transferred. Must be signed by the funder.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var dest sdk.AccAddress
+			if destArg, _ := cmd.Flags().GetString(FlagDest); destArg != "" {
+				if dest, err = sdk.AccAddressFromBech32(destArg); err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgClawback(clientCtx.GetFromAddress(), addr, dest)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDest, "", "Address receiving the clawed back tokens, defaults to the funder")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgAddPeriodicVestingGrantCmd returns a CLI command handler for creating a
+// MsgAddPeriodicVestingGrant transaction.
+func NewMsgAddPeriodicVestingGrantCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-periodic-vesting-grant [to_address] [periods_json_file]",
+		Short: "Add a grant funded with an allocation of tokens to an existing periodic vesting account.",
+		Long: `Add a grant funded with an allocation of tokens to an existing periodic vesting account.
+The grant schedule is read from a periods JSON file in the format of create-periodic-vesting-account,
+and merged into the schedule of the account. The transaction must be signed by both the sender and
+the vesting account, e.g. by generating it with --generate-only and signing it with each key.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			vestingData, err := readVestingData(args[1])
+			if err != nil {
+				return err
+			}
+
+			periods, err := vestingData.parsePeriods()
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddPeriodicVestingGrant(clientCtx.GetFromAddress(), toAddr, vestingData.StartTime, periods)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -0,0 +1,100 @@
+package vesting
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+type queryServer struct {
+	keeper.AccountKeeper
+}
+
+// NewQueryServerImpl returns an implementation of the vesting QueryServer interface
+// for the provided AccountKeeper.
+func NewQueryServerImpl(k keeper.AccountKeeper) types.QueryServer {
+	return &queryServer{AccountKeeper: k}
+}
+
+var _ types.QueryServer = queryServer{}
+
+// VestingBalances returns the vested, unvested and locked coins of a vesting
+// account at the requested time.
+func (q queryServer) VestingBalances(c context.Context, req *types.QueryVestingBalancesRequest) (*types.QueryVestingBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	va, err := q.getVestingAccount(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	blockTime := queryTime(ctx, req.Time)
+	return &types.QueryVestingBalancesResponse{
+		Vested:   va.GetVestedCoins(blockTime),
+		Unvested: va.GetVestingCoins(blockTime),
+		Locked:   va.LockedCoins(blockTime),
+		Time:     blockTime,
+	}, nil
+}
+
+// UnlockEvents returns the unlock events of a vesting account after the
+// requested time.
+func (q queryServer) UnlockEvents(c context.Context, req *types.QueryUnlockEventsRequest) (*types.QueryUnlockEventsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	va, err := q.getVestingAccount(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	events := types.GetUnlockEvents(va, queryTime(ctx, req.Time))
+	if req.Limit > 0 && len(events) > int(req.Limit) {
+		events = events[:req.Limit]
+	}
+
+	return &types.QueryUnlockEventsResponse{Events: events}, nil
+}
+
+func (q queryServer) getVestingAccount(ctx sdk.Context, address string) (exported.VestingAccount, error) {
+	if address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	acc := q.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, status.Errorf(codes.NotFound, "account %s not found", address)
+	}
+
+	va, ok := acc.(exported.VestingAccount)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "account %s is not a vesting account", address)
+	}
+
+	return va, nil
+}
+
+// queryTime returns the requested time, defaulting to the block time.
+func queryTime(ctx sdk.Context, t *time.Time) time.Time {
+	if t == nil {
+		return ctx.BlockTime()
+	}
+	return *t
+}
@@ -0,0 +1,62 @@
+package vesting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func TestVestingQueries(t *testing.T) {
+	app := simapp.Setup(t, false)
+	now := time.Unix(time.Now().Unix(), 0).UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: now})
+	goCtx := sdk.WrapSDKContext(ctx)
+	msgServer := vesting.NewMsgServerImpl(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+	queryServer := vesting.NewQueryServerImpl(app.AccountKeeper)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000))
+	_, _, vestingAddr := testdata.KeyTestPubAddr()
+	coins := func(amt int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amt)) }
+
+	_, err := msgServer.CreatePeriodicVestingAccount(goCtx, types.NewMsgCreatePeriodicVestingAccount(
+		addrs[0], vestingAddr, now.Unix(), types.Periods{{Length: 100, Amount: coins(100)}, {Length: 100, Amount: coins(200)}, {Length: 100, Amount: coins(300)}},
+	))
+	require.NoError(t, err)
+
+	// require non vesting accounts to be rejected
+	_, err = queryServer.VestingBalances(goCtx, &types.QueryVestingBalancesRequest{Address: addrs[0].String()})
+	require.Error(t, err)
+
+	balances, err := queryServer.VestingBalances(goCtx, &types.QueryVestingBalancesRequest{Address: vestingAddr.String()})
+	require.NoError(t, err)
+	require.True(t, balances.Vested.IsZero())
+	require.Equal(t, coins(600), balances.Unvested)
+	require.Equal(t, coins(600), balances.Locked)
+	require.Equal(t, now, balances.Time)
+
+	at := now.Add(250 * time.Second)
+	balances, err = queryServer.VestingBalances(goCtx, &types.QueryVestingBalancesRequest{Address: vestingAddr.String(), Time: &at})
+	require.NoError(t, err)
+	require.Equal(t, coins(300), balances.Vested)
+	require.Equal(t, coins(300), balances.Unvested)
+	require.Equal(t, coins(300), balances.Locked)
+
+	events, err := queryServer.UnlockEvents(goCtx, &types.QueryUnlockEventsRequest{Address: vestingAddr.String(), Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, []types.UnlockEvent{
+		{Time: now.Add(100 * time.Second), Amount: coins(100)},
+		{Time: now.Add(200 * time.Second), Amount: coins(200)},
+	}, events.Events)
+
+	events, err = queryServer.UnlockEvents(goCtx, &types.QueryUnlockEventsRequest{Address: vestingAddr.String(), Time: &at})
+	require.NoError(t, err)
+	require.Equal(t, []types.UnlockEvent{{Time: now.Add(300 * time.Second), Amount: coins(300)}}, events.Events)
+}
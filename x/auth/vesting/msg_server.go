@@ -2,6 +2,7 @@ package vesting
 
 import (
 	"context"
+	"math"
 
 	"github.com/armon/go-metrics"
 
@@ -16,12 +17,13 @@ import (
 type msgServer struct {
 	keeper.AccountKeeper
 	types.BankKeeper
+	types.StakingKeeper
 }
 
 // NewMsgServerImpl returns an implementation of the vesting MsgServer interface,
-// wrapping the corresponding AccountKeeper and BankKeeper.
-func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper) types.MsgServer {
-	return &msgServer{AccountKeeper: k, BankKeeper: bk}
+// wrapping the corresponding AccountKeeper, BankKeeper and StakingKeeper.
+func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) types.MsgServer {
+	return &msgServer{AccountKeeper: k, BankKeeper: bk, StakingKeeper: sk}
 }
 
 var _ types.MsgServer = msgServer{}
@@ -217,3 +219,274 @@ func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *type
 	)
 	return &types.MsgCreatePeriodicVestingAccountResponse{}, nil
 }
+
+func (s msgServer) CreateClawbackVestingAccount(goCtx context.Context, msg *types.MsgCreateClawbackVestingAccount) (*types.MsgCreateClawbackVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if bk.BlockedAddr(to) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	if acc := ak.GetAccount(ctx, to); acc != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+	}
+
+	totalCoins := msg.TotalAmount()
+	if err := bk.IsSendEnabledCoins(ctx, totalCoins...); err != nil {
+		return nil, err
+	}
+
+	baseAccount := authtypes.NewBaseAccountWithAddress(to)
+	baseAccount = ak.NewAccount(ctx, baseAccount).(*authtypes.BaseAccount)
+	vestingAccount := types.NewClawbackVestingAccount(baseAccount, from, totalCoins.Sort(), msg.StartTime, msg.LockupPeriods, msg.VestingPeriods)
+
+	ak.SetAccount(ctx, vestingAccount)
+
+	defer func() {
+		telemetry.IncrCounter(1, "new", "account")
+
+		for _, a := range totalCoins {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "create_clawback_vesting_account"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	if err = bk.SendCoins(ctx, from, to, totalCoins); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return &types.MsgCreateClawbackVestingAccountResponse{}, nil
+}
+
+func (s msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	dest, err := sdk.AccAddressFromBech32(msg.FunderAddress)
+	if err != nil {
+		return nil, err
+	}
+	if msg.DestAddress != "" {
+		if dest, err = sdk.AccAddressFromBech32(msg.DestAddress); err != nil {
+			return nil, err
+		}
+	}
+
+	if s.BankKeeper.BlockedAddr(dest) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", dest)
+	}
+
+	acc := s.AccountKeeper.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "account %s does not exist", msg.Address)
+	}
+	va, ok := acc.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a clawback vesting account", msg.Address)
+	}
+	if va.FunderAddress != msg.FunderAddress {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the funder of account %s", msg.FunderAddress, msg.Address)
+	}
+
+	unvested := va.ComputeClawback(ctx.BlockTime())
+	s.AccountKeeper.SetAccount(ctx, va)
+
+	coins, err := s.clawback(ctx, addr, dest, unvested)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		for _, a := range coins {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "clawback"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return &types.MsgClawbackResponse{Coins: coins}, nil
+}
+
+func (s msgServer) AddPeriodicVestingGrant(goCtx context.Context, msg *types.MsgAddPeriodicVestingGrant) (*types.MsgAddPeriodicVestingGrantResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if bk.BlockedAddr(to) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	acc := ak.GetAccount(ctx, to)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "account %s does not exist", msg.ToAddress)
+	}
+	pva, ok := acc.(*types.PeriodicVestingAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a periodic vesting account", msg.ToAddress)
+	}
+
+	totalCoins := types.Periods(msg.VestingPeriods).TotalAmount()
+	if err := bk.IsSendEnabledCoins(ctx, totalCoins...); err != nil {
+		return nil, err
+	}
+
+	pva.AddGrant(msg.StartTime, msg.VestingPeriods)
+	ak.SetAccount(ctx, pva)
+
+	defer func() {
+		for _, a := range totalCoins {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "add_periodic_vesting_grant"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	if err = bk.SendCoins(ctx, from, to, totalCoins); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return &types.MsgAddPeriodicVestingGrantResponse{}, nil
+}
+
+// clawback transfers up to amount coins from the account to dest: first from
+// its spendable balance, then from its unbonding delegations and finally from
+// its delegations, which are transferred to dest. The distribution rewards of
+// the delegations are withdrawn by the staking hooks when they are
+// transferred. It returns the transferred coins, which can be less than amount
+// if the delegations were slashed.
+func (s msgServer) clawback(ctx sdk.Context, addr, dest sdk.AccAddress, amount sdk.Coins) (sdk.Coins, error) {
+	fromBalance := amount.Min(s.BankKeeper.SpendableCoins(ctx, addr))
+	if !fromBalance.IsZero() {
+		if err := s.BankKeeper.SendCoins(ctx, addr, dest, fromBalance); err != nil {
+			return nil, err
+		}
+	}
+
+	bondDenom := s.StakingKeeper.BondDenom(ctx)
+	want := amount.Sub(fromBalance...).AmountOf(bondDenom)
+	transferred := sdk.ZeroInt()
+
+	for _, ubd := range s.StakingKeeper.GetUnbondingDelegations(ctx, addr, math.MaxUint16) {
+		if !want.IsPositive() {
+			break
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(ubd.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		amt := s.StakingKeeper.TransferUnbonding(ctx, addr, dest, valAddr, want)
+		want = want.Sub(amt)
+		transferred = transferred.Add(amt)
+	}
+
+	for _, delegation := range s.StakingKeeper.GetDelegatorDelegations(ctx, addr, math.MaxUint16) {
+		if !want.IsPositive() {
+			break
+		}
+
+		valAddr := delegation.GetValidatorAddr()
+		validator, found := s.StakingKeeper.GetValidator(ctx, valAddr)
+		if !found {
+			continue
+		}
+
+		amt := sdk.MinInt(validator.TokensFromShares(delegation.Shares).TruncateInt(), want)
+		if !amt.IsPositive() {
+			continue
+		}
+
+		shares, err := s.StakingKeeper.ValidateUnbondAmount(ctx, addr, valAddr, amt)
+		if err != nil {
+			return nil, err
+		}
+
+		// an unbonded validator is removed along with its last shares, which
+		// can't be transferred then
+		if validator.IsUnbonded() && shares.Equal(validator.DelegatorShares) {
+			continue
+		}
+
+		unbonded, err := s.StakingKeeper.Unbond(ctx, addr, valAddr, shares)
+		if err != nil {
+			return nil, err
+		}
+
+		validator, _ = s.StakingKeeper.GetValidator(ctx, valAddr)
+		if _, err := s.StakingKeeper.Delegate(ctx, dest, unbonded, validator.GetStatus(), validator, false); err != nil {
+			return nil, err
+		}
+		want = want.Sub(unbonded)
+		transferred = transferred.Add(unbonded)
+	}
+
+	if transferred.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(bondDenom, transferred))
+		va, ok := s.AccountKeeper.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrNotSupported, "account %s is not a clawback vesting account", addr)
+		}
+		va.TrackUndelegation(coins)
+		va.ReallocateDelegations(ctx.BlockTime())
+		s.AccountKeeper.SetAccount(ctx, va)
+		fromBalance = fromBalance.Add(coins...)
+	}
+	return fromBalance, nil
+}
@@ -0,0 +1,185 @@
+package vesting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestClawback(t *testing.T) {
+	app := simapp.Setup(t, false)
+	now := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: now})
+	msgServer := vesting.NewMsgServerImpl(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000))
+	funder, other := addrs[0], addrs[1]
+	_, _, vestingAddr := testdata.KeyTestPubAddr()
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	coins := func(amt int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(bondDenom, amt)) }
+
+	// create an account vesting 250 every 100s and locked up for 400s
+	msg := types.NewMsgCreateClawbackVestingAccount(funder, vestingAddr, now.Unix(),
+		types.Periods{{Length: 400, Amount: coins(1000)}},
+		types.Periods{{Length: 100, Amount: coins(250)}, {Length: 100, Amount: coins(250)}, {Length: 100, Amount: coins(250)}, {Length: 100, Amount: coins(250)}},
+	)
+	_, err := msgServer.CreateClawbackVestingAccount(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	// delegate 600 and undelegate 100 of them
+	ctx = ctx.WithBlockTime(now.Add(150 * time.Second))
+	validator := app.StakingKeeper.GetAllValidators(ctx)[0]
+	_, err = app.StakingKeeper.Delegate(ctx, vestingAddr, sdk.NewInt(600), stakingtypes.Unbonded, validator, true)
+	require.NoError(t, err)
+	shares, err := app.StakingKeeper.ValidateUnbondAmount(ctx, vestingAddr, validator.GetOperator(), sdk.NewInt(100))
+	require.NoError(t, err)
+	_, err = app.StakingKeeper.Undelegate(ctx, vestingAddr, validator.GetOperator(), shares)
+	require.NoError(t, err)
+	unbondingID := app.StakingKeeper.GetLastUnbondingID(ctx)
+
+	// only the funder can claw back
+	_, err = msgServer.Clawback(sdk.WrapSDKContext(ctx), types.NewMsgClawback(other, vestingAddr, nil))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// only clawback vesting accounts can be clawed back
+	_, err = msgServer.Clawback(sdk.WrapSDKContext(ctx), types.NewMsgClawback(funder, other, nil))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// require the unvested coins to be clawed back from the balance, the
+	// unbonding delegation and the delegation
+	res, err := msgServer.Clawback(sdk.WrapSDKContext(ctx), types.NewMsgClawback(funder, vestingAddr, nil))
+	require.NoError(t, err)
+	require.Equal(t, coins(750), res.Coins)
+
+	require.Equal(t, coins(9400), app.BankKeeper.GetAllBalances(ctx, funder))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, vestingAddr).IsZero())
+
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, funder, validator.GetOperator())
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(100), ubd.Entries[0].Balance)
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, vestingAddr, validator.GetOperator())
+	require.False(t, found)
+
+	// require the unbonding delegation entry to keep its unbonding id
+	require.Equal(t, unbondingID, ubd.Entries[0].UnbondingId)
+	require.Equal(t, unbondingID, app.StakingKeeper.GetLastUnbondingID(ctx))
+	ubd, found = app.StakingKeeper.GetUnbondingDelegationByUnbondingID(ctx, unbondingID)
+	require.True(t, found)
+	require.Equal(t, funder.String(), ubd.DelegatorAddress)
+
+	validator, _ = app.StakingKeeper.GetValidator(ctx, validator.GetOperator())
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, funder, validator.GetOperator())
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(250), validator.TokensFromShares(delegation.Shares).TruncateInt())
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, vestingAddr, validator.GetOperator())
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(250), validator.TokensFromShares(delegation.Shares).TruncateInt())
+
+	va := app.AccountKeeper.GetAccount(ctx, vestingAddr).(*types.ClawbackVestingAccount)
+	require.Equal(t, coins(250), va.OriginalVesting)
+	require.Equal(t, coins(250), va.DelegatedVesting)
+	require.True(t, va.DelegatedFree.IsZero())
+}
+
+func TestClawbackEntireAccount(t *testing.T) {
+	app := simapp.Setup(t, false)
+	now := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: now})
+	msgServer := vesting.NewMsgServerImpl(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000))
+	funder := addrs[0]
+	_, _, vestingAddr := testdata.KeyTestPubAddr()
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	coins := func(amt int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(bondDenom, amt)) }
+
+	// create an account without lockup that starts vesting in 100s
+	msg := types.NewMsgCreateClawbackVestingAccount(funder, vestingAddr, now.Unix()+100, nil,
+		types.Periods{{Length: 100, Amount: coins(500)}, {Length: 100, Amount: coins(500)}},
+	)
+	_, err := msgServer.CreateClawbackVestingAccount(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	// require all the coins to be clawed back before the start time
+	res, err := msgServer.Clawback(sdk.WrapSDKContext(ctx), types.NewMsgClawback(funder, vestingAddr, nil))
+	require.NoError(t, err)
+	require.Equal(t, coins(1000), res.Coins)
+
+	va := app.AccountKeeper.GetAccount(ctx, vestingAddr).(*types.ClawbackVestingAccount)
+	require.True(t, va.OriginalVesting.IsZero())
+	require.NoError(t, va.Validate())
+
+	// require the account to be exported and imported
+	cdc := app.AppCodec()
+	var genState authtypes.GenesisState
+	cdc.MustUnmarshalJSON(cdc.MustMarshalJSON(app.AccountKeeper.ExportGenesis(ctx)), &genState)
+	require.NoError(t, authtypes.ValidateGenesis(genState))
+
+	app2 := simapp.Setup(t, false)
+	ctx2 := app2.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: now})
+	app2.AccountKeeper.InitGenesis(ctx2, genState)
+	imported, ok := app2.AccountKeeper.GetAccount(ctx2, vestingAddr).(*types.ClawbackVestingAccount)
+	require.True(t, ok)
+	require.NoError(t, imported.Validate())
+	require.Equal(t, va.EndTime, imported.EndTime)
+	require.True(t, imported.GetVestingCoins(now).IsZero())
+}
+
+func TestAddPeriodicVestingGrant(t *testing.T) {
+	app := simapp.Setup(t, false)
+	now := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: now})
+	msgServer := vesting.NewMsgServerImpl(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000))
+	funder := addrs[0]
+	_, _, vestingAddr := testdata.KeyTestPubAddr()
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	coins := func(amt int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(bondDenom, amt)) }
+
+	// require the account to exist
+	grant := types.NewMsgAddPeriodicVestingGrant(funder, vestingAddr, now.Unix()+50, types.Periods{{Length: 100, Amount: coins(300)}})
+	require.Equal(t, []sdk.AccAddress{funder, vestingAddr}, grant.GetSigners())
+	require.NoError(t, grant.ValidateBasic())
+	tooManyPeriods := make(types.Periods, types.MaxGrantVestingPeriods+1)
+	for i := range tooManyPeriods {
+		tooManyPeriods[i] = types.Period{Length: 1, Amount: coins(1)}
+	}
+	require.ErrorIs(t, types.NewMsgAddPeriodicVestingGrant(funder, vestingAddr, now.Unix(), tooManyPeriods).ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	_, err := msgServer.AddPeriodicVestingGrant(sdk.WrapSDKContext(ctx), grant)
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	_, err = msgServer.CreatePeriodicVestingAccount(sdk.WrapSDKContext(ctx), types.NewMsgCreatePeriodicVestingAccount(
+		funder, vestingAddr, now.Unix(), types.Periods{{Length: 100, Amount: coins(100)}, {Length: 100, Amount: coins(100)}},
+	))
+	require.NoError(t, err)
+
+	// require only periodic vesting accounts to receive grants
+	_, err = msgServer.AddPeriodicVestingGrant(sdk.WrapSDKContext(ctx),
+		types.NewMsgAddPeriodicVestingGrant(funder, funder, now.Unix(), types.Periods{{Length: 100, Amount: coins(300)}}))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = msgServer.AddPeriodicVestingGrant(sdk.WrapSDKContext(ctx), grant)
+	require.NoError(t, err)
+
+	pva := app.AccountKeeper.GetAccount(ctx, vestingAddr).(*types.PeriodicVestingAccount)
+	require.Equal(t, coins(500), pva.OriginalVesting)
+	require.Equal(t, now.Unix(), pva.StartTime)
+	require.Equal(t, now.Unix()+200, pva.EndTime)
+	require.Equal(t, types.Periods{{Length: 100, Amount: coins(100)}, {Length: 50, Amount: coins(300)}, {Length: 50, Amount: coins(100)}}, types.Periods(pva.VestingPeriods))
+
+	require.Equal(t, coins(500), app.BankKeeper.GetAllBalances(ctx, vestingAddr))
+	require.Equal(t, coins(100), app.BankKeeper.SpendableCoins(ctx.WithBlockTime(now.Add(120*time.Second)), vestingAddr))
+	require.Equal(t, coins(400), app.BankKeeper.SpendableCoins(ctx.WithBlockTime(now.Add(150*time.Second)), vestingAddr))
+}
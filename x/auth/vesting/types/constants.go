@@ -9,4 +9,8 @@ const (
 
 	// RouterKey defines the module's message routing key
 	RouterKey = ModuleName
+
+	// MaxGrantVestingPeriods is the maximum number of vesting periods of a
+	// MsgAddPeriodicVestingGrant.
+	MaxGrantVestingPeriods = 1000
 )
@@ -16,12 +16,27 @@ const TypeMsgCreatePermanentLockedAccount = "msg_create_permanent_locked_account
 // TypeMsgCreatePeriodicVestingAccount defines the type value for a MsgCreateVestingAccount.
 const TypeMsgCreatePeriodicVestingAccount = "msg_create_periodic_vesting_account"
 
+// TypeMsgCreateClawbackVestingAccount defines the type value for a MsgCreateClawbackVestingAccount.
+const TypeMsgCreateClawbackVestingAccount = "msg_create_clawback_vesting_account"
+
+// TypeMsgClawback defines the type value for a MsgClawback.
+const TypeMsgClawback = "msg_clawback"
+
+// TypeMsgAddPeriodicVestingGrant defines the type value for a MsgAddPeriodicVestingGrant.
+const TypeMsgAddPeriodicVestingGrant = "msg_add_periodic_vesting_grant"
+
 var _ sdk.Msg = &MsgCreateVestingAccount{}
 
 var _ sdk.Msg = &MsgCreatePermanentLockedAccount{}
 
 var _ sdk.Msg = &MsgCreatePeriodicVestingAccount{}
 
+var _ sdk.Msg = &MsgCreateClawbackVestingAccount{}
+
+var _ sdk.Msg = &MsgClawback{}
+
+var _ sdk.Msg = &MsgAddPeriodicVestingGrant{}
+
 // NewMsgCreateVestingAccount returns a reference to a new MsgCreateVestingAccount.
 //
 //nolint:interfacer
@@ -197,3 +212,205 @@ func (msg MsgCreatePeriodicVestingAccount) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgCreateClawbackVestingAccount returns a reference to a new MsgCreateClawbackVestingAccount.
+//
+//nolint:interfacer
+func NewMsgCreateClawbackVestingAccount(fromAddr, toAddr sdk.AccAddress, startTime int64, lockupPeriods, vestingPeriods []Period) *MsgCreateClawbackVestingAccount {
+	return &MsgCreateClawbackVestingAccount{
+		FromAddress:    fromAddr.String(),
+		ToAddress:      toAddr.String(),
+		StartTime:      startTime,
+		LockupPeriods:  lockupPeriods,
+		VestingPeriods: vestingPeriods,
+	}
+}
+
+// Route returns the message route for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) Type() string { return TypeMsgCreateClawbackVestingAccount }
+
+// GetSigners returns the expected signers for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.FromAddress)
+	return []sdk.AccAddress{from}
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// TotalAmount returns the coins funding the account, which is the total of
+// the vesting periods or, if empty, of the lockup periods.
+func (msg MsgCreateClawbackVestingAccount) TotalAmount() sdk.Coins {
+	if len(msg.VestingPeriods) > 0 {
+		return Periods(msg.VestingPeriods).TotalAmount()
+	}
+	return Periods(msg.LockupPeriods).TotalAmount()
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateClawbackVestingAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.ToAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	if msg.StartTime < 1 {
+		return fmt.Errorf("invalid start time of %d, length must be greater than 0", msg.StartTime)
+	}
+
+	if len(msg.LockupPeriods) == 0 && len(msg.VestingPeriods) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("lockup and vesting periods cannot both be empty")
+	}
+
+	for _, periods := range [][]Period{msg.LockupPeriods, msg.VestingPeriods} {
+		for i, period := range periods {
+			if !period.Amount.IsValid() {
+				return sdkerrors.ErrInvalidCoins.Wrap(period.Amount.String())
+			}
+
+			if !period.Amount.IsAllPositive() {
+				return sdkerrors.ErrInvalidCoins.Wrap(period.Amount.String())
+			}
+
+			if period.Length < 1 {
+				return fmt.Errorf("invalid period length of %d in period %d, length must be greater than 0", period.Length, i)
+			}
+		}
+	}
+
+	if len(msg.LockupPeriods) > 0 && len(msg.VestingPeriods) > 0 &&
+		!Periods(msg.LockupPeriods).TotalAmount().IsEqual(Periods(msg.VestingPeriods).TotalAmount()) {
+		return sdkerrors.ErrInvalidRequest.Wrap("lockup and vesting periods must have the same total amount")
+	}
+
+	return nil
+}
+
+// NewMsgClawback returns a reference to a new MsgClawback.
+//
+//nolint:interfacer
+func NewMsgClawback(funder, addr, dest sdk.AccAddress) *MsgClawback {
+	var destAddr string
+	if dest != nil {
+		destAddr = dest.String()
+	}
+	return &MsgClawback{
+		FunderAddress: funder.String(),
+		Address:       addr.String(),
+		DestAddress:   destAddr,
+	}
+}
+
+// Route returns the message route for a MsgClawback.
+func (msg MsgClawback) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgClawback.
+func (msg MsgClawback) Type() string { return TypeMsgClawback }
+
+// GetSigners returns the expected signers for a MsgClawback.
+func (msg MsgClawback) GetSigners() []sdk.AccAddress {
+	funder, _ := sdk.AccAddressFromBech32(msg.FunderAddress)
+	return []sdk.AccAddress{funder}
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgClawback.
+func (msg MsgClawback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgClawback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FunderAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid funder address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid account address: %s", err)
+	}
+	if msg.DestAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.DestAddress); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid destination address: %s", err)
+		}
+	}
+	return nil
+}
+
+// NewMsgAddPeriodicVestingGrant returns a reference to a new MsgAddPeriodicVestingGrant.
+//
+//nolint:interfacer
+func NewMsgAddPeriodicVestingGrant(fromAddr, toAddr sdk.AccAddress, startTime int64, periods []Period) *MsgAddPeriodicVestingGrant {
+	return &MsgAddPeriodicVestingGrant{
+		FromAddress:    fromAddr.String(),
+		ToAddress:      toAddr.String(),
+		StartTime:      startTime,
+		VestingPeriods: periods,
+	}
+}
+
+// Route returns the message route for a MsgAddPeriodicVestingGrant.
+func (msg MsgAddPeriodicVestingGrant) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgAddPeriodicVestingGrant.
+func (msg MsgAddPeriodicVestingGrant) Type() string { return TypeMsgAddPeriodicVestingGrant }
+
+// GetSigners returns the expected signers for a MsgAddPeriodicVestingGrant:
+// the funder of the grant and the periodic vesting account receiving it.
+func (msg MsgAddPeriodicVestingGrant) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.FromAddress)
+	to, _ := sdk.AccAddressFromBech32(msg.ToAddress)
+	if from.Equals(to) {
+		return []sdk.AccAddress{from}
+	}
+	return []sdk.AccAddress{from, to}
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgAddPeriodicVestingGrant.
+func (msg MsgAddPeriodicVestingGrant) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgAddPeriodicVestingGrant) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.ToAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	if msg.StartTime < 1 {
+		return fmt.Errorf("invalid start time of %d, length must be greater than 0", msg.StartTime)
+	}
+
+	if len(msg.VestingPeriods) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("vesting periods cannot be empty")
+	}
+	if len(msg.VestingPeriods) > MaxGrantVestingPeriods {
+		return sdkerrors.ErrInvalidRequest.Wrapf("too many vesting periods: %d > %d", len(msg.VestingPeriods), MaxGrantVestingPeriods)
+	}
+
+	for i, period := range msg.VestingPeriods {
+		if !period.Amount.IsValid() {
+			return sdkerrors.ErrInvalidCoins.Wrap(period.Amount.String())
+		}
+
+		if !period.Amount.IsAllPositive() {
+			return sdkerrors.ErrInvalidCoins.Wrap(period.Amount.String())
+		}
+
+		if period.Length < 1 {
+			return fmt.Errorf("invalid period length of %d in period %d, length must be greater than 0", period.Length, i)
+		}
+	}
+
+	return nil
+}
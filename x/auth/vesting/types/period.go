@@ -48,6 +48,44 @@ func (p Periods) TotalAmount() sdk.Coins {
 	return total
 }
 
+// MergePeriods merges the schedules p and q, starting at startP and startQ,
+// into a single schedule vesting at any time the sum of the coins vested by
+// both schedules. It returns the start time and the periods of the merged
+// schedule.
+func MergePeriods(startP int64, p Periods, startQ int64, q Periods) (int64, Periods) {
+	startTime := startP
+	if startQ < startTime {
+		startTime = startQ
+	}
+
+	merged := Periods{}
+	last := startTime
+	add := func(t int64, amount sdk.Coins) {
+		if len(merged) > 0 && t == last {
+			merged[len(merged)-1].Amount = merged[len(merged)-1].Amount.Add(amount...)
+			return
+		}
+		merged = append(merged, Period{Length: t - last, Amount: amount})
+		last = t
+	}
+
+	timeP, timeQ := startP, startQ
+	i, j := 0, 0
+	for i < len(p) || j < len(q) {
+		if j == len(q) || (i < len(p) && timeP+p[i].Length <= timeQ+q[j].Length) {
+			timeP += p[i].Length
+			add(timeP, p[i].Amount)
+			i++
+		} else {
+			timeQ += q[j].Length
+			add(timeQ, q[j].Amount)
+			j++
+		}
+	}
+
+	return startTime, merged
+}
+
 // String implements the fmt.Stringer interface
 func (p Periods) String() string {
 	periodsListString := make([]string, len(p))
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"sort"
+	"time"
+
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+)
+
+// GetUnlockEvents returns the events at which coins of the vesting account
+// become vested and unlocked after the given time, in chronological order.
+// Continuously vesting coins are returned as a single event at the end time of
+// the account.
+func GetUnlockEvents(va vestexported.VestingAccount, after time.Time) []UnlockEvent {
+	var times []int64
+	switch va := va.(type) {
+	case *PeriodicVestingAccount:
+		times = scheduleTimes(va.StartTime, va.VestingPeriods)
+	case *ClawbackVestingAccount:
+		times = append(scheduleTimes(va.StartTime, va.LockupPeriods), scheduleTimes(va.StartTime, va.VestingPeriods)...)
+	default:
+		times = []int64{va.GetEndTime()}
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i] < times[j] })
+
+	events := []UnlockEvent{}
+	vested := va.GetVestedCoins(after)
+	for i, t := range times {
+		if t <= after.Unix() || (i > 0 && t == times[i-1]) {
+			continue
+		}
+
+		eventTime := time.Unix(t, 0).UTC()
+		eventVested := va.GetVestedCoins(eventTime)
+		amount := eventVested.Sub(vested...)
+		if amount.IsZero() {
+			continue
+		}
+
+		events = append(events, UnlockEvent{Time: eventTime, Amount: amount})
+		vested = eventVested
+	}
+
+	return events
+}
+
+// scheduleTimes returns the end times of the periods of a schedule starting at
+// startTime.
+func scheduleTimes(startTime int64, periods []Period) []int64 {
+	times := make([]int64, len(periods))
+	t := startTime
+	for i, p := range periods {
+		t += p.Length
+		times[i] = t
+	}
+	return times
+}
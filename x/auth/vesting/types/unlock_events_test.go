@@ -0,0 +1,58 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func TestGetUnlockEvents(t *testing.T) {
+	now := time.Unix(1000000, 0).UTC()
+	_, _, funder := testdata.KeyTestPubAddr()
+	coins := func(fee, stake int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(feeDenom, fee), sdk.NewInt64Coin(stakeDenom, stake))
+	}
+	periods := types.Periods{
+		{Length: int64(12 * 60 * 60), Amount: coins(500, 50)},
+		{Length: int64(6 * 60 * 60), Amount: coins(250, 25)},
+		{Length: int64(6 * 60 * 60), Amount: coins(250, 25)},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	pva := types.NewPeriodicVestingAccount(bacc, origCoins, now.Unix(), periods)
+	require.Equal(t, []types.UnlockEvent{
+		{Time: now.Add(12 * time.Hour), Amount: coins(500, 50)},
+		{Time: now.Add(18 * time.Hour), Amount: coins(250, 25)},
+		{Time: now.Add(24 * time.Hour), Amount: coins(250, 25)},
+	}, types.GetUnlockEvents(pva, now))
+
+	// require the past events not to be returned
+	require.Equal(t, []types.UnlockEvent{
+		{Time: now.Add(24 * time.Hour), Amount: coins(250, 25)},
+	}, types.GetUnlockEvents(pva, now.Add(18*time.Hour)))
+	require.Empty(t, types.GetUnlockEvents(pva, now.Add(24*time.Hour)))
+
+	// require the coins to unlock once both vested and unlocked
+	cva := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(),
+		types.Periods{{Length: int64(15 * 60 * 60), Amount: origCoins}}, periods)
+	require.Equal(t, []types.UnlockEvent{
+		{Time: now.Add(15 * time.Hour), Amount: coins(500, 50)},
+		{Time: now.Add(18 * time.Hour), Amount: coins(250, 25)},
+		{Time: now.Add(24 * time.Hour), Amount: coins(250, 25)},
+	}, types.GetUnlockEvents(cva, now))
+
+	// require the remaining continuously vesting coins to unlock at the end time
+	cont := types.NewContinuousVestingAccount(bacc, origCoins, now.Unix(), now.Add(24*time.Hour).Unix())
+	require.Equal(t, []types.UnlockEvent{
+		{Time: now.Add(24 * time.Hour), Amount: coins(500, 50)},
+	}, types.GetUnlockEvents(cont, now.Add(12*time.Hour)))
+
+	// require permanently locked coins never to unlock
+	plva := types.NewPermanentLockedAccount(bacc, origCoins)
+	require.Empty(t, types.GetUnlockEvents(plva, now))
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"sigs.k8s.io/yaml"
@@ -164,6 +165,8 @@ type vestingAccountYAML struct {
 	// custom fields based on concrete vesting type which can be omitted
 	StartTime      int64   `json:"start_time,omitempty"`
 	VestingPeriods Periods `json:"vesting_periods,omitempty"`
+	FunderAddress  string  `json:"funder_address,omitempty"`
+	LockupPeriods  Periods `json:"lockup_periods,omitempty"`
 }
 
 func (bva BaseVestingAccount) String() string {
@@ -405,6 +408,18 @@ func (pva PeriodicVestingAccount) GetVestingPeriods() Periods {
 	return pva.VestingPeriods
 }
 
+// AddGrant merges a grant vesting over grantPeriods from grantStartTime into
+// the vesting schedule of the account. The granted coins are added to the
+// original vesting coins, and must be transferred to the account separately.
+func (pva *PeriodicVestingAccount) AddGrant(grantStartTime int64, grantPeriods Periods) {
+	startTime, periods := MergePeriods(pva.StartTime, pva.VestingPeriods, grantStartTime, grantPeriods)
+
+	pva.StartTime = startTime
+	pva.EndTime = startTime + periods.TotalLength()
+	pva.VestingPeriods = periods
+	pva.OriginalVesting = pva.OriginalVesting.Add(grantPeriods.TotalAmount()...)
+}
+
 // Validate checks for errors on the account fields
 func (pva PeriodicVestingAccount) Validate() error {
 	if pva.GetStartTime() >= pva.GetEndTime() {
@@ -591,6 +606,219 @@ func (plva PermanentLockedAccount) String() string {
 	return out.(string)
 }
 
+//-----------------------------------------------------------------------------
+// Clawback Vesting Account
+
+var (
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
+	_ authtypes.GenesisAccount    = (*ClawbackVestingAccount)(nil)
+)
+
+// NewClawbackVestingAccount returns a new ClawbackVestingAccount. Empty lockup
+// periods mean that all the coins are unlocked, and empty vesting periods that
+// all the coins are vested.
+func NewClawbackVestingAccount(baseAcc *authtypes.BaseAccount, funder sdk.AccAddress, originalVesting sdk.Coins, startTime int64, lockupPeriods, vestingPeriods Periods) *ClawbackVestingAccount {
+	endTime := startTime + max64(lockupPeriods.TotalLength(), vestingPeriods.TotalLength())
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: originalVesting,
+		EndTime:         endTime,
+	}
+
+	return &ClawbackVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		FunderAddress:      funder.String(),
+		StartTime:          startTime,
+		LockupPeriods:      lockupPeriods,
+		VestingPeriods:     vestingPeriods,
+	}
+}
+
+// GetVestedOnly returns the coins vested by the vesting schedule, whether they
+// are unlocked or not.
+func (va ClawbackVestingAccount) GetVestedOnly(blockTime time.Time) sdk.Coins {
+	if len(va.VestingPeriods) == 0 {
+		return va.OriginalVesting
+	}
+	return readSchedule(va.StartTime, va.VestingPeriods, blockTime)
+}
+
+// GetUnlockedOnly returns the coins unlocked by the lockup schedule, whether
+// they are vested or not.
+func (va ClawbackVestingAccount) GetUnlockedOnly(blockTime time.Time) sdk.Coins {
+	if len(va.LockupPeriods) == 0 {
+		return va.OriginalVesting
+	}
+	return readSchedule(va.StartTime, va.LockupPeriods, blockTime)
+}
+
+// GetVestedCoins returns the coins that are both vested and unlocked. If no
+// coins are vested, nil is returned.
+func (va ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	return va.GetVestedOnly(blockTime).Min(va.GetUnlockedOnly(blockTime))
+}
+
+// GetVestingCoins returns the coins that are either not vested or not
+// unlocked yet.
+func (va ClawbackVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return va.OriginalVesting.Sub(va.GetVestedCoins(blockTime)...)
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked),
+// defined as the vesting coins that are not delegated.
+func (va ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return va.BaseVestingAccount.LockedCoinsFromVesting(va.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (va *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	va.BaseVestingAccount.TrackDelegation(balance, va.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting and unlocking start for a
+// clawback vesting account.
+func (va ClawbackVestingAccount) GetStartTime() int64 {
+	return va.StartTime
+}
+
+// GetFunder returns the address of the funder of the account.
+func (va ClawbackVestingAccount) GetFunder() sdk.AccAddress {
+	funder, _ := sdk.AccAddressFromBech32(va.FunderAddress)
+	return funder
+}
+
+// ComputeClawback removes the unvested coins from the account at the given
+// time, truncating the vesting schedule to its elapsed periods and capping the
+// lockup schedule to the remaining coins, and returns them. The unvested coins
+// are removed whether they are unlocked or not. It is the caller's
+// responsibility to transfer the returned coins out of the account.
+func (va *ClawbackVestingAccount) ComputeClawback(blockTime time.Time) sdk.Coins {
+	unvested := va.OriginalVesting.Sub(va.GetVestedOnly(blockTime)...)
+	if unvested.IsZero() {
+		return sdk.NewCoins()
+	}
+
+	// keep the vesting periods that already elapsed, the others are unvested
+	periodStartTime := va.StartTime
+	elapsed := 0
+	for _, period := range va.VestingPeriods {
+		if blockTime.Unix() <= va.StartTime || blockTime.Unix()-periodStartTime < period.Length {
+			break
+		}
+		periodStartTime += period.Length
+		elapsed++
+	}
+	va.VestingPeriods = va.VestingPeriods[:elapsed]
+	va.OriginalVesting = va.OriginalVesting.Sub(unvested...)
+
+	// an account clawed back entirely keeps an empty vesting period so that its
+	// schedules are still valid
+	if len(va.VestingPeriods) == 0 && len(va.LockupPeriods) == 0 {
+		va.VestingPeriods = []Period{{Length: 0, Amount: sdk.NewCoins()}}
+	}
+
+	// the lockup schedule cannot unlock more than the remaining coins
+	remaining := va.OriginalVesting
+	lockupPeriods := make(Periods, len(va.LockupPeriods))
+	for i, period := range va.LockupPeriods {
+		amount := period.Amount.Min(remaining)
+		remaining = remaining.Sub(amount...)
+		lockupPeriods[i] = Period{Length: period.Length, Amount: amount}
+	}
+	va.LockupPeriods = lockupPeriods
+	va.EndTime = va.StartTime + max64(Periods(va.LockupPeriods).TotalLength(), Periods(va.VestingPeriods).TotalLength())
+
+	va.ReallocateDelegations(blockTime)
+	return unvested
+}
+
+// ReallocateDelegations splits the delegated coins between delegated vesting
+// and delegated free coins as if they were delegated at the given time, so
+// that the delegated vesting coins don't exceed the vesting coins after the
+// schedules of the account changed.
+func (va *ClawbackVestingAccount) ReallocateDelegations(blockTime time.Time) {
+	delegated := va.DelegatedVesting.Add(va.DelegatedFree...)
+	va.DelegatedVesting = delegated.Min(va.GetVestingCoins(blockTime))
+	va.DelegatedFree = delegated.Sub(va.DelegatedVesting...)
+}
+
+// Validate checks for errors on the account fields
+func (va ClawbackVestingAccount) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(va.FunderAddress); err != nil {
+		return fmt.Errorf("invalid funder address: %w", err)
+	}
+	if len(va.LockupPeriods) == 0 && len(va.VestingPeriods) == 0 {
+		return errors.New("lockup and vesting periods cannot both be empty")
+	}
+	if endTime := va.StartTime + max64(Periods(va.LockupPeriods).TotalLength(), Periods(va.VestingPeriods).TotalLength()); endTime != va.EndTime {
+		return errors.New("vesting end time does not match length of all lockup and vesting periods")
+	}
+	if len(va.LockupPeriods) > 0 && !Periods(va.LockupPeriods).TotalAmount().IsEqual(va.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in lockup periods")
+	}
+	if len(va.VestingPeriods) > 0 && !Periods(va.VestingPeriods).TotalAmount().IsEqual(va.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in vesting periods")
+	}
+
+	return va.BaseVestingAccount.Validate()
+}
+
+func (va ClawbackVestingAccount) String() string {
+	out, _ := va.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a ClawbackVestingAccount.
+func (va ClawbackVestingAccount) MarshalYAML() (interface{}, error) {
+	accAddr, err := sdk.AccAddressFromBech32(va.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	out := vestingAccountYAML{
+		Address:          accAddr,
+		AccountNumber:    va.AccountNumber,
+		PubKey:           getPKString(va),
+		Sequence:         va.Sequence,
+		OriginalVesting:  va.OriginalVesting,
+		DelegatedFree:    va.DelegatedFree,
+		DelegatedVesting: va.DelegatedVesting,
+		EndTime:          va.EndTime,
+		StartTime:        va.StartTime,
+		VestingPeriods:   va.VestingPeriods,
+		FunderAddress:    va.FunderAddress,
+		LockupPeriods:    va.LockupPeriods,
+	}
+	return marshalYaml(out)
+}
+
+// readSchedule returns the coins of the periods elapsed at the given time.
+func readSchedule(startTime int64, periods Periods, blockTime time.Time) sdk.Coins {
+	coins := sdk.NewCoins()
+	if blockTime.Unix() <= startTime {
+		return coins
+	}
+
+	periodStartTime := startTime
+	for _, period := range periods {
+		if blockTime.Unix()-periodStartTime < period.Length {
+			break
+		}
+		coins = coins.Add(period.Amount...)
+		periodStartTime += period.Length
+	}
+	return coins
+}
+
+func max64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
 type getPK interface {
 	GetPubKey() cryptotypes.PubKey
 }
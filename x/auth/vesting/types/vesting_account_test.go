@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"math/rand"
 	"testing"
 	"time"
 
@@ -677,6 +678,179 @@ func TestTrackUndelegationPermLockedVestingAcc(t *testing.T) {
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, plva.DelegatedVesting)
 }
 
+func TestGetVestedCoinsClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	_, _, funder := testdata.KeyTestPubAddr()
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(18 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+	require.NoError(t, va.Validate())
+	require.Equal(t, now.Add(24*time.Hour).Unix(), va.GetEndTime())
+
+	// require no coins vested nor unlocked at the beginning of the schedules
+	require.True(t, va.GetVestedCoins(now).IsZero())
+	require.Equal(t, origCoins, va.LockedCoins(now))
+
+	// require vested coins to stay locked until the end of the lockup
+	require.Equal(t, vestingPeriods[0].Amount, va.GetVestedOnly(now.Add(12*time.Hour)))
+	require.True(t, va.GetVestedCoins(now.Add(12*time.Hour)).IsZero())
+
+	// require unlocked coins to be spendable once vested only
+	require.Equal(t, origCoins, va.GetUnlockedOnly(now.Add(18*time.Hour)))
+	require.Equal(t, vestingPeriods[0].Amount, va.GetVestedCoins(now.Add(18*time.Hour)))
+	require.Equal(t, vestingPeriods[1].Amount, va.LockedCoins(now.Add(18*time.Hour)))
+
+	// require all coins vested at the end of the schedules
+	require.Equal(t, origCoins, va.GetVestedCoins(now.Add(24*time.Hour)))
+	require.True(t, va.GetVestingCoins(now.Add(24*time.Hour)).IsZero())
+
+	// require all coins unlocked without lockup schedule
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), nil, vestingPeriods)
+	require.NoError(t, va.Validate())
+	require.Equal(t, vestingPeriods[0].Amount, va.GetVestedCoins(now.Add(12*time.Hour)))
+}
+
+func TestComputeClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	_, _, funder := testdata.KeyTestPubAddr()
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 200), sdk.NewInt64Coin(stakeDenom, 20)}},
+		types.Period{Length: int64(30 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 800), sdk.NewInt64Coin(stakeDenom, 80)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+
+	// delegate 80 locked stake
+	va.TrackDelegation(now, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 80)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 80)}, va.DelegatedVesting)
+
+	// require the coins of the second vesting period to be clawed back
+	unvested := va.ComputeClawback(now.Add(15 * time.Hour))
+	require.Equal(t, vestingPeriods[1].Amount, unvested)
+	require.Equal(t, vestingPeriods[0].Amount, va.OriginalVesting)
+	require.Equal(t, vestingPeriods[:1], types.Periods(va.VestingPeriods))
+	require.Equal(t, lockupPeriods[0].Amount, va.LockupPeriods[0].Amount)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 300), sdk.NewInt64Coin(stakeDenom, 30)}, va.LockupPeriods[1].Amount)
+	require.Equal(t, now.Add(36*time.Hour).Unix(), va.EndTime)
+	require.NoError(t, va.Validate())
+
+	// require the delegated vesting coins not to exceed the vesting coins anymore
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 30)}, va.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, va.DelegatedFree)
+
+	// require nothing more to claw back
+	require.True(t, va.ComputeClawback(now.Add(15*time.Hour)).IsZero())
+}
+
+func TestComputeClawbackBeforeStartVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	_, _, funder := testdata.KeyTestPubAddr()
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), nil, vestingPeriods)
+
+	// require the whole account to be clawed back at the start time and the
+	// account to remain valid
+	require.Equal(t, origCoins, va.ComputeClawback(now))
+	require.True(t, va.OriginalVesting.IsZero())
+	require.Equal(t, now.Unix(), va.EndTime)
+	require.NoError(t, va.Validate())
+	require.True(t, va.GetVestingCoins(now).IsZero())
+	require.True(t, va.ComputeClawback(now.Add(time.Hour)).IsZero())
+}
+
+func TestMergePeriods(t *testing.T) {
+	coins := func(amt int64) sdk.Coins { return sdk.Coins{sdk.NewInt64Coin(stakeDenom, amt)} }
+
+	startTime, merged := types.MergePeriods(
+		100, types.Periods{{Length: 10, Amount: coins(1)}, {Length: 10, Amount: coins(2)}},
+		95, types.Periods{{Length: 15, Amount: coins(4)}, {Length: 20, Amount: coins(8)}},
+	)
+	require.Equal(t, int64(95), startTime)
+	require.Equal(t, types.Periods{
+		{Length: 15, Amount: coins(5)},
+		{Length: 10, Amount: coins(2)},
+		{Length: 10, Amount: coins(8)},
+	}, merged)
+
+	// require an empty schedule to leave the other one unchanged
+	startTime, merged = types.MergePeriods(100, types.Periods{{Length: 10, Amount: coins(1)}}, 100, nil)
+	require.Equal(t, int64(100), startTime)
+	require.Equal(t, types.Periods{{Length: 10, Amount: coins(1)}}, merged)
+}
+
+func TestAddGrantPeriodicVestingAcc(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	randomSchedule := func() (int64, types.Periods) {
+		periods := make(types.Periods, 1+r.Intn(5))
+		for i := range periods {
+			periods[i] = types.Period{
+				Length: int64(1 + r.Intn(50)),
+				Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, int64(1+r.Intn(100))), sdk.NewInt64Coin(stakeDenom, int64(1+r.Intn(100)))},
+			}
+		}
+		return int64(1000 + r.Intn(100)), periods
+	}
+
+	for i := 0; i < 100; i++ {
+		bacc, _ := initBaseAccount()
+		startTime, periods := randomSchedule()
+		grantStartTime, grantPeriods := randomSchedule()
+
+		pva := types.NewPeriodicVestingAccount(bacc, periods.TotalAmount(), startTime, periods)
+		orig := types.NewPeriodicVestingAccount(bacc, periods.TotalAmount(), startTime, periods)
+		grant := types.NewPeriodicVestingAccount(bacc, grantPeriods.TotalAmount(), grantStartTime, grantPeriods)
+
+		// delegate some of the stake before adding the grant
+		delegateTime := time.Unix(startTime+int64(r.Intn(300)), 0)
+		delegated := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 1+r.Int63n(pva.OriginalVesting.AmountOf(stakeDenom).Int64()))}
+		pva.TrackDelegation(delegateTime, pva.OriginalVesting, delegated)
+		orig.TrackDelegation(delegateTime, orig.OriginalVesting, delegated)
+		balance := pva.OriginalVesting.Sub(delegated...).Add(grant.OriginalVesting...)
+
+		pva.AddGrant(grantStartTime, grantPeriods)
+		require.NoError(t, pva.Validate())
+		require.Equal(t, orig.OriginalVesting.Add(grant.OriginalVesting...), pva.OriginalVesting)
+		require.Equal(t, max64(orig.EndTime, grant.EndTime), pva.EndTime)
+
+		// require the merged schedule to vest the coins of both schedules
+		for ts := pva.StartTime - 5; ts <= pva.EndTime+5; ts++ {
+			blockTime := time.Unix(ts, 0)
+			require.Equal(t, orig.GetVestedCoins(blockTime).Add(grant.GetVestedCoins(blockTime)...), pva.GetVestedCoins(blockTime))
+
+			vesting := orig.GetVestingCoins(blockTime).Add(grant.GetVestingCoins(blockTime)...)
+			require.Equal(t, vesting, pva.GetVestingCoins(blockTime))
+			require.Equal(t, orig.LockedCoinsFromVesting(vesting), pva.LockedCoins(blockTime))
+			if !blockTime.Before(delegateTime) {
+				require.True(t, balance.IsAllGTE(pva.LockedCoins(blockTime)))
+			}
+		}
+	}
+}
+
+func max64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
 func TestGenesisAccountValidate(t *testing.T) {
 	pubkey := secp256k1.GenPrivKey().PubKey()
 	addr := sdk.AccAddress(pubkey.Address())
@@ -742,6 +916,28 @@ func TestGenesisAccountValidate(t *testing.T) {
 			&types.PermanentLockedAccount{BaseVestingAccount: baseVestingWithCoins},
 			true,
 		},
+		{
+			"valid clawback vesting account",
+			types.NewClawbackVestingAccount(baseAcc, addr, initialVesting, 0, nil, types.Periods{types.Period{Length: int64(100), Amount: initialVesting}}),
+			false,
+		},
+		{
+			"invalid clawback vesting account funder",
+			types.NewClawbackVestingAccount(baseAcc, nil, initialVesting, 0, nil, types.Periods{types.Period{Length: int64(100), Amount: initialVesting}}),
+			true,
+		},
+		{
+			"invalid clawback vesting account without periods",
+			types.NewClawbackVestingAccount(baseAcc, addr, initialVesting, 0, nil, nil),
+			true,
+		},
+		{
+			"invalid clawback lockup period amounts",
+			types.NewClawbackVestingAccount(baseAcc, addr, initialVesting, 0,
+				types.Periods{types.Period{Length: int64(100), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)}}},
+				types.Periods{types.Period{Length: int64(100), Amount: initialVesting}}),
+			true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -811,6 +1007,26 @@ func (s *VestingAccountTestSuite) TestDelayedVestingAccountMarshal() {
 	require.NotNil(err)
 }
 
+func (s *VestingAccountTestSuite) TestClawbackVestingAccountMarshal() {
+	app := s.app
+	require := s.Require()
+	baseAcc, coins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	acc := types.NewClawbackVestingAccount(baseAcc, funder, coins, time.Now().Unix(), nil, types.Periods{types.Period{3600, coins}})
+
+	bz, err := app.AccountKeeper.MarshalAccount(acc)
+	require.Nil(err)
+
+	acc2, err := app.AccountKeeper.UnmarshalAccount(bz)
+	require.Nil(err)
+	require.IsType(&types.ClawbackVestingAccount{}, acc2)
+	require.Equal(acc.String(), acc2.String())
+
+	// error on bad bytes
+	_, err = app.AccountKeeper.UnmarshalAccount(bz[:len(bz)/2])
+	require.NotNil(err)
+}
+
 func (s *VestingAccountTestSuite) TestPermanentLockedAccountMarshal() {
 	app := s.app
 	require := s.Require()
@@ -6,10 +6,13 @@ package authz
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	types "github.com/cosmos/cosmos-sdk/codec/types"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -111,10 +114,206 @@ func (m *CountAuthorization) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CountAuthorization proto.InternalMessageInfo
 
+// CoinBudgetAuthorization gives the grantee permissions to execute the provided
+// method on behalf of the granter's account, as long as the coins found in the
+// executed messages stay within a spend limit, optionally reset every period.
+type CoinBudgetAuthorization struct {
+	// Msg, identified by it's type URL, to grant permissions to execute
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// spend_limit is the maximum amount of coins the executed messages can move
+	// in total. If empty, there is no total limit.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// period specifies the time duration in which period_spend_limit coins can
+	// be moved before that budget is reset. If zero, there is no periodic limit.
+	Period time.Duration `protobuf:"bytes,3,opt,name=period,proto3,stdduration" json:"period"`
+	// period_spend_limit specifies the maximum amount of coins that can be moved
+	// in the period
+	PeriodSpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=period_spend_limit,json=periodSpendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_spend_limit"`
+	// period_can_spend is the amount of coins left to be moved before the
+	// period_reset time
+	PeriodCanSpend github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=period_can_spend,json=periodCanSpend,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_can_spend"`
+	// period_reset is the time at which this period resets and a new one begins,
+	// it is calculated from the start time of the first execution after the
+	// last period ended
+	PeriodReset time.Time `protobuf:"bytes,6,opt,name=period_reset,json=periodReset,proto3,stdtime" json:"period_reset"`
+}
+
+func (m *CoinBudgetAuthorization) Reset()         { *m = CoinBudgetAuthorization{} }
+func (m *CoinBudgetAuthorization) String() string { return proto.CompactTextString(m) }
+func (*CoinBudgetAuthorization) ProtoMessage()    {}
+func (*CoinBudgetAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{2}
+}
+func (m *CoinBudgetAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CoinBudgetAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CoinBudgetAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CoinBudgetAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CoinBudgetAuthorization.Merge(m, src)
+}
+func (m *CoinBudgetAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *CoinBudgetAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_CoinBudgetAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CoinBudgetAuthorization proto.InternalMessageInfo
+
+// ConditionalAuthorization wraps an authorization with grant conditions, which
+// are checked before delegating to the wrapped authorization.
+type ConditionalAuthorization struct {
+	// authorization is the wrapped authorization.
+	Authorization *types1.Any `protobuf:"bytes,1,opt,name=authorization,proto3" json:"authorization,omitempty"`
+	// conditions are the conditions checked before each execution.
+	Conditions GrantConditions `protobuf:"bytes,2,opt,name=conditions,proto3" json:"conditions"`
+	// window_start_height is the height at which the current block window,
+	// used to limit the number of executions, started.
+	WindowStartHeight int64 `protobuf:"varint,3,opt,name=window_start_height,json=windowStartHeight,proto3" json:"window_start_height,omitempty"`
+	// window_executions is the number of executions in the current block window.
+	WindowExecutions uint64 `protobuf:"varint,4,opt,name=window_executions,json=windowExecutions,proto3" json:"window_executions,omitempty"`
+}
+
+func (m *ConditionalAuthorization) Reset()         { *m = ConditionalAuthorization{} }
+func (m *ConditionalAuthorization) String() string { return proto.CompactTextString(m) }
+func (*ConditionalAuthorization) ProtoMessage()    {}
+func (*ConditionalAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{3}
+}
+func (m *ConditionalAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConditionalAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConditionalAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConditionalAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConditionalAuthorization.Merge(m, src)
+}
+func (m *ConditionalAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConditionalAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConditionalAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConditionalAuthorization proto.InternalMessageInfo
+
+// GrantConditions are reusable conditions attached to a grant. Empty
+// conditions are not enforced.
+type GrantConditions struct {
+	// time_windows are the daily UTC time windows during which the grant can be
+	// executed.
+	TimeWindows []TimeWindow `protobuf:"bytes,1,rep,name=time_windows,json=timeWindows,proto3" json:"time_windows"`
+	// allowed_recipients are the only addresses, other than the granter, that
+	// the executed messages can carry.
+	AllowedRecipients []string `protobuf:"bytes,2,rep,name=allowed_recipients,json=allowedRecipients,proto3" json:"allowed_recipients,omitempty"`
+	// max_executions is the max number of executions per block window.
+	MaxExecutions uint64 `protobuf:"varint,3,opt,name=max_executions,json=maxExecutions,proto3" json:"max_executions,omitempty"`
+	// block_window is the number of blocks of a block window.
+	BlockWindow int64 `protobuf:"varint,4,opt,name=block_window,json=blockWindow,proto3" json:"block_window,omitempty"`
+	// co_signers are the addresses which must co-sign each execution.
+	CoSigners []string `protobuf:"bytes,5,rep,name=co_signers,json=coSigners,proto3" json:"co_signers,omitempty"`
+}
+
+func (m *GrantConditions) Reset()         { *m = GrantConditions{} }
+func (m *GrantConditions) String() string { return proto.CompactTextString(m) }
+func (*GrantConditions) ProtoMessage()    {}
+func (*GrantConditions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{4}
+}
+func (m *GrantConditions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GrantConditions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GrantConditions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GrantConditions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantConditions.Merge(m, src)
+}
+func (m *GrantConditions) XXX_Size() int {
+	return m.Size()
+}
+func (m *GrantConditions) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantConditions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantConditions proto.InternalMessageInfo
+
+// TimeWindow is a daily UTC time window. A window whose end is before its start
+// spans midnight.
+type TimeWindow struct {
+	// start is the time elapsed since midnight UTC at which the window opens.
+	Start time.Duration `protobuf:"bytes,1,opt,name=start,proto3,stdduration" json:"start"`
+	// end is the time elapsed since midnight UTC at which the window closes.
+	End time.Duration `protobuf:"bytes,2,opt,name=end,proto3,stdduration" json:"end"`
+}
+
+func (m *TimeWindow) Reset()         { *m = TimeWindow{} }
+func (m *TimeWindow) String() string { return proto.CompactTextString(m) }
+func (*TimeWindow) ProtoMessage()    {}
+func (*TimeWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{5}
+}
+func (m *TimeWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimeWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimeWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeWindow.Merge(m, src)
+}
+func (m *TimeWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeWindow proto.InternalMessageInfo
+
 // Grant gives permissions to execute
 // the provide method with expiration time.
 type Grant struct {
-	Authorization *types.Any `protobuf:"bytes,1,opt,name=authorization,proto3" json:"authorization,omitempty"`
+	Authorization *types1.Any `protobuf:"bytes,1,opt,name=authorization,proto3" json:"authorization,omitempty"`
 	// time when the grant will expire and will be pruned. If null, then the grant
 	// doesn't have a time expiration (other conditions  in `authorization`
 	// may apply to invalidate the grant)
@@ -125,7 +324,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{2}
+	return fileDescriptor_544dc2e84b61c637, []int{6}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -157,17 +356,17 @@ var xxx_messageInfo_Grant proto.InternalMessageInfo
 // GrantAuthorization extends a grant with both the addresses of the grantee and granter.
 // It is used in genesis.proto and query.proto
 type GrantAuthorization struct {
-	Granter       string     `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
-	Grantee       string     `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
-	Authorization *types.Any `protobuf:"bytes,3,opt,name=authorization,proto3" json:"authorization,omitempty"`
-	Expiration    *time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+	Granter       string      `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	Grantee       string      `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	Authorization *types1.Any `protobuf:"bytes,3,opt,name=authorization,proto3" json:"authorization,omitempty"`
+	Expiration    *time.Time  `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
 }
 
 func (m *GrantAuthorization) Reset()         { *m = GrantAuthorization{} }
 func (m *GrantAuthorization) String() string { return proto.CompactTextString(m) }
 func (*GrantAuthorization) ProtoMessage()    {}
 func (*GrantAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{3}
+	return fileDescriptor_544dc2e84b61c637, []int{7}
 }
 func (m *GrantAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -206,7 +405,7 @@ func (m *GrantQueueItem) Reset()         { *m = GrantQueueItem{} }
 func (m *GrantQueueItem) String() string { return proto.CompactTextString(m) }
 func (*GrantQueueItem) ProtoMessage()    {}
 func (*GrantQueueItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{4}
+	return fileDescriptor_544dc2e84b61c637, []int{8}
 }
 func (m *GrantQueueItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -238,6 +437,10 @@ var xxx_messageInfo_GrantQueueItem proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*GenericAuthorization)(nil), "cosmos.authz.v1beta1.GenericAuthorization")
 	proto.RegisterType((*CountAuthorization)(nil), "cosmos.authz.v1beta1.CountAuthorization")
+	proto.RegisterType((*CoinBudgetAuthorization)(nil), "cosmos.authz.v1beta1.CoinBudgetAuthorization")
+	proto.RegisterType((*ConditionalAuthorization)(nil), "cosmos.authz.v1beta1.ConditionalAuthorization")
+	proto.RegisterType((*GrantConditions)(nil), "cosmos.authz.v1beta1.GrantConditions")
+	proto.RegisterType((*TimeWindow)(nil), "cosmos.authz.v1beta1.TimeWindow")
 	proto.RegisterType((*Grant)(nil), "cosmos.authz.v1beta1.Grant")
 	proto.RegisterType((*GrantAuthorization)(nil), "cosmos.authz.v1beta1.GrantAuthorization")
 	proto.RegisterType((*GrantQueueItem)(nil), "cosmos.authz.v1beta1.GrantQueueItem")
@@ -246,36 +449,61 @@ func init() {
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/authz.proto", fileDescriptor_544dc2e84b61c637) }
 
 var fileDescriptor_544dc2e84b61c637 = []byte{
-	// 455 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0xeb, 0x76, 0x03, 0xe6, 0xa9, 0x08, 0xac, 0x82, 0xba, 0x1e, 0xd2, 0xaa, 0xe2, 0xd0,
-	0x4b, 0x13, 0x6d, 0xc0, 0x05, 0x2e, 0x34, 0x20, 0x4d, 0x1c, 0x38, 0x10, 0xc6, 0x85, 0x4b, 0xe5,
-	0xb6, 0x2f, 0x6e, 0x44, 0x1c, 0x47, 0xfe, 0x03, 0xeb, 0xbe, 0x03, 0xd2, 0x3e, 0x00, 0x1f, 0x63,
-	0x1f, 0xa2, 0xe2, 0x34, 0x71, 0xe2, 0xc4, 0x9f, 0xf6, 0x8b, 0xa0, 0xda, 0x89, 0x58, 0x08, 0x52,
-	0x91, 0x38, 0xc5, 0xef, 0xeb, 0xe7, 0x79, 0xfc, 0xfa, 0x17, 0x19, 0xf7, 0xa6, 0x42, 0x71, 0xa1,
-	0x02, 0x6a, 0xf4, 0xfc, 0x2c, 0x78, 0x7f, 0x38, 0x01, 0x4d, 0x0f, 0x5d, 0xe5, 0x67, 0x52, 0x68,
-	0x41, 0x5a, 0x4e, 0xe1, 0xbb, 0x5e, 0xae, 0xe8, 0x1c, 0xb8, 0xee, 0xd8, 0x6a, 0x82, 0x5c, 0x62,
-	0x8b, 0x4e, 0x97, 0x09, 0xc1, 0x12, 0x08, 0x6c, 0x35, 0x31, 0x6f, 0x03, 0x1d, 0x73, 0x50, 0x9a,
-	0xf2, 0x2c, 0x17, 0xb4, 0x98, 0x60, 0xc2, 0x19, 0x37, 0xab, 0xbc, 0x7b, 0xf0, 0xa7, 0x8d, 0xa6,
-	0x0b, 0xb7, 0xd5, 0x7f, 0x8c, 0x5b, 0xc7, 0x90, 0x82, 0x8c, 0xa7, 0x23, 0xa3, 0xe7, 0x42, 0xc6,
-	0x67, 0x54, 0xc7, 0x22, 0x25, 0xb7, 0x70, 0x83, 0x2b, 0xd6, 0x46, 0x3d, 0x34, 0xd8, 0x8b, 0x36,
-	0xcb, 0x47, 0xb7, 0x3f, 0x5f, 0x0c, 0x9b, 0x25, 0x51, 0x3f, 0xc3, 0xe4, 0xa9, 0x30, 0xa9, 0xde,
-	0x62, 0x25, 0x0f, 0xf1, 0x5d, 0x9a, 0x24, 0xe2, 0x03, 0xcc, 0xc6, 0xf4, 0xaa, 0x54, 0xb5, 0xeb,
-	0x3d, 0x34, 0xd8, 0x8d, 0xee, 0xe4, 0xbb, 0xa5, 0x1c, 0xf5, 0xb7, 0x13, 0x3f, 0x21, 0xbc, 0x7b,
-	0x2c, 0x69, 0xaa, 0xc9, 0x0b, 0xdc, 0x2c, 0x65, 0xd9, 0xf3, 0xf6, 0x8f, 0x5a, 0xbe, 0xbb, 0xab,
-	0x5f, 0xdc, 0xd5, 0x1f, 0xa5, 0x8b, 0xb0, 0x9a, 0x14, 0x95, 0xdd, 0xe4, 0x19, 0xc6, 0x70, 0x9a,
-	0xc5, 0xd2, 0x65, 0xd5, 0x6d, 0x56, 0xa7, 0x92, 0x75, 0x52, 0xe0, 0x0e, 0x6f, 0x2c, 0xbf, 0x75,
-	0xd1, 0xf9, 0xf7, 0x2e, 0x8a, 0xae, 0xf8, 0xfa, 0x1f, 0xeb, 0x98, 0xd8, 0xf1, 0xca, 0x44, 0x8e,
-	0xf0, 0x75, 0xb6, 0xe9, 0x82, 0x74, 0x54, 0xc2, 0xf6, 0x97, 0x8b, 0x61, 0xf1, 0xf3, 0x47, 0xb3,
-	0x99, 0x04, 0xa5, 0x5e, 0x69, 0x19, 0xa7, 0x2c, 0x2a, 0x84, 0xbf, 0x3d, 0x60, 0xa7, 0xf9, 0x07,
-	0x0f, 0x54, 0x99, 0x34, 0xfe, 0x8b, 0xc9, 0x93, 0x12, 0x93, 0x9d, 0xad, 0x4c, 0x76, 0x2a, 0x3c,
-	0x1e, 0xe0, 0x9b, 0x16, 0xc7, 0x4b, 0x03, 0x06, 0x9e, 0x6b, 0xe0, 0xa4, 0x8f, 0x9b, 0x5c, 0xb1,
-	0xb1, 0x5e, 0x64, 0x30, 0x36, 0x32, 0x51, 0x6d, 0xd4, 0x6b, 0x0c, 0xf6, 0xa2, 0x7d, 0xae, 0xd8,
-	0xc9, 0x22, 0x83, 0xd7, 0x32, 0x51, 0x61, 0xb8, 0xfc, 0xe9, 0xd5, 0x96, 0x2b, 0x0f, 0x5d, 0xae,
-	0x3c, 0xf4, 0x63, 0xe5, 0xa1, 0xf3, 0xb5, 0x57, 0xbb, 0x5c, 0x7b, 0xb5, 0xaf, 0x6b, 0xaf, 0xf6,
-	0xe6, 0x1e, 0x8b, 0xf5, 0xdc, 0x4c, 0xfc, 0xa9, 0xe0, 0xf9, 0xe3, 0xc8, 0x3f, 0x43, 0x35, 0x7b,
-	0x17, 0x9c, 0xba, 0x07, 0x36, 0xb9, 0x66, 0xe7, 0xbb, 0xff, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x98,
-	0x0e, 0x60, 0xb8, 0x85, 0x03, 0x00, 0x00,
+	// 856 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0xed, 0xb4, 0x90, 0xe7, 0xb6, 0xb4, 0x43, 0x01, 0xb7, 0x07, 0x37, 0x44, 0xac, 0x14,
+	0x09, 0xd5, 0x61, 0x0b, 0x2b, 0x04, 0x7b, 0xa1, 0xe9, 0xa2, 0xb2, 0x02, 0x0e, 0xb8, 0x8b, 0x90,
+	0xb8, 0x58, 0x8e, 0x3d, 0x38, 0xa3, 0xb5, 0x3d, 0x96, 0x67, 0x4c, 0x93, 0x3d, 0xf0, 0x0d, 0x40,
+	0x7b, 0xe4, 0xc0, 0x11, 0x71, 0xe0, 0xbc, 0x1f, 0xa2, 0xe2, 0xb4, 0xe2, 0xc4, 0x89, 0x85, 0xf6,
+	0x8b, 0xa0, 0xf9, 0xe3, 0xfc, 0x69, 0xa2, 0x6d, 0x24, 0x7a, 0x8a, 0xe7, 0xbd, 0xf7, 0x7b, 0xbf,
+	0xf7, 0x5f, 0x81, 0x76, 0x44, 0x59, 0x46, 0x59, 0x2f, 0xac, 0xf8, 0xf0, 0x49, 0xef, 0xfb, 0xbb,
+	0x03, 0xcc, 0xc3, 0xbb, 0xea, 0xe5, 0x15, 0x25, 0xe5, 0x14, 0xed, 0x2a, 0x0b, 0x4f, 0xc9, 0xb4,
+	0xc5, 0xfe, 0x9e, 0x92, 0x06, 0xd2, 0xa6, 0xa7, 0x4d, 0xe4, 0x63, 0xff, 0x20, 0xa1, 0x34, 0x49,
+	0x71, 0x4f, 0xbe, 0x06, 0xd5, 0x77, 0x3d, 0x4e, 0x32, 0xcc, 0x78, 0x98, 0x15, 0xda, 0xc0, 0xbd,
+	0x6e, 0x10, 0x57, 0x65, 0xc8, 0x09, 0xcd, 0xb5, 0x7e, 0x37, 0xa1, 0x09, 0x55, 0x8e, 0xc5, 0x97,
+	0x96, 0xee, 0x5d, 0x47, 0x85, 0xf9, 0xb8, 0x76, 0xa8, 0x93, 0x18, 0x84, 0x0c, 0x4f, 0x72, 0x88,
+	0x28, 0xd1, 0x0e, 0x3b, 0xf7, 0x61, 0xf7, 0x14, 0xe7, 0xb8, 0x24, 0xd1, 0x71, 0xc5, 0x87, 0xb4,
+	0x24, 0x4f, 0x24, 0x1d, 0xda, 0x06, 0x2b, 0x63, 0x89, 0x63, 0xb4, 0x8d, 0x6e, 0xcb, 0x17, 0x9f,
+	0x1f, 0xef, 0xfc, 0xf1, 0xec, 0x70, 0x73, 0xce, 0xa8, 0x53, 0x00, 0x3a, 0xa1, 0x55, 0xce, 0x6f,
+	0x80, 0xa2, 0x7b, 0xf0, 0x66, 0x98, 0xa6, 0xf4, 0x1c, 0xc7, 0x41, 0x38, 0x6b, 0xca, 0x1c, 0xb3,
+	0x6d, 0x74, 0xd7, 0xfc, 0x37, 0xb4, 0x76, 0xce, 0x0f, 0x5b, 0xc6, 0xf8, 0x6b, 0x13, 0xde, 0x3a,
+	0xa1, 0x24, 0xef, 0x57, 0x71, 0x82, 0x6f, 0xe4, 0x4d, 0xc1, 0x66, 0x05, 0xce, 0xe3, 0x20, 0x25,
+	0x19, 0xe1, 0x8e, 0xd9, 0xb6, 0xba, 0xf6, 0xd1, 0x9e, 0xa7, 0x5b, 0x22, 0x4a, 0x52, 0x37, 0xcd,
+	0x93, 0x4e, 0xdf, 0xbb, 0xf8, 0xfb, 0xa0, 0xf1, 0xfb, 0x8b, 0x83, 0x6e, 0x42, 0xf8, 0xb0, 0x1a,
+	0x78, 0x11, 0xcd, 0x74, 0xff, 0xf4, 0xcf, 0x21, 0x8b, 0x1f, 0xf7, 0xf8, 0xb8, 0xc0, 0x4c, 0x02,
+	0x98, 0x0f, 0xd2, 0xff, 0x17, 0xc2, 0x3d, 0xba, 0x0f, 0xeb, 0x05, 0x2e, 0x09, 0x8d, 0x1d, 0xab,
+	0x6d, 0x48, 0x22, 0xd5, 0x16, 0xaf, 0x6e, 0x8b, 0xf7, 0x40, 0x37, 0xb3, 0xff, 0xaa, 0x20, 0xfa,
+	0xf9, 0xc5, 0x81, 0xe1, 0x6b, 0x08, 0x1a, 0x03, 0x52, 0x5f, 0xc1, 0x6c, 0xc4, 0xcd, 0xdb, 0x8f,
+	0x78, 0x5b, 0xd1, 0x9c, 0x4d, 0xe3, 0xae, 0x40, 0xcb, 0x82, 0x28, 0xcc, 0x15, 0xbd, 0xb3, 0x76,
+	0xfb, 0xc4, 0x5b, 0x8a, 0xe4, 0x24, 0xcc, 0x25, 0x37, 0x3a, 0x85, 0x0d, 0x4d, 0x5b, 0x62, 0x86,
+	0xb9, 0xb3, 0x2e, 0x8b, 0xb6, 0xbf, 0x50, 0xb4, 0x47, 0xf5, 0x8a, 0xa8, 0xaa, 0x3d, 0x15, 0x55,
+	0xb3, 0x15, 0xd2, 0x17, 0xc0, 0x65, 0x63, 0xf2, 0x9b, 0x09, 0xce, 0x09, 0xcd, 0x63, 0x22, 0x5e,
+	0x61, 0x3a, 0x3f, 0x27, 0x5f, 0xc2, 0xe6, 0xdc, 0x14, 0xca, 0x89, 0xb1, 0x8f, 0x76, 0x17, 0x98,
+	0x8f, 0xf3, 0x71, 0x7f, 0xd1, 0xb9, 0x3f, 0x8f, 0x46, 0x9f, 0x03, 0x44, 0x35, 0x95, 0x1a, 0x68,
+	0xfb, 0xe8, 0x8e, 0xb7, 0xec, 0x32, 0x78, 0xa7, 0x65, 0x98, 0xf3, 0x49, 0x5c, 0xac, 0xdf, 0x14,
+	0x09, 0xf9, 0x33, 0x70, 0xe4, 0xc1, 0xeb, 0xe7, 0x24, 0x8f, 0xe9, 0x79, 0xc0, 0x78, 0x58, 0xf2,
+	0x60, 0x88, 0x49, 0x32, 0xe4, 0x72, 0xa0, 0x2c, 0x7f, 0x47, 0xa9, 0xce, 0x84, 0xe6, 0x33, 0xa9,
+	0x40, 0xef, 0x82, 0x16, 0x06, 0x78, 0x84, 0xa3, 0x4a, 0xc5, 0xd0, 0x6c, 0x1b, 0xdd, 0xa6, 0xbf,
+	0xad, 0x14, 0x9f, 0x4e, 0xe4, 0xcb, 0x0a, 0xf5, 0x93, 0x09, 0xaf, 0x5d, 0x8b, 0x0a, 0x3d, 0x84,
+	0x0d, 0x71, 0x96, 0x02, 0x85, 0x67, 0x8e, 0x21, 0x67, 0xa1, 0xbd, 0x3c, 0x25, 0xd1, 0x9d, 0x6f,
+	0xa4, 0xa1, 0xce, 0xc6, 0xe6, 0x13, 0x09, 0x43, 0x87, 0x80, 0xea, 0xc5, 0x2f, 0x71, 0x44, 0x0a,
+	0x82, 0x73, 0xce, 0xe4, 0x1e, 0xb6, 0xfc, 0x1d, 0xad, 0xf1, 0x27, 0x0a, 0x74, 0x07, 0xb6, 0xb2,
+	0x70, 0x34, 0x9b, 0x8a, 0x25, 0x53, 0xd9, 0xcc, 0xc2, 0xd1, 0x34, 0x0f, 0xf4, 0x36, 0x6c, 0x0c,
+	0x52, 0x1a, 0x3d, 0xd6, 0x11, 0xca, 0x7c, 0x2d, 0xdf, 0x96, 0x32, 0xc5, 0x8c, 0x3e, 0x14, 0x4d,
+	0x09, 0x18, 0x49, 0x72, 0x5c, 0x32, 0x39, 0xcd, 0xad, 0xbe, 0xf3, 0xe7, 0xb3, 0xc3, 0xfa, 0x62,
+	0x1f, 0xc7, 0x71, 0x89, 0x19, 0x3b, 0xe3, 0x25, 0xc9, 0x13, 0xbf, 0x15, 0xd1, 0x33, 0x65, 0xda,
+	0xf9, 0x01, 0x60, 0x9a, 0x12, 0xfa, 0x08, 0xd6, 0x64, 0x1f, 0x1c, 0x63, 0xf5, 0x8d, 0x56, 0x08,
+	0x74, 0x0f, 0x2c, 0xb1, 0x48, 0xe6, 0xea, 0x40, 0x61, 0xdf, 0xf9, 0xc5, 0x80, 0x35, 0xd9, 0x90,
+	0xdb, 0x1e, 0xd3, 0x07, 0x00, 0x78, 0x54, 0x10, 0xc5, 0xea, 0x98, 0x2b, 0x2d, 0x9b, 0x21, 0x97,
+	0x6d, 0x06, 0xd7, 0xf9, 0xd1, 0x04, 0x24, 0xc3, 0x9b, 0x5f, 0xa9, 0x23, 0x78, 0x25, 0x11, 0x52,
+	0x5c, 0xaa, 0xf3, 0xfb, 0x92, 0x5a, 0xd7, 0x86, 0x53, 0x0c, 0x76, 0xcc, 0xd5, 0x30, 0x78, 0xb1,
+	0x26, 0xd6, 0xff, 0xaa, 0xc9, 0x27, 0x73, 0x35, 0x69, 0xde, 0x58, 0x93, 0xe6, 0x42, 0x3d, 0x3e,
+	0x80, 0x2d, 0x59, 0x8e, 0xaf, 0x2a, 0x5c, 0xe1, 0x87, 0x1c, 0x67, 0xa8, 0x03, 0x9b, 0x19, 0x4b,
+	0x02, 0x71, 0xf9, 0x82, 0xaa, 0x4c, 0xd5, 0xfa, 0xb4, 0x7c, 0x3b, 0x63, 0xc9, 0xa3, 0x71, 0x81,
+	0xbf, 0x2e, 0x53, 0xd6, 0xef, 0x5f, 0xfc, 0xeb, 0x36, 0x2e, 0x2e, 0x5d, 0xe3, 0xf9, 0xa5, 0x6b,
+	0xfc, 0x73, 0xe9, 0x1a, 0x4f, 0xaf, 0xdc, 0xc6, 0xf3, 0x2b, 0xb7, 0xf1, 0xd7, 0x95, 0xdb, 0xf8,
+	0xf6, 0x9d, 0x97, 0x9e, 0xd4, 0x91, 0xfa, 0x07, 0x32, 0x58, 0x97, 0xf1, 0xbd, 0xff, 0xdf, 0x00,
+	0xee, 0x8e, 0x3e, 0xab, 0xa6, 0x08, 0x00, 0x00,
 }
 
 func (m *GenericAuthorization) Marshal() (dAtA []byte, err error) {
@@ -343,6 +571,253 @@ func (m *CountAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CoinBudgetAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CoinBudgetAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CoinBudgetAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PeriodReset, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PeriodReset):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAuthz(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if len(m.PeriodCanSpend) > 0 {
+		for iNdEx := len(m.PeriodCanSpend) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodCanSpend[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PeriodSpendLimit) > 0 {
+		for iNdEx := len(m.PeriodSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintAuthz(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConditionalAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConditionalAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConditionalAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowExecutions != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.WindowExecutions))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WindowStartHeight != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.WindowStartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Conditions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuthz(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Authorization != nil {
+		{
+			size, err := m.Authorization.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GrantConditions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GrantConditions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GrantConditions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CoSigners) > 0 {
+		for iNdEx := len(m.CoSigners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CoSigners[iNdEx])
+			copy(dAtA[i:], m.CoSigners[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.CoSigners[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.BlockWindow != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.BlockWindow))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxExecutions != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.MaxExecutions))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AllowedRecipients) > 0 {
+		for iNdEx := len(m.AllowedRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedRecipients[iNdEx])
+			copy(dAtA[i:], m.AllowedRecipients[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedRecipients[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TimeWindows) > 0 {
+		for iNdEx := len(m.TimeWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimeWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimeWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimeWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.End, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.End):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintAuthz(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Start, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Start):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintAuthz(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Grant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -364,12 +839,12 @@ func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Expiration != nil {
-		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
-		if err1 != nil {
-			return 0, err1
+		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err7 != nil {
+			return 0, err7
 		}
-		i -= n1
-		i = encodeVarintAuthz(dAtA, i, uint64(n1))
+		i -= n7
+		i = encodeVarintAuthz(dAtA, i, uint64(n7))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -409,12 +884,12 @@ func (m *GrantAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Expiration != nil {
-		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
-		if err3 != nil {
-			return 0, err3
+		n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err9 != nil {
+			return 0, err9
 		}
-		i -= n3
-		i = encodeVarintAuthz(dAtA, i, uint64(n3))
+		i -= n9
+		i = encodeVarintAuthz(dAtA, i, uint64(n9))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -519,36 +994,138 @@ func (m *CountAuthorization) Size() (n int) {
 	return n
 }
 
-func (m *Grant) Size() (n int) {
+func (m *CoinBudgetAuthorization) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Authorization != nil {
-		l = m.Authorization.Size()
+	l = len(m.Msg)
+	if l > 0 {
 		n += 1 + l + sovAuthz(uint64(l))
 	}
-	if m.Expiration != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
-		n += 1 + l + sovAuthz(uint64(l))
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovAuthz(uint64(l))
+	if len(m.PeriodSpendLimit) > 0 {
+		for _, e := range m.PeriodSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.PeriodCanSpend) > 0 {
+		for _, e := range m.PeriodCanSpend {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.PeriodReset)
+	n += 1 + l + sovAuthz(uint64(l))
 	return n
 }
 
-func (m *GrantAuthorization) Size() (n int) {
+func (m *ConditionalAuthorization) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Granter)
-	if l > 0 {
+	if m.Authorization != nil {
+		l = m.Authorization.Size()
 		n += 1 + l + sovAuthz(uint64(l))
 	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovAuthz(uint64(l))
+	l = m.Conditions.Size()
+	n += 1 + l + sovAuthz(uint64(l))
+	if m.WindowStartHeight != 0 {
+		n += 1 + sovAuthz(uint64(m.WindowStartHeight))
+	}
+	if m.WindowExecutions != 0 {
+		n += 1 + sovAuthz(uint64(m.WindowExecutions))
+	}
+	return n
+}
+
+func (m *GrantConditions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TimeWindows) > 0 {
+		for _, e := range m.TimeWindows {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.AllowedRecipients) > 0 {
+		for _, s := range m.AllowedRecipients {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.MaxExecutions != 0 {
+		n += 1 + sovAuthz(uint64(m.MaxExecutions))
+	}
+	if m.BlockWindow != 0 {
+		n += 1 + sovAuthz(uint64(m.BlockWindow))
+	}
+	if len(m.CoSigners) > 0 {
+		for _, s := range m.CoSigners {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TimeWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Start)
+	n += 1 + l + sovAuthz(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.End)
+	n += 1 + l + sovAuthz(uint64(l))
+	return n
+}
+
+func (m *Grant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Authorization != nil {
+		l = m.Authorization.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func (m *GrantAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
 	}
 	if m.Authorization != nil {
 		l = m.Authorization.Size()
@@ -765,6 +1342,715 @@ func (m *CountAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CoinBudgetAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CoinBudgetAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CoinBudgetAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodSpendLimit = append(m.PeriodSpendLimit, types.Coin{})
+			if err := m.PeriodSpendLimit[len(m.PeriodSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodCanSpend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodCanSpend = append(m.PeriodCanSpend, types.Coin{})
+			if err := m.PeriodCanSpend[len(m.PeriodCanSpend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConditionalAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConditionalAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConditionalAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Authorization == nil {
+				m.Authorization = &types1.Any{}
+			}
+			if err := m.Authorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Conditions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStartHeight", wireType)
+			}
+			m.WindowStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowExecutions", wireType)
+			}
+			m.WindowExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowExecutions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GrantConditions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GrantConditions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GrantConditions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeWindows = append(m.TimeWindows, TimeWindow{})
+			if err := m.TimeWindows[len(m.TimeWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedRecipients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedRecipients = append(m.AllowedRecipients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecutions", wireType)
+			}
+			m.MaxExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecutions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockWindow", wireType)
+			}
+			m.BlockWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoSigners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoSigners = append(m.CoSigners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimeWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimeWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimeWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.End, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Grant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -824,7 +2110,7 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Authorization == nil {
-				m.Authorization = &types.Any{}
+				m.Authorization = &types1.Any{}
 			}
 			if err := m.Authorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1010,7 +2296,7 @@ func (m *GrantAuthorization) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Authorization == nil {
-				m.Authorization = &types.Any{}
+				m.Authorization = &types1.Any{}
 			}
 			if err := m.Authorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
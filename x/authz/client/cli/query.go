@@ -29,6 +29,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryGrants(),
 		GetQueryGranterGrants(),
 		GetQueryGranteeGrants(),
+		GetQueryGranteeGrantsByMsgType(),
 	)
 
 	return authorizationQueryCmd
@@ -192,3 +193,54 @@ $ %s q %s grants-by-grantee cosmos1skj..
 	flags.AddPaginationFlagsToCmd(cmd, "grantee-grants")
 	return cmd
 }
+
+// GetQueryGranteeGrantsByMsgType returns cmd to query for all grants for a
+// grantee and a msg type.
+func GetQueryGranteeGrantsByMsgType() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grants-by-grantee-msg-type [grantee-addr] [msg-type-url]",
+		Args:  cobra.ExactArgs(2),
+		Short: "query authorization grants granted to a grantee for a msg type",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query authorization grants granted to a grantee for a msg type.
+Examples:
+$ %s q %s grants-by-grantee-msg-type cosmos1skj.. %s
+`,
+				version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL()),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := authz.NewQueryClient(clientCtx)
+			res, err := queryClient.GranteeGrantsByMsgType(
+				cmd.Context(),
+				&authz.QueryGranteeGrantsByMsgTypeRequest{
+					Grantee:    grantee.String(),
+					MsgTypeUrl: args[1],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "grantee-grants-by-msg-type")
+	return cmd
+}
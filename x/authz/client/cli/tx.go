@@ -28,6 +28,13 @@ const (
 	FlagDenyValidators        = "deny-validators"
 	FlagAllowedAuthorizations = "allowed-authorizations"
 	FlagAllowList             = "allow-list"
+	FlagPeriod                = "period"
+	FlagPeriodLimit           = "period-limit"
+	FlagTimeWindows           = "time-windows"
+	FlagAllowedRecipients     = "allowed-recipients"
+	FlagMaxExecutions         = "max-executions"
+	FlagBlockWindow           = "block-window"
+	FlagCoSigners             = "co-signers"
 	delegate                  = "delegate"
 	redelegate                = "redelegate"
 	unbond                    = "unbond"
@@ -47,6 +54,7 @@ func GetTxCmd() *cobra.Command {
 	AuthorizationTxCmd.AddCommand(
 		NewCmdGrantAuthorization(),
 		NewCmdRevokeAuthorization(),
+		NewCmdRevokeAllAuthorizations(),
 		NewCmdExecAuthorization(),
 	)
 
@@ -56,7 +64,7 @@ func GetTxCmd() *cobra.Command {
 // NewCmdGrantAuthorization returns a CLI command handler for creating a MsgGrant transaction.
 func NewCmdGrantAuthorization() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "grant <grantee> <\"count\"|\"send\"|\"generic\"|\"delegate\"|\"unbond\"|\"redelegate\"> --from <granter>",
+		Use:   "grant <grantee> <\"count\"|\"send\"|\"generic\"|\"coin-budget\"|\"delegate\"|\"unbond\"|\"redelegate\"> --from <granter>",
 		Short: "Grant authorization to an address",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`create a new grant authorization to an address to execute a transaction on your behalf:
@@ -64,7 +72,9 @@ func NewCmdGrantAuthorization() *cobra.Command {
 Examples:
  $ %s tx %s grant cosmos1skjw.. send --spend-limit=1000stake --from=cosmos1skl..
  $ %s tx %s grant cosmos1skjw.. generic --msg-type=/cosmos.gov.v1.MsgVote --from=cosmos1sk..
-	`, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
+ $ %s tx %s grant cosmos1skjw.. coin-budget --msg-type=/cosmos.gov.v1.MsgDeposit --spend-limit=1000stake --period=86400 --period-limit=100stake --from=cosmos1sk..
+ $ %s tx %s grant cosmos1skjw.. send --spend-limit=1000stake --time-windows=09:00-17:00 --max-executions=1 --block-window=100 --from=cosmos1sk..
+	`, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
 		),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -130,6 +140,42 @@ Examples:
 				}
 
 				authorization = authz.NewGenericAuthorization(msgType)
+			case "coin-budget":
+				msgType, err := cmd.Flags().GetString(FlagMsgType)
+				if err != nil {
+					return err
+				}
+
+				limit, err := cmd.Flags().GetString(FlagSpendLimit)
+				if err != nil {
+					return err
+				}
+
+				spendLimit, err := sdk.ParseCoinsNormalized(limit)
+				if err != nil {
+					return err
+				}
+
+				period, err := cmd.Flags().GetInt64(FlagPeriod)
+				if err != nil {
+					return err
+				}
+
+				periodLimitVal, err := cmd.Flags().GetString(FlagPeriodLimit)
+				if err != nil {
+					return err
+				}
+
+				periodLimit, err := sdk.ParseCoinsNormalized(periodLimitVal)
+				if err != nil {
+					return err
+				}
+
+				if period < 0 {
+					return fmt.Errorf("period should not be negative")
+				}
+
+				authorization = authz.NewCoinBudgetAuthorization(msgType, spendLimit, time.Duration(period)*time.Second, periodLimit)
 			case delegate, unbond, redelegate:
 				limit, err := cmd.Flags().GetString(FlagSpendLimit)
 				if err != nil {
@@ -195,6 +241,17 @@ Examples:
 				return fmt.Errorf("invalid authorization type, %s", args[1])
 			}
 
+			conditions, err := getGrantConditions(cmd)
+			if err != nil {
+				return err
+			}
+			if conditions != nil {
+				authorization, err = authz.NewConditionalAuthorization(authorization, *conditions)
+				if err != nil {
+					return err
+				}
+			}
+
 			expire, err := getExpireTime(cmd)
 			if err != nil {
 				return err
@@ -216,9 +273,77 @@ Examples:
 	cmd.Flags().StringSlice(FlagAllowList, []string{}, "Allowed addresses grantee is allowed to send funds separated by ,")
 	cmd.Flags().Int64(FlagExpiration, 0, "Expire time as Unix timestamp. Set zero (0) for no expiry. Default is 0.")
 	cmd.Flags().Int32(FlagAllowedAuthorizations, 0, "Allowed authorizations for a Count Authorization")
+	cmd.Flags().Int64(FlagPeriod, 0, "Period in seconds after which the period limit of a Coin Budget Authorization is reset")
+	cmd.Flags().String(FlagPeriodLimit, "", "Period spend limit of a Coin Budget Authorization, an array of Coins allowed to be moved per period")
+	cmd.Flags().StringSlice(FlagTimeWindows, []string{}, "UTC time windows (HH:MM-HH:MM) during which the grant can be executed, separated by ,")
+	cmd.Flags().StringSlice(FlagAllowedRecipients, []string{}, "Addresses the executed messages are allowed to reference, separated by ,")
+	cmd.Flags().Uint64(FlagMaxExecutions, 0, "Max executions of the grant per block window")
+	cmd.Flags().Int64(FlagBlockWindow, 0, "Number of blocks over which the max executions are counted")
+	cmd.Flags().StringSlice(FlagCoSigners, []string{}, "Addresses required to co-sign the grant executions, separated by ,")
 	return cmd
 }
 
+// getGrantConditions returns the grant conditions set through the flags, or
+// nil if none is set.
+func getGrantConditions(cmd *cobra.Command) (*authz.GrantConditions, error) {
+	windows, err := cmd.Flags().GetStringSlice(FlagTimeWindows)
+	if err != nil {
+		return nil, err
+	}
+	recipients, err := cmd.Flags().GetStringSlice(FlagAllowedRecipients)
+	if err != nil {
+		return nil, err
+	}
+	maxExecutions, err := cmd.Flags().GetUint64(FlagMaxExecutions)
+	if err != nil {
+		return nil, err
+	}
+	blockWindow, err := cmd.Flags().GetInt64(FlagBlockWindow)
+	if err != nil {
+		return nil, err
+	}
+	coSigners, err := cmd.Flags().GetStringSlice(FlagCoSigners)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(windows) == 0 && len(recipients) == 0 && maxExecutions == 0 && blockWindow == 0 && len(coSigners) == 0 {
+		return nil, nil
+	}
+
+	conditions := &authz.GrantConditions{
+		AllowedRecipients: recipients,
+		MaxExecutions:     maxExecutions,
+		BlockWindow:       blockWindow,
+		CoSigners:         coSigners,
+	}
+	for _, w := range windows {
+		window, err := parseTimeWindow(w)
+		if err != nil {
+			return nil, err
+		}
+		conditions.TimeWindows = append(conditions.TimeWindows, window)
+	}
+	return conditions, nil
+}
+
+// parseTimeWindow parses a time window in the HH:MM-HH:MM format.
+func parseTimeWindow(s string) (authz.TimeWindow, error) {
+	bounds := strings.Split(s, "-")
+	if len(bounds) != 2 {
+		return authz.TimeWindow{}, fmt.Errorf("invalid time window %s, expected HH:MM-HH:MM", s)
+	}
+	var window [2]time.Duration
+	for i, b := range bounds {
+		t, err := time.Parse("15:04", strings.TrimSpace(b))
+		if err != nil {
+			return authz.TimeWindow{}, fmt.Errorf("invalid time window %s: %w", s, err)
+		}
+		window[i] = time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+	}
+	return authz.TimeWindow{Start: window[0], End: window[1]}, nil
+}
+
 func getExpireTime(cmd *cobra.Command) (*time.Time, error) {
 	exp, err := cmd.Flags().GetInt64(FlagExpiration)
 	if err != nil {
@@ -265,6 +390,38 @@ Example:
 	return cmd
 }
 
+// NewCmdRevokeAllAuthorizations returns a CLI command handler for creating a MsgRevokeAll transaction.
+func NewCmdRevokeAllAuthorizations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-all [msg-type-url]? --from=[granter]",
+		Short: "revoke all authorizations",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`revoke all authorizations granted by a granter, optionally only the ones for a msg type:
+Example:
+ $ %s tx %s revoke-all --from=cosmos1skj..
+ $ %s tx %s revoke-all %s --from=cosmos1skj..
+			`, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL()),
+		),
+		Args: cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msgAuthorized := ""
+			if len(args) == 1 {
+				msgAuthorized = args[0]
+			}
+			msg := authz.NewMsgRevokeAll(clientCtx.GetFromAddress(), msgAuthorized)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewCmdExecAuthorization returns a CLI command handler for creating a MsgExec transaction.
 func NewCmdExecAuthorization() *cobra.Command {
 	cmd := &cobra.Command{
@@ -294,12 +451,17 @@ Example:
 				return err
 			}
 			msg := authz.NewMsgExec(grantee, theTx.GetMsgs())
+			msg.CoSigners, err = cmd.Flags().GetStringSlice(FlagCoSigners)
+			if err != nil {
+				return err
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().StringSlice(FlagCoSigners, []string{}, "Co-signers of the execution, required by the grant conditions, separated by ,")
 
 	return cmd
 }
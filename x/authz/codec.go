@@ -15,9 +15,12 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgGrant{}, "cosmos-sdk/MsgGrant")
 	legacy.RegisterAminoMsg(cdc, &MsgRevoke{}, "cosmos-sdk/MsgRevoke")
 	legacy.RegisterAminoMsg(cdc, &MsgExec{}, "cosmos-sdk/MsgExec")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokeAll{}, "cosmos-sdk/MsgRevokeAll")
 
 	cdc.RegisterInterface((*Authorization)(nil), nil)
 	cdc.RegisterConcrete(&GenericAuthorization{}, "cosmos-sdk/GenericAuthorization", nil)
+	cdc.RegisterConcrete(&CoinBudgetAuthorization{}, "cosmos-sdk/CoinBudgetAuthorization", nil)
+	cdc.RegisterConcrete(&ConditionalAuthorization{}, "cosmos-sdk/ConditionalAuthorization", nil)
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry
@@ -26,6 +29,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgGrant{},
 		&MsgRevoke{},
 		&MsgExec{},
+		&MsgRevokeAll{},
 	)
 
 	registry.RegisterInterface(
@@ -33,6 +37,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*Authorization)(nil),
 		&GenericAuthorization{},
 		&CountAuthorization{},
+		&CoinBudgetAuthorization{},
+		&ConditionalAuthorization{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, MsgServiceDesc())
@@ -0,0 +1,173 @@
+package authz
+
+import (
+	"reflect"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ Authorization = &CoinBudgetAuthorization{}
+
+	coinType = reflect.TypeOf(sdk.Coin{})
+)
+
+// NewCoinBudgetAuthorization creates a new CoinBudgetAuthorization object. A
+// zero period disables the periodic spend limit.
+func NewCoinBudgetAuthorization(msgTypeURL string, spendLimit sdk.Coins, period time.Duration, periodSpendLimit sdk.Coins) *CoinBudgetAuthorization {
+	return &CoinBudgetAuthorization{
+		Msg:              msgTypeURL,
+		SpendLimit:       spendLimit,
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+		PeriodCanSpend:   periodSpendLimit,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a CoinBudgetAuthorization) MsgTypeURL() string {
+	return a.Msg
+}
+
+// Accept implements Authorization.Accept. All the coins found in the fields of
+// the message are deducted from both the current period and the total spend
+// limit.
+func (a CoinBudgetAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (AcceptResponse, error) {
+	coins, err := MsgCoins(msg)
+	if err != nil {
+		return AcceptResponse{}, err
+	}
+
+	var isNeg bool
+	if a.Period > 0 {
+		a.tryResetPeriod(ctx.BlockTime())
+
+		a.PeriodCanSpend, isNeg = a.PeriodCanSpend.SafeSub(coins...)
+		if isNeg {
+			return AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("requested amount is more than period spend limit")
+		}
+	}
+
+	if !a.SpendLimit.Empty() {
+		a.SpendLimit, isNeg = a.SpendLimit.SafeSub(coins...)
+		if isNeg {
+			return AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("requested amount is more than spend limit")
+		}
+		if a.SpendLimit.IsZero() {
+			return AcceptResponse{Accept: true, Delete: true}, nil
+		}
+	}
+
+	return AcceptResponse{Accept: true, Delete: false, Updated: &a}, nil
+}
+
+// tryResetPeriod tops up PeriodCanSpend to min(PeriodSpendLimit, SpendLimit)
+// once PeriodReset has been hit, the same way feegrant's PeriodicAllowance
+// does. It is a no-op otherwise.
+func (a *CoinBudgetAuthorization) tryResetPeriod(blockTime time.Time) {
+	if blockTime.Before(a.PeriodReset) {
+		return
+	}
+
+	if _, isNeg := a.SpendLimit.SafeSub(a.PeriodSpendLimit...); isNeg && !a.SpendLimit.Empty() {
+		a.PeriodCanSpend = a.SpendLimit
+	} else {
+		a.PeriodCanSpend = a.PeriodSpendLimit
+	}
+
+	a.PeriodReset = a.PeriodReset.Add(a.Period)
+	if blockTime.After(a.PeriodReset) {
+		a.PeriodReset = blockTime.Add(a.Period)
+	}
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a CoinBudgetAuthorization) ValidateBasic() error {
+	if a.Msg == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("msg type url cannot be empty")
+	}
+	if !a.SpendLimit.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("spend limit is invalid: %s", a.SpendLimit)
+	}
+	if a.Period < 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("period cannot be negative")
+	}
+
+	if a.Period == 0 {
+		if a.SpendLimit.Empty() {
+			return sdkerrors.ErrInvalidCoins.Wrap("spend limit or period spend limit must be set")
+		}
+		if !a.PeriodSpendLimit.Empty() || !a.PeriodCanSpend.Empty() {
+			return sdkerrors.ErrInvalidRequest.Wrap("period spend limit requires a period")
+		}
+		return nil
+	}
+
+	if !a.PeriodSpendLimit.IsValid() || !a.PeriodSpendLimit.IsAllPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("period spend limit must be positive: %s", a.PeriodSpendLimit)
+	}
+	if !a.PeriodCanSpend.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("period can spend is invalid: %s", a.PeriodCanSpend)
+	}
+	if !a.SpendLimit.Empty() && !a.PeriodSpendLimit.DenomsSubsetOf(a.SpendLimit) {
+		return sdkerrors.ErrInvalidCoins.Wrap("period spend limit has different currency than spend limit")
+	}
+
+	return nil
+}
+
+// MsgCoins returns the sum of all the coins found in the fields of a message,
+// including nested messages and repeated fields. Coins wrapped in Any fields
+// are not inspected.
+func MsgCoins(msg sdk.Msg) (sdk.Coins, error) {
+	coins := sdk.NewCoins()
+	err := collectCoins(reflect.ValueOf(msg), &coins)
+	return coins, err
+}
+
+func collectCoins(v reflect.Value, coins *sdk.Coins) error {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return collectCoins(v.Elem(), coins)
+
+	case reflect.Struct:
+		if v.Type() == coinType {
+			coin := v.Interface().(sdk.Coin)
+			if coin.Amount.IsNil() || coin.IsZero() {
+				return nil
+			}
+			if err := coin.Validate(); err != nil {
+				return sdkerrors.ErrInvalidCoins.Wrap(err.Error())
+			}
+			*coins = coins.Add(coin)
+			return nil
+		}
+
+		for i := 0; i < v.NumField(); i++ {
+			// Unexported fields, e.g. the cached value of an Any, are skipped.
+			if !v.Type().Field(i).IsExported() {
+				continue
+			}
+			if err := collectCoins(v.Field(i), coins); err != nil {
+				return err
+			}
+		}
+
+	case reflect.Slice, reflect.Array:
+		switch v.Type().Elem().Kind() {
+		case reflect.Struct, reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Array:
+			for i := 0; i < v.Len(); i++ {
+				if err := collectCoins(v.Index(i), coins); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package authz_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMsgCoins(t *testing.T) {
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+
+	testCases := []struct {
+		name   string
+		msg    sdk.Msg
+		expErr bool
+		expect sdk.Coins
+	}{
+		{
+			"coins field",
+			banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("atom", 5))),
+			false,
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("atom", 5)),
+		},
+		{
+			"coin field",
+			stakingtypes.NewMsgDelegate(addr1, sdk.ValAddress(addr2), sdk.NewInt64Coin("stake", 7)),
+			false,
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 7)),
+		},
+		{
+			"nested repeated fields",
+			banktypes.NewMsgMultiSend(
+				[]banktypes.Input{banktypes.NewInput(addr1, sdk.NewCoins(sdk.NewInt64Coin("stake", 3)))},
+				[]banktypes.Output{banktypes.NewOutput(addr2, sdk.NewCoins(sdk.NewInt64Coin("stake", 3)))},
+			),
+			false,
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 6)),
+		},
+		{
+			"no coins",
+			stakingtypes.NewMsgUndelegate(addr1, sdk.ValAddress(addr2), sdk.Coin{}),
+			false,
+			sdk.NewCoins(),
+		},
+		{
+			"invalid coin",
+			banktypes.NewMsgSend(addr1, addr2, sdk.Coins{sdk.Coin{Denom: "1x", Amount: sdk.NewInt(1)}}),
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			coins, err := authz.MsgCoins(tc.msg)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expect, coins)
+		})
+	}
+}
+
+func TestCoinBudgetAuthorizationValidateBasic(t *testing.T) {
+	limit := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	testCases := []struct {
+		name   string
+		auth   *authz.CoinBudgetAuthorization
+		expErr bool
+	}{
+		{"spend limit only", authz.NewCoinBudgetAuthorization(msgTypeURL, limit, 0, nil), false},
+		{"period only", authz.NewCoinBudgetAuthorization(msgTypeURL, nil, time.Hour, limit), false},
+		{"spend limit and period", authz.NewCoinBudgetAuthorization(msgTypeURL, limit, time.Hour, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))), false},
+		{"no limit", authz.NewCoinBudgetAuthorization(msgTypeURL, nil, 0, nil), true},
+		{"empty msg type", authz.NewCoinBudgetAuthorization("", limit, 0, nil), true},
+		{"period limit without period", authz.NewCoinBudgetAuthorization(msgTypeURL, limit, 0, limit), true},
+		{"period without period limit", authz.NewCoinBudgetAuthorization(msgTypeURL, limit, time.Hour, nil), true},
+		{"negative period", authz.NewCoinBudgetAuthorization(msgTypeURL, limit, -time.Hour, limit), true},
+		{"period limit with other denom", authz.NewCoinBudgetAuthorization(msgTypeURL, limit, time.Hour, sdk.NewCoins(sdk.NewInt64Coin("atom", 10))), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.auth.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCoinBudgetAuthorization(t *testing.T) {
+	app := simapp.Setup(t, false)
+	now := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: now})
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	send := func(amount int64) sdk.Msg {
+		return banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("stake", amount)))
+	}
+
+	authorization := authz.NewCoinBudgetAuthorization(msgTypeURL,
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), time.Hour, sdk.NewCoins(sdk.NewInt64Coin("stake", 60)))
+	require.Equal(t, msgTypeURL, authorization.MsgTypeURL())
+	require.NoError(t, authorization.ValidateBasic())
+
+	t.Log("verify the spend limits are decremented")
+	resp, err := authorization.Accept(ctx, send(50))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	updated := resp.Updated.(*authz.CoinBudgetAuthorization)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), updated.SpendLimit)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), updated.PeriodCanSpend)
+	require.Equal(t, now.Add(time.Hour), updated.PeriodReset)
+
+	t.Log("verify the period spend limit is enforced")
+	_, err = updated.Accept(ctx, send(20))
+	require.ErrorContains(t, err, "period spend limit")
+
+	t.Log("verify coins of other denoms are rejected")
+	_, err = updated.Accept(ctx, banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("atom", 1))))
+	require.Error(t, err)
+
+	t.Log("verify the period spend limit is reset, capped by the spend limit")
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	_, err = updated.Accept(ctx, send(60))
+	require.ErrorContains(t, err, "period spend limit")
+	resp, err = updated.Accept(ctx, send(20))
+	require.NoError(t, err)
+	updated = resp.Updated.(*authz.CoinBudgetAuthorization)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 30)), updated.SpendLimit)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 30)), updated.PeriodCanSpend)
+	require.Equal(t, now.Add(2*time.Hour), updated.PeriodReset)
+
+	t.Log("verify the authorization is deleted once the spend limit is used up")
+	resp, err = updated.Accept(ctx, send(30))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+	require.Nil(t, resp.Updated)
+}
@@ -0,0 +1,256 @@
+package authz
+
+import (
+	"reflect"
+	"time"
+
+	proto "github.com/gogo/protobuf/proto"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const day = 24 * time.Hour
+
+var (
+	_ Authorization                    = &ConditionalAuthorization{}
+	_ cdctypes.UnpackInterfacesMessage = &ConditionalAuthorization{}
+)
+
+// NewConditionalAuthorization creates a new ConditionalAuthorization object
+// wrapping the given authorization.
+func NewConditionalAuthorization(a Authorization, conditions GrantConditions) (*ConditionalAuthorization, error) {
+	msg, ok := a.(proto.Message)
+	if !ok {
+		return nil, sdkerrors.ErrPackAny.Wrapf("cannot proto marshal %T", a)
+	}
+	any, err := cdctypes.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+	return &ConditionalAuthorization{
+		Authorization: any,
+		Conditions:    conditions,
+	}, nil
+}
+
+// GetAuthorization returns the cached value of the wrapped authorization.
+func (a ConditionalAuthorization) GetAuthorization() (Authorization, error) {
+	if a.Authorization == nil {
+		return nil, sdkerrors.ErrInvalidType.Wrap("authorization is nil")
+	}
+	av := a.Authorization.GetCachedValue()
+	inner, ok := av.(Authorization)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", (Authorization)(nil), av)
+	}
+	return inner, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a ConditionalAuthorization) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var inner Authorization
+	return unpacker.UnpackAny(a.Authorization, &inner)
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a ConditionalAuthorization) MsgTypeURL() string {
+	inner, err := a.GetAuthorization()
+	if err != nil {
+		return ""
+	}
+	return inner.MsgTypeURL()
+}
+
+// Accept implements Authorization.Accept. It delegates to the wrapped
+// authorization and keeps track of the executions in the current block window.
+// The grant conditions themselves are checked by CheckConditions beforehand.
+func (a ConditionalAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (AcceptResponse, error) {
+	inner, err := a.GetAuthorization()
+	if err != nil {
+		return AcceptResponse{}, err
+	}
+
+	resp, err := inner.Accept(ctx, msg)
+	if err != nil || resp.Delete {
+		return resp, err
+	}
+
+	updated := a
+	if resp.Updated != nil {
+		if updated.Authorization, err = cdctypes.NewAnyWithValue(resp.Updated); err != nil {
+			return AcceptResponse{}, err
+		}
+	}
+	if resp.Accept && a.Conditions.MaxExecutions > 0 {
+		if a.isNewWindow(ctx.BlockHeight()) {
+			updated.WindowStartHeight = ctx.BlockHeight()
+			updated.WindowExecutions = 0
+		}
+		updated.WindowExecutions++
+	}
+	if resp.Updated != nil || a.Conditions.MaxExecutions > 0 {
+		resp.Updated = &updated
+	}
+
+	return resp, nil
+}
+
+// CheckConditions checks that the grant conditions allow the given message,
+// signed by the granter, to be executed with the given co-signers.
+func (a ConditionalAuthorization) CheckConditions(ctx sdk.Context, granter sdk.AccAddress, coSigners []sdk.AccAddress, msg sdk.Msg) error {
+	c := a.Conditions
+
+	if len(c.TimeWindows) > 0 {
+		blockTime := ctx.BlockTime().UTC()
+		elapsed := blockTime.Sub(blockTime.Truncate(day))
+		inWindow := false
+		for _, w := range c.TimeWindows {
+			if w.contains(elapsed) {
+				inWindow = true
+				break
+			}
+		}
+		if !inWindow {
+			return sdkerrors.ErrUnauthorized.Wrapf("block time %s is outside of the grant time windows", blockTime.Format(time.RFC3339))
+		}
+	}
+
+	if len(c.AllowedRecipients) > 0 {
+		allowed := make(map[string]bool, len(c.AllowedRecipients))
+		for _, r := range c.AllowedRecipients {
+			allowed[r] = true
+		}
+		for _, addr := range msgAddresses(reflect.ValueOf(msg), nil) {
+			if addr != granter.String() && !allowed[addr] {
+				return sdkerrors.ErrUnauthorized.Wrapf("recipient %s is not allowed", addr)
+			}
+		}
+	}
+
+	if c.MaxExecutions > 0 && !a.isNewWindow(ctx.BlockHeight()) && a.WindowExecutions >= c.MaxExecutions {
+		return sdkerrors.ErrUnauthorized.Wrapf("max executions of %d per %d blocks reached", c.MaxExecutions, c.BlockWindow)
+	}
+
+	for _, required := range c.CoSigners {
+		found := false
+		for _, coSigner := range coSigners {
+			if coSigner.String() == required {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return sdkerrors.ErrUnauthorized.Wrapf("missing co-signer %s", required)
+		}
+	}
+
+	return nil
+}
+
+func (a ConditionalAuthorization) isNewWindow(height int64) bool {
+	return height >= a.WindowStartHeight+a.Conditions.BlockWindow
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a ConditionalAuthorization) ValidateBasic() error {
+	inner, err := a.GetAuthorization()
+	if err != nil {
+		return err
+	}
+	if err := inner.ValidateBasic(); err != nil {
+		return err
+	}
+	return a.Conditions.ValidateBasic()
+}
+
+// ValidateBasic performs basic validation of the grant conditions.
+func (c GrantConditions) ValidateBasic() error {
+	for _, w := range c.TimeWindows {
+		if w.Start < 0 || w.Start >= day || w.End < 0 || w.End >= day {
+			return sdkerrors.ErrInvalidRequest.Wrap("time window bounds must be within a day")
+		}
+		if w.Start == w.End {
+			return sdkerrors.ErrInvalidRequest.Wrap("time window cannot be empty")
+		}
+	}
+
+	recipients := make(map[string]bool, len(c.AllowedRecipients))
+	for _, r := range c.AllowedRecipients {
+		if _, err := sdk.AccAddressFromBech32(r); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid allowed recipient %s: %s", r, err)
+		}
+		if recipients[r] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate allowed recipient %s", r)
+		}
+		recipients[r] = true
+	}
+
+	if c.BlockWindow < 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("block window cannot be negative")
+	}
+	if c.MaxExecutions > 0 && c.BlockWindow == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("max executions requires a block window")
+	}
+
+	coSigners := make(map[string]bool, len(c.CoSigners))
+	for _, s := range c.CoSigners {
+		if _, err := sdk.AccAddressFromBech32(s); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid co-signer %s: %s", s, err)
+		}
+		if coSigners[s] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate co-signer %s", s)
+		}
+		coSigners[s] = true
+	}
+
+	return nil
+}
+
+// contains returns true if the time elapsed since midnight is within the window.
+func (w TimeWindow) contains(elapsed time.Duration) bool {
+	if w.Start <= w.End {
+		return elapsed >= w.Start && elapsed < w.End
+	}
+	// The window spans midnight.
+	return elapsed >= w.Start || elapsed < w.End
+}
+
+// msgAddresses returns all the account addresses found in the string fields
+// of a message, including nested messages and repeated fields. Other bech32
+// addresses, e.g. validator operator addresses, are not recipients and are
+// skipped. Addresses wrapped in Any fields are not inspected.
+func msgAddresses(v reflect.Value, addrs []string) []string {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return addrs
+		}
+		return msgAddresses(v.Elem(), addrs)
+
+	case reflect.String:
+		if _, err := sdk.AccAddressFromBech32(v.String()); err == nil {
+			addrs = append(addrs, v.String())
+		}
+
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			// Unexported fields, e.g. the cached value of an Any, are skipped.
+			if !v.Type().Field(i).IsExported() {
+				continue
+			}
+			addrs = msgAddresses(v.Field(i), addrs)
+		}
+
+	case reflect.Slice, reflect.Array:
+		switch v.Type().Elem().Kind() {
+		case reflect.String, reflect.Struct, reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Array:
+			for i := 0; i < v.Len(); i++ {
+				addrs = msgAddresses(v.Index(i), addrs)
+			}
+		}
+	}
+
+	return addrs
+}
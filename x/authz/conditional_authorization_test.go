@@ -0,0 +1,117 @@
+package authz_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestConditionalAuthorizationTimeWindows(t *testing.T) {
+	app := simapp.Setup(t, false)
+	midnight := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, _, granter := testdata.KeyTestPubAddr()
+	_, _, recipient := testdata.KeyTestPubAddr()
+	msg := banktypes.NewMsgSend(granter, recipient, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+
+	authorization, err := authz.NewConditionalAuthorization(authz.NewGenericAuthorization(msgTypeURL), authz.GrantConditions{
+		TimeWindows: []authz.TimeWindow{
+			{Start: 9 * time.Hour, End: 17 * time.Hour},
+			{Start: 22 * time.Hour, End: time.Hour},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, authorization.ValidateBasic())
+
+	testCases := []struct {
+		name   string
+		offset time.Duration
+		expErr bool
+	}{
+		{"window start", 9 * time.Hour, false},
+		{"within window", 12 * time.Hour, false},
+		{"window end", 17 * time.Hour, true},
+		{"outside windows", 20 * time.Hour, true},
+		{"window spanning midnight, before midnight", 23 * time.Hour, false},
+		{"window spanning midnight, after midnight", 30 * time.Minute, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: midnight.Add(tc.offset)})
+			err := authorization.CheckConditions(ctx, granter, nil, msg)
+			if tc.expErr {
+				require.ErrorContains(t, err, "outside of the grant time windows")
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestConditionalAuthorizationAllowedRecipients(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	_, _, granter := testdata.KeyTestPubAddr()
+	_, _, recipient := testdata.KeyTestPubAddr()
+	_, _, other := testdata.KeyTestPubAddr()
+	coin := sdk.NewInt64Coin("stake", 1)
+
+	authorization, err := authz.NewConditionalAuthorization(authz.NewGenericAuthorization(msgTypeURL), authz.GrantConditions{
+		AllowedRecipients: []string{recipient.String()},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, authorization.CheckConditions(ctx, granter, nil, banktypes.NewMsgSend(granter, recipient, sdk.NewCoins(coin))))
+	require.ErrorContains(t, authorization.CheckConditions(ctx, granter, nil, banktypes.NewMsgSend(granter, other, sdk.NewCoins(coin))),
+		"is not allowed")
+
+	// validator operator addresses are not recipients
+	delegate := stakingtypes.NewMsgDelegate(granter, sdk.ValAddress(other), coin)
+	require.NoError(t, authorization.CheckConditions(ctx, granter, nil, delegate))
+}
+
+func TestGrantConditionsValidateBasic(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+	testCases := []struct {
+		name       string
+		conditions authz.GrantConditions
+		expErr     bool
+	}{
+		{"empty conditions", authz.GrantConditions{}, false},
+		{"valid conditions", authz.GrantConditions{
+			TimeWindows:       []authz.TimeWindow{{Start: time.Hour, End: 2 * time.Hour}},
+			AllowedRecipients: []string{addr.String()},
+			MaxExecutions:     2,
+			BlockWindow:       100,
+			CoSigners:         []string{addr.String()},
+		}, false},
+		{"time window beyond a day", authz.GrantConditions{TimeWindows: []authz.TimeWindow{{Start: time.Hour, End: 25 * time.Hour}}}, true},
+		{"empty time window", authz.GrantConditions{TimeWindows: []authz.TimeWindow{{Start: time.Hour, End: time.Hour}}}, true},
+		{"invalid recipient", authz.GrantConditions{AllowedRecipients: []string{"recipient"}}, true},
+		{"validator recipient", authz.GrantConditions{AllowedRecipients: []string{sdk.ValAddress(addr).String()}}, true},
+		{"duplicate recipient", authz.GrantConditions{AllowedRecipients: []string{addr.String(), addr.String()}}, true},
+		{"max executions without block window", authz.GrantConditions{MaxExecutions: 1}, true},
+		{"negative block window", authz.GrantConditions{BlockWindow: -1}, true},
+		{"invalid co-signer", authz.GrantConditions{CoSigners: []string{"co-signer"}}, true},
+		{"duplicate co-signer", authz.GrantConditions{CoSigners: []string{addr.String(), addr.String()}}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.conditions.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -182,3 +182,57 @@ func (k Keeper) GranteeGrants(c context.Context, req *authz.QueryGranteeGrantsRe
 		Pagination: pageRes,
 	}, nil
 }
+
+// GranteeGrantsByMsgType implements the Query/GranteeGrantsByMsgType gRPC method.
+func (k Keeper) GranteeGrantsByMsgType(c context.Context, req *authz.QueryGranteeGrantsByMsgTypeRequest) (*authz.QueryGranteeGrantsByMsgTypeResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.MsgTypeUrl == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "empty msg type url")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), granteeMsgTypeIndexKey(grantee, req.MsgTypeUrl, nil))
+
+	var authorizations []*authz.GrantAuthorization
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		granter := firstAddressFromGrantStoreKey(key)
+		grant, found := k.getGrant(ctx, grantStoreKey(grantee, granter, req.MsgTypeUrl))
+		if !found {
+			return sdkerrors.Wrapf(authz.ErrNoAuthorizationFound, "authorization not found for %s type", req.MsgTypeUrl)
+		}
+
+		authorization, err := grant.GetAuthorization()
+		if err != nil {
+			return err
+		}
+
+		authorizationAny, err := codectypes.NewAnyWithValue(authorization)
+		if err != nil {
+			return status.Errorf(codes.Internal, err.Error())
+		}
+
+		authorizations = append(authorizations, &authz.GrantAuthorization{
+			Authorization: authorizationAny,
+			Expiration:    grant.Expiration,
+			Granter:       granter.String(),
+			Grantee:       grantee.String(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &authz.QueryGranteeGrantsByMsgTypeResponse{
+		Grants:     authorizations,
+		Pagination: pageRes,
+	}, nil
+}
@@ -302,6 +302,100 @@ func (suite *TestSuite) TestGRPCQueryGranteeGrants() {
 	}
 }
 
+func (suite *TestSuite) TestGRPCQueryGranteeGrantsByMsgType() {
+	require := suite.Require()
+	queryClient, addrs := suite.queryClient, suite.addrs
+	multiSendMsgType := sdk.MsgTypeURL(&banktypes.MsgMultiSend{})
+
+	testCases := []struct {
+		msg      string
+		preRun   func()
+		expError bool
+		request  authz.QueryGranteeGrantsByMsgTypeRequest
+		numItems int
+	}{
+		{
+			"fail invalid grantee addr",
+			func() {},
+			true,
+			authz.QueryGranteeGrantsByMsgTypeRequest{MsgTypeUrl: bankSendAuthMsgType},
+			0,
+		},
+		{
+			"fail empty msg type",
+			func() {},
+			true,
+			authz.QueryGranteeGrantsByMsgTypeRequest{Grantee: addrs[0].String()},
+			0,
+		},
+		{
+			"valid case, single authorization",
+			func() {
+				suite.createSendAuthorization(addrs[0], addrs[1])
+				err := suite.app.AuthzKeeper.SaveGrant(suite.ctx, addrs[0], addrs[2], authz.NewGenericAuthorization(multiSendMsgType), nil)
+				require.NoError(err)
+			},
+			false,
+			authz.QueryGranteeGrantsByMsgTypeRequest{
+				Grantee:    addrs[0].String(),
+				MsgTypeUrl: bankSendAuthMsgType,
+			},
+			1,
+		},
+		{
+			"valid case, no authorization found for msg type",
+			func() {},
+			false,
+			authz.QueryGranteeGrantsByMsgTypeRequest{
+				Grantee:    addrs[1].String(),
+				MsgTypeUrl: bankSendAuthMsgType,
+			},
+			0,
+		},
+		{
+			"valid case, multiple authorization",
+			func() {
+				suite.createSendAuthorization(addrs[0], addrs[2])
+			},
+			false,
+			authz.QueryGranteeGrantsByMsgTypeRequest{
+				Grantee:    addrs[0].String(),
+				MsgTypeUrl: bankSendAuthMsgType,
+			},
+			2,
+		},
+		{
+			"valid case, pagination",
+			func() {},
+			false,
+			authz.QueryGranteeGrantsByMsgTypeRequest{
+				Grantee:    addrs[0].String(),
+				MsgTypeUrl: bankSendAuthMsgType,
+				Pagination: &query.PageRequest{
+					Limit: 1,
+				},
+			},
+			1,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			tc.preRun()
+			result, err := queryClient.GranteeGrantsByMsgType(gocontext.Background(), &tc.request)
+			if tc.expError {
+				require.Error(err)
+			} else {
+				require.NoError(err)
+				require.Len(result.Grants, tc.numItems)
+				for _, grant := range result.Grants {
+					require.Equal(addrs[0].String(), grant.Grantee)
+				}
+			}
+		})
+	}
+}
+
 func (suite *TestSuite) createSendAuthorization(a1, a2 sdk.AccAddress) authz.Authorization {
 	exp := suite.ctx.BlockHeader().Time.Add(time.Hour)
 	newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
@@ -84,6 +84,13 @@ func (k Keeper) update(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccA
 // DispatchActions attempts to execute the provided messages via authorization
 // grants from the message signer to the grantee.
 func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) ([][]byte, error) {
+	return k.DispatchActionsWithCoSigners(ctx, grantee, nil, msgs)
+}
+
+// DispatchActionsWithCoSigners attempts to execute the provided messages via
+// authorization grants from the message signer to the grantee, co-signed by
+// the given co-signers.
+func (k Keeper) DispatchActionsWithCoSigners(ctx sdk.Context, grantee sdk.AccAddress, coSigners []sdk.AccAddress, msgs []sdk.Msg) ([][]byte, error) {
 	results := make([][]byte, len(msgs))
 	now := ctx.BlockTime()
 
@@ -114,6 +121,10 @@ func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []
 				return nil, err
 			}
 
+			if err := checkConditions(ctx, authorization, granter, coSigners, msg); err != nil {
+				return nil, err
+			}
+
 			resp, err := authorization.Accept(ctx, msg)
 			if err != nil {
 				return nil, err
@@ -161,6 +172,25 @@ func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []
 	return results, nil
 }
 
+// checkConditions checks the grant conditions of the conditional
+// authorizations wrapping an authorization, if any.
+func checkConditions(ctx sdk.Context, authorization authz.Authorization, granter sdk.AccAddress, coSigners []sdk.AccAddress, msg sdk.Msg) error {
+	for {
+		conditional, ok := authorization.(*authz.ConditionalAuthorization)
+		if !ok {
+			return nil
+		}
+		if err := conditional.CheckConditions(ctx, granter, coSigners, msg); err != nil {
+			return err
+		}
+
+		var err error
+		if authorization, err = conditional.GetAuthorization(); err != nil {
+			return err
+		}
+	}
+}
+
 // SaveGrant method grants the provided authorization to the grantee on the granter's account
 // with the provided expiration time and insert authorization key into the grants queue. If there is an existing authorization grant for the
 // same `sdk.Msg` type, this grant overwrites that.
@@ -192,6 +222,7 @@ func (k Keeper) SaveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, auth
 
 	bz := k.cdc.MustMarshal(&grant)
 	store.Set(skey, bz)
+	store.Set(granteeMsgTypeIndexKey(grantee, msgType, granter), []byte{})
 
 	return ctx.EventManager().EmitTypedEvent(&authz.EventGrant{
 		MsgTypeUrl: authorization.MsgTypeURL(),
@@ -218,6 +249,7 @@ func (k Keeper) DeleteGrant(ctx sdk.Context, grantee sdk.AccAddress, granter sdk
 	}
 
 	store.Delete(skey)
+	store.Delete(granteeMsgTypeIndexKey(grantee, msgType, granter))
 
 	return ctx.EventManager().EmitTypedEvent(&authz.EventRevoke{
 		MsgTypeUrl: msgType,
@@ -374,29 +406,83 @@ func (keeper Keeper) removeFromGrantQueue(ctx sdk.Context, grantKey []byte, gran
 }
 
 // DequeueAndDeleteExpiredGrants deletes expired grants from the state and grant queue.
-func (k Keeper) DequeueAndDeleteExpiredGrants(ctx sdk.Context) error {
+// At most limit grants are deleted, the remaining ones are deleted on the
+// following calls.
+func (k Keeper) DequeueAndDeleteExpiredGrants(ctx sdk.Context, limit int) error {
 	store := ctx.KVStore(k.storeKey)
 
+	// The queue items are collected first, the store must not be written while
+	// the iterator is open.
+	var keys [][]byte
+	var items []authz.GrantQueueItem
 	iterator := store.Iterator(GrantQueuePrefix, sdk.InclusiveEndBytes(GrantQueueTimePrefix(ctx.BlockTime())))
-	defer iterator.Close()
-
-	for ; iterator.Valid(); iterator.Next() {
+	count := 0
+	for ; iterator.Valid() && count < limit; iterator.Next() {
 		var queueItem authz.GrantQueueItem
 		if err := k.cdc.Unmarshal(iterator.Value(), &queueItem); err != nil {
+			iterator.Close()
 			return err
 		}
 
-		_, granter, grantee, err := parseGrantQueueKey(iterator.Key())
+		keys = append(keys, iterator.Key())
+		items = append(items, queueItem)
+		count += len(queueItem.MsgTypeUrls)
+	}
+	iterator.Close()
+
+	count = 0
+	for i, key := range keys {
+		exp, granter, grantee, err := parseGrantQueueKey(key)
 		if err != nil {
 			return err
 		}
 
-		store.Delete(iterator.Key())
+		// The queue item is only partially pruned when the limit is reached.
+		pruned := items[i].MsgTypeUrls
+		if n := limit - count; len(pruned) > n {
+			pruned = pruned[:n]
+			if err := k.setGrantQueueItem(ctx, exp, granter, grantee, &authz.GrantQueueItem{
+				MsgTypeUrls: items[i].MsgTypeUrls[n:],
+			}); err != nil {
+				return err
+			}
+		} else {
+			store.Delete(key)
+		}
 
-		for _, typeURL := range queueItem.MsgTypeUrls {
+		for _, typeURL := range pruned {
 			store.Delete(grantStoreKey(grantee, granter, typeURL))
+			store.Delete(granteeMsgTypeIndexKey(grantee, typeURL, granter))
 		}
+		count += len(pruned)
 	}
 
 	return nil
 }
+
+// RevokeAllGrants revokes all the grants of the granter, or only the ones for
+// msgType if it is not empty, and returns the number of revoked grants.
+func (k Keeper) RevokeAllGrants(ctx sdk.Context, granter sdk.AccAddress, msgType string) (uint64, error) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, grantStoreKey(nil, granter, ""))
+
+	var grantees []sdk.AccAddress
+	var msgTypes []string
+	for ; iter.Valid(); iter.Next() {
+		_, grantee, typeURL := parseGrantStoreKey(iter.Key())
+		if msgType != "" && typeURL != msgType {
+			continue
+		}
+		grantees = append(grantees, grantee)
+		msgTypes = append(msgTypes, typeURL)
+	}
+	iter.Close()
+
+	for i, grantee := range grantees {
+		if err := k.DeleteGrant(ctx, grantee, granter, msgTypes[i]); err != nil {
+			return 0, err
+		}
+	}
+
+	return uint64(len(grantees)), nil
+}
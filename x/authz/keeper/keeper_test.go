@@ -321,6 +321,57 @@ func (s *TestSuite) TestDispatchedEvents() {
 	}
 }
 
+func (s *TestSuite) TestDispatchActionsWithConditions() {
+	require := s.Require()
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	granterAddr := addrs[0]
+	granteeAddr := addrs[1]
+	recipientAddr := addrs[2]
+	coSignerAddr := addrs[3]
+	require.NoError(testutil.FundAccount(app.BankKeeper, ctx, granterAddr, coins1000))
+
+	authorization, err := authz.NewConditionalAuthorization(&banktypes.SendAuthorization{SpendLimit: coins100}, authz.GrantConditions{
+		AllowedRecipients: []string{recipientAddr.String()},
+		MaxExecutions:     1,
+		BlockWindow:       10,
+		CoSigners:         []string{coSignerAddr.String()},
+	})
+	require.NoError(err)
+	require.Equal(bankSendAuthMsgType, authorization.MsgTypeURL())
+	require.NoError(app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, authorization, nil))
+
+	send := func(to sdk.AccAddress) []sdk.Msg {
+		return []sdk.Msg{banktypes.NewMsgSend(granterAddr, to, coins10)}
+	}
+	coSigners := []sdk.AccAddress{coSignerAddr}
+
+	_, err = app.AuthzKeeper.DispatchActionsWithCoSigners(ctx, granteeAddr, coSigners, send(addrs[4]))
+	require.ErrorContains(err, "is not allowed")
+
+	_, err = app.AuthzKeeper.DispatchActions(ctx, granteeAddr, send(recipientAddr))
+	require.ErrorContains(err, "missing co-signer")
+
+	_, err = app.AuthzKeeper.DispatchActionsWithCoSigners(ctx, granteeAddr, coSigners, send(recipientAddr))
+	require.NoError(err)
+
+	authorizations, err := app.AuthzKeeper.GetAuthorizations(ctx, granteeAddr, granterAddr)
+	require.NoError(err)
+	require.Len(authorizations, 1)
+	updated := authorizations[0].(*authz.ConditionalAuthorization)
+	require.Equal(uint64(1), updated.WindowExecutions)
+	inner, err := updated.GetAuthorization()
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 90)), inner.(*banktypes.SendAuthorization).SpendLimit)
+
+	_, err = app.AuthzKeeper.DispatchActionsWithCoSigners(ctx, granteeAddr, coSigners, send(recipientAddr))
+	require.ErrorContains(err, "max executions")
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	_, err = app.AuthzKeeper.DispatchActionsWithCoSigners(ctx, granteeAddr, coSigners, send(recipientAddr))
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), app.BankKeeper.GetAllBalances(ctx, recipientAddr).Sub(sdk.NewCoins(sdk.NewInt64Coin("stake", 30000000))...))
+}
+
 func (s *TestSuite) TestDequeueAllGrantsQueue() {
 	require := s.Require()
 	app, addrs := s.app, s.addrs
@@ -346,7 +397,7 @@ func (s *TestSuite) TestDequeueAllGrantsQueue() {
 	require.NoError(err)
 
 	newCtx := s.ctx.WithBlockTime(exp.AddDate(1, 0, 0))
-	err = app.AuthzKeeper.DequeueAndDeleteExpiredGrants(newCtx)
+	err = app.AuthzKeeper.DequeueAndDeleteExpiredGrants(newCtx, 200)
 	require.NoError(err)
 
 	s.T().Log("verify expired grants are pruned from the state")
@@ -367,6 +418,87 @@ func (s *TestSuite) TestDequeueAllGrantsQueue() {
 	require.Len(authzs, 1)
 }
 
+func (s *TestSuite) TestDequeueExpiredGrantsLimit() {
+	require := s.Require()
+	app, addrs := s.app, s.addrs
+	granter := addrs[0]
+	exp := s.ctx.BlockTime().AddDate(0, 0, 1)
+
+	for _, grantee := range addrs[1:4] {
+		require.NoError(app.AuthzKeeper.SaveGrant(s.ctx, grantee, granter, authz.NewGenericAuthorization(bankSendAuthMsgType), &exp))
+		require.NoError(app.AuthzKeeper.SaveGrant(s.ctx, grantee, granter, authz.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgMultiSend{})), &exp))
+	}
+	countGrants := func(ctx sdk.Context) int {
+		count := 0
+		app.AuthzKeeper.IterateGrants(ctx, func(_, _ sdk.AccAddress, _ authz.Grant) bool {
+			count++
+			return false
+		})
+		return count
+	}
+	require.Equal(6, countGrants(s.ctx))
+
+	s.T().Log("verify at most limit grants are pruned, including partially pruned queue items")
+	newCtx := s.ctx.WithBlockTime(exp.AddDate(0, 0, 1))
+	require.NoError(app.AuthzKeeper.DequeueAndDeleteExpiredGrants(newCtx, 3))
+	require.Equal(3, countGrants(newCtx))
+
+	require.NoError(app.AuthzKeeper.DequeueAndDeleteExpiredGrants(newCtx, 2))
+	require.Equal(1, countGrants(newCtx))
+
+	require.NoError(app.AuthzKeeper.DequeueAndDeleteExpiredGrants(newCtx, 2))
+	require.Equal(0, countGrants(newCtx))
+
+	s.T().Log("verify the pruned grants are removed from the grantee msg type index")
+	res, err := app.AuthzKeeper.GranteeGrantsByMsgType(sdk.WrapSDKContext(newCtx), &authz.QueryGranteeGrantsByMsgTypeRequest{
+		Grantee:    addrs[1].String(),
+		MsgTypeUrl: bankSendAuthMsgType,
+	})
+	require.NoError(err)
+	require.Empty(res.Grants)
+}
+
+func (s *TestSuite) TestRevokeAllGrants() {
+	require := s.Require()
+	app, addrs := s.app, s.addrs
+	granter := addrs[0]
+	exp := s.ctx.BlockTime().AddDate(0, 0, 1)
+	multiSendMsgType := sdk.MsgTypeURL(&banktypes.MsgMultiSend{})
+
+	require.NoError(app.AuthzKeeper.SaveGrant(s.ctx, addrs[1], granter, authz.NewGenericAuthorization(bankSendAuthMsgType), &exp))
+	require.NoError(app.AuthzKeeper.SaveGrant(s.ctx, addrs[1], granter, authz.NewGenericAuthorization(multiSendMsgType), nil))
+	require.NoError(app.AuthzKeeper.SaveGrant(s.ctx, addrs[2], granter, authz.NewGenericAuthorization(bankSendAuthMsgType), &exp))
+	require.NoError(app.AuthzKeeper.SaveGrant(s.ctx, addrs[2], addrs[1], authz.NewGenericAuthorization(bankSendAuthMsgType), nil))
+
+	msgSrvr := app.AuthzKeeper
+
+	s.T().Log("verify revoking the grants of a msg type")
+	res, err := msgSrvr.RevokeAll(sdk.WrapSDKContext(s.ctx), &authz.MsgRevokeAll{Granter: granter.String(), MsgTypeUrl: bankSendAuthMsgType})
+	require.NoError(err)
+	require.Equal(uint64(2), res.Revoked)
+	authorization, _ := app.AuthzKeeper.GetAuthorization(s.ctx, addrs[1], granter, multiSendMsgType)
+	require.NotNil(authorization)
+
+	s.T().Log("verify the revoked grants are removed from the grant queue")
+	newCtx := s.ctx.WithBlockTime(exp.AddDate(0, 0, 1))
+	require.NoError(app.AuthzKeeper.DequeueAndDeleteExpiredGrants(newCtx, 200))
+
+	s.T().Log("verify revoking all the grants")
+	res, err = msgSrvr.RevokeAll(sdk.WrapSDKContext(s.ctx), &authz.MsgRevokeAll{Granter: granter.String()})
+	require.NoError(err)
+	require.Equal(uint64(1), res.Revoked)
+	authorizations, err := app.AuthzKeeper.GetAuthorizations(s.ctx, addrs[1], granter)
+	require.NoError(err)
+	require.Len(authorizations, 0)
+
+	s.T().Log("verify grants of other granters are kept")
+	authorization, _ = app.AuthzKeeper.GetAuthorization(s.ctx, addrs[2], addrs[1], bankSendAuthMsgType)
+	require.NotNil(authorization)
+
+	_, err = msgSrvr.RevokeAll(sdk.WrapSDKContext(s.ctx), &authz.MsgRevokeAll{Granter: granter.String()})
+	require.ErrorIs(err, authz.ErrNoAuthorizationFound)
+}
+
 func (s *TestSuite) TestGetAuthorization() {
 	addr1 := s.addrs[3]
 	addr2 := s.addrs[4]
@@ -15,9 +15,11 @@ import (
 //
 // - 0x01<grant_Bytes>: Grant
 // - 0x02<grant_expiration_Bytes>: GrantQueueItem
+// - 0x03<grantee_Bytes><msgType_Bytes><granter_Bytes>: []byte{}
 var (
-	GrantKey         = []byte{0x01} // prefix for each key
-	GrantQueuePrefix = []byte{0x02}
+	GrantKey                  = []byte{0x01} // prefix for each key
+	GrantQueuePrefix          = []byte{0x02}
+	GranteeMsgTypeIndexPrefix = []byte{0x03}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -92,6 +94,20 @@ func GrantQueueTimePrefix(expiration time.Time) []byte {
 	return append(GrantQueuePrefix, sdk.FormatTimeBytes(expiration)...)
 }
 
+// granteeMsgTypeIndexKey - return the (grantee, msg type) index key of a grant.
+// An empty granter returns the prefix of all the grants of the grantee for
+// msgType.
+// Key format is:
+//
+//	0x03<granteeAddressLen (1 Byte)><granteeAddress_Bytes><msgTypeLen (1 Byte)><msgType_Bytes><granterAddressLen (1 Byte)><granterAddress_Bytes>
+func granteeMsgTypeIndexKey(grantee sdk.AccAddress, msgType string, granter sdk.AccAddress) []byte {
+	m := address.MustLengthPrefix(conv.UnsafeStrToBytes(msgType))
+	grantee = address.MustLengthPrefix(grantee)
+	granter = address.MustLengthPrefix(granter)
+
+	return sdk.AppendLengthPrefixedBytes(GranteeMsgTypeIndexPrefix, grantee, m, granter)
+}
+
 // firstAddressFromGrantStoreKey parses the first address only
 func firstAddressFromGrantStoreKey(key []byte) sdk.AccAddress {
 	addrLen := key[0]
@@ -68,6 +68,25 @@ func (k Keeper) Revoke(goCtx context.Context, msg *authz.MsgRevoke) (*authz.MsgR
 	return &authz.MsgRevokeResponse{}, nil
 }
 
+// RevokeAll implements the MsgServer.RevokeAll method.
+func (k Keeper) RevokeAll(goCtx context.Context, msg *authz.MsgRevokeAll) (*authz.MsgRevokeAllResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, err := k.RevokeAllGrants(ctx, granter, msg.MsgTypeUrl)
+	if err != nil {
+		return nil, err
+	}
+	if revoked == 0 {
+		return nil, sdkerrors.Wrapf(authz.ErrNoAuthorizationFound, "no grants found for granter %s", msg.Granter)
+	}
+
+	return &authz.MsgRevokeAllResponse{Revoked: revoked}, nil
+}
+
 // Exec implements the MsgServer.Exec method.
 func (k Keeper) Exec(goCtx context.Context, msg *authz.MsgExec) (*authz.MsgExecResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -81,7 +100,7 @@ func (k Keeper) Exec(goCtx context.Context, msg *authz.MsgExec) (*authz.MsgExecR
 		return nil, err
 	}
 
-	results, err := k.DispatchActions(ctx, grantee, msgs)
+	results, err := k.DispatchActionsWithCoSigners(ctx, grantee, msg.GetCoSigners(), msgs)
 	if err != nil {
 		return nil, err
 	}
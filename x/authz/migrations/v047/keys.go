@@ -0,0 +1,29 @@
+package v047
+
+import (
+	"github.com/cosmos/cosmos-sdk/internal/conv"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// Keys for store prefixes
+// Items are stored with the following key: values
+//
+// - 0x01<grant_Bytes>: Grant
+// - 0x03<grantee_Bytes><msgType_Bytes><granter_Bytes>: []byte{}
+var (
+	GrantPrefix               = []byte{0x01}
+	GranteeMsgTypeIndexPrefix = []byte{0x03}
+)
+
+// GranteeMsgTypeIndexKey - return the (grantee, msg type) index key of a grant
+// Key format is
+//
+// - 0x03<granteeAddressLen (1 Byte)><granteeAddress_Bytes><msgTypeLen (1 Byte)><msgType_Bytes><granterAddressLen (1 Byte)><granterAddress_Bytes>: []byte{}
+func GranteeMsgTypeIndexKey(grantee sdk.AccAddress, msgType string, granter sdk.AccAddress) []byte {
+	m := address.MustLengthPrefix(conv.UnsafeStrToBytes(msgType))
+	grantee = address.MustLengthPrefix(grantee)
+	granter = address.MustLengthPrefix(granter)
+
+	return sdk.AppendLengthPrefixedBytes(GranteeMsgTypeIndexPrefix, grantee, m, granter)
+}
@@ -0,0 +1,31 @@
+package v047
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v046 "github.com/cosmos/cosmos-sdk/x/authz/migrations/v046"
+)
+
+// MigrateStore performs in-place store migrations from v0.46 to v0.47. The
+// migration includes:
+//
+// - create the (grantee, msg type) secondary index of the grants
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+	grantsStore := prefix.NewStore(store, GrantPrefix)
+
+	grantsIter := grantsStore.Iterator(nil, nil)
+	var keys [][]byte
+	for ; grantsIter.Valid(); grantsIter.Next() {
+		granter, grantee, msgType := v046.ParseGrantKey(grantsIter.Key())
+		keys = append(keys, GranteeMsgTypeIndexKey(grantee, msgType, granter))
+	}
+	grantsIter.Close()
+
+	for _, key := range keys {
+		store.Set(key, []byte{})
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v046 "github.com/cosmos/cosmos-sdk/x/authz/migrations/v046"
+	v047 "github.com/cosmos/cosmos-sdk/x/authz/migrations/v047"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestMigration(t *testing.T) {
+	authzKey := sdk.NewKVStoreKey("authz")
+	ctx := testutil.DefaultContext(authzKey, sdk.NewTransientStoreKey("transient_test"))
+	granter := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	grantee1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	grantee2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	sendMsgType := banktypes.SendAuthorization{}.MsgTypeURL()
+
+	store := ctx.KVStore(authzKey)
+	store.Set(v046.GrantStoreKey(grantee1, granter, sendMsgType), []byte("grant"))
+	store.Set(v046.GrantStoreKey(grantee2, granter, sendMsgType), []byte("grant"))
+
+	require.NoError(t, v047.MigrateStore(ctx, authzKey))
+
+	require.True(t, store.Has(v047.GranteeMsgTypeIndexKey(grantee1, sendMsgType, granter)))
+	require.True(t, store.Has(v047.GranteeMsgTypeIndexKey(grantee2, sendMsgType, granter)))
+	require.False(t, store.Has(v047.GranteeMsgTypeIndexKey(grantee1, "/cosmos.gov.v1beta1.MsgVote", granter)))
+}
@@ -5,10 +5,22 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
 )
 
-// BeginBlocker is called at the beginning of every block
-func BeginBlocker(ctx sdk.Context, keeper keeper.Keeper) {
-	// delete all the mature grants
-	if err := keeper.DequeueAndDeleteExpiredGrants(ctx); err != nil {
+// MaxPrunedGrantsPerBlock is the maximum number of expired grants deleted at
+// the end of each block.
+const MaxPrunedGrantsPerBlock = 200
+
+// EndBlocker is called at the end of every block
+func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
+	// delete the mature grants, bounded per block
+	if err := keeper.DequeueAndDeleteExpiredGrants(ctx, MaxPrunedGrantsPerBlock); err != nil {
 		panic(err)
 	}
 }
+
+// BeginBlocker deletes the mature grants, bounded per block.
+//
+// Deprecated: the module prunes the expired grants in EndBlocker, BeginBlocker
+// is kept for the callers of the previous API.
+func BeginBlocker(ctx sdk.Context, keeper keeper.Keeper) {
+	EndBlocker(ctx, keeper)
+}
@@ -17,11 +17,13 @@ var (
 	_ sdk.Msg = &MsgGrant{}
 	_ sdk.Msg = &MsgRevoke{}
 	_ sdk.Msg = &MsgExec{}
+	_ sdk.Msg = &MsgRevokeAll{}
 
 	// For amino support.
 	_ legacytx.LegacyMsg = &MsgGrant{}
 	_ legacytx.LegacyMsg = &MsgRevoke{}
 	_ legacytx.LegacyMsg = &MsgExec{}
+	_ legacytx.LegacyMsg = &MsgRevokeAll{}
 
 	_ cdctypes.UnpackInterfacesMessage = &MsgGrant{}
 	_ cdctypes.UnpackInterfacesMessage = &MsgExec{}
@@ -172,6 +174,47 @@ func (msg MsgRevoke) GetSignBytes() []byte {
 	return sdk.MustSortJSON(authzcodec.ModuleCdc.MustMarshalJSON(&msg))
 }
 
+// NewMsgRevokeAll creates a new MsgRevokeAll. An empty msgTypeURL revokes
+// the grants of all msg types.
+//
+//nolint:interfacer
+func NewMsgRevokeAll(granter sdk.AccAddress, msgTypeURL string) MsgRevokeAll {
+	return MsgRevokeAll{
+		Granter:    granter.String(),
+		MsgTypeUrl: msgTypeURL,
+	}
+}
+
+// GetSigners implements Msg
+func (msg MsgRevokeAll) GetSigners() []sdk.AccAddress {
+	granter, _ := sdk.AccAddressFromBech32(msg.Granter)
+	return []sdk.AccAddress{granter}
+}
+
+// ValidateBasic implements MsgRequest.ValidateBasic
+func (msg MsgRevokeAll) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Granter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+	}
+
+	return nil
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgRevokeAll) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgRevokeAll) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgRevokeAll) GetSignBytes() []byte {
+	return sdk.MustSortJSON(authzcodec.ModuleCdc.MustMarshalJSON(&msg))
+}
+
 // NewMsgExec creates a new MsgExecAuthorized
 //
 //nolint:interfacer
@@ -209,7 +252,17 @@ func (msg MsgExec) GetMessages() ([]sdk.Msg, error) {
 // GetSigners implements Msg
 func (msg MsgExec) GetSigners() []sdk.AccAddress {
 	grantee, _ := sdk.AccAddressFromBech32(msg.Grantee)
-	return []sdk.AccAddress{grantee}
+	return append([]sdk.AccAddress{grantee}, msg.GetCoSigners()...)
+}
+
+// GetCoSigners returns the co-signers of the execution.
+func (msg MsgExec) GetCoSigners() []sdk.AccAddress {
+	coSigners := make([]sdk.AccAddress, 0, len(msg.CoSigners))
+	for _, s := range msg.CoSigners {
+		coSigner, _ := sdk.AccAddressFromBech32(s)
+		coSigners = append(coSigners, coSigner)
+	}
+	return coSigners
 }
 
 // ValidateBasic implements Msg
@@ -222,6 +275,17 @@ func (msg MsgExec) ValidateBasic() error {
 		return sdkerrors.ErrInvalidRequest.Wrapf("messages cannot be empty")
 	}
 
+	coSigners := map[string]bool{msg.Grantee: true}
+	for _, s := range msg.CoSigners {
+		if _, err := sdk.AccAddressFromBech32(s); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid co-signer address: %s", err)
+		}
+		if coSigners[s] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate signer %s", s)
+		}
+		coSigners[s] = true
+	}
+
 	msgs, err := msg.GetMessages()
 	if err != nil {
 		return err
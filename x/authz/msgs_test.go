@@ -55,6 +55,24 @@ func TestMsgExecAuthorized(t *testing.T) {
 	}
 }
 
+func TestMsgExecCoSigners(t *testing.T) {
+	coSigner := sdk.AccAddress("______co-signer_____")
+	msg := authz.NewMsgExec(grantee, []sdk.Msg{banktypes.NewMsgSend(granter, grantee, coinsPos)})
+
+	msg.CoSigners = []string{coSigner.String()}
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{grantee, coSigner}, msg.GetSigners())
+
+	msg.CoSigners = []string{coSigner.String(), coSigner.String()}
+	require.Error(t, msg.ValidateBasic())
+
+	msg.CoSigners = []string{grantee.String()}
+	require.Error(t, msg.ValidateBasic())
+
+	msg.CoSigners = []string{"co-signer"}
+	require.Error(t, msg.ValidateBasic())
+}
+
 func TestMsgRevokeAuthorization(t *testing.T) {
 	tests := []struct {
 		title            string
@@ -83,6 +101,13 @@ func addDatePtr(t *time.Time, months, days int) *time.Time {
 	return &t2
 }
 
+func TestMsgRevokeAll(t *testing.T) {
+	require.NoError(t, authz.NewMsgRevokeAll(granter, "").ValidateBasic())
+	require.NoError(t, authz.NewMsgRevokeAll(granter, sdk.MsgTypeURL(&banktypes.MsgSend{})).ValidateBasic())
+	require.Error(t, authz.NewMsgRevokeAll(nil, "").ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{granter}, authz.NewMsgRevokeAll(granter, "").GetSigners())
+}
+
 func TestMsgGrantAuthorization(t *testing.T) {
 	now := time.Now()
 	tests := []struct {
@@ -374,6 +374,123 @@ func (m *QueryGranteeGrantsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryGranteeGrantsByMsgTypeRequest is the request type for the Query/GranteeGrantsByMsgType RPC method.
+type QueryGranteeGrantsByMsgTypeRequest struct {
+	Grantee    string `protobuf:"bytes,1,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// pagination defines an pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGranteeGrantsByMsgTypeRequest) Reset()         { *m = QueryGranteeGrantsByMsgTypeRequest{} }
+func (m *QueryGranteeGrantsByMsgTypeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGranteeGrantsByMsgTypeRequest) ProtoMessage()    {}
+func (*QueryGranteeGrantsByMsgTypeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376d714ffdeb1545, []int{6}
+}
+func (m *QueryGranteeGrantsByMsgTypeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGranteeGrantsByMsgTypeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGranteeGrantsByMsgTypeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGranteeGrantsByMsgTypeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGranteeGrantsByMsgTypeRequest.Merge(m, src)
+}
+func (m *QueryGranteeGrantsByMsgTypeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGranteeGrantsByMsgTypeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGranteeGrantsByMsgTypeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGranteeGrantsByMsgTypeRequest proto.InternalMessageInfo
+
+func (m *QueryGranteeGrantsByMsgTypeRequest) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *QueryGranteeGrantsByMsgTypeRequest) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *QueryGranteeGrantsByMsgTypeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGranteeGrantsByMsgTypeResponse is the response type for the Query/GranteeGrantsByMsgType RPC method.
+type QueryGranteeGrantsByMsgTypeResponse struct {
+	// grants is a list of grants for the msg type granted to the grantee.
+	Grants []*GrantAuthorization `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants,omitempty"`
+	// pagination defines an pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGranteeGrantsByMsgTypeResponse) Reset()         { *m = QueryGranteeGrantsByMsgTypeResponse{} }
+func (m *QueryGranteeGrantsByMsgTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGranteeGrantsByMsgTypeResponse) ProtoMessage()    {}
+func (*QueryGranteeGrantsByMsgTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376d714ffdeb1545, []int{7}
+}
+func (m *QueryGranteeGrantsByMsgTypeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGranteeGrantsByMsgTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGranteeGrantsByMsgTypeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGranteeGrantsByMsgTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGranteeGrantsByMsgTypeResponse.Merge(m, src)
+}
+func (m *QueryGranteeGrantsByMsgTypeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGranteeGrantsByMsgTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGranteeGrantsByMsgTypeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGranteeGrantsByMsgTypeResponse proto.InternalMessageInfo
+
+func (m *QueryGranteeGrantsByMsgTypeResponse) GetGrants() []*GrantAuthorization {
+	if m != nil {
+		return m.Grants
+	}
+	return nil
+}
+
+func (m *QueryGranteeGrantsByMsgTypeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryGrantsRequest)(nil), "cosmos.authz.v1beta1.QueryGrantsRequest")
 	proto.RegisterType((*QueryGrantsResponse)(nil), "cosmos.authz.v1beta1.QueryGrantsResponse")
@@ -381,46 +498,52 @@ func init() {
 	proto.RegisterType((*QueryGranterGrantsResponse)(nil), "cosmos.authz.v1beta1.QueryGranterGrantsResponse")
 	proto.RegisterType((*QueryGranteeGrantsRequest)(nil), "cosmos.authz.v1beta1.QueryGranteeGrantsRequest")
 	proto.RegisterType((*QueryGranteeGrantsResponse)(nil), "cosmos.authz.v1beta1.QueryGranteeGrantsResponse")
+	proto.RegisterType((*QueryGranteeGrantsByMsgTypeRequest)(nil), "cosmos.authz.v1beta1.QueryGranteeGrantsByMsgTypeRequest")
+	proto.RegisterType((*QueryGranteeGrantsByMsgTypeResponse)(nil), "cosmos.authz.v1beta1.QueryGranteeGrantsByMsgTypeResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/query.proto", fileDescriptor_376d714ffdeb1545) }
 
 var fileDescriptor_376d714ffdeb1545 = []byte{
-	// 529 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0x41, 0x6f, 0xd3, 0x3e,
-	0x18, 0xc6, 0xeb, 0xf6, 0xff, 0x2f, 0xc2, 0x83, 0x8b, 0xe1, 0x90, 0x85, 0x29, 0x8a, 0xaa, 0x09,
-	0x0a, 0xd2, 0xec, 0xad, 0x93, 0x38, 0x22, 0xb6, 0xc3, 0x76, 0x85, 0x00, 0x17, 0x2e, 0x55, 0xba,
-	0xbe, 0x72, 0x23, 0xda, 0x38, 0xb3, 0x1d, 0x44, 0x87, 0x76, 0x81, 0x2f, 0x80, 0xb4, 0x03, 0x1f,
-	0x01, 0x89, 0x33, 0x1f, 0x82, 0xe3, 0x04, 0x17, 0x8e, 0xa8, 0x45, 0xf0, 0x35, 0x50, 0x6d, 0x97,
-	0xae, 0x23, 0xdb, 0x02, 0xd3, 0x24, 0x4e, 0xad, 0xa3, 0xe7, 0x7d, 0xde, 0xdf, 0xfb, 0x38, 0x76,
-	0x70, 0xb8, 0x23, 0xd4, 0x40, 0x28, 0x16, 0xe7, 0xba, 0xb7, 0xc7, 0x9e, 0xaf, 0x75, 0x40, 0xc7,
-	0x6b, 0x6c, 0x37, 0x07, 0x39, 0xa4, 0x99, 0x14, 0x5a, 0x90, 0xeb, 0x56, 0x41, 0x8d, 0x82, 0x3a,
-	0x85, 0xbf, 0xc4, 0x85, 0xe0, 0x7d, 0x60, 0x71, 0x96, 0xb0, 0x38, 0x4d, 0x85, 0x8e, 0x75, 0x22,
-	0x52, 0x65, 0x6b, 0xfc, 0x3b, 0xce, 0xb5, 0x13, 0x2b, 0xb0, 0x66, 0xbf, 0xac, 0xb3, 0x98, 0x27,
-	0xa9, 0x11, 0x3b, 0x6d, 0x31, 0x81, 0xed, 0x66, 0x15, 0x8b, 0x56, 0xd1, 0x36, 0x2b, 0xe6, 0x70,
-	0xcc, 0xa2, 0xf1, 0x1d, 0x61, 0xf2, 0x70, 0xe2, 0xbf, 0x2d, 0xe3, 0x54, 0xab, 0x08, 0x76, 0x73,
-	0x50, 0x9a, 0xb4, 0xf0, 0x25, 0x3e, 0x79, 0x00, 0xd2, 0x43, 0x21, 0x6a, 0x5e, 0xde, 0xf4, 0x3e,
-	0x7d, 0x58, 0x99, 0x0e, 0xb2, 0xd1, 0xed, 0x4a, 0x50, 0xea, 0x91, 0x96, 0x49, 0xca, 0xa3, 0xa9,
-	0x70, 0x56, 0x03, 0x5e, 0xb5, 0x5c, 0x0d, 0x90, 0x10, 0x5f, 0x19, 0x28, 0xde, 0xd6, 0xc3, 0x0c,
-	0xda, 0xb9, 0xec, 0x7b, 0xb5, 0x49, 0x61, 0x84, 0x07, 0x8a, 0x3f, 0x1e, 0x66, 0xf0, 0x44, 0xf6,
-	0xc9, 0x16, 0xc6, 0xb3, 0x89, 0xbd, 0xff, 0x42, 0xd4, 0x5c, 0x68, 0xdd, 0xa4, 0xce, 0x75, 0x12,
-	0x0f, 0xb5, 0x59, 0xbb, 0xb9, 0xe9, 0x83, 0x98, 0x83, 0x9b, 0x22, 0x3a, 0x52, 0xd9, 0x38, 0x40,
-	0xf8, 0xda, 0xdc, 0xa0, 0x2a, 0x13, 0xa9, 0x02, 0xb2, 0x8e, 0xeb, 0x06, 0x46, 0x79, 0x28, 0xac,
-	0x35, 0x17, 0x5a, 0x37, 0x68, 0xd1, 0x76, 0x51, 0x53, 0x15, 0x39, 0x29, 0xd9, 0x9e, 0x83, 0xaa,
-	0x1a, 0xa8, 0x5b, 0x67, 0x42, 0xd9, 0x8e, 0x73, 0x54, 0x6f, 0x11, 0x5e, 0x9c, 0x51, 0x81, 0x3c,
-	0xff, 0x2e, 0x6c, 0x15, 0xa0, 0xfd, 0x4d, 0x5e, 0xef, 0x10, 0xf6, 0x8b, 0xc8, 0x5c, 0x6c, 0xf7,
-	0x8f, 0xc5, 0xd6, 0x3c, 0x25, 0xb6, 0x8d, 0x5c, 0xf7, 0x84, 0x4c, 0xf6, 0x8c, 0xf1, 0x85, 0x67,
-	0x08, 0x27, 0x64, 0x08, 0x65, 0x33, 0x84, 0x8b, 0xca, 0x10, 0xfe, 0xd9, 0x0c, 0x5b, 0x3f, 0x6a,
-	0xf8, 0x7f, 0x43, 0x4a, 0x5e, 0x23, 0x5c, 0xb7, 0x9c, 0xe4, 0x04, 0x9e, 0xdf, 0xaf, 0x0b, 0xff,
-	0x76, 0x09, 0xa5, 0xed, 0xda, 0x58, 0x7e, 0xf5, 0xf9, 0xdb, 0x41, 0x35, 0x20, 0x4b, 0xac, 0xf0,
-	0xda, 0x72, 0x83, 0xbd, 0x47, 0xf8, 0xea, 0xdc, 0x8b, 0x47, 0xd8, 0x59, 0x2d, 0x8e, 0x1d, 0x1e,
-	0x7f, 0xb5, 0x7c, 0x81, 0x43, 0xbb, 0x6b, 0xd0, 0x56, 0x09, 0x3d, 0x0d, 0x8d, 0xb9, 0x83, 0xc6,
-	0x5e, 0xba, 0x3f, 0xfb, 0x47, 0x60, 0xa1, 0x34, 0x2c, 0xfc, 0x29, 0x2c, 0x9c, 0x03, 0x16, 0xa6,
-	0xb0, 0xb0, 0xbf, 0x79, 0xef, 0xe3, 0x28, 0x40, 0x87, 0xa3, 0x00, 0x7d, 0x1d, 0x05, 0xe8, 0xcd,
-	0x38, 0xa8, 0x1c, 0x8e, 0x83, 0xca, 0x97, 0x71, 0x50, 0x79, 0xba, 0xcc, 0x13, 0xdd, 0xcb, 0x3b,
-	0x74, 0x47, 0x0c, 0xa6, 0x9e, 0xf6, 0x67, 0x45, 0x75, 0x9f, 0xb1, 0x17, 0xb6, 0x41, 0xa7, 0x6e,
-	0xbe, 0x1b, 0xeb, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x3e, 0x8b, 0x47, 0x69, 0xf8, 0x06, 0x00,
-	0x00,
+	// 596 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0x41, 0x6f, 0x12, 0x4f,
+	0x18, 0xc6, 0x3b, 0xf4, 0x5f, 0xfe, 0x71, 0xaa, 0x97, 0xd1, 0x98, 0xed, 0xda, 0x6c, 0x08, 0x36,
+	0x8a, 0x26, 0xdd, 0x69, 0x69, 0xa2, 0xf6, 0xa2, 0x96, 0x43, 0x7b, 0x32, 0x51, 0xd4, 0x8b, 0x17,
+	0xb2, 0x94, 0x37, 0xcb, 0x46, 0xd8, 0xd9, 0xce, 0xcc, 0x1a, 0xa9, 0xe9, 0x45, 0xbf, 0x80, 0x49,
+	0x0f, 0x7e, 0x04, 0x13, 0x4f, 0x1e, 0x3c, 0x78, 0xf6, 0xe4, 0xc9, 0x34, 0x7a, 0xf1, 0x68, 0xc0,
+	0xf8, 0x39, 0x0c, 0x33, 0x83, 0x14, 0xba, 0x85, 0x2d, 0xa4, 0x49, 0x4f, 0xcb, 0xc2, 0xf3, 0xbe,
+	0xef, 0xef, 0x79, 0x96, 0x77, 0x00, 0xe7, 0xb6, 0x99, 0x68, 0x32, 0x41, 0xbd, 0x58, 0xd6, 0x77,
+	0xe9, 0x8b, 0xd5, 0x2a, 0x48, 0x6f, 0x95, 0xee, 0xc4, 0xc0, 0x5b, 0x6e, 0xc4, 0x99, 0x64, 0xe4,
+	0x92, 0x56, 0xb8, 0x4a, 0xe1, 0x1a, 0x85, 0xbd, 0xe8, 0x33, 0xe6, 0x37, 0x80, 0x7a, 0x51, 0x40,
+	0xbd, 0x30, 0x64, 0xd2, 0x93, 0x01, 0x0b, 0x85, 0xae, 0xb1, 0x6f, 0x9a, 0xae, 0x55, 0x4f, 0x80,
+	0x6e, 0xf6, 0xaf, 0x75, 0xe4, 0xf9, 0x41, 0xa8, 0xc4, 0x46, 0x9b, 0x4c, 0xa0, 0xa7, 0x69, 0xc5,
+	0x82, 0x56, 0x54, 0xd4, 0x1d, 0xd5, 0x37, 0xfa, 0xa3, 0xfc, 0x1f, 0x84, 0xc9, 0xa3, 0x6e, 0xff,
+	0x2d, 0xee, 0x85, 0x52, 0x94, 0x61, 0x27, 0x06, 0x21, 0x49, 0x11, 0xff, 0xef, 0x77, 0xdf, 0x00,
+	0x6e, 0xa1, 0x1c, 0x2a, 0x9c, 0x2b, 0x59, 0xdf, 0x3f, 0x2d, 0xf7, 0x8c, 0x6c, 0xd4, 0x6a, 0x1c,
+	0x84, 0x78, 0x2c, 0x79, 0x10, 0xfa, 0xe5, 0x9e, 0xb0, 0x5f, 0x03, 0x56, 0x26, 0x5d, 0x0d, 0x90,
+	0x1c, 0x3e, 0xdf, 0x14, 0x7e, 0x45, 0xb6, 0x22, 0xa8, 0xc4, 0xbc, 0x61, 0xcd, 0x76, 0x0b, 0xcb,
+	0xb8, 0x29, 0xfc, 0x27, 0xad, 0x08, 0x9e, 0xf2, 0x06, 0xd9, 0xc4, 0xb8, 0xef, 0xd8, 0xfa, 0x2f,
+	0x87, 0x0a, 0xf3, 0xc5, 0x6b, 0xae, 0xe9, 0xda, 0x8d, 0xc7, 0xd5, 0x59, 0x1b, 0xdf, 0xee, 0x43,
+	0xcf, 0x07, 0xe3, 0xa2, 0x7c, 0xa8, 0x32, 0xbf, 0x8f, 0xf0, 0xc5, 0x01, 0xa3, 0x22, 0x62, 0xa1,
+	0x00, 0xb2, 0x86, 0xb3, 0x0a, 0x46, 0x58, 0x28, 0x37, 0x5b, 0x98, 0x2f, 0x5e, 0x71, 0x93, 0x1e,
+	0x97, 0xab, 0xaa, 0xca, 0x46, 0x4a, 0xb6, 0x06, 0xa0, 0x32, 0x0a, 0xea, 0xfa, 0x58, 0x28, 0x3d,
+	0x71, 0x80, 0xea, 0x1d, 0xc2, 0x0b, 0x7d, 0x2a, 0xe0, 0xd3, 0x3f, 0x85, 0xcd, 0x04, 0xb4, 0x49,
+	0xf2, 0x7a, 0x8f, 0xb0, 0x9d, 0x44, 0x66, 0x62, 0xbb, 0x3f, 0x14, 0x5b, 0x61, 0x44, 0x6c, 0x1b,
+	0xb1, 0xac, 0x33, 0x1e, 0xec, 0xaa, 0xc6, 0xa7, 0x9e, 0x21, 0x1c, 0x93, 0x21, 0xa4, 0xcd, 0x10,
+	0x4e, 0x2b, 0x43, 0x38, 0xbb, 0x19, 0x7e, 0x41, 0x38, 0x7f, 0x94, 0xb4, 0xd4, 0x7a, 0xa0, 0x17,
+	0x71, 0x9a, 0x30, 0x87, 0x57, 0x3c, 0x33, 0x66, 0xc5, 0x67, 0x27, 0x8e, 0xfb, 0x23, 0xc2, 0x57,
+	0x47, 0x9a, 0x38, 0x73, 0xb9, 0x17, 0x3f, 0xcf, 0xe1, 0x39, 0x85, 0x4c, 0xde, 0x20, 0x9c, 0xd5,
+	0xc0, 0xe4, 0x18, 0x9e, 0xa3, 0xc7, 0xb4, 0x7d, 0x23, 0x85, 0x52, 0x4f, 0xcd, 0x2f, 0xbd, 0xfe,
+	0xf1, 0x7b, 0x3f, 0xe3, 0x90, 0x45, 0x9a, 0xf8, 0x73, 0x61, 0x8c, 0x7d, 0x40, 0xf8, 0xc2, 0xc0,
+	0xc2, 0x13, 0x3a, 0x6e, 0xc4, 0xd0, 0xa1, 0x65, 0xaf, 0xa4, 0x2f, 0x30, 0x68, 0xb7, 0x14, 0xda,
+	0x0a, 0x71, 0x47, 0xa1, 0xe9, 0x0b, 0x70, 0xfa, 0xca, 0xbc, 0xd8, 0x3b, 0x04, 0x0b, 0xa9, 0x61,
+	0xe1, 0xa4, 0xb0, 0x30, 0x05, 0x2c, 0xf4, 0x60, 0x61, 0x8f, 0x7c, 0x43, 0xf8, 0x72, 0xf2, 0xf7,
+	0x92, 0xdc, 0x49, 0x0b, 0x31, 0xbc, 0x8f, 0xf6, 0xfa, 0x04, 0x95, 0xc6, 0xc7, 0x3d, 0xe5, 0x63,
+	0x9d, 0xdc, 0x3e, 0x99, 0x0f, 0xda, 0xdb, 0xe5, 0xd2, 0xdd, 0xaf, 0x6d, 0x07, 0x1d, 0xb4, 0x1d,
+	0xf4, 0xab, 0xed, 0xa0, 0xb7, 0x1d, 0x67, 0xe6, 0xa0, 0xe3, 0xcc, 0xfc, 0xec, 0x38, 0x33, 0xcf,
+	0x96, 0xfc, 0x40, 0xd6, 0xe3, 0xaa, 0xbb, 0xcd, 0x9a, 0xbd, 0xe6, 0xfa, 0xb2, 0x2c, 0x6a, 0xcf,
+	0xe9, 0x4b, 0x3d, 0xa9, 0x9a, 0x55, 0x7f, 0x40, 0xd6, 0xfe, 0x0e, 0x00, 0x5b, 0x84, 0x16, 0x38,
+	0x41, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -445,6 +568,9 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	GranteeGrants(ctx context.Context, in *QueryGranteeGrantsRequest, opts ...grpc.CallOption) (*QueryGranteeGrantsResponse, error)
+	// GranteeGrantsByMsgType returns a list of `GrantAuthorization` by grantee
+	// for the given msg type.
+	GranteeGrantsByMsgType(ctx context.Context, in *QueryGranteeGrantsByMsgTypeRequest, opts ...grpc.CallOption) (*QueryGranteeGrantsByMsgTypeResponse, error)
 }
 
 type queryClient struct {
@@ -482,6 +608,15 @@ func (c *queryClient) GranteeGrants(ctx context.Context, in *QueryGranteeGrantsR
 	return out, nil
 }
 
+func (c *queryClient) GranteeGrantsByMsgType(ctx context.Context, in *QueryGranteeGrantsByMsgTypeRequest, opts ...grpc.CallOption) (*QueryGranteeGrantsByMsgTypeResponse, error) {
+	out := new(QueryGranteeGrantsByMsgTypeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.authz.v1beta1.Query/GranteeGrantsByMsgType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Returns list of `Authorization`, granted to the grantee by the granter.
@@ -494,6 +629,9 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	GranteeGrants(context.Context, *QueryGranteeGrantsRequest) (*QueryGranteeGrantsResponse, error)
+	// GranteeGrantsByMsgType returns a list of `GrantAuthorization` by grantee
+	// for the given msg type.
+	GranteeGrantsByMsgType(context.Context, *QueryGranteeGrantsByMsgTypeRequest) (*QueryGranteeGrantsByMsgTypeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -509,6 +647,9 @@ func (*UnimplementedQueryServer) GranterGrants(ctx context.Context, req *QueryGr
 func (*UnimplementedQueryServer) GranteeGrants(ctx context.Context, req *QueryGranteeGrantsRequest) (*QueryGranteeGrantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GranteeGrants not implemented")
 }
+func (*UnimplementedQueryServer) GranteeGrantsByMsgType(ctx context.Context, req *QueryGranteeGrantsByMsgTypeRequest) (*QueryGranteeGrantsByMsgTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GranteeGrantsByMsgType not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -568,6 +709,24 @@ func _Query_GranteeGrants_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GranteeGrantsByMsgType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGranteeGrantsByMsgTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GranteeGrantsByMsgType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.authz.v1beta1.Query/GranteeGrantsByMsgType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GranteeGrantsByMsgType(ctx, req.(*QueryGranteeGrantsByMsgTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.authz.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -584,6 +743,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GranteeGrants",
 			Handler:    _Query_GranteeGrants_Handler,
 		},
+		{
+			MethodName: "GranteeGrantsByMsgType",
+			Handler:    _Query_GranteeGrantsByMsgType_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/authz/v1beta1/query.proto",
@@ -876,6 +1039,104 @@ func (m *QueryGranteeGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryGranteeGrantsByMsgTypeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGranteeGrantsByMsgTypeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGranteeGrantsByMsgTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGranteeGrantsByMsgTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGranteeGrantsByMsgTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGranteeGrantsByMsgTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1003,6 +1264,46 @@ func (m *QueryGranteeGrantsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGranteeGrantsByMsgTypeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGranteeGrantsByMsgTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1787,6 +2088,276 @@ func (m *QueryGranteeGrantsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGranteeGrantsByMsgTypeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGranteeGrantsByMsgTypeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGranteeGrantsByMsgTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGranteeGrantsByMsgTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGranteeGrantsByMsgTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGranteeGrantsByMsgTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, &GrantAuthorization{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
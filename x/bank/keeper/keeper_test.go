@@ -25,6 +25,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -87,12 +88,12 @@ func (suite *IntegrationTestSuite) initKeepersWithmAccPerms(blockedAddrs map[str
 	maccPerms[multiPerm] = []string{authtypes.Burner, authtypes.Minter, authtypes.Staking}
 	maccPerms[randomPerm] = []string{"random"}
 	authKeeper := authkeeper.NewAccountKeeper(
-		appCodec, app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName),
-		authtypes.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix,
+		appCodec, app.GetKey(types.StoreKey), authtypes.ProtoBaseAccount, maccPerms,
+		sdk.Bech32MainPrefix, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	keeper := keeper.NewBaseKeeper(
-		appCodec, app.GetKey(types.StoreKey), authKeeper,
-		app.GetSubspace(types.ModuleName), blockedAddrs,
+		appCodec, app.GetKey(types.StoreKey), authKeeper, blockedAddrs,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	return authKeeper, keeper
@@ -1826,12 +1827,12 @@ func (suite *IntegrationTestSuite) TestBalanceTrackingEvents() {
 	maccPerms[multiPerm] = []string{authtypes.Burner, authtypes.Minter, authtypes.Staking}
 
 	suite.app.AccountKeeper = authkeeper.NewAccountKeeper(
-		suite.app.AppCodec(), suite.app.GetKey(authtypes.StoreKey), suite.app.GetSubspace(authtypes.ModuleName),
-		authtypes.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix,
+		suite.app.AppCodec(), suite.app.GetKey(authtypes.StoreKey), authtypes.ProtoBaseAccount, maccPerms,
+		sdk.Bech32MainPrefix, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	suite.app.BankKeeper = keeper.NewBaseKeeper(suite.app.AppCodec(), suite.app.GetKey(types.StoreKey),
-		suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil,
+		suite.app.AccountKeeper, nil, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	// set account with multiple permissions
@@ -1951,8 +1952,8 @@ func (suite *IntegrationTestSuite) TestMintCoinRestrictions() {
 	maccPerms[multiPerm] = []string{authtypes.Burner, authtypes.Minter, authtypes.Staking}
 
 	suite.app.AccountKeeper = authkeeper.NewAccountKeeper(
-		suite.app.AppCodec(), suite.app.GetKey(authtypes.StoreKey), suite.app.GetSubspace(authtypes.ModuleName),
-		authtypes.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix,
+		suite.app.AppCodec(), suite.app.GetKey(authtypes.StoreKey), authtypes.ProtoBaseAccount, maccPerms,
+		sdk.Bech32MainPrefix, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	suite.app.AccountKeeper.SetModuleAccount(suite.ctx, multiPermAcc)
 
@@ -1991,7 +1992,7 @@ func (suite *IntegrationTestSuite) TestMintCoinRestrictions() {
 
 	for _, test := range tests {
 		suite.app.BankKeeper = keeper.NewBaseKeeper(suite.app.AppCodec(), suite.app.GetKey(types.StoreKey),
-			suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil,
+			suite.app.AccountKeeper, nil, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		).WithMintCoinsRestriction(test.restrictionFn)
 		for _, tc := range test.testCases {
 			if tc.expectPass {
@@ -2374,13 +2375,14 @@ func (suite *IntegrationTestSuite) TestGetAllSendEnabledEntries() {
 
 func (suite *IntegrationTestSuite) TestMigrator_Migrate3to4() {
 	ctx, bankKeeper := suite.ctx, suite.app.BankKeeper
+	legacySubspace := suite.app.GetSubspace(types.ModuleName)
 
 	for _, def := range []bool{true, false} {
 		params := types.Params{DefaultSendEnabled: def}
-		bankKeeper.SetParams(ctx, params)
+		legacySubspace.SetParamSet(ctx, &params)
 		suite.T().Run(fmt.Sprintf("default %t does not change", def), func(t *testing.T) {
 			kp := bankKeeper.(*keeper.BaseKeeper)
-			migrator := keeper.NewMigrator(*kp)
+			migrator := keeper.NewMigrator(*kp, legacySubspace)
 			require.NoError(t, migrator.Migrate3to4(ctx))
 			actual := bankKeeper.GetParams(ctx)
 			assert.Equal(t, params.DefaultSendEnabled, actual.DefaultSendEnabled)
@@ -2394,10 +2396,10 @@ func (suite *IntegrationTestSuite) TestMigrator_Migrate3to4() {
 				{fmt.Sprintf("falsecoin%t", def), false},
 			},
 		}
-		bankKeeper.SetParams(ctx, params)
+		legacySubspace.SetParamSet(ctx, &params)
 		suite.T().Run(fmt.Sprintf("default %t send enabled info moved to store", def), func(t *testing.T) {
 			kp := bankKeeper.(*keeper.BaseKeeper)
-			migrator := keeper.NewMigrator(*kp)
+			migrator := keeper.NewMigrator(*kp, legacySubspace)
 			require.NoError(t, migrator.Migrate3to4(ctx))
 			newParams := bankKeeper.GetParams(ctx)
 			assert.Len(t, newParams.SendEnabled, 0)
@@ -2409,6 +2411,34 @@ func (suite *IntegrationTestSuite) TestMigrator_Migrate3to4() {
 	}
 }
 
+func (suite *IntegrationTestSuite) TestMigrator_Migrate4to5() {
+	ctx, bankKeeper := suite.ctx, suite.app.BankKeeper
+	legacySubspace := suite.app.GetSubspace(types.ModuleName)
+
+	for _, def := range []bool{true, false} {
+		params := types.Params{
+			SendEnabled: []*types.SendEnabled{
+				{Denom: fmt.Sprintf("legacytruecoin%t", def), Enabled: true},
+				{Denom: fmt.Sprintf("legacyfalsecoin%t", def), Enabled: false},
+			},
+			DefaultSendEnabled: def,
+		}
+		legacySubspace.SetParamSet(ctx, &params)
+		bankKeeper.SetParams(ctx, types.NewParams(!def))
+		suite.T().Run(fmt.Sprintf("default %t params moved to store", def), func(t *testing.T) {
+			kp := bankKeeper.(*keeper.BaseKeeper)
+			migrator := keeper.NewMigrator(*kp, legacySubspace)
+			require.NoError(t, migrator.Migrate4to5(ctx))
+			actual := bankKeeper.GetParams(ctx)
+			assert.Equal(t, def, actual.DefaultSendEnabled, "DefaultSendEnabled")
+			assert.Len(t, actual.SendEnabled, 0, "SendEnabled")
+			for _, se := range params.SendEnabled {
+				assert.Equal(t, se.Enabled, bankKeeper.IsSendEnabledDenom(ctx, se.Denom), se.Denom)
+			}
+		})
+	}
+}
+
 func (suite *IntegrationTestSuite) TestSetParams() {
 	ctx, bankKeeper := suite.ctx, suite.app.BankKeeper
 	params := types.NewParams(true)
@@ -2,19 +2,22 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/exported"
 	v043 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v043"
 	v046 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v046"
+	v047 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v047"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
-	keeper BaseKeeper
+	keeper         BaseKeeper
+	legacySubspace exported.Subspace
 }
 
 // NewMigrator returns a new Migrator.
-func NewMigrator(keeper BaseKeeper) Migrator {
-	return Migrator{keeper: keeper}
+func NewMigrator(keeper BaseKeeper, legacySubspace exported.Subspace) Migrator {
+	return Migrator{keeper: keeper, legacySubspace: legacySubspace}
 }
 
 // Migrate1to2 migrates from version 1 to 2.
@@ -28,13 +31,23 @@ func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 }
 
 // Migrate3to4 migrates x/bank storage from version 3 to 4.
+// The params are read from the legacy x/params subspace and written to the
+// x/bank module store.
 func (m Migrator) Migrate3to4(ctx sdk.Context) error {
-	oldParams := m.keeper.GetParams(ctx)
+	var oldParams banktypes.Params
+	m.legacySubspace.GetParamSet(ctx, &oldParams)
 	m.keeper.SetAllSendEnabled(ctx, oldParams.GetSendEnabled())
 	m.keeper.SetParams(ctx, banktypes.NewParams(oldParams.DefaultSendEnabled))
 	return nil
 }
 
+// Migrate4to5 migrates x/bank storage from version 4 to 5. Specifically, it
+// takes the parameters that are currently stored and managed by the x/params
+// module and stores them directly into the x/bank module state.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v047.MigrateStore(ctx, m.keeper.storeKey, m.legacySubspace, m.keeper.cdc)
+}
+
 // Migrate3_V046_4_To_V046_5 fixes migrations from version 2 to for chains based on SDK 0.46.0 - v0.46.4 ONLY.
 // See v046.Migrate_V046_4_To_V046_5 for more details.
 func (m Migrator) Migrate3_V046_4_To_V046_5(ctx sdk.Context) error {
@@ -72,3 +72,47 @@ func TestUpdateDenomMetadata(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateParams(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.BankKeeper)
+	testCases := []struct {
+		Name        string
+		ExpectErr   bool
+		ExpectedErr string
+		req         types.MsgUpdateParams
+	}{
+		{
+			Name:        "fail - wrong authority",
+			ExpectErr:   true,
+			ExpectedErr: "expected cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn got nongovaccount: expected gov account as only signer for proposal message",
+			req: types.MsgUpdateParams{
+				Authority: "nongovaccount",
+				Params:    types.NewParams(false),
+			},
+		},
+		{
+			Name:        "success - correct authority",
+			ExpectErr:   false,
+			ExpectedErr: "",
+			req: types.MsgUpdateParams{
+				Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+				Params:    types.NewParams(false),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			_, err := msgServer.UpdateParams(ctx, &testCase.req)
+			if testCase.ExpectErr {
+				require.Error(t, err)
+				require.Equal(t, testCase.ExpectedErr, err.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.req.Params, app.BankKeeper.GetParams(ctx))
+			}
+		})
+	}
+}
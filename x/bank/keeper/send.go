@@ -12,7 +12,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
-	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/math"
 )
 
@@ -54,10 +53,9 @@ var _ SendKeeper = (*BaseSendKeeper)(nil)
 type BaseSendKeeper struct {
 	BaseViewKeeper
 
-	cdc        codec.BinaryCodec
-	ak         types.AccountKeeper
-	storeKey   storetypes.StoreKey
-	paramSpace paramtypes.Subspace
+	cdc      codec.BinaryCodec
+	ak       types.AccountKeeper
+	storeKey storetypes.StoreKey
 
 	// list of addresses that are restricted from receiving transactions
 	blockedAddrs map[string]bool
@@ -73,7 +71,6 @@ func NewBaseSendKeeper(
 	cdc codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
 	ak types.AccountKeeper,
-	paramSpace paramtypes.Subspace,
 	blockedAddrs map[string]bool,
 	authority string,
 ) BaseSendKeeper {
@@ -86,7 +83,6 @@ func NewBaseSendKeeper(
 		cdc:             cdc,
 		ak:              ak,
 		storeKey:        storeKey,
-		paramSpace:      paramSpace,
 		blockedAddrs:    blockedAddrs,
 		authority:       authority,
 		sendRestriction: newSendRestriction(),
@@ -114,17 +110,28 @@ func (k BaseSendKeeper) GetAuthority() string {
 
 // GetParams returns the total set of bank parameters.
 func (k BaseSendKeeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
 	return params
 }
 
 // SetParams sets the total set of bank parameters.
+//
+// Note: params.SendEnabled is deprecated but it should be here regardless.
+// Any SendEnabled entries are moved into the send enabled store and are not
+// stored with the params.
 func (k BaseSendKeeper) SetParams(ctx sdk.Context, params types.Params) {
 	if len(params.SendEnabled) > 0 {
 		k.SetAllSendEnabled(ctx, params.SendEnabled)
 	}
 	p := types.NewParams(params.DefaultSendEnabled)
-	k.paramSpace.SetParamSet(ctx, &p)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ParamsKey, k.cdc.MustMarshal(&p))
 }
 
 // InputOutputCoins performs multi-send functionality. There must be exactly one input and/or exactly one output.
@@ -0,0 +1,38 @@
+package v047
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/exported"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// MigrateStore performs in-place store migrations from v4 to v5. The
+// migration includes:
+//
+// - Migrate params from the x/params module to the x/bank module store.
+// - Move any SendEnabled entries still in the legacy params into the send enabled store.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, legacySubspace exported.Subspace, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var currParams types.Params
+	legacySubspace.GetParamSet(ctx, &currParams)
+
+	for _, se := range currParams.SendEnabled {
+		store.Set(types.CreateSendEnabledKey(se.Denom), []byte{types.ToBoolB(se.Enabled)})
+	}
+
+	newParams := types.NewParams(currParams.DefaultSendEnabled)
+	if err := newParams.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&newParams)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+	return nil
+}
@@ -10,6 +10,7 @@ const (
 	TypeMsgSend                = "send"
 	TypeMsgMultiSend           = "multisend"
 	TypeMsgUpdateDenomMetadata = "updatedenommetada"
+	TypeMsgUpdateParams        = "update_params"
 )
 
 var _ sdk.Msg = &MsgSend{}
@@ -234,3 +235,35 @@ func (msg MsgUpdateDenomMetadata) GetSigners() []sdk.AccAddress {
 	fromAddress, _ := sdk.AccAddressFromBech32(msg.FromAddress)
 	return []sdk.AccAddress{fromAddress}
 }
+
+var _ sdk.Msg = &MsgUpdateParams{}
+
+// Route Implements Msg
+func (msg MsgUpdateParams) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg
+func (msg MsgUpdateParams) Type() string {
+	return TypeMsgUpdateParams
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return msg.Params.Validate()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
@@ -277,6 +277,101 @@ func (m *MsgUpdateDenomMetadataResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateDenomMetadataResponse proto.InternalMessageInfo
 
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/bank parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{6}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{7}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSend)(nil), "cosmos.bank.v1beta1.MsgSend")
 	proto.RegisterType((*MsgSendResponse)(nil), "cosmos.bank.v1beta1.MsgSendResponse")
@@ -284,47 +379,54 @@ func init() {
 	proto.RegisterType((*MsgMultiSendResponse)(nil), "cosmos.bank.v1beta1.MsgMultiSendResponse")
 	proto.RegisterType((*MsgUpdateDenomMetadata)(nil), "cosmos.bank.v1beta1.MsgUpdateDenomMetadata")
 	proto.RegisterType((*MsgUpdateDenomMetadataResponse)(nil), "cosmos.bank.v1beta1.MsgUpdateDenomMetadataResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.bank.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.bank.v1beta1.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/tx.proto", fileDescriptor_1d8cb1613481f5b7) }
 
 var fileDescriptor_1d8cb1613481f5b7 = []byte{
-	// 560 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x3d, 0x6f, 0xd3, 0x40,
-	0x18, 0xb6, 0x9b, 0x90, 0x92, 0x37, 0x95, 0x2a, 0xdc, 0xa8, 0x24, 0xa6, 0x38, 0x21, 0x62, 0x48,
-	0x41, 0xb5, 0x49, 0x3a, 0x00, 0xed, 0x44, 0xca, 0x02, 0x92, 0x85, 0xe4, 0x8a, 0x01, 0x96, 0xca,
-	0xb1, 0x0f, 0x63, 0xb5, 0xbe, 0xb3, 0x7c, 0xe7, 0x52, 0x56, 0x26, 0x46, 0x26, 0xe6, 0x2e, 0x2c,
-	0x4c, 0x0c, 0xfc, 0x88, 0x8e, 0x15, 0x13, 0x13, 0xa0, 0x64, 0x80, 0x7f, 0x01, 0xf2, 0xdd, 0xd9,
-	0x04, 0xe1, 0xa6, 0x42, 0x4c, 0x4e, 0xfc, 0x7c, 0xbc, 0x1f, 0x8f, 0xef, 0x60, 0xcd, 0x23, 0x34,
-	0x22, 0xd4, 0x1a, 0xbb, 0x78, 0xdf, 0x3a, 0x1c, 0x8c, 0x11, 0x73, 0x07, 0x16, 0x3b, 0x32, 0xe3,
-	0x84, 0x30, 0xa2, 0xad, 0x08, 0xd4, 0xcc, 0x50, 0x53, 0xa2, 0x7a, 0x33, 0x20, 0x01, 0xe1, 0xb8,
-	0x95, 0xfd, 0x12, 0x54, 0xdd, 0x28, 0x8c, 0x28, 0x2a, 0x8c, 0x3c, 0x12, 0xe2, 0xbf, 0xf0, 0x99,
-	0x42, 0xdc, 0x57, 0xe0, 0x6d, 0x81, 0xef, 0x09, 0x63, 0x59, 0x57, 0x40, 0x97, 0xa5, 0x34, 0xa2,
-	0x81, 0x75, 0x38, 0xc8, 0x1e, 0x02, 0xe8, 0xfd, 0x54, 0x61, 0xd1, 0xa6, 0xc1, 0x2e, 0xc2, 0xbe,
-	0xb6, 0x0d, 0x4b, 0xcf, 0x12, 0x12, 0xed, 0xb9, 0xbe, 0x9f, 0x20, 0x4a, 0x5b, 0x6a, 0x57, 0xed,
-	0xd7, 0x47, 0xad, 0x4f, 0x1f, 0x37, 0x9a, 0xd2, 0xec, 0x9e, 0x40, 0x76, 0x59, 0x12, 0xe2, 0xc0,
-	0x69, 0x64, 0x6c, 0xf9, 0x4a, 0xbb, 0x0d, 0xc0, 0x48, 0x21, 0x5d, 0x38, 0x47, 0x5a, 0x67, 0x24,
-	0x17, 0x7a, 0x50, 0x73, 0x23, 0x92, 0x62, 0xd6, 0xaa, 0x74, 0x2b, 0xfd, 0xc6, 0xb0, 0x6d, 0x16,
-	0x1b, 0xa3, 0x28, 0xdf, 0x98, 0xb9, 0x43, 0x42, 0x3c, 0xba, 0x75, 0xf2, 0xa5, 0xa3, 0xbc, 0xff,
-	0xda, 0xe9, 0x07, 0x21, 0x7b, 0x9e, 0x8e, 0x4d, 0x8f, 0x44, 0x72, 0x4c, 0xf9, 0xd8, 0xa0, 0xfe,
-	0xbe, 0xc5, 0x5e, 0xc6, 0x88, 0x72, 0x01, 0x75, 0xa4, 0xf5, 0x56, 0xfb, 0xf5, 0x71, 0x47, 0xf9,
-	0x71, 0xdc, 0x51, 0x5e, 0x7d, 0xff, 0x70, 0xe3, 0x8f, 0x29, 0x7b, 0x97, 0x60, 0x59, 0x2e, 0xc0,
-	0x41, 0x34, 0x26, 0x98, 0xa2, 0xde, 0x5b, 0x15, 0x96, 0x6c, 0x1a, 0xd8, 0xe9, 0x01, 0x0b, 0xf9,
-	0x66, 0xee, 0x40, 0x2d, 0xc4, 0x71, 0xca, 0xb2, 0x9d, 0x64, 0x3d, 0xea, 0x66, 0x49, 0xaa, 0xe6,
-	0x83, 0x8c, 0x32, 0xaa, 0x66, 0x4d, 0x3a, 0x92, 0xaf, 0x6d, 0xc3, 0x22, 0x49, 0x19, 0x97, 0x2e,
-	0x70, 0xe9, 0x95, 0x52, 0xe9, 0x23, 0xce, 0x91, 0xda, 0x5c, 0xb1, 0xb5, 0x9c, 0x77, 0x2c, 0xdd,
-	0x7a, 0xab, 0xd0, 0x9c, 0xed, 0xab, 0x68, 0x78, 0xaa, 0xc2, 0xaa, 0x4d, 0x83, 0xc7, 0xb1, 0xef,
-	0x32, 0x74, 0x1f, 0x61, 0x12, 0xd9, 0x88, 0xb9, 0xbe, 0xcb, 0xdc, 0xff, 0x0b, 0xb5, 0x09, 0x17,
-	0x58, 0xc8, 0x0e, 0x90, 0xc8, 0xd3, 0x11, 0x7f, 0xb4, 0x2e, 0x34, 0x7c, 0x44, 0xbd, 0x24, 0x8c,
-	0x59, 0x48, 0x70, 0xab, 0xc2, 0xb1, 0xd9, 0x57, 0xda, 0x5d, 0xb8, 0x18, 0xc9, 0x06, 0x5a, 0xd5,
-	0xae, 0xda, 0x6f, 0x0c, 0xaf, 0x96, 0x8e, 0x9d, 0x77, 0xe9, 0x14, 0xf4, 0x79, 0x49, 0x75, 0xc1,
-	0x28, 0x1f, 0x32, 0xdf, 0xc3, 0xf0, 0xdd, 0x02, 0x54, 0x6c, 0x1a, 0x68, 0x0f, 0xa1, 0xca, 0x73,
-	0x5b, 0x2b, 0xaf, 0x2a, 0xe2, 0xd6, 0xaf, 0xcf, 0x43, 0x73, 0x4f, 0xed, 0x09, 0xd4, 0x7f, 0x7f,
-	0x08, 0xd7, 0xce, 0x92, 0x14, 0x14, 0x7d, 0xfd, 0x5c, 0x4a, 0x61, 0xfd, 0x02, 0x56, 0xca, 0x22,
-	0xbb, 0x79, 0x96, 0x43, 0x09, 0x59, 0xdf, 0xfc, 0x07, 0x72, 0x5e, 0x78, 0xb4, 0x73, 0x32, 0x31,
-	0xd4, 0xd3, 0x89, 0xa1, 0x7e, 0x9b, 0x18, 0xea, 0x9b, 0xa9, 0xa1, 0x9c, 0x4e, 0x0d, 0xe5, 0xf3,
-	0xd4, 0x50, 0x9e, 0xae, 0xcf, 0x3d, 0x5a, 0x47, 0xe2, 0xee, 0xe1, 0x27, 0x6c, 0x5c, 0xe3, 0x37,
-	0xc8, 0xe6, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4d, 0xf3, 0xf7, 0x2a, 0x00, 0x05, 0x00, 0x00,
+	// 629 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0xb7, 0x9b, 0x92, 0x92, 0x97, 0x8a, 0x0a, 0x37, 0x6a, 0x13, 0x53, 0x9c, 0x10, 0x75, 0x48,
+	0x81, 0xda, 0xb4, 0x95, 0x80, 0xb6, 0x13, 0x29, 0x0b, 0x48, 0x16, 0xc8, 0x15, 0x03, 0x2c, 0x95,
+	0x13, 0x1f, 0xae, 0xd5, 0xda, 0x67, 0xf9, 0xce, 0xa5, 0x5d, 0x99, 0x60, 0x63, 0x40, 0xcc, 0x9d,
+	0x99, 0x18, 0xf8, 0x10, 0x1d, 0x2b, 0x26, 0x26, 0x40, 0xcd, 0x00, 0xdf, 0x02, 0xe4, 0xbb, 0xb3,
+	0x93, 0x82, 0x93, 0x80, 0x98, 0x9c, 0xdc, 0xef, 0xcf, 0xfb, 0xbd, 0xf7, 0x4e, 0x07, 0x0b, 0x5d,
+	0x4c, 0x7c, 0x4c, 0x8c, 0x8e, 0x1d, 0xec, 0x19, 0x07, 0x2b, 0x1d, 0x44, 0xed, 0x15, 0x83, 0x1e,
+	0xea, 0x61, 0x84, 0x29, 0x56, 0x66, 0x39, 0xaa, 0x27, 0xa8, 0x2e, 0x50, 0xb5, 0xe2, 0x62, 0x17,
+	0x33, 0xdc, 0x48, 0x7e, 0x71, 0xaa, 0xaa, 0x65, 0x46, 0x04, 0x65, 0x46, 0x5d, 0xec, 0x05, 0x7f,
+	0xe0, 0x03, 0x85, 0x98, 0x2f, 0xc7, 0x6b, 0x1c, 0xdf, 0xe1, 0xc6, 0xa2, 0x2e, 0x87, 0xe6, 0x85,
+	0xd4, 0x27, 0xae, 0x71, 0xb0, 0x92, 0x7c, 0x38, 0xd0, 0xfc, 0x29, 0xc3, 0x94, 0x49, 0xdc, 0x6d,
+	0x14, 0x38, 0xca, 0x26, 0x4c, 0x3f, 0x8f, 0xb0, 0xbf, 0x63, 0x3b, 0x4e, 0x84, 0x08, 0xa9, 0xca,
+	0x0d, 0xb9, 0x55, 0x6a, 0x57, 0x3f, 0x7d, 0x5c, 0xae, 0x08, 0xb3, 0x7b, 0x1c, 0xd9, 0xa6, 0x91,
+	0x17, 0xb8, 0x56, 0x39, 0x61, 0x8b, 0x23, 0xe5, 0x0e, 0x00, 0xc5, 0x99, 0x74, 0x62, 0x8c, 0xb4,
+	0x44, 0x71, 0x2a, 0xec, 0x42, 0xd1, 0xf6, 0x71, 0x1c, 0xd0, 0x6a, 0xa1, 0x51, 0x68, 0x95, 0x57,
+	0x6b, 0x7a, 0x36, 0x31, 0x82, 0xd2, 0x89, 0xe9, 0x5b, 0xd8, 0x0b, 0xda, 0xb7, 0x4e, 0xbe, 0xd4,
+	0xa5, 0xf7, 0x5f, 0xeb, 0x2d, 0xd7, 0xa3, 0xbb, 0x71, 0x47, 0xef, 0x62, 0x5f, 0xb4, 0x29, 0x3e,
+	0xcb, 0xc4, 0xd9, 0x33, 0xe8, 0x51, 0x88, 0x08, 0x13, 0x10, 0x4b, 0x58, 0x6f, 0xd4, 0x5e, 0x1d,
+	0xd7, 0xa5, 0x1f, 0xc7, 0x75, 0xe9, 0xe5, 0xf7, 0x0f, 0xd7, 0xcf, 0x75, 0xd9, 0xbc, 0x0c, 0x33,
+	0x62, 0x00, 0x16, 0x22, 0x21, 0x0e, 0x08, 0x6a, 0xbe, 0x93, 0x61, 0xda, 0x24, 0xae, 0x19, 0xef,
+	0x53, 0x8f, 0x4d, 0xe6, 0x2e, 0x14, 0xbd, 0x20, 0x8c, 0x69, 0x32, 0x93, 0x24, 0xa3, 0xaa, 0xe7,
+	0x6c, 0x55, 0x7f, 0x90, 0x50, 0xda, 0x93, 0x49, 0x48, 0x4b, 0xf0, 0x95, 0x4d, 0x98, 0xc2, 0x31,
+	0x65, 0xd2, 0x09, 0x26, 0xbd, 0x92, 0x2b, 0x7d, 0x14, 0xd3, 0xbe, 0x36, 0x55, 0x6c, 0xcc, 0xa4,
+	0x89, 0x85, 0x5b, 0x73, 0x0e, 0x2a, 0x83, 0xb9, 0xb2, 0xc0, 0x3d, 0x19, 0xe6, 0x4c, 0xe2, 0x3e,
+	0x09, 0x1d, 0x9b, 0xa2, 0xfb, 0x28, 0xc0, 0xbe, 0x89, 0xa8, 0xed, 0xd8, 0xd4, 0xfe, 0xbf, 0xa5,
+	0x56, 0xe0, 0x02, 0xf5, 0xe8, 0x3e, 0xe2, 0xfb, 0xb4, 0xf8, 0x1f, 0xa5, 0x01, 0x65, 0x07, 0x91,
+	0x6e, 0xe4, 0x85, 0xd4, 0xc3, 0x41, 0xb5, 0xc0, 0xb0, 0xc1, 0x23, 0x65, 0x1d, 0x2e, 0xfa, 0x22,
+	0x40, 0x75, 0xb2, 0x21, 0xb7, 0xca, 0xab, 0x57, 0x73, 0xdb, 0x4e, 0x53, 0x5a, 0x19, 0x7d, 0xd4,
+	0xa6, 0x1a, 0xa0, 0xe5, 0x37, 0x99, 0xcd, 0xe1, 0xad, 0x0c, 0x33, 0x19, 0xe5, 0xb1, 0x1d, 0xd9,
+	0x3e, 0x51, 0x6e, 0x43, 0xc9, 0x8e, 0xe9, 0x2e, 0x8e, 0x3c, 0x7a, 0x34, 0xb6, 0xfb, 0x3e, 0x55,
+	0x59, 0x87, 0x62, 0xc8, 0x1c, 0x58, 0xf3, 0xc3, 0x16, 0xc7, 0x8b, 0xa4, 0x4b, 0xe7, 0x82, 0x8d,
+	0x4b, 0x49, 0xf6, 0xbe, 0x55, 0xb3, 0x06, 0xf3, 0xbf, 0xa5, 0x4a, 0x13, 0xaf, 0xbe, 0x2e, 0x40,
+	0xc1, 0x24, 0xae, 0xf2, 0x10, 0x26, 0xd9, 0x4d, 0x5b, 0xc8, 0x9f, 0x13, 0xbf, 0xa0, 0xea, 0xe2,
+	0x28, 0x34, 0xf5, 0x54, 0x9e, 0x42, 0xa9, 0x7f, 0x75, 0xaf, 0x0d, 0x93, 0x64, 0x14, 0x75, 0x69,
+	0x2c, 0x25, 0xb3, 0x7e, 0x01, 0xb3, 0x79, 0x97, 0xec, 0xc6, 0x30, 0x87, 0x1c, 0xb2, 0xba, 0xf6,
+	0x0f, 0xe4, 0xac, 0x70, 0x07, 0xa6, 0xcf, 0x6d, 0x75, 0x71, 0xb4, 0x09, 0x67, 0xa9, 0x37, 0xff,
+	0x86, 0x95, 0xd6, 0x68, 0x6f, 0x9d, 0x9c, 0x69, 0xf2, 0xe9, 0x99, 0x26, 0x7f, 0x3b, 0xd3, 0xe4,
+	0x37, 0x3d, 0x4d, 0x3a, 0xed, 0x69, 0xd2, 0xe7, 0x9e, 0x26, 0x3d, 0x5b, 0x1a, 0xf9, 0xe0, 0x1c,
+	0xf2, 0x17, 0x99, 0xbd, 0x3b, 0x9d, 0x22, 0x7b, 0x57, 0xd7, 0x7e, 0x0d, 0x00, 0xf3, 0x76, 0x2c,
+	0xd7, 0x16, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -345,6 +447,9 @@ type MsgClient interface {
 	MultiSend(ctx context.Context, in *MsgMultiSend, opts ...grpc.CallOption) (*MsgMultiSendResponse, error)
 	// UpdateDenomMetadata defines a method for updating the denom metadata. Only usable in x/gov proposal.
 	UpdateDenomMetadata(ctx context.Context, in *MsgUpdateDenomMetadata, opts ...grpc.CallOption) (*MsgUpdateDenomMetadataResponse, error)
+	// UpdateParams defines a governance operation for updating the x/bank module
+	// parameters. The authority is defined in the keeper.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -382,6 +487,15 @@ func (c *msgClient) UpdateDenomMetadata(ctx context.Context, in *MsgUpdateDenomM
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Send defines a method for sending coins from one account to another account.
@@ -390,6 +504,9 @@ type MsgServer interface {
 	MultiSend(context.Context, *MsgMultiSend) (*MsgMultiSendResponse, error)
 	// UpdateDenomMetadata defines a method for updating the denom metadata. Only usable in x/gov proposal.
 	UpdateDenomMetadata(context.Context, *MsgUpdateDenomMetadata) (*MsgUpdateDenomMetadataResponse, error)
+	// UpdateParams defines a governance operation for updating the x/bank module
+	// parameters. The authority is defined in the keeper.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -405,6 +522,9 @@ func (*UnimplementedMsgServer) MultiSend(ctx context.Context, req *MsgMultiSend)
 func (*UnimplementedMsgServer) UpdateDenomMetadata(ctx context.Context, req *MsgUpdateDenomMetadata) (*MsgUpdateDenomMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateDenomMetadata not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -464,6 +584,24 @@ func _Msg_UpdateDenomMetadata_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.bank.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -480,6 +618,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateDenomMetadata",
 			Handler:    _Msg_UpdateDenomMetadata_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/tx.proto",
@@ -712,6 +854,69 @@ func (m *MsgUpdateDenomMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -819,6 +1024,30 @@ func (m *MsgUpdateDenomMetadataResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1423,6 +1652,171 @@ func (m *MsgUpdateDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
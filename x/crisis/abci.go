@@ -13,6 +13,16 @@ import (
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
+	// the alert mode writes the broken invariants to state, so it is run every
+	// governed check period, while the halt mode is run every node-local
+	// invariant check period.
+	if params := k.GetInvariantCheckParams(ctx); params.Mode == types.InvariantCheckModeAlert {
+		if params.CheckPeriod > 0 && ctx.BlockHeight()%int64(params.CheckPeriod) == 0 {
+			k.CheckInvariants(ctx)
+		}
+		return
+	}
+
 	if k.InvCheckPeriod() == 0 || ctx.BlockHeight()%int64(k.InvCheckPeriod()) != 0 {
 		// skip running the invariant check
 		return
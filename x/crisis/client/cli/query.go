@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+// GetQueryCmd returns a root CLI command handler for all x/crisis query commands.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the crisis module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(GetCmdQueryBrokenInvariants())
+
+	return queryCmd
+}
+
+// GetCmdQueryBrokenInvariants implements a command to return the invariants
+// found broken by the non-halting invariant checks.
+func GetCmdQueryBrokenInvariants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "broken-invariants",
+		Short: "Query the invariants found broken by the non-halting invariant checks",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(`Query the invariants found broken by the non-halting invariant checks,
+with their last failure and the number of checks they were found broken by:
+
+$ <appd> query crisis broken-invariants
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BrokenInvariants(cmd.Context(), &types.QueryBrokenInvariantsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "broken invariants")
+
+	return cmd
+}
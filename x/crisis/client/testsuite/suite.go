@@ -5,12 +5,14 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/suite"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
 )
 
 type IntegrationTestSuite struct {
@@ -105,3 +107,15 @@ func (s *IntegrationTestSuite) TestNewMsgVerifyInvariantTxCmd() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestGetCmdQueryBrokenInvariants() {
+	val := s.network.Validators[0]
+
+	cmd := cli.GetCmdQueryBrokenInvariants()
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, []string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err)
+
+	var res types.QueryBrokenInvariantsResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+	s.Require().Empty(res.BrokenInvariants)
+}
@@ -0,0 +1,15 @@
+package exported
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type (
+	// Subspace defines an interface that implements the legacy x/params Subspace
+	// type.
+	//
+	// NOTE: This is used solely for migration of x/params managed parameters.
+	Subspace interface {
+		Get(ctx sdk.Context, key []byte, ptr interface{})
+	}
+)
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+// GetBrokenInvariant returns the broken invariant record of an invariant route.
+func (k Keeper) GetBrokenInvariant(ctx sdk.Context, moduleName, route string) (brokenInvariant types.BrokenInvariant, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBrokenInvariantKey(moduleName, route))
+	if bz == nil {
+		return brokenInvariant, false
+	}
+	k.cdc.MustUnmarshal(bz, &brokenInvariant)
+	return brokenInvariant, true
+}
+
+// SetBrokenInvariant sets a broken invariant record.
+func (k Keeper) SetBrokenInvariant(ctx sdk.Context, brokenInvariant types.BrokenInvariant) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&brokenInvariant)
+	store.Set(types.GetBrokenInvariantKey(brokenInvariant.ModuleName, brokenInvariant.Route), bz)
+}
+
+// IterateBrokenInvariants iterates over the broken invariant records and
+// performs a callback function, stopping if it returns true.
+func (k Keeper) IterateBrokenInvariants(ctx sdk.Context, cb func(brokenInvariant types.BrokenInvariant) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BrokenInvariantKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var brokenInvariant types.BrokenInvariant
+		k.cdc.MustUnmarshal(iterator.Value(), &brokenInvariant)
+		if cb(brokenInvariant) {
+			break
+		}
+	}
+}
+
+// GetAllBrokenInvariants returns all the broken invariant records.
+func (k Keeper) GetAllBrokenInvariants(ctx sdk.Context) []types.BrokenInvariant {
+	var brokenInvariants []types.BrokenInvariant
+	k.IterateBrokenInvariants(ctx, func(brokenInvariant types.BrokenInvariant) bool {
+		brokenInvariants = append(brokenInvariants, brokenInvariant)
+		return false
+	})
+	return brokenInvariants
+}
+
+// recordBrokenInvariant updates the broken invariant record of an invariant
+// route with a new failure at the current block.
+func (k Keeper) recordBrokenInvariant(ctx sdk.Context, ir types.InvarRoute, msg string) {
+	brokenInvariant, _ := k.GetBrokenInvariant(ctx, ir.ModuleName, ir.Route)
+	brokenInvariant.ModuleName = ir.ModuleName
+	brokenInvariant.Route = ir.Route
+	brokenInvariant.Message = msg
+	brokenInvariant.Height = ctx.BlockHeight()
+	brokenInvariant.Time = ctx.BlockTime()
+	brokenInvariant.Count++
+	k.SetBrokenInvariant(ctx, brokenInvariant)
+}
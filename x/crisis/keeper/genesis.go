@@ -8,10 +8,14 @@ import (
 // new crisis genesis
 func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 	k.SetConstantFee(ctx, data.ConstantFee)
+	k.SetInvariantCheckParams(ctx, data.InvariantCheckParams)
+	for _, brokenInvariant := range data.BrokenInvariants {
+		k.SetBrokenInvariant(ctx, brokenInvariant)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	constantFee := k.GetConstantFee(ctx)
-	return types.NewGenesisState(constantFee)
+	return types.NewGenesisState(constantFee, k.GetAllBrokenInvariants(ctx), k.GetInvariantCheckParams(ctx))
 }
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// BrokenInvariants implements the Query/BrokenInvariants gRPC method
+func (k Keeper) BrokenInvariants(c context.Context, req *types.QueryBrokenInvariantsRequest) (*types.QueryBrokenInvariantsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var brokenInvariants []types.BrokenInvariant
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BrokenInvariantKeyPrefix)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var brokenInvariant types.BrokenInvariant
+		if err := k.cdc.Unmarshal(value, &brokenInvariant); err != nil {
+			return err
+		}
+		brokenInvariants = append(brokenInvariants, brokenInvariant)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBrokenInvariantsResponse{BrokenInvariants: brokenInvariants, Pagination: pageRes}, nil
+}
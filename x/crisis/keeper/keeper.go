@@ -4,18 +4,26 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/armon/go-metrics"
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis/types"
-	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // Keeper - crisis keeper
 type Keeper struct {
 	routes         []types.InvarRoute
-	paramSpace     paramtypes.Subspace
 	invCheckPeriod uint
+	storeKey       storetypes.StoreKey
+	cdc            codec.BinaryCodec
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
 
 	supplyKeeper types.SupplyKeeper
 
@@ -24,23 +32,29 @@ type Keeper struct {
 
 // NewKeeper creates a new Keeper object
 func NewKeeper(
-	paramSpace paramtypes.Subspace, invCheckPeriod uint, supplyKeeper types.SupplyKeeper,
-	feeCollectorName string,
+	cdc codec.BinaryCodec, storeKey storetypes.StoreKey, invCheckPeriod uint,
+	supplyKeeper types.SupplyKeeper, feeCollectorName string, authority string,
 ) Keeper {
-	// set KeyTable if it has not already been set
-	if !paramSpace.HasKeyTable() {
-		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Errorf("invalid crisis authority address: %w", err))
 	}
 
 	return Keeper{
+		storeKey:         storeKey,
+		cdc:              cdc,
 		routes:           make([]types.InvarRoute, 0),
-		paramSpace:       paramSpace,
 		invCheckPeriod:   invCheckPeriod,
 		supplyKeeper:     supplyKeeper,
 		feeCollectorName: feeCollectorName,
+		authority:        authority,
 	}
 }
 
+// GetAuthority returns the x/crisis module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -89,6 +103,58 @@ func (k Keeper) AssertInvariants(ctx sdk.Context) {
 	logger.Info("asserted all invariants", "duration", diff, "height", ctx.BlockHeight())
 }
 
+// CheckInvariants checks all registered invariants without halting the chain
+// on the broken ones, unless they are critical according to the invariant check
+// params.
+func (k Keeper) CheckInvariants(ctx sdk.Context) {
+	logger := k.Logger(ctx)
+	params := k.GetInvariantCheckParams(ctx)
+
+	start := time.Now()
+	broken := 0
+	for _, ir := range k.Routes() {
+		if res, stop := ir.Invar(ctx); stop {
+			broken++
+			k.handleBrokenInvariant(ctx, params, ir, res)
+		}
+	}
+
+	diff := time.Since(start)
+	logger.Info("checked all invariants", "duration", diff, "height", ctx.BlockHeight(), "broken", broken)
+}
+
+// handleBrokenInvariant halts the chain if a broken invariant is critical.
+// Otherwise, it records the broken invariant in state and reports it with an
+// event, a telemetry counter and an error log.
+func (k Keeper) handleBrokenInvariant(ctx sdk.Context, params types.InvariantCheckParams, ir types.InvarRoute, res string) {
+	if params.IsCritical(ir.FullRoute()) {
+		panic(fmt.Errorf("critical invariant broken: %s\n"+
+			"\tCRITICAL please submit the following transaction:\n"+
+			"\t\t tx crisis invariant-broken %s %s", res, ir.ModuleName, ir.Route))
+	}
+
+	k.recordBrokenInvariant(ctx, ir, res)
+	k.Logger(ctx).Error("invariant broken", "name", ir.FullRoute(), "height", ctx.BlockHeight(), "msg", res)
+
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, "invariant_broken"},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel("invariant_module", ir.ModuleName),
+			telemetry.NewLabel("invariant_route", ir.Route),
+		},
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeInvariantBroken,
+			sdk.NewAttribute(types.AttributeKeyModule, ir.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyRoute, ir.Route),
+			sdk.NewAttribute(types.AttributeKeyMessage, res),
+		),
+	)
+}
+
 // InvCheckPeriod returns the invariant checks period.
 func (k Keeper) InvCheckPeriod() uint { return k.invCheckPeriod }
 
@@ -9,6 +9,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
 	"github.com/cosmos/cosmos-sdk/x/crisis/types"
 )
 
@@ -48,3 +50,118 @@ func TestAssertInvariants(t *testing.T) {
 	app.CrisisKeeper.RegisterRoute("testModule", "testRoute2", func(sdk.Context) (string, bool) { return "", true })
 	require.Panics(t, func() { app.CrisisKeeper.AssertInvariants(ctx) })
 }
+
+func TestCheckInvariants(t *testing.T) {
+	app := simapp.Setup(t, false)
+	app.Commit()
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1}})
+
+	ctx := app.NewContext(false, tmproto.Header{Height: 10})
+	app.CrisisKeeper.SetInvariantCheckParams(ctx, types.NewInvariantCheckParams(types.InvariantCheckModeAlert, 5, nil))
+
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute1", func(sdk.Context) (string, bool) { return "", false })
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute2", func(sdk.Context) (string, bool) { return "broken", true })
+
+	// non-critical broken invariants are recorded and reported
+	require.NotPanics(t, func() { app.CrisisKeeper.CheckInvariants(ctx) })
+	require.NotPanics(t, func() { app.CrisisKeeper.CheckInvariants(ctx.WithBlockHeight(15)) })
+
+	_, found := app.CrisisKeeper.GetBrokenInvariant(ctx, "testModule", "testRoute1")
+	require.False(t, found)
+	brokenInvariant, found := app.CrisisKeeper.GetBrokenInvariant(ctx, "testModule", "testRoute2")
+	require.True(t, found)
+	require.Equal(t, "broken", brokenInvariant.Message)
+	require.Equal(t, int64(15), brokenInvariant.Height)
+	require.Equal(t, uint64(2), brokenInvariant.Count)
+	require.Len(t, app.CrisisKeeper.GetAllBrokenInvariants(ctx), 1)
+
+	var events []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeInvariantBroken {
+			events = append(events, event)
+		}
+	}
+	require.Len(t, events, 2)
+	require.Equal(t, []byte("testRoute2"), events[0].Attributes[1].Value)
+
+	// the broken invariants and the params are exported
+	genState := app.CrisisKeeper.ExportGenesis(ctx)
+	require.Equal(t, []types.BrokenInvariant{brokenInvariant}, genState.BrokenInvariants)
+	require.Equal(t, app.CrisisKeeper.GetInvariantCheckParams(ctx), genState.InvariantCheckParams)
+
+	// critical broken invariants halt the chain
+	app.CrisisKeeper.SetInvariantCheckParams(ctx, types.NewInvariantCheckParams(types.InvariantCheckModeAlert, 5, []string{"testModule/testRoute2"}))
+	require.Panics(t, func() { app.CrisisKeeper.CheckInvariants(ctx) })
+}
+
+func TestEndBlockerAlertMode(t *testing.T) {
+	app := simapp.Setup(t, false)
+	app.Commit()
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1}})
+
+	ctx := app.NewContext(false, tmproto.Header{Height: 7})
+	app.CrisisKeeper.SetInvariantCheckParams(ctx, types.NewInvariantCheckParams(types.InvariantCheckModeAlert, 7, nil))
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute", func(sdk.Context) (string, bool) { return "broken", true })
+
+	// the governed check period is used, not the node-local one
+	require.NotPanics(t, func() { crisis.EndBlocker(ctx.WithBlockHeight(5), app.CrisisKeeper) })
+	require.Empty(t, app.CrisisKeeper.GetAllBrokenInvariants(ctx))
+
+	require.NotPanics(t, func() { crisis.EndBlocker(ctx, app.CrisisKeeper) })
+	require.Len(t, app.CrisisKeeper.GetAllBrokenInvariants(ctx), 1)
+}
+
+func TestVerifyInvariantAlertMode(t *testing.T) {
+	app := simapp.Setup(t, false)
+	app.Commit()
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1}})
+
+	ctx := app.NewContext(false, tmproto.Header{Height: 10})
+	app.CrisisKeeper.SetInvariantCheckParams(ctx, types.NewInvariantCheckParams(types.InvariantCheckModeAlert, 5, []string{"testModule/critical"}))
+	app.CrisisKeeper.RegisterRoute("testModule", "nonCritical", func(sdk.Context) (string, bool) { return "broken", true })
+	app.CrisisKeeper.RegisterRoute("testModule", "critical", func(sdk.Context) (string, bool) { return "broken", true })
+
+	sender := sdk.AccAddress("sender______________")
+	constantFee := app.CrisisKeeper.GetConstantFee(ctx)
+	require.NoError(t, banktestutil.FundAccount(app.BankKeeper, ctx, sender, sdk.NewCoins(constantFee.Add(constantFee))))
+
+	// non-critical broken invariants are recorded without halting the chain
+	_, err := app.CrisisKeeper.VerifyInvariant(sdk.WrapSDKContext(ctx), types.NewMsgVerifyInvariant(sender, "testModule", "nonCritical"))
+	require.NoError(t, err)
+	brokenInvariant, found := app.CrisisKeeper.GetBrokenInvariant(ctx, "testModule", "nonCritical")
+	require.True(t, found)
+	require.Equal(t, uint64(1), brokenInvariant.Count)
+
+	// critical broken invariants halt the chain
+	require.Panics(t, func() {
+		app.CrisisKeeper.VerifyInvariant(sdk.WrapSDKContext(ctx), types.NewMsgVerifyInvariant(sender, "testModule", "critical")) //nolint:errcheck
+	})
+	_, found = app.CrisisKeeper.GetBrokenInvariant(ctx, "testModule", "critical")
+	require.False(t, found)
+}
+
+func TestUpdateParams(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.NewContext(false, tmproto.Header{})
+	authority := app.CrisisKeeper.GetAuthority()
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute", func(sdk.Context) (string, bool) { return "", false })
+
+	msg := &types.MsgUpdateParams{
+		Authority:            authority,
+		ConstantFee:          sdk.NewInt64Coin(sdk.DefaultBondDenom, 10),
+		InvariantCheckParams: types.NewInvariantCheckParams(types.InvariantCheckModeAlert, 10, []string{"testModule/testRoute"}),
+	}
+	_, err := app.CrisisKeeper.UpdateParams(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	require.Equal(t, msg.InvariantCheckParams, app.CrisisKeeper.GetInvariantCheckParams(ctx))
+
+	// unknown critical routes are rejected
+	msg.InvariantCheckParams.CriticalRoutes = []string{"testModule/unknownRoute"}
+	_, err = app.CrisisKeeper.UpdateParams(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrUnknownInvariant)
+
+	// the alert mode requires a check period
+	msg.InvariantCheckParams = types.NewInvariantCheckParams(types.InvariantCheckModeAlert, 0, nil)
+	_, err = app.CrisisKeeper.UpdateParams(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrInvalidInvariantCheckParams)
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 var _ types.MsgServer = Keeper{}
@@ -29,9 +31,11 @@ func (k Keeper) VerifyInvariant(goCtx context.Context, msg *types.MsgVerifyInvar
 
 	var res string
 	var stop bool
+	var ir types.InvarRoute
 	for _, invarRoute := range k.Routes() {
 		if invarRoute.FullRoute() == msgFullRoute {
 			res, stop = invarRoute.Invar(cacheCtx)
+			ir = invarRoute
 			found = true
 
 			break
@@ -42,7 +46,11 @@ func (k Keeper) VerifyInvariant(goCtx context.Context, msg *types.MsgVerifyInvar
 		return nil, types.ErrUnknownInvariant
 	}
 
-	if stop {
+	// in the alert mode, only critical invariants halt the chain, and the other
+	// broken invariants are recorded as in the end blocker.
+	if params := k.GetInvariantCheckParams(ctx); stop && params.Mode == types.InvariantCheckModeAlert {
+		k.handleBrokenInvariant(ctx, params, ir, res)
+	} else if stop {
 		// Currently, because the chain halts here, this transaction will never be included in the
 		// blockchain thus the constant fee will have never been deducted. Thus no refund is required.
 
@@ -64,3 +72,27 @@ func (k Keeper) VerifyInvariant(goCtx context.Context, msg *types.MsgVerifyInvar
 
 	return &types.MsgVerifyInvariantResponse{}, nil
 }
+
+// UpdateParams updates the x/crisis module parameters if the message is signed by the authority.
+func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", k.authority, msg.Authority)
+	}
+
+	if !msg.ConstantFee.IsValid() {
+		return nil, types.ErrInvalidConstantFee.Wrapf("invalid constant fee: %s", msg.ConstantFee)
+	}
+
+	if err := msg.InvariantCheckParams.Validate(); err != nil {
+		return nil, err
+	}
+	if err := k.ValidateCriticalRoutes(msg.InvariantCheckParams); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetConstantFee(ctx, msg.ConstantFee)
+	k.SetInvariantCheckParams(ctx, msg.InvariantCheckParams)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
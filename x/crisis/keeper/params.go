@@ -5,13 +5,55 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/crisis/types"
 )
 
-// GetConstantFee get's the constant fee from the paramSpace
+// GetConstantFee get's the constant fee from the store
 func (k Keeper) GetConstantFee(ctx sdk.Context) (constantFee sdk.Coin) {
-	k.paramSpace.Get(ctx, types.ParamStoreKeyConstantFee, &constantFee)
-	return
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConstantFeeKey)
+	if bz == nil {
+		return constantFee
+	}
+	k.cdc.MustUnmarshal(bz, &constantFee)
+	return constantFee
 }
 
-// GetConstantFee set's the constant fee in the paramSpace
+// SetConstantFee set's the constant fee in the store
+// CONTRACT: This method performs no validation of the fee.
 func (k Keeper) SetConstantFee(ctx sdk.Context, constantFee sdk.Coin) {
-	k.paramSpace.Set(ctx, types.ParamStoreKeyConstantFee, constantFee)
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&constantFee)
+	store.Set(types.ConstantFeeKey, bz)
+}
+
+// GetInvariantCheckParams returns the invariant check params from the store.
+func (k Keeper) GetInvariantCheckParams(ctx sdk.Context) (params types.InvariantCheckParams) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.InvariantCheckParamsKey)
+	if bz == nil {
+		return types.DefaultInvariantCheckParams()
+	}
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetInvariantCheckParams sets the invariant check params in the store.
+// CONTRACT: This method performs no validation of the params.
+func (k Keeper) SetInvariantCheckParams(ctx sdk.Context, params types.InvariantCheckParams) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&params)
+	store.Set(types.InvariantCheckParamsKey, bz)
+}
+
+// ValidateCriticalRoutes returns an error if a critical route of the invariant
+// check params is not a registered invariant route.
+func (k Keeper) ValidateCriticalRoutes(params types.InvariantCheckParams) error {
+	registered := make(map[string]bool, len(k.routes))
+	for _, ir := range k.routes {
+		registered[ir.FullRoute()] = true
+	}
+	for _, fullRoute := range params.CriticalRoutes {
+		if !registered[fullRoute] {
+			return types.ErrUnknownInvariant.Wrap(fullRoute)
+		}
+	}
+	return nil
 }
@@ -0,0 +1,35 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v047crisis "github.com/cosmos/cosmos-sdk/x/crisis/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	legacySubspace := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, storeKey, tKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	constantFee := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	legacySubspace.Set(ctx, types.ParamStoreKeyConstantFee, constantFee)
+
+	// Run migrations.
+	require.NoError(t, v047crisis.MigrateStore(ctx, storeKey, legacySubspace, encCfg.Codec))
+
+	// Make sure the constant fee is now in the module store.
+	var res sdk.Coin
+	bz := ctx.KVStore(storeKey).Get(types.ConstantFeeKey)
+	require.NoError(t, encCfg.Codec.Unmarshal(bz, &res))
+	require.Equal(t, constantFee, res)
+}
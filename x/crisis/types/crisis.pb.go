@@ -0,0 +1,855 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/crisis/v1beta1/crisis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InvariantCheckMode defines how the invariants found broken by the periodic
+// invariant checks of the end blocker are handled.
+type InvariantCheckMode int32
+
+const (
+	// INVARIANT_CHECK_MODE_HALT halts the chain on any broken invariant. The
+	// invariants are checked every node-local inv-check-period blocks.
+	InvariantCheckModeHalt InvariantCheckMode = 0
+	// INVARIANT_CHECK_MODE_ALERT records the broken invariants in state and emits
+	// events and telemetry for them, and only halts the chain on broken critical
+	// invariants. The invariants are checked every check_period blocks.
+	InvariantCheckModeAlert InvariantCheckMode = 1
+)
+
+var InvariantCheckMode_name = map[int32]string{
+	0: "INVARIANT_CHECK_MODE_HALT",
+	1: "INVARIANT_CHECK_MODE_ALERT",
+}
+
+var InvariantCheckMode_value = map[string]int32{
+	"INVARIANT_CHECK_MODE_HALT":  0,
+	"INVARIANT_CHECK_MODE_ALERT": 1,
+}
+
+func (x InvariantCheckMode) String() string {
+	return proto.EnumName(InvariantCheckMode_name, int32(x))
+}
+
+func (InvariantCheckMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4563994d65183ad5, []int{0}
+}
+
+// BrokenInvariant records the last failure of an invariant found broken by the
+// non-halting invariant checks.
+type BrokenInvariant struct {
+	// module_name is the name of the module registering the invariant.
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// route is the route of the invariant within its module.
+	Route string `protobuf:"bytes,2,opt,name=route,proto3" json:"route,omitempty"`
+	// message is the message returned by the invariant at its last failure.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// height is the block height of the last failure.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time of the last failure.
+	Time time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+	// count is the number of checks the invariant was found broken by.
+	Count uint64 `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *BrokenInvariant) Reset()         { *m = BrokenInvariant{} }
+func (m *BrokenInvariant) String() string { return proto.CompactTextString(m) }
+func (*BrokenInvariant) ProtoMessage()    {}
+func (*BrokenInvariant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4563994d65183ad5, []int{0}
+}
+func (m *BrokenInvariant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BrokenInvariant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BrokenInvariant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BrokenInvariant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BrokenInvariant.Merge(m, src)
+}
+func (m *BrokenInvariant) XXX_Size() int {
+	return m.Size()
+}
+func (m *BrokenInvariant) XXX_DiscardUnknown() {
+	xxx_messageInfo_BrokenInvariant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BrokenInvariant proto.InternalMessageInfo
+
+func (m *BrokenInvariant) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+func (m *BrokenInvariant) GetRoute() string {
+	if m != nil {
+		return m.Route
+	}
+	return ""
+}
+
+func (m *BrokenInvariant) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *BrokenInvariant) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BrokenInvariant) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *BrokenInvariant) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// InvariantCheckParams defines the governed parameters of the invariant checks.
+type InvariantCheckParams struct {
+	// mode is the invariant check mode.
+	Mode InvariantCheckMode `protobuf:"varint,1,opt,name=mode,proto3,enum=cosmos.crisis.v1beta1.InvariantCheckMode" json:"mode,omitempty"`
+	// check_period is the number of blocks between two invariant checks in the
+	// alert mode.
+	CheckPeriod uint64 `protobuf:"varint,2,opt,name=check_period,json=checkPeriod,proto3" json:"check_period,omitempty"`
+	// critical_routes are the full routes ({module}/{route}) of the invariants
+	// halting the chain when broken in the alert mode.
+	CriticalRoutes []string `protobuf:"bytes,3,rep,name=critical_routes,json=criticalRoutes,proto3" json:"critical_routes,omitempty"`
+}
+
+func (m *InvariantCheckParams) Reset()         { *m = InvariantCheckParams{} }
+func (m *InvariantCheckParams) String() string { return proto.CompactTextString(m) }
+func (*InvariantCheckParams) ProtoMessage()    {}
+func (*InvariantCheckParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4563994d65183ad5, []int{1}
+}
+func (m *InvariantCheckParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvariantCheckParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvariantCheckParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InvariantCheckParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvariantCheckParams.Merge(m, src)
+}
+func (m *InvariantCheckParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvariantCheckParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvariantCheckParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvariantCheckParams proto.InternalMessageInfo
+
+func (m *InvariantCheckParams) GetMode() InvariantCheckMode {
+	if m != nil {
+		return m.Mode
+	}
+	return InvariantCheckModeHalt
+}
+
+func (m *InvariantCheckParams) GetCheckPeriod() uint64 {
+	if m != nil {
+		return m.CheckPeriod
+	}
+	return 0
+}
+
+func (m *InvariantCheckParams) GetCriticalRoutes() []string {
+	if m != nil {
+		return m.CriticalRoutes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.crisis.v1beta1.InvariantCheckMode", InvariantCheckMode_name, InvariantCheckMode_value)
+	proto.RegisterType((*BrokenInvariant)(nil), "cosmos.crisis.v1beta1.BrokenInvariant")
+	proto.RegisterType((*InvariantCheckParams)(nil), "cosmos.crisis.v1beta1.InvariantCheckParams")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/crisis/v1beta1/crisis.proto", fileDescriptor_4563994d65183ad5)
+}
+
+var fileDescriptor_4563994d65183ad5 = []byte{
+	// 476 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x41, 0x6f, 0xd3, 0x30,
+	0x1c, 0xc5, 0x63, 0x9a, 0x15, 0xe6, 0xa2, 0x6d, 0xb2, 0xca, 0x08, 0x41, 0x4a, 0x43, 0x2f, 0x14,
+	0x10, 0x89, 0x36, 0x2e, 0x20, 0xc4, 0x21, 0x2d, 0x95, 0x5a, 0xb1, 0x95, 0xc9, 0xaa, 0x38, 0x70,
+	0x89, 0xdc, 0xc4, 0xa4, 0x51, 0xe3, 0xb8, 0xb2, 0x9d, 0x09, 0xbe, 0x01, 0xda, 0x69, 0xe2, 0xbe,
+	0x03, 0xe2, 0xcb, 0x4c, 0x9c, 0x76, 0xe4, 0x04, 0xa8, 0xfd, 0x22, 0xa8, 0x4e, 0x83, 0x34, 0x75,
+	0xa7, 0xe4, 0xfd, 0xf2, 0x9e, 0xf2, 0xfe, 0xf6, 0x1f, 0xb6, 0x23, 0x2e, 0x19, 0x97, 0x7e, 0x24,
+	0x52, 0x99, 0x4a, 0xff, 0xf4, 0x60, 0x42, 0x15, 0x39, 0x58, 0x4b, 0x6f, 0x2e, 0xb8, 0xe2, 0xe8,
+	0x5e, 0xe9, 0xf1, 0xd6, 0x70, 0xed, 0xb1, 0x9b, 0x09, 0x4f, 0xb8, 0x76, 0xf8, 0xab, 0xb7, 0xd2,
+	0x6c, 0xb7, 0x12, 0xce, 0x93, 0x8c, 0xfa, 0x5a, 0x4d, 0x8a, 0x4f, 0xbe, 0x4a, 0x19, 0x95, 0x8a,
+	0xb0, 0x79, 0x69, 0x68, 0xff, 0x04, 0x70, 0xb7, 0x2b, 0xf8, 0x8c, 0xe6, 0xc3, 0xfc, 0x94, 0x88,
+	0x94, 0xe4, 0x0a, 0xb5, 0x60, 0x83, 0xf1, 0xb8, 0xc8, 0x68, 0x98, 0x13, 0x46, 0x2d, 0xe0, 0x82,
+	0xce, 0x36, 0x86, 0x25, 0x1a, 0x11, 0x46, 0x51, 0x13, 0x6e, 0x09, 0x5e, 0x28, 0x6a, 0xdd, 0xd2,
+	0x9f, 0x4a, 0x81, 0x2c, 0x78, 0x9b, 0x51, 0x29, 0x49, 0x42, 0xad, 0x9a, 0xe6, 0x95, 0x44, 0xfb,
+	0xb0, 0x3e, 0xa5, 0x69, 0x32, 0x55, 0x96, 0xe9, 0x82, 0x4e, 0x0d, 0xaf, 0x15, 0x7a, 0x09, 0xcd,
+	0x55, 0x1f, 0x6b, 0xcb, 0x05, 0x9d, 0xc6, 0xa1, 0xed, 0x95, 0x65, 0xbd, 0xaa, 0xac, 0x37, 0xae,
+	0xca, 0x76, 0xef, 0x5c, 0xfe, 0x6e, 0x19, 0xe7, 0x7f, 0x5a, 0x00, 0xeb, 0xc4, 0xaa, 0x41, 0xc4,
+	0x8b, 0x5c, 0x59, 0x75, 0x17, 0x74, 0x4c, 0x5c, 0x8a, 0xf6, 0x77, 0x00, 0x9b, 0xff, 0xc7, 0xe8,
+	0x4d, 0x69, 0x34, 0x3b, 0x21, 0x82, 0x30, 0x89, 0xde, 0x40, 0x93, 0xf1, 0xb8, 0x1c, 0x65, 0xe7,
+	0xf0, 0x89, 0x77, 0xe3, 0x11, 0x7a, 0xd7, 0xa3, 0xc7, 0x3c, 0xa6, 0x58, 0xc7, 0xd0, 0x23, 0x78,
+	0x37, 0x5a, 0xa1, 0x70, 0x4e, 0x45, 0xca, 0x63, 0x3d, 0xb6, 0x89, 0x1b, 0x9a, 0x9d, 0x68, 0x84,
+	0x1e, 0xc3, 0xdd, 0x48, 0xa4, 0x2a, 0x8d, 0x48, 0x16, 0xea, 0xe3, 0x90, 0x56, 0xcd, 0xad, 0x75,
+	0xb6, 0xf1, 0x4e, 0x85, 0xb1, 0xa6, 0x4f, 0xbf, 0x01, 0x88, 0x36, 0x7f, 0x84, 0x5e, 0xc1, 0x07,
+	0xc3, 0xd1, 0x87, 0x00, 0x0f, 0x83, 0xd1, 0x38, 0xec, 0x0d, 0xfa, 0xbd, 0x77, 0xe1, 0xf1, 0xfb,
+	0xb7, 0xfd, 0x70, 0x10, 0x1c, 0x8d, 0xf7, 0x0c, 0xdb, 0x3e, 0xbb, 0x70, 0xf7, 0x37, 0x63, 0x03,
+	0x92, 0x29, 0xf4, 0x1a, 0xda, 0x37, 0x46, 0x83, 0xa3, 0x3e, 0x1e, 0xef, 0x01, 0xfb, 0xe1, 0xd9,
+	0x85, 0x7b, 0x7f, 0x33, 0x1b, 0x64, 0x54, 0x28, 0xdb, 0xfc, 0xfa, 0xc3, 0x31, 0xba, 0xfd, 0xcb,
+	0x85, 0x03, 0xae, 0x16, 0x0e, 0xf8, 0xbb, 0x70, 0xc0, 0xf9, 0xd2, 0x31, 0xae, 0x96, 0x8e, 0xf1,
+	0x6b, 0xe9, 0x18, 0x1f, 0x9f, 0x25, 0xa9, 0x9a, 0x16, 0x13, 0x2f, 0xe2, 0xcc, 0xaf, 0x96, 0x53,
+	0x3f, 0x9e, 0xcb, 0x78, 0xe6, 0x7f, 0xae, 0x36, 0x55, 0x7d, 0x99, 0x53, 0x39, 0xa9, 0xeb, 0x9b,
+	0x7b, 0xf1, 0x6f, 0x00, 0x5b, 0x7f, 0x93, 0x38, 0xc7, 0x02, 0x00, 0x00,
+}
+
+func (m *BrokenInvariant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BrokenInvariant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BrokenInvariant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintCrisis(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x30
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintCrisis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintCrisis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintCrisis(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Route) > 0 {
+		i -= len(m.Route)
+		copy(dAtA[i:], m.Route)
+		i = encodeVarintCrisis(dAtA, i, uint64(len(m.Route)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintCrisis(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InvariantCheckParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvariantCheckParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvariantCheckParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CriticalRoutes) > 0 {
+		for iNdEx := len(m.CriticalRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CriticalRoutes[iNdEx])
+			copy(dAtA[i:], m.CriticalRoutes[iNdEx])
+			i = encodeVarintCrisis(dAtA, i, uint64(len(m.CriticalRoutes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.CheckPeriod != 0 {
+		i = encodeVarintCrisis(dAtA, i, uint64(m.CheckPeriod))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Mode != 0 {
+		i = encodeVarintCrisis(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCrisis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCrisis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BrokenInvariant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovCrisis(uint64(l))
+	}
+	l = len(m.Route)
+	if l > 0 {
+		n += 1 + l + sovCrisis(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovCrisis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovCrisis(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovCrisis(uint64(l))
+	if m.Count != 0 {
+		n += 1 + sovCrisis(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *InvariantCheckParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		n += 1 + sovCrisis(uint64(m.Mode))
+	}
+	if m.CheckPeriod != 0 {
+		n += 1 + sovCrisis(uint64(m.CheckPeriod))
+	}
+	if len(m.CriticalRoutes) > 0 {
+		for _, s := range m.CriticalRoutes {
+			l = len(s)
+			n += 1 + l + sovCrisis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovCrisis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCrisis(x uint64) (n int) {
+	return sovCrisis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BrokenInvariant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrisis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BrokenInvariant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BrokenInvariant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrisis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InvariantCheckParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrisis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvariantCheckParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvariantCheckParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= InvariantCheckMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckPeriod", wireType)
+			}
+			m.CheckPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CriticalRoutes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CriticalRoutes = append(m.CriticalRoutes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrisis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCrisis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCrisis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCrisis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCrisis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCrisis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCrisis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCrisis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCrisis = fmt.Errorf("proto: unexpected end of group")
+)
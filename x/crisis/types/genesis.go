@@ -7,16 +7,19 @@ import (
 )
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(constantFee sdk.Coin) *GenesisState {
+func NewGenesisState(constantFee sdk.Coin, brokenInvariants []BrokenInvariant, invariantCheckParams InvariantCheckParams) *GenesisState {
 	return &GenesisState{
-		ConstantFee: constantFee,
+		ConstantFee:          constantFee,
+		BrokenInvariants:     brokenInvariants,
+		InvariantCheckParams: invariantCheckParams,
 	}
 }
 
 // DefaultGenesisState creates a default GenesisState object
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		ConstantFee: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)),
+		ConstantFee:          sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)),
+		InvariantCheckParams: DefaultInvariantCheckParams(),
 	}
 }
 
@@ -25,5 +28,22 @@ func ValidateGenesis(data *GenesisState) error {
 	if !data.ConstantFee.IsPositive() {
 		return fmt.Errorf("constant fee must be positive: %s", data.ConstantFee)
 	}
+	if err := data.InvariantCheckParams.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(data.BrokenInvariants))
+	for _, b := range data.BrokenInvariants {
+		if b.ModuleName == "" || b.Route == "" {
+			return fmt.Errorf("invalid broken invariant route %q", b.FullRoute())
+		}
+		if seen[b.FullRoute()] {
+			return fmt.Errorf("duplicate broken invariant %s", b.FullRoute())
+		}
+		if b.Count == 0 {
+			return fmt.Errorf("broken invariant %s count must be positive", b.FullRoute())
+		}
+		seen[b.FullRoute()] = true
+	}
 	return nil
 }
@@ -29,6 +29,11 @@ type GenesisState struct {
 	// constant_fee is the fee used to verify the invariant in the crisis
 	// module.
 	ConstantFee types.Coin `protobuf:"bytes,3,opt,name=constant_fee,json=constantFee,proto3" json:"constant_fee"`
+	// broken_invariants defines the invariants found broken by the non-halting
+	// invariant checks.
+	BrokenInvariants []BrokenInvariant `protobuf:"bytes,4,rep,name=broken_invariants,json=brokenInvariants,proto3" json:"broken_invariants"`
+	// invariant_check_params defines the parameters of the invariant checks.
+	InvariantCheckParams InvariantCheckParams `protobuf:"bytes,5,opt,name=invariant_check_params,json=invariantCheckParams,proto3" json:"invariant_check_params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -71,6 +76,20 @@ func (m *GenesisState) GetConstantFee() types.Coin {
 	return types.Coin{}
 }
 
+func (m *GenesisState) GetBrokenInvariants() []BrokenInvariant {
+	if m != nil {
+		return m.BrokenInvariants
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInvariantCheckParams() InvariantCheckParams {
+	if m != nil {
+		return m.InvariantCheckParams
+	}
+	return InvariantCheckParams{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.crisis.v1beta1.GenesisState")
 }
@@ -80,22 +99,27 @@ func init() {
 }
 
 var fileDescriptor_7a9c2781aa8a27ae = []byte{
-	// 225 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4e, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2e, 0xca, 0x2c, 0xce, 0x2c, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49,
-	0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x12, 0x85, 0x28, 0xd2, 0x83, 0x28, 0xd2, 0x83, 0x2a, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07,
-	0xab, 0xd0, 0x07, 0xb1, 0x20, 0x8a, 0xa5, 0xe4, 0xa0, 0x26, 0x26, 0x25, 0x16, 0xa7, 0xc2, 0xcd,
-	0x4b, 0xce, 0xcf, 0xcc, 0x83, 0xc8, 0x2b, 0x05, 0x71, 0xf1, 0xb8, 0x43, 0x4c, 0x0f, 0x2e, 0x49,
-	0x2c, 0x49, 0x15, 0x72, 0xe2, 0xe2, 0x49, 0xce, 0xcf, 0x2b, 0x2e, 0x49, 0xcc, 0x2b, 0x89, 0x4f,
-	0x4b, 0x4d, 0x95, 0x60, 0x56, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd4, 0x83, 0xda, 0x09, 0x32, 0x06,
-	0x66, 0xa3, 0x9e, 0x73, 0x7e, 0x66, 0x9e, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0xdc, 0x30,
-	0x4d, 0x6e, 0xa9, 0xa9, 0x4e, 0xae, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0,
-	0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10,
-	0xa5, 0x9d, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0xf3, 0x2a, 0x98,
-	0xd2, 0x2d, 0x4e, 0xc9, 0xd6, 0xaf, 0x80, 0xf9, 0xbb, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d,
-	0xec, 0x42, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x49, 0xae, 0x6d, 0xf5, 0x15, 0x01, 0x00,
-	0x00,
+	// 312 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xc1, 0x4a, 0x33, 0x31,
+	0x10, 0xc7, 0x77, 0xbf, 0xf6, 0xf3, 0xb0, 0xed, 0x41, 0x97, 0x2a, 0xb5, 0x87, 0x58, 0x2a, 0x48,
+	0xa1, 0x98, 0xd0, 0xfa, 0x06, 0x2d, 0x2a, 0xde, 0x44, 0x4f, 0x7a, 0x59, 0x92, 0x38, 0x6e, 0x43,
+	0x69, 0x52, 0x76, 0x62, 0xd1, 0xb7, 0xf0, 0xe4, 0x33, 0xf5, 0xd8, 0xa3, 0x27, 0x91, 0xf6, 0x45,
+	0xa4, 0xd9, 0xec, 0x82, 0xb0, 0x9e, 0x12, 0x32, 0xbf, 0xf9, 0xcd, 0x3f, 0x13, 0x9d, 0x4a, 0x83,
+	0x73, 0x83, 0x4c, 0x66, 0x0a, 0x15, 0xb2, 0xe5, 0x50, 0x80, 0xe5, 0x43, 0x96, 0x82, 0x06, 0x54,
+	0x48, 0x17, 0x99, 0xb1, 0x26, 0x3e, 0xcc, 0x21, 0x9a, 0x43, 0xd4, 0x43, 0x9d, 0x56, 0x6a, 0x52,
+	0xe3, 0x08, 0xb6, 0xbb, 0xe5, 0x70, 0x87, 0x78, 0xa3, 0xe0, 0x08, 0xa5, 0x4f, 0x1a, 0xa5, 0x7d,
+	0xbd, 0x57, 0x3d, 0xd1, 0xbb, 0x1d, 0xd3, 0xfb, 0xf8, 0x17, 0x35, 0xaf, 0xf3, 0x08, 0xf7, 0x96,
+	0x5b, 0x88, 0xc7, 0x51, 0x53, 0x1a, 0x8d, 0x96, 0x6b, 0x9b, 0x3c, 0x03, 0xb4, 0x6b, 0xdd, 0xb0,
+	0xdf, 0x18, 0x1d, 0x53, 0x1f, 0x6c, 0x37, 0xab, 0x88, 0x45, 0x27, 0x46, 0xe9, 0x71, 0x7d, 0xf5,
+	0x75, 0x12, 0xdc, 0x35, 0x8a, 0xa6, 0x2b, 0x80, 0xf8, 0x21, 0x3a, 0x10, 0x99, 0x99, 0x81, 0x4e,
+	0x94, 0x5e, 0xf2, 0x4c, 0x71, 0x6d, 0xb1, 0x5d, 0xef, 0xd6, 0xfa, 0x8d, 0xd1, 0x19, 0xad, 0xfc,
+	0x21, 0x1d, 0x3b, 0xfe, 0xa6, 0xc0, 0xbd, 0x75, 0x5f, 0xfc, 0x7e, 0xc6, 0x38, 0x8d, 0x8e, 0x4a,
+	0x67, 0x22, 0xa7, 0x20, 0x67, 0xc9, 0x82, 0x67, 0x7c, 0x8e, 0xed, 0xff, 0x2e, 0xe8, 0xe0, 0x0f,
+	0x7f, 0xa9, 0x98, 0xec, 0x7a, 0x6e, 0x5d, 0x8b, 0x1f, 0xd2, 0x52, 0x55, 0xb5, 0xcb, 0xd5, 0x86,
+	0x84, 0xeb, 0x0d, 0x09, 0xbf, 0x37, 0x24, 0x7c, 0xdf, 0x92, 0x60, 0xbd, 0x25, 0xc1, 0xe7, 0x96,
+	0x04, 0x8f, 0x83, 0x54, 0xd9, 0xe9, 0x8b, 0xa0, 0xd2, 0xcc, 0x59, 0xb1, 0x61, 0x77, 0x9c, 0xe3,
+	0xd3, 0x8c, 0xbd, 0x16, 0xeb, 0xb6, 0x6f, 0x0b, 0x40, 0xb1, 0xe7, 0xd6, 0x7c, 0xf1, 0x33, 0x00,
+	0x77, 0xd2, 0x57, 0xd5, 0xfe, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -118,6 +142,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.InvariantCheckParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.BrokenInvariants) > 0 {
+		for iNdEx := len(m.BrokenInvariants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BrokenInvariants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.ConstantFee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -150,6 +198,14 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.ConstantFee.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.BrokenInvariants) > 0 {
+		for _, e := range m.BrokenInvariants {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.InvariantCheckParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -221,6 +277,73 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BrokenInvariants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BrokenInvariants = append(m.BrokenInvariants, BrokenInvariant{})
+			if err := m.BrokenInvariants[len(m.BrokenInvariants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvariantCheckParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InvariantCheckParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
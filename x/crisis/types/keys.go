@@ -1,6 +1,29 @@
 package types
 
+import "github.com/cosmos/cosmos-sdk/types/address"
+
 const (
 	// module name
 	ModuleName = "crisis"
+
+	// StoreKey is the default store key for crisis
+	StoreKey = ModuleName
 )
+
+var (
+	// ConstantFeeKey is the key to use for the x/crisis constant fee parameter.
+	ConstantFeeKey = []byte{0x01}
+
+	// BrokenInvariantKeyPrefix is the prefix of the broken invariant records.
+	BrokenInvariantKeyPrefix = []byte{0x02}
+
+	// InvariantCheckParamsKey is the key of the invariant check parameters.
+	InvariantCheckParamsKey = []byte{0x03}
+)
+
+// GetBrokenInvariantKey returns the key of the broken invariant record of an
+// invariant route: 0x02 | len(moduleName) | moduleName | route
+func GetBrokenInvariantKey(moduleName, route string) []byte {
+	key := append(BrokenInvariantKeyPrefix, address.MustLengthPrefix([]byte(moduleName))...)
+	return append(key, []byte(route)...)
+}
@@ -29,3 +29,54 @@ func validateConstantFee(i interface{}) error {
 
 	return nil
 }
+
+// NewInvariantCheckParams creates a new InvariantCheckParams object
+func NewInvariantCheckParams(mode InvariantCheckMode, checkPeriod uint64, criticalRoutes []string) InvariantCheckParams {
+	return InvariantCheckParams{
+		Mode:           mode,
+		CheckPeriod:    checkPeriod,
+		CriticalRoutes: criticalRoutes,
+	}
+}
+
+// DefaultInvariantCheckParams returns the default invariant check params,
+// halting the chain on any broken invariant.
+func DefaultInvariantCheckParams() InvariantCheckParams {
+	return NewInvariantCheckParams(InvariantCheckModeHalt, 0, nil)
+}
+
+// Validate performs basic validation of the invariant check params.
+func (p InvariantCheckParams) Validate() error {
+	switch p.Mode {
+	case InvariantCheckModeHalt:
+	case InvariantCheckModeAlert:
+		if p.CheckPeriod == 0 {
+			return ErrInvalidInvariantCheckParams.Wrap("check period must be positive in the alert mode")
+		}
+	default:
+		return ErrInvalidInvariantCheckParams.Wrapf("unknown invariant check mode %s", p.Mode)
+	}
+
+	seen := make(map[string]bool, len(p.CriticalRoutes))
+	for _, fullRoute := range p.CriticalRoutes {
+		if _, _, err := ParseFullRoute(fullRoute); err != nil {
+			return ErrInvalidInvariantCheckParams.Wrap(err.Error())
+		}
+		if seen[fullRoute] {
+			return ErrInvalidInvariantCheckParams.Wrapf("duplicate critical route %s", fullRoute)
+		}
+		seen[fullRoute] = true
+	}
+
+	return nil
+}
+
+// IsCritical returns true if the invariant of a full route is critical.
+func (p InvariantCheckParams) IsCritical(fullRoute string) bool {
+	for _, criticalRoute := range p.CriticalRoutes {
+		if criticalRoute == fullRoute {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,676 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/crisis/v1beta1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryBrokenInvariantsRequest is the request type for the Query/BrokenInvariants
+// RPC method.
+type QueryBrokenInvariantsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBrokenInvariantsRequest) Reset()         { *m = QueryBrokenInvariantsRequest{} }
+func (m *QueryBrokenInvariantsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBrokenInvariantsRequest) ProtoMessage()    {}
+func (*QueryBrokenInvariantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ca16352ca9a50b9, []int{0}
+}
+func (m *QueryBrokenInvariantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBrokenInvariantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBrokenInvariantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBrokenInvariantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBrokenInvariantsRequest.Merge(m, src)
+}
+func (m *QueryBrokenInvariantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBrokenInvariantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBrokenInvariantsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBrokenInvariantsRequest proto.InternalMessageInfo
+
+func (m *QueryBrokenInvariantsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBrokenInvariantsResponse is the response type for the
+// Query/BrokenInvariants RPC method.
+type QueryBrokenInvariantsResponse struct {
+	// broken_invariants returns the broken invariants records.
+	BrokenInvariants []BrokenInvariant `protobuf:"bytes,1,rep,name=broken_invariants,json=brokenInvariants,proto3" json:"broken_invariants"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBrokenInvariantsResponse) Reset()         { *m = QueryBrokenInvariantsResponse{} }
+func (m *QueryBrokenInvariantsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBrokenInvariantsResponse) ProtoMessage()    {}
+func (*QueryBrokenInvariantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ca16352ca9a50b9, []int{1}
+}
+func (m *QueryBrokenInvariantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBrokenInvariantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBrokenInvariantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBrokenInvariantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBrokenInvariantsResponse.Merge(m, src)
+}
+func (m *QueryBrokenInvariantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBrokenInvariantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBrokenInvariantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBrokenInvariantsResponse proto.InternalMessageInfo
+
+func (m *QueryBrokenInvariantsResponse) GetBrokenInvariants() []BrokenInvariant {
+	if m != nil {
+		return m.BrokenInvariants
+	}
+	return nil
+}
+
+func (m *QueryBrokenInvariantsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryBrokenInvariantsRequest)(nil), "cosmos.crisis.v1beta1.QueryBrokenInvariantsRequest")
+	proto.RegisterType((*QueryBrokenInvariantsResponse)(nil), "cosmos.crisis.v1beta1.QueryBrokenInvariantsResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/crisis/v1beta1/query.proto", fileDescriptor_3ca16352ca9a50b9) }
+
+var fileDescriptor_3ca16352ca9a50b9 = []byte{
+	// 365 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x3f, 0x4f, 0x32, 0x31,
+	0x18, 0xbf, 0xf2, 0xfe, 0x19, 0xfa, 0x2e, 0xbc, 0x17, 0x4d, 0x08, 0xc1, 0x13, 0x6f, 0x50, 0x82,
+	0xb1, 0x15, 0xf0, 0x13, 0x90, 0xa8, 0x71, 0x53, 0x36, 0x5d, 0x4c, 0x0f, 0x6b, 0x6d, 0x90, 0xf6,
+	0xb8, 0x16, 0x22, 0xab, 0x9f, 0xc0, 0xc4, 0x0f, 0xe2, 0xec, 0xec, 0xc2, 0x48, 0xe2, 0xe2, 0x64,
+	0x0c, 0xf8, 0x41, 0x0c, 0x6d, 0x45, 0x40, 0xd0, 0x38, 0xdd, 0x93, 0x7b, 0x7e, 0x7f, 0x9f, 0x3b,
+	0xb8, 0x56, 0x97, 0xaa, 0x29, 0x15, 0xae, 0x27, 0x5c, 0x71, 0x85, 0x3b, 0xa5, 0x88, 0x6a, 0x52,
+	0xc2, 0xad, 0x36, 0x4d, 0xba, 0x28, 0x4e, 0xa4, 0x96, 0xfe, 0xb2, 0x85, 0x20, 0x0b, 0x41, 0x0e,
+	0x92, 0x2d, 0x3a, 0x66, 0x44, 0x14, 0xb5, 0xf8, 0x31, 0x3b, 0x26, 0x8c, 0x0b, 0xa2, 0xb9, 0x14,
+	0x56, 0x22, 0xbb, 0xc4, 0x24, 0x93, 0x66, 0xc4, 0xa3, 0xc9, 0xbd, 0xcd, 0x31, 0x29, 0xd9, 0x25,
+	0xc5, 0x24, 0xe6, 0x98, 0x08, 0x21, 0xb5, 0xa1, 0x28, 0xb7, 0x0d, 0xe7, 0x27, 0x73, 0x29, 0x0c,
+	0x26, 0x3c, 0x87, 0xb9, 0xa3, 0x91, 0x73, 0x35, 0x91, 0x0d, 0x2a, 0x0e, 0x44, 0x87, 0x24, 0x9c,
+	0x08, 0xad, 0x6a, 0xb4, 0xd5, 0xa6, 0x4a, 0xfb, 0x7b, 0x10, 0x7e, 0x64, 0xc9, 0x80, 0x3c, 0x28,
+	0xfc, 0x2b, 0xaf, 0x23, 0xd7, 0x67, 0x14, 0x1c, 0xd9, 0xa2, 0x4e, 0x1c, 0x1d, 0x12, 0x46, 0x1d,
+	0xb7, 0x36, 0xc1, 0x0c, 0x1f, 0x00, 0x5c, 0x59, 0x60, 0xa4, 0x62, 0x29, 0x14, 0xf5, 0x8f, 0xe1,
+	0xff, 0xc8, 0xec, 0x4e, 0xf9, 0x78, 0x99, 0x01, 0xf9, 0x5f, 0x93, 0x86, 0xd3, 0x07, 0x44, 0x33,
+	0x5a, 0xd5, 0xdf, 0xbd, 0xe7, 0x55, 0xaf, 0x96, 0x8e, 0x66, 0x2c, 0xfc, 0xfd, 0xa9, 0x12, 0x29,
+	0x53, 0x62, 0xe3, 0xdb, 0x12, 0x36, 0xd7, 0x64, 0x8b, 0xf2, 0x3d, 0x80, 0x7f, 0x4c, 0x0b, 0xff,
+	0x0e, 0xc0, 0xf4, 0x6c, 0x15, 0xbf, 0xb2, 0x20, 0xe7, 0x57, 0x17, 0xce, 0xee, 0xfc, 0x8c, 0x64,
+	0x53, 0x85, 0xdb, 0xd7, 0x8f, 0xaf, 0xb7, 0xa9, 0xa2, 0x5f, 0xc0, 0xf3, 0x3f, 0xf2, 0xa7, 0x53,
+	0x56, 0x77, 0x7b, 0x83, 0x00, 0xf4, 0x07, 0x01, 0x78, 0x19, 0x04, 0xe0, 0x66, 0x18, 0x78, 0xfd,
+	0x61, 0xe0, 0x3d, 0x0d, 0x03, 0xef, 0x64, 0x93, 0x71, 0x7d, 0xd1, 0x8e, 0x50, 0x5d, 0x36, 0xc7,
+	0x6a, 0xe6, 0xb1, 0xa5, 0xce, 0x1a, 0xf8, 0xea, 0x5d, 0x5a, 0x77, 0x63, 0xaa, 0xa2, 0xbf, 0xe6,
+	0xbf, 0xa9, 0xbc, 0x0d, 0x00, 0x0d, 0x10, 0xe3, 0xd5, 0xf7, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// BrokenInvariants queries the invariants found broken by the non-halting
+	// invariant checks.
+	BrokenInvariants(ctx context.Context, in *QueryBrokenInvariantsRequest, opts ...grpc.CallOption) (*QueryBrokenInvariantsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) BrokenInvariants(ctx context.Context, in *QueryBrokenInvariantsRequest, opts ...grpc.CallOption) (*QueryBrokenInvariantsResponse, error) {
+	out := new(QueryBrokenInvariantsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.crisis.v1beta1.Query/BrokenInvariants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// BrokenInvariants queries the invariants found broken by the non-halting
+	// invariant checks.
+	BrokenInvariants(context.Context, *QueryBrokenInvariantsRequest) (*QueryBrokenInvariantsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) BrokenInvariants(ctx context.Context, req *QueryBrokenInvariantsRequest) (*QueryBrokenInvariantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BrokenInvariants not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_BrokenInvariants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBrokenInvariantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BrokenInvariants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.crisis.v1beta1.Query/BrokenInvariants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BrokenInvariants(ctx, req.(*QueryBrokenInvariantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.crisis.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BrokenInvariants",
+			Handler:    _Query_BrokenInvariants_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/crisis/v1beta1/query.proto",
+}
+
+func (m *QueryBrokenInvariantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBrokenInvariantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBrokenInvariantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBrokenInvariantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBrokenInvariantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBrokenInvariantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BrokenInvariants) > 0 {
+		for iNdEx := len(m.BrokenInvariants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BrokenInvariants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryBrokenInvariantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBrokenInvariantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BrokenInvariants) > 0 {
+		for _, e := range m.BrokenInvariants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryBrokenInvariantsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBrokenInvariantsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBrokenInvariantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBrokenInvariantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBrokenInvariantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBrokenInvariantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BrokenInvariants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BrokenInvariants = append(m.BrokenInvariants, BrokenInvariant{})
+			if err := m.BrokenInvariants[len(m.BrokenInvariants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
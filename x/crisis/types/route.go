@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -24,3 +27,17 @@ func NewInvarRoute(moduleName, route string, invar sdk.Invariant) InvarRoute {
 func (i InvarRoute) FullRoute() string {
 	return i.ModuleName + "/" + i.Route
 }
+
+// ParseFullRoute splits a full invariant route into its module name and route.
+func ParseFullRoute(fullRoute string) (moduleName, route string, err error) {
+	moduleName, route, found := strings.Cut(fullRoute, "/")
+	if !found || moduleName == "" || route == "" {
+		return "", "", fmt.Errorf("invalid invariant route %q, expected {module}/{route}", fullRoute)
+	}
+	return moduleName, route, nil
+}
+
+// FullRoute returns the full route of the broken invariant.
+func (b BrokenInvariant) FullRoute() string {
+	return b.ModuleName + "/" + b.Route
+}
@@ -12,7 +12,8 @@ import (
 )
 
 // BeginBlocker sets the proposer for determining distribution during endblock
-// and distribute rewards for the previous block
+// and distribute rewards for the previous block, and pays the continuous funds
+// of the community pool
 func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
@@ -35,4 +36,14 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 	// record the proposer for when we payout on the next block
 	consAddr := sdk.ConsAddress(req.Header.ProposerAddress)
 	k.SetPreviousProposerConsAddr(ctx, consAddr)
+
+	k.PayContinuousFunds(ctx)
+}
+
+// EndBlocker auto-compounds the rewards of the next batch of delegators opted
+// in to auto-compounding.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.AutoCompoundRewards(ctx)
 }
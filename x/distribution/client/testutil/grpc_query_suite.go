@@ -47,6 +47,11 @@ func (s *GRPCQueryTestSuite) TestQueryParamsGRPC() {
 	val := s.network.Validators[0]
 	baseURL := val.APIAddress
 
+	// the JSON response decodes the empty community pool spenders to an empty
+	// list
+	params := types.DefaultParams()
+	params.CommunityPoolSpenders = []string{}
+
 	testCases := []struct {
 		name     string
 		url      string
@@ -58,7 +63,7 @@ func (s *GRPCQueryTestSuite) TestQueryParamsGRPC() {
 			fmt.Sprintf("%s/cosmos/distribution/v1beta1/params", baseURL),
 			&types.QueryParamsResponse{},
 			&types.QueryParamsResponse{
-				Params: types.DefaultParams(),
+				Params: params,
 			},
 		},
 	}
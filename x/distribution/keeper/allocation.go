@@ -143,3 +143,20 @@ func (k Keeper) AllocateTokensToValidator(ctx sdk.Context, val stakingtypes.Vali
 	outstanding.Rewards = outstanding.Rewards.Add(tokens...)
 	k.SetValidatorOutstandingRewards(ctx, val.GetOperator(), outstanding)
 }
+
+// DepositValidatorRewardsPool sends amount from a depositor to the rewards pool
+// of a validator, allocated like the block rewards between the commission of
+// the validator and its delegators.
+func (k Keeper) DepositValidatorRewardsPool(ctx sdk.Context, depositor sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coins) error {
+	validator := k.stakingKeeper.Validator(ctx, valAddr)
+	if validator == nil {
+		return types.ErrNoValidatorExists.Wrap(valAddr.String())
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	k.AllocateTokensToValidator(ctx, validator, sdk.NewDecCoinsFromCoins(amount...))
+	return nil
+}
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetAutoCompound returns true if the delegator opted in to the
+// auto-compounding of its rewards.
+func (k Keeper) GetAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetAutoCompoundDelegatorKey(delAddr))
+}
+
+// SetAutoCompound opts the delegator in or out of the auto-compounding of its
+// rewards.
+func (k Keeper) SetAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, enabled bool) {
+	store := ctx.KVStore(k.storeKey)
+	if enabled {
+		store.Set(types.GetAutoCompoundDelegatorKey(delAddr), []byte{})
+	} else {
+		store.Delete(types.GetAutoCompoundDelegatorKey(delAddr))
+	}
+}
+
+// IterateAutoCompoundDelegators iterates over the delegators opted in to
+// auto-compounding, until handler returns true.
+func (k Keeper) IterateAutoCompoundDelegators(ctx sdk.Context, handler func(delAddr sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AutoCompoundDelegatorPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if handler(types.GetAutoCompoundDelegatorAddress(iter.Key())) {
+			break
+		}
+	}
+}
+
+// AutoCompoundRewards compounds the rewards of the next batch of delegators
+// opted in to auto-compounding. A round of auto-compounding starts every
+// AutoCompoundInterval blocks and goes on over the next blocks, at most
+// AutoCompoundBatchSize delegators per block, until the rewards of all the
+// opted-in delegators are compounded.
+func (k Keeper) AutoCompoundRewards(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.AutoCompoundBatchSize == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	cursor := store.Get(types.AutoCompoundCursorKey)
+	if cursor == nil && (params.AutoCompoundInterval == 0 || uint64(ctx.BlockHeight())%params.AutoCompoundInterval != 0) {
+		return
+	}
+
+	var (
+		delegators []sdk.AccAddress
+		next       []byte
+	)
+	iter := prefix.NewStore(store, types.AutoCompoundDelegatorPrefix).Iterator(cursor, nil)
+	for ; iter.Valid(); iter.Next() {
+		if uint64(len(delegators)) == params.AutoCompoundBatchSize {
+			next = iter.Key()
+			break
+		}
+
+		// remove the length prefix of the address
+		delegators = append(delegators, sdk.AccAddress(iter.Key()[1:]))
+	}
+	iter.Close()
+
+	// the round goes on with the next block if there are delegators left
+	if next != nil {
+		store.Set(types.AutoCompoundCursorKey, next)
+	} else {
+		store.Delete(types.AutoCompoundCursorKey)
+	}
+
+	for _, delAddr := range delegators {
+		// the rewards of a delegator are compounded atomically
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.compoundDelegatorRewards(cacheCtx, delAddr); err != nil {
+			k.Logger(ctx).Error("failed to auto-compound rewards", "delegator", delAddr.String(), "error", err)
+			continue
+		}
+
+		write()
+	}
+}
+
+// compoundDelegatorRewards withdraws the rewards of all the delegations of a
+// delegator and delegates the rewards in bond denom to the same validators.
+// The rewards are not compounded if they are withdrawn to another address.
+func (k Keeper) compoundDelegatorRewards(ctx sdk.Context, delAddr sdk.AccAddress) error {
+	if !k.GetDelegatorWithdrawAddr(ctx, delAddr).Equals(delAddr) {
+		return nil
+	}
+
+	var valAddrs []sdk.ValAddress
+	k.stakingKeeper.IterateDelegations(ctx, delAddr, func(_ int64, del stakingtypes.DelegationI) (stop bool) {
+		valAddrs = append(valAddrs, del.GetValidatorAddr())
+		return false
+	})
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	for _, valAddr := range valAddrs {
+		rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+		if err != nil {
+			return err
+		}
+
+		amount := rewards.AmountOf(bondDenom)
+		if !amount.IsPositive() {
+			continue
+		}
+
+		validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if !found {
+			return types.ErrNoValidatorExists
+		}
+
+		// the rewards are left withdrawn if the validator does not accept new
+		// delegations
+		if !sdk.ValAddress(delAddr).Equals(valAddr) && !k.stakingKeeper.IsValidatorBondHealthy(ctx, validator, amount) {
+			continue
+		}
+
+		if _, err := k.stakingKeeper.Delegate(ctx, delAddr, amount, stakingtypes.Unbonded, validator, true); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAutoCompound,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(bondDenom, amount).String()),
+				sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			),
+		)
+	}
+
+	return nil
+}
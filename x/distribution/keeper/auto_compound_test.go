@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+)
+
+// setupAutoCompound creates a validator with a self-bond of 100 tokens and
+// delegations of 100 tokens from two delegators, and allocates rewards of 30
+// tokens to the validator, 10 tokens for each delegation.
+func setupAutoCompound(t *testing.T) (*simapp.SimApp, sdk.Context, []sdk.AccAddress, sdk.ValAddress) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000000000))
+	valAddr := sdk.ValAddress(addrs[2])
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidator(valAddr, valConsPk1, sdk.NewInt(100), true)
+	tstaking.Delegate(addrs[0], valAddr, sdk.NewInt(100))
+	tstaking.Delegate(addrs[1], valAddr, sdk.NewInt(100))
+
+	// end block to bond validator and start new block
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	params := app.DistrKeeper.GetParams(ctx)
+	params.AutoCompoundInterval = 10
+	app.DistrKeeper.SetParams(ctx, params)
+
+	tokens := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(30)))
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, types.ModuleName, tokens))
+	val := app.StakingKeeper.Validator(ctx, valAddr)
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.NewDecCoinsFromCoins(tokens...))
+
+	return app, ctx, addrs, valAddr
+}
+
+func TestAutoCompoundRewards(t *testing.T) {
+	app, ctx, addrs, valAddr := setupAutoCompound(t)
+	app.DistrKeeper.SetAutoCompound(ctx, addrs[0], true)
+	require.True(t, app.DistrKeeper.GetAutoCompound(ctx, addrs[0]))
+	require.False(t, app.DistrKeeper.GetAutoCompound(ctx, addrs[1]))
+
+	// nothing is compounded out of the interval
+	app.DistrKeeper.AutoCompoundRewards(ctx.WithBlockHeight(9))
+	del, found := app.StakingKeeper.GetDelegation(ctx, addrs[0], valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(100), del.Shares)
+
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	app.DistrKeeper.AutoCompoundRewards(ctx)
+
+	del, found = app.StakingKeeper.GetDelegation(ctx, addrs[0], valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(110), del.Shares)
+
+	// the delegations of the delegators not opted in are left untouched
+	del, found = app.StakingKeeper.GetDelegation(ctx, addrs[1], valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(100), del.Shares)
+
+	var compounded bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeAutoCompound {
+			compounded = true
+			require.Equal(t, sdk.AttributeKeyAmount, string(event.Attributes[0].Key))
+			require.Equal(t, "10stake", string(event.Attributes[0].Value))
+		}
+	}
+	require.True(t, compounded)
+
+	app.DistrKeeper.SetAutoCompound(ctx, addrs[0], false)
+	require.False(t, app.DistrKeeper.GetAutoCompound(ctx, addrs[0]))
+}
+
+func TestAutoCompoundRewardsBatches(t *testing.T) {
+	app, ctx, addrs, valAddr := setupAutoCompound(t)
+	app.DistrKeeper.SetAutoCompound(ctx, addrs[0], true)
+	app.DistrKeeper.SetAutoCompound(ctx, addrs[1], true)
+
+	params := app.DistrKeeper.GetParams(ctx)
+	params.AutoCompoundBatchSize = 1
+	app.DistrKeeper.SetParams(ctx, params)
+
+	shares := func() []sdk.Dec {
+		var shares []sdk.Dec
+		for _, addr := range addrs[:2] {
+			del, found := app.StakingKeeper.GetDelegation(ctx, addr, valAddr)
+			require.True(t, found)
+			shares = append(shares, del.Shares)
+		}
+		return shares
+	}
+
+	// the round starts with a single delegator
+	ctx = ctx.WithBlockHeight(10)
+	app.DistrKeeper.AutoCompoundRewards(ctx)
+	require.ElementsMatch(t, []sdk.Dec{sdk.NewDec(110), sdk.NewDec(100)}, shares())
+
+	// and goes on with the next block
+	ctx = ctx.WithBlockHeight(11)
+	app.DistrKeeper.AutoCompoundRewards(ctx)
+	require.Equal(t, []sdk.Dec{sdk.NewDec(110), sdk.NewDec(110)}, shares())
+
+	// until the next interval once every delegator is compounded
+	ctx = ctx.WithBlockHeight(12)
+	app.DistrKeeper.AutoCompoundRewards(ctx)
+	require.Equal(t, []sdk.Dec{sdk.NewDec(110), sdk.NewDec(110)}, shares())
+}
+
+func TestAutoCompoundWithdrawAddr(t *testing.T) {
+	app, ctx, addrs, valAddr := setupAutoCompound(t)
+	msgServer := keeper.NewMsgServerImpl(app.DistrKeeper)
+
+	require.NoError(t, app.DistrKeeper.SetWithdrawAddr(ctx, addrs[0], addrs[1]))
+	_, err := msgServer.SetAutoCompound(sdk.WrapSDKContext(ctx), types.NewMsgSetAutoCompound(addrs[0], true))
+	require.ErrorIs(t, err, types.ErrAutoCompoundWithdrawAddr)
+	require.False(t, app.DistrKeeper.GetAutoCompound(ctx, addrs[0]))
+
+	// the rewards are not compounded once withdrawn to another address
+	require.NoError(t, app.DistrKeeper.SetWithdrawAddr(ctx, addrs[0], addrs[0]))
+	_, err = msgServer.SetAutoCompound(sdk.WrapSDKContext(ctx), types.NewMsgSetAutoCompound(addrs[0], true))
+	require.NoError(t, err)
+	require.True(t, app.DistrKeeper.GetAutoCompound(ctx, addrs[0]))
+	require.NoError(t, app.DistrKeeper.SetWithdrawAddr(ctx, addrs[0], addrs[1]))
+
+	app.DistrKeeper.AutoCompoundRewards(ctx.WithBlockHeight(10))
+	del, found := app.StakingKeeper.GetDelegation(ctx, addrs[0], valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(100), del.Shares)
+}
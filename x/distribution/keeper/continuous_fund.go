@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// GetLastContinuousFundID returns the id of the last continuous fund created.
+func (k Keeper) GetLastContinuousFundID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastContinuousFundIDKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetLastContinuousFundID sets the id of the last continuous fund created.
+func (k Keeper) SetLastContinuousFundID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	store.Set(types.LastContinuousFundIDKey, bz)
+}
+
+// GetContinuousFund returns the continuous fund of the given id.
+func (k Keeper) GetContinuousFund(ctx sdk.Context, id uint64) (fund types.ContinuousFund, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetContinuousFundKey(id))
+	if bz == nil {
+		return fund, false
+	}
+	k.cdc.MustUnmarshal(bz, &fund)
+	return fund, true
+}
+
+// SetContinuousFund sets a continuous fund.
+func (k Keeper) SetContinuousFund(ctx sdk.Context, fund types.ContinuousFund) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetContinuousFundKey(fund.Id), k.cdc.MustMarshal(&fund))
+}
+
+// DeleteContinuousFund deletes the continuous fund of the given id.
+func (k Keeper) DeleteContinuousFund(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetContinuousFundKey(id))
+}
+
+// IterateContinuousFunds iterates over the continuous funds by id, until
+// handler returns true.
+func (k Keeper) IterateContinuousFunds(ctx sdk.Context, handler func(fund types.ContinuousFund) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ContinuousFundPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var fund types.ContinuousFund
+		k.cdc.MustUnmarshal(iter.Value(), &fund)
+		if handler(fund) {
+			break
+		}
+	}
+}
+
+// CreateContinuousFund grants amount of the community pool to recipient, paid
+// linearly between startTime and endTime, and returns the id of the fund. The
+// community pool is not required to hold the amount yet, each payment is made
+// once the community pool can afford it.
+func (k Keeper) CreateContinuousFund(
+	ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coins, startTime, endTime time.Time,
+) (uint64, error) {
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", recipient)
+	}
+	if !endTime.After(ctx.BlockTime()) {
+		return 0, types.ErrInvalidContinuousFund.Wrapf("end time %s must be after the block time %s", endTime, ctx.BlockTime())
+	}
+
+	id := k.GetLastContinuousFundID(ctx) + 1
+	fund := types.ContinuousFund{
+		Id:        id,
+		Recipient: recipient.String(),
+		Amount:    amount,
+		StartTime: startTime,
+		EndTime:   endTime,
+		Paid:      sdk.NewCoins(),
+	}
+	if err := fund.ValidateBasic(); err != nil {
+		return 0, err
+	}
+
+	k.SetContinuousFund(ctx, fund)
+	k.SetLastContinuousFundID(ctx, id)
+
+	return id, nil
+}
+
+// CancelContinuousFund cancels the continuous fund of the given id and returns
+// the amount left unpaid, which stays in the community pool.
+func (k Keeper) CancelContinuousFund(ctx sdk.Context, id uint64) (sdk.Coins, error) {
+	fund, found := k.GetContinuousFund(ctx, id)
+	if !found {
+		return nil, types.ErrContinuousFundNotFound.Wrapf("id %d", id)
+	}
+
+	k.DeleteContinuousFund(ctx, id)
+
+	return fund.Amount.Sub(fund.Paid...), nil
+}
+
+// PayContinuousFunds pays the recipients of the continuous funds the amounts
+// unlocked since their last payment. At most ContinuousFundBatchSize funds are
+// paid per block, in turn, so each fund is paid every few blocks when there are
+// more funds. A payment the community pool cannot afford is postponed to the
+// next turn of the fund, and the funds fully paid are deleted.
+func (k Keeper) PayContinuousFunds(ctx sdk.Context) {
+	batchSize := k.GetParams(ctx).ContinuousFundBatchSize
+	if batchSize == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	cursor := store.Get(types.ContinuousFundCursorKey)
+
+	var (
+		funds []types.ContinuousFund
+		next  []byte
+	)
+	iter := prefix.NewStore(store, types.ContinuousFundPrefix).Iterator(cursor, nil)
+	for ; iter.Valid(); iter.Next() {
+		if uint64(len(funds)) == batchSize {
+			next = iter.Key()
+			break
+		}
+
+		var fund types.ContinuousFund
+		k.cdc.MustUnmarshal(iter.Value(), &fund)
+		funds = append(funds, fund)
+	}
+	iter.Close()
+
+	// the next block pays the following funds, or starts over from the first
+	// one
+	if next != nil {
+		store.Set(types.ContinuousFundCursorKey, next)
+	} else {
+		store.Delete(types.ContinuousFundCursorKey)
+	}
+
+	for _, fund := range funds {
+		due, negative := fund.UnlockedAmount(ctx.BlockTime()).SafeSub(fund.Paid...)
+		if negative || due.IsZero() {
+			continue
+		}
+
+		recipient := sdk.MustAccAddressFromBech32(fund.Recipient)
+		if err := k.DistributeFromFeePool(ctx, due, recipient); err != nil {
+			k.Logger(ctx).Error("failed to pay continuous fund", "id", fund.Id, "amount", due.String(), "error", err)
+			continue
+		}
+
+		fund.Paid = fund.Paid.Add(due...)
+		if fund.IsPaid() {
+			k.DeleteContinuousFund(ctx, fund.Id)
+		} else {
+			k.SetContinuousFund(ctx, fund)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeContinuousFundPayment,
+				sdk.NewAttribute(types.AttributeKeyFundID, strconv.FormatUint(fund.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyRecipient, fund.Recipient),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, due.String()),
+			),
+		)
+	}
+}
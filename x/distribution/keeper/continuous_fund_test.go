@@ -0,0 +1,263 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+)
+
+func setupCommunityPool(t *testing.T, pool sdk.Coins) (*simapp.SimApp, sdk.Context, []sdk.AccAddress) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(0, 0).UTC()})
+
+	addrs := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000000000))
+
+	feePool := app.DistrKeeper.GetFeePool(ctx)
+	feePool.CommunityPool = sdk.NewDecCoinsFromCoins(pool...)
+	app.DistrKeeper.SetFeePool(ctx, feePool)
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, types.ModuleName, pool))
+
+	return app, ctx, addrs
+}
+
+func TestMsgCommunityPoolSpend(t *testing.T) {
+	pool := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	app, ctx, addrs := setupCommunityPool(t, pool)
+	msgServer := keeper.NewMsgServerImpl(app.DistrKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 400))
+
+	_, err := msgServer.CommunityPoolSpend(sdk.WrapSDKContext(ctx), types.NewMsgCommunityPoolSpend(addrs[0], addrs[1], amount))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	// the module accounts cannot receive the funds
+	distrAddr := app.DistrKeeper.GetDistributionAccount(ctx).GetAddress()
+	_, err = msgServer.CommunityPoolSpend(sdk.WrapSDKContext(ctx), types.NewMsgCommunityPoolSpend(authority, distrAddr, amount))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	balance := app.BankKeeper.GetAllBalances(ctx, addrs[1])
+	_, err = msgServer.CommunityPoolSpend(sdk.WrapSDKContext(ctx), types.NewMsgCommunityPoolSpend(authority, addrs[1], amount))
+	require.NoError(t, err)
+	require.Equal(t, balance.Add(amount...), app.BankKeeper.GetAllBalances(ctx, addrs[1]))
+	require.Equal(t, sdk.NewDecCoinsFromCoins(pool.Sub(amount...)...), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+
+	_, err = msgServer.CommunityPoolSpend(sdk.WrapSDKContext(ctx), types.NewMsgCommunityPoolSpend(authority, addrs[1], pool))
+	require.ErrorIs(t, err, types.ErrBadDistribution)
+}
+
+func TestMsgDepositValidatorRewardsPool(t *testing.T) {
+	app, ctx, addrs := setupCommunityPool(t, sdk.NewCoins())
+	msgServer := keeper.NewMsgServerImpl(app.DistrKeeper)
+	valAddr := sdk.ValAddress(addrs[1])
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidator(valAddr, valConsPk1, sdk.NewInt(100), true)
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	_, err := msgServer.DepositValidatorRewardsPool(sdk.WrapSDKContext(ctx), types.NewMsgDepositValidatorRewardsPool(addrs[0], sdk.ValAddress(addrs[0]), amount))
+	require.ErrorIs(t, err, types.ErrNoValidatorExists)
+
+	balance := app.BankKeeper.GetAllBalances(ctx, addrs[0])
+	_, err = msgServer.DepositValidatorRewardsPool(sdk.WrapSDKContext(ctx), types.NewMsgDepositValidatorRewardsPool(addrs[0], valAddr, amount))
+	require.NoError(t, err)
+	require.Equal(t, balance.Sub(amount...), app.BankKeeper.GetAllBalances(ctx, addrs[0]))
+
+	// the validator has no commission, the whole deposit goes to the delegators
+	require.Equal(t, sdk.NewDecCoinsFromCoins(amount...), app.DistrKeeper.GetValidatorOutstandingRewards(ctx, valAddr).Rewards)
+	require.Equal(t, sdk.NewDecCoinsFromCoins(amount...), app.DistrKeeper.GetValidatorCurrentRewards(ctx, valAddr).Rewards)
+}
+
+func TestContinuousFund(t *testing.T) {
+	pool := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	app, ctx, addrs := setupCommunityPool(t, pool)
+	msgServer := keeper.NewMsgServerImpl(app.DistrKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	start := ctx.BlockTime().Add(time.Hour)
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	msg := types.NewMsgCreateContinuousFund(authority, addrs[0], amount, start, start.Add(10*time.Hour))
+
+	_, err := msgServer.CreateContinuousFund(sdk.WrapSDKContext(ctx), types.NewMsgCreateContinuousFund(addrs[1], addrs[0], amount, start, start.Add(10*time.Hour)))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	res, err := msgServer.CreateContinuousFund(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Id)
+
+	balance := app.BankKeeper.GetAllBalances(ctx, addrs[0])
+	balanceAt := func(ctx sdk.Context) sdk.Coins {
+		return app.BankKeeper.GetAllBalances(ctx, addrs[0]).Sub(balance...)
+	}
+
+	// nothing is paid before the start time
+	app.DistrKeeper.PayContinuousFunds(ctx)
+	require.True(t, balanceAt(ctx).IsZero())
+
+	// the amount is paid linearly
+	ctx = ctx.WithBlockTime(start.Add(3 * time.Hour))
+	app.DistrKeeper.PayContinuousFunds(ctx)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300)), balanceAt(ctx))
+
+	// the payments the community pool cannot afford are postponed
+	_, err = msgServer.CommunityPoolSpend(sdk.WrapSDKContext(ctx), types.NewMsgCommunityPoolSpend(authority, addrs[1], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 600))))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(start.Add(5 * time.Hour))
+	app.DistrKeeper.PayContinuousFunds(ctx)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300)), balanceAt(ctx))
+
+	require.NoError(t, app.DistrKeeper.FundCommunityPool(ctx, pool, addrs[2]))
+	app.DistrKeeper.PayContinuousFunds(ctx)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500)), balanceAt(ctx))
+
+	fund, found := app.DistrKeeper.GetContinuousFund(ctx, res.Id)
+	require.True(t, found)
+	require.Equal(t, balanceAt(ctx), fund.Paid)
+
+	// the fund is deleted once fully paid
+	ctx = ctx.WithBlockTime(start.Add(11 * time.Hour))
+	app.DistrKeeper.PayContinuousFunds(ctx)
+	require.Equal(t, amount, balanceAt(ctx))
+	_, found = app.DistrKeeper.GetContinuousFund(ctx, res.Id)
+	require.False(t, found)
+}
+
+func TestCancelContinuousFund(t *testing.T) {
+	pool := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	app, ctx, addrs := setupCommunityPool(t, pool)
+	msgServer := keeper.NewMsgServerImpl(app.DistrKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	start := ctx.BlockTime()
+	msg := types.NewMsgCreateContinuousFund(authority, addrs[0], pool, start, start.Add(10*time.Hour))
+	res, err := msgServer.CreateContinuousFund(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(start.Add(4 * time.Hour))
+	app.DistrKeeper.PayContinuousFunds(ctx)
+
+	_, err = msgServer.CancelContinuousFund(sdk.WrapSDKContext(ctx), types.NewMsgCancelContinuousFund(authority, res.Id+1))
+	require.ErrorIs(t, err, types.ErrContinuousFundNotFound)
+
+	cancelRes, err := msgServer.CancelContinuousFund(sdk.WrapSDKContext(ctx), types.NewMsgCancelContinuousFund(authority, res.Id))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 600)), cancelRes.Unpaid)
+	require.Equal(t, sdk.NewDecCoinsFromCoins(cancelRes.Unpaid...), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+
+	_, found := app.DistrKeeper.GetContinuousFund(ctx, res.Id)
+	require.False(t, found)
+
+	// the ids are not reused
+	res, err = msgServer.CreateContinuousFund(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.Id)
+
+	genesis := app.DistrKeeper.ExportGenesis(ctx)
+	require.Len(t, genesis.ContinuousFunds, 1)
+	require.Equal(t, uint64(2), genesis.LastContinuousFundId)
+	require.NoError(t, types.ValidateGenesis(genesis))
+}
+
+func TestPayContinuousFundsBatch(t *testing.T) {
+	pool := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	app, ctx, addrs := setupCommunityPool(t, pool)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	params := app.DistrKeeper.GetParams(ctx)
+	params.ContinuousFundBatchSize = 2
+	app.DistrKeeper.SetParams(ctx, params)
+
+	start := ctx.BlockTime()
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	msgServer := keeper.NewMsgServerImpl(app.DistrKeeper)
+	for _, recipient := range addrs {
+		_, err := msgServer.CreateContinuousFund(sdk.WrapSDKContext(ctx), types.NewMsgCreateContinuousFund(authority, recipient, amount, start, start.Add(time.Hour)))
+		require.NoError(t, err)
+	}
+	balances := make([]sdk.Coins, len(addrs))
+	for i, addr := range addrs {
+		balances[i] = app.BankKeeper.GetAllBalances(ctx, addr)
+	}
+	paid := func(i int) sdk.Coins {
+		return app.BankKeeper.GetAllBalances(ctx, addrs[i]).Sub(balances[i]...)
+	}
+
+	// at most two funds are paid per block, in turn
+	ctx = ctx.WithBlockTime(start.Add(2 * time.Hour))
+	app.DistrKeeper.PayContinuousFunds(ctx)
+	require.Equal(t, amount, paid(0))
+	require.Equal(t, amount, paid(1))
+	require.True(t, paid(2).IsZero())
+
+	app.DistrKeeper.PayContinuousFunds(ctx)
+	require.Equal(t, amount, paid(2))
+	require.Empty(t, app.DistrKeeper.ExportGenesis(ctx).ContinuousFunds)
+
+	// a zero batch size pauses the payments
+	params.ContinuousFundBatchSize = 0
+	app.DistrKeeper.SetParams(ctx, params)
+	res, err := msgServer.CreateContinuousFund(sdk.WrapSDKContext(ctx), types.NewMsgCreateContinuousFund(authority, addrs[0], amount, start, ctx.BlockTime().Add(time.Hour)))
+	require.NoError(t, err)
+	app.DistrKeeper.PayContinuousFunds(ctx)
+	fund, found := app.DistrKeeper.GetContinuousFund(ctx, res.Id)
+	require.True(t, found)
+	require.True(t, fund.Paid.IsZero())
+}
+
+func TestCommunityPoolSpendByGroupPolicy(t *testing.T) {
+	pool := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	app, ctx, addrs := setupCommunityPool(t, pool)
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 400))
+
+	groupMsg, err := group.NewMsgCreateGroupWithPolicy(addrs[0].String(), []group.MemberRequest{
+		{Address: addrs[0].String(), Weight: "1"},
+	}, "", "", true, group.NewThresholdDecisionPolicy("1", time.Hour, 0))
+	require.NoError(t, err)
+	groupRes, err := app.GroupKeeper.CreateGroupWithPolicy(sdk.WrapSDKContext(ctx), groupMsg)
+	require.NoError(t, err)
+	policyAddr := sdk.MustAccAddressFromBech32(groupRes.GroupPolicyAddress)
+
+	// the proposals are voted by their proposer and executed on submission
+	submit := func(msgs ...sdk.Msg) uint64 {
+		proposal, err := group.NewMsgSubmitProposal(policyAddr.String(), []string{addrs[0].String()}, msgs, "", group.Exec_EXEC_TRY)
+		require.NoError(t, err)
+		res, err := app.GroupKeeper.SubmitProposal(sdk.WrapSDKContext(ctx), proposal)
+		require.NoError(t, err)
+		return res.ProposalId
+	}
+
+	// the group policy cannot spend the community pool until it is a spender
+	proposalID := submit(types.NewMsgCommunityPoolSpend(policyAddr, addrs[1], amount))
+	proposal, err := app.GroupKeeper.Proposal(sdk.WrapSDKContext(ctx), &group.QueryProposalRequest{ProposalId: proposalID})
+	require.NoError(t, err)
+	require.Equal(t, group.PROPOSAL_EXECUTOR_RESULT_FAILURE, proposal.Proposal.ExecutorResult)
+
+	params := app.DistrKeeper.GetParams(ctx)
+	params.CommunityPoolSpenders = []string{policyAddr.String()}
+	app.DistrKeeper.SetParams(ctx, params)
+
+	balance := app.BankKeeper.GetAllBalances(ctx, addrs[1])
+	submit(types.NewMsgCommunityPoolSpend(policyAddr, addrs[1], amount))
+	require.Equal(t, balance.Add(amount...), app.BankKeeper.GetAllBalances(ctx, addrs[1]))
+	require.Equal(t, sdk.NewDecCoinsFromCoins(pool.Sub(amount...)...), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+
+	start := ctx.BlockTime()
+	submit(types.NewMsgCreateContinuousFund(policyAddr, addrs[2], amount, start, start.Add(time.Hour)))
+	_, found := app.DistrKeeper.GetContinuousFund(ctx, 1)
+	require.True(t, found)
+	submit(types.NewMsgCancelContinuousFund(policyAddr, 1))
+	_, found = app.DistrKeeper.GetContinuousFund(ctx, 1)
+	require.False(t, found)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
@@ -28,3 +29,18 @@ func (k Keeper) DistributeFromFeePool(ctx sdk.Context, amount sdk.Coins, receive
 	k.SetFeePool(ctx, feePool)
 	return nil
 }
+
+// SpendCommunityPool sends amount from the community pool to a recipient, which
+// must be allowed to receive external funds.
+func (k Keeper) SpendCommunityPool(ctx sdk.Context, amount sdk.Coins, recipient sdk.AccAddress) error {
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", recipient)
+	}
+
+	if err := k.DistributeFromFeePool(ctx, amount, recipient); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("transferred from the community pool to recipient", "amount", amount.String(), "recipient", recipient.String())
+	return nil
+}
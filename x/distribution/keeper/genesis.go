@@ -76,6 +76,13 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		}
 		k.SetValidatorSlashEvent(ctx, valAddr, evt.Height, evt.Period, evt.ValidatorSlashEvent)
 	}
+	for _, delegator := range data.AutoCompoundDelegators {
+		k.SetAutoCompound(ctx, sdk.MustAccAddressFromBech32(delegator), true)
+	}
+	for _, fund := range data.ContinuousFunds {
+		k.SetContinuousFund(ctx, fund)
+	}
+	k.SetLastContinuousFundID(ctx, data.LastContinuousFundId)
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -181,5 +188,20 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	autoCompound := make([]string, 0)
+	k.IterateAutoCompoundDelegators(ctx, func(delAddr sdk.AccAddress) (stop bool) {
+		autoCompound = append(autoCompound, delAddr.String())
+		return false
+	})
+
+	gs := types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	gs.AutoCompoundDelegators = autoCompound
+
+	gs.ContinuousFunds = make([]types.ContinuousFund, 0)
+	k.IterateContinuousFunds(ctx, func(fund types.ContinuousFund) (stop bool) {
+		gs.ContinuousFunds = append(gs.ContinuousFunds, fund)
+		return false
+	})
+	gs.LastContinuousFundId = k.GetLastContinuousFundID(ctx)
+	return gs
 }
@@ -19,8 +19,7 @@ var _ types.QueryServer = Keeper{}
 // Params queries params of distribution module
 func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	var params types.Params
-	k.paramSpace.GetParamSet(ctx, &params)
+	params := k.GetParams(ctx)
 
 	return &types.QueryParamsResponse{Params: params}, nil
 }
@@ -236,6 +235,25 @@ func (k Keeper) DelegatorWithdrawAddress(c context.Context, req *types.QueryDele
 	return &types.QueryDelegatorWithdrawAddressResponse{WithdrawAddress: withdrawAddr.String()}, nil
 }
 
+// DelegatorAutoCompound queries whether the rewards of a delegator are
+// auto-compounded
+func (k Keeper) DelegatorAutoCompound(c context.Context, req *types.QueryDelegatorAutoCompoundRequest) (*types.QueryDelegatorAutoCompoundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+	delAdr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryDelegatorAutoCompoundResponse{Enabled: k.GetAutoCompound(ctx, delAdr)}, nil
+}
+
 // CommunityPool queries the community pool coins
 func (k Keeper) CommunityPool(c context.Context, req *types.QueryCommunityPoolRequest) (*types.QueryCommunityPoolResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -243,3 +261,43 @@ func (k Keeper) CommunityPool(c context.Context, req *types.QueryCommunityPoolRe
 
 	return &types.QueryCommunityPoolResponse{Pool: pool}, nil
 }
+
+// ContinuousFund queries a continuous fund of the community pool by id
+func (k Keeper) ContinuousFund(c context.Context, req *types.QueryContinuousFundRequest) (*types.QueryContinuousFundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	fund, found := k.GetContinuousFund(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "continuous fund %d not found", req.Id)
+	}
+
+	return &types.QueryContinuousFundResponse{Fund: fund}, nil
+}
+
+// ContinuousFunds queries all the continuous funds of the community pool
+func (k Keeper) ContinuousFunds(c context.Context, req *types.QueryContinuousFundsRequest) (*types.QueryContinuousFundsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ContinuousFundPrefix)
+
+	funds := []types.ContinuousFund{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var fund types.ContinuousFund
+		if err := k.cdc.Unmarshal(value, &fund); err != nil {
+			return err
+		}
+		funds = append(funds, fund)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryContinuousFundsResponse{Funds: funds, Pagination: pageRes}, nil
+}
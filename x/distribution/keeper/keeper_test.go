@@ -11,6 +11,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func TestSetWithdrawAddr(t *testing.T) {
@@ -129,3 +133,52 @@ func TestFundCommunityPool(t *testing.T) {
 	assert.Equal(t, initPool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(amount...)...), app.DistrKeeper.GetFeePool(ctx).CommunityPool)
 	assert.Empty(t, app.BankKeeper.GetAllBalances(ctx, addr[0]))
 }
+
+func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(100000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// create validator with 0% commission
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// tokenize half of the self delegation, owned by the second account
+	tokenized := app.StakingKeeper.TokensFromConsensusPower(ctx, 50)
+	stakingMsgServer := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	res, err := stakingMsgServer.TokenizeShares(sdk.WrapSDKContext(ctx), stakingtypes.NewMsgTokenizeShares(
+		addr[0], valAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, tokenized), addr[1],
+	))
+	require.NoError(t, err)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards
+	initial := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	rewards := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial))
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, types.ModuleName, rewards))
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.NewDecCoinsFromCoins(rewards...))
+
+	// the owner of the record receives the rewards of the tokenized half
+	balance := app.BankKeeper.GetAllBalances(ctx, addr[1])
+	amount, err := app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, addr[1])
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2))), amount)
+	require.Equal(t, balance.Add(amount...), app.BankKeeper.GetAllBalances(ctx, addr[1]))
+
+	record, found := app.StakingKeeper.GetTokenizeShareRecord(ctx, res.RecordId)
+	require.True(t, found)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, record.GetModuleAddress()).IsZero())
+
+	// records of other owners are left untouched
+	amount, err = app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, addr[0])
+	require.NoError(t, err)
+	require.True(t, amount.IsZero())
+}
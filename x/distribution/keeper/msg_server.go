@@ -2,12 +2,15 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/armon/go-metrics"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 type msgServer struct {
@@ -143,3 +146,190 @@ func (k msgServer) FundCommunityPool(goCtx context.Context, msg *types.MsgFundCo
 
 	return &types.MsgFundCommunityPoolResponse{}, nil
 }
+
+// UpdateParams updates the x/distribution module parameters if the message is signed by the authority.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Params.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetParams(ctx, msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+func (k msgServer) WithdrawTokenizeShareRecordReward(goCtx context.Context, msg *types.MsgWithdrawTokenizeShareRecordReward) (*types.MsgWithdrawTokenizeShareRecordRewardResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ownerAddr, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := k.Keeper.WithdrawTokenizeShareRecordReward(ctx, ownerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.OwnerAddress),
+		),
+	)
+
+	return &types.MsgWithdrawTokenizeShareRecordRewardResponse{Amount: amount}, nil
+}
+
+func (k msgServer) SetAutoCompound(goCtx context.Context, msg *types.MsgSetAutoCompound) (*types.MsgSetAutoCompoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// the rewards withdrawn to another address cannot be delegated again
+	if msg.Enabled && !k.GetDelegatorWithdrawAddr(ctx, delegatorAddress).Equals(delegatorAddress) {
+		return nil, types.ErrAutoCompoundWithdrawAddr
+	}
+
+	k.Keeper.SetAutoCompound(ctx, delegatorAddress, msg.Enabled)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(msg.Enabled)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	})
+
+	return &types.MsgSetAutoCompoundResponse{}, nil
+}
+
+// CommunityPoolSpend sends tokens from the community pool to an account if the
+// message is signed by the authority or a community pool spender.
+func (k msgServer) CommunityPoolSpend(goCtx context.Context, msg *types.MsgCommunityPoolSpend) (*types.MsgCommunityPoolSpendResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.IsCommunityPoolSpender(ctx, msg.Authority) {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "expected %s or a community pool spender got %s", k.authority, msg.Authority)
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.SpendCommunityPool(ctx, msg.Amount, recipient); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCommunityPoolSpend,
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgCommunityPoolSpendResponse{}, nil
+}
+
+func (k msgServer) DepositValidatorRewardsPool(goCtx context.Context, msg *types.MsgDepositValidatorRewardsPool) (*types.MsgDepositValidatorRewardsPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return nil, err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.DepositValidatorRewardsPool(ctx, depositor, valAddr, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDepositValidatorRewardsPool,
+			sdk.NewAttribute(types.AttributeKeyDepositor, msg.Depositor),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Depositor),
+		),
+	})
+
+	return &types.MsgDepositValidatorRewardsPoolResponse{}, nil
+}
+
+// CreateContinuousFund grants tokens of the community pool to an account, paid
+// over time, if the message is signed by the authority or a community pool
+// spender.
+func (k msgServer) CreateContinuousFund(goCtx context.Context, msg *types.MsgCreateContinuousFund) (*types.MsgCreateContinuousFundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.IsCommunityPoolSpender(ctx, msg.Authority) {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "expected %s or a community pool spender got %s", k.authority, msg.Authority)
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := k.Keeper.CreateContinuousFund(ctx, recipient, msg.Amount, msg.StartTime, msg.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateContinuousFund,
+			sdk.NewAttribute(types.AttributeKeyFundID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgCreateContinuousFundResponse{Id: id}, nil
+}
+
+// CancelContinuousFund cancels the payments of a continuous fund not paid yet
+// if the message is signed by the authority or a community pool spender.
+func (k msgServer) CancelContinuousFund(goCtx context.Context, msg *types.MsgCancelContinuousFund) (*types.MsgCancelContinuousFundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.IsCommunityPoolSpender(ctx, msg.Authority) {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "expected %s or a community pool spender got %s", k.authority, msg.Authority)
+	}
+
+	unpaid, err := k.Keeper.CancelContinuousFund(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelContinuousFund,
+			sdk.NewAttribute(types.AttributeKeyFundID, strconv.FormatUint(msg.Id, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, unpaid.String()),
+		),
+	)
+
+	return &types.MsgCancelContinuousFundResponse{Unpaid: unpaid}, nil
+}
@@ -2,7 +2,6 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
@@ -13,17 +12,5 @@ func HandleCommunityPoolSpendProposal(ctx sdk.Context, k Keeper, p *types.Commun
 		return addrErr
 	}
 
-	if k.bankKeeper.BlockedAddr(recipient) {
-		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", p.Recipient)
-	}
-
-	err := k.DistributeFromFeePool(ctx, p.Amount, recipient)
-	if err != nil {
-		return err
-	}
-
-	logger := k.Logger(ctx)
-	logger.Info("transferred from the community pool to recipient", "amount", p.Amount.String(), "recipient", p.Recipient)
-
-	return nil
+	return k.SpendCommunityPool(ctx, p.Amount, recipient)
 }
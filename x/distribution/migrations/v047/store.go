@@ -0,0 +1,38 @@
+package v047
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/exported"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// MigrateStore performs in-place store migrations from v2 (v0.46) to v3 (v0.47). The
+// migration includes:
+//
+// - Migrate params from the x/params module to the x/distribution module store.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, legacySubspace exported.Subspace, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var currParams types.Params
+	legacySubspace.GetParamSet(ctx, &currParams)
+
+	// the auto-compounding and continuous fund params are not part of the
+	// legacy params
+	currParams.AutoCompoundInterval = types.DefaultAutoCompoundInterval
+	currParams.AutoCompoundBatchSize = types.DefaultAutoCompoundBatchSize
+	currParams.ContinuousFundBatchSize = types.DefaultContinuousFundBatchSize
+
+	if err := currParams.ValidateBasic(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&currParams)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+	return nil
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateBasic performs basic validation of the continuous fund.
+func (f ContinuousFund) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(f.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+	if !f.Amount.IsValid() || f.Amount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, f.Amount.String())
+	}
+	if !f.EndTime.After(f.StartTime) {
+		return ErrInvalidContinuousFund.Wrapf("end time %s must be after start time %s", f.EndTime, f.StartTime)
+	}
+	if !f.Paid.IsValid() || !f.Paid.IsAllLTE(f.Amount) {
+		return ErrInvalidContinuousFund.Wrapf("paid amount %s exceeds the amount %s", f.Paid, f.Amount)
+	}
+	return nil
+}
+
+// UnlockedAmount returns the amount of the fund unlocked at blockTime, which
+// grows linearly from zero at the start time to the whole amount at the end
+// time.
+func (f ContinuousFund) UnlockedAmount(blockTime time.Time) sdk.Coins {
+	if !blockTime.After(f.StartTime) {
+		return sdk.NewCoins()
+	}
+	if !blockTime.Before(f.EndTime) {
+		return f.Amount
+	}
+
+	elapsed := sdk.NewInt(int64(blockTime.Sub(f.StartTime)))
+	duration := sdk.NewInt(int64(f.EndTime.Sub(f.StartTime)))
+
+	unlocked := sdk.NewCoins()
+	for _, coin := range f.Amount {
+		unlocked = unlocked.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(elapsed).Quo(duration)))
+	}
+	return unlocked
+}
+
+// IsPaid returns true if the whole amount of the fund is paid.
+func (f ContinuousFund) IsPaid() bool {
+	return f.Amount.IsAllLTE(f.Paid)
+}
@@ -10,15 +10,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -32,6 +36,20 @@ type Params struct {
 	BaseProposerReward  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_proposer_reward,json=baseProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_proposer_reward"`
 	BonusProposerReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonus_proposer_reward"`
 	WithdrawAddrEnabled bool                                   `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// auto_compound_interval is the number of blocks between two rounds of
+	// auto-compounding of the rewards of the opted-in delegators, zero disables
+	// auto-compounding.
+	AutoCompoundInterval uint64 `protobuf:"varint,5,opt,name=auto_compound_interval,json=autoCompoundInterval,proto3" json:"auto_compound_interval,omitempty"`
+	// auto_compound_batch_size is the maximum number of delegators whose rewards
+	// are auto-compounded in a block, zero disables auto-compounding.
+	AutoCompoundBatchSize uint64 `protobuf:"varint,6,opt,name=auto_compound_batch_size,json=autoCompoundBatchSize,proto3" json:"auto_compound_batch_size,omitempty"`
+	// continuous_fund_batch_size is the maximum number of continuous funds paid
+	// in a block, zero pauses the payments.
+	ContinuousFundBatchSize uint64 `protobuf:"varint,7,opt,name=continuous_fund_batch_size,json=continuousFundBatchSize,proto3" json:"continuous_fund_batch_size,omitempty"`
+	// community_pool_spenders are the addresses allowed, besides the authority,
+	// to spend the community pool and to create and cancel continuous funds, such
+	// as group policies.
+	CommunityPoolSpenders []string `protobuf:"bytes,8,rep,name=community_pool_spenders,json=communityPoolSpenders,proto3" json:"community_pool_spenders,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -73,6 +91,34 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetAutoCompoundInterval() uint64 {
+	if m != nil {
+		return m.AutoCompoundInterval
+	}
+	return 0
+}
+
+func (m *Params) GetAutoCompoundBatchSize() uint64 {
+	if m != nil {
+		return m.AutoCompoundBatchSize
+	}
+	return 0
+}
+
+func (m *Params) GetContinuousFundBatchSize() uint64 {
+	if m != nil {
+		return m.ContinuousFundBatchSize
+	}
+	return 0
+}
+
+func (m *Params) GetCommunityPoolSpenders() []string {
+	if m != nil {
+		return m.CommunityPoolSpenders
+	}
+	return nil
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -80,11 +126,12 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 // The reference count indicates the number of objects
 // which might need to reference this historical entry at any point.
 // ReferenceCount =
-//    number of outstanding delegations which ended the associated period (and
-//    might need to read that record)
-//  + number of slashes which ended the associated period (and might need to
-//  read that record)
-//  + one per validator for the zeroeth period, set on initialization
+//
+//	  number of outstanding delegations which ended the associated period (and
+//	  might need to read that record)
+//	+ number of slashes which ended the associated period (and might need to
+//	read that record)
+//	+ one per validator for the zeroeth period, set on initialization
 type ValidatorHistoricalRewards struct {
 	CumulativeRewardRatio github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=cumulative_reward_ratio,json=cumulativeRewardRatio,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_reward_ratio"`
 	ReferenceCount        uint32                                      `protobuf:"varint,2,opt,name=reference_count,json=referenceCount,proto3" json:"reference_count,omitempty"`
@@ -606,6 +653,57 @@ func (m *CommunityPoolSpendProposalWithDeposit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolSpendProposalWithDeposit proto.InternalMessageInfo
 
+// ContinuousFund defines a grant of the community pool streamed to a recipient
+// over time. The amount is paid linearly between the start and end time, at the
+// beginning of every block.
+type ContinuousFund struct {
+	// id is the unique id of the continuous fund.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// recipient is the address receiving the payments of the fund.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the total amount granted to the recipient.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// start_time is the time the payments start at.
+	StartTime time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time is the time the whole amount is paid at.
+	EndTime time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// paid is the amount already paid to the recipient.
+	Paid github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=paid,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"paid"`
+}
+
+func (m *ContinuousFund) Reset()         { *m = ContinuousFund{} }
+func (m *ContinuousFund) String() string { return proto.CompactTextString(m) }
+func (*ContinuousFund) ProtoMessage()    {}
+func (*ContinuousFund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{12}
+}
+func (m *ContinuousFund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContinuousFund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContinuousFund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContinuousFund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContinuousFund.Merge(m, src)
+}
+func (m *ContinuousFund) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContinuousFund) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContinuousFund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContinuousFund proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.distribution.v1beta1.Params")
 	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewards")
@@ -619,6 +717,7 @@ func init() {
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
 	proto.RegisterType((*CommunityPoolSpendProposalWithDeposit)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit")
+	proto.RegisterType((*ContinuousFund)(nil), "cosmos.distribution.v1beta1.ContinuousFund")
 }
 
 func init() {
@@ -626,66 +725,81 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 941 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xf7, 0x34, 0x8e, 0x93, 0x4e, 0xbf, 0x4d, 0xbe, 0x4c, 0x9c, 0xd4, 0x71, 0x2b, 0x3b, 0x5a,
-	0x89, 0x12, 0xa8, 0xe2, 0x34, 0xed, 0x2d, 0xe2, 0x12, 0x3b, 0x41, 0x70, 0x6a, 0xb4, 0x41, 0x80,
-	0xb8, 0xac, 0xc6, 0xbb, 0x2f, 0xf6, 0x28, 0xbb, 0x33, 0xcb, 0xcc, 0xac, 0x93, 0x9e, 0x7b, 0xe0,
-	0xc7, 0x09, 0x89, 0x0b, 0xe2, 0x80, 0x72, 0x44, 0x9c, 0xf3, 0x0f, 0x70, 0xab, 0x38, 0x95, 0x5e,
-	0x40, 0x1c, 0x02, 0x4a, 0x2e, 0x88, 0xbf, 0x02, 0xcd, 0xce, 0x78, 0xed, 0x40, 0x88, 0x7a, 0x88,
-	0xc5, 0xc9, 0x9e, 0xf7, 0x66, 0x3f, 0x3f, 0xde, 0xbc, 0x7d, 0xb3, 0xb8, 0x15, 0x0a, 0x95, 0x08,
-	0xb5, 0x1e, 0x31, 0xa5, 0x25, 0xeb, 0x66, 0x9a, 0x09, 0xbe, 0x3e, 0xd8, 0xe8, 0x82, 0xa6, 0x1b,
-	0x17, 0x82, 0xad, 0x54, 0x0a, 0x2d, 0xc8, 0x5d, 0xbb, 0xbf, 0x75, 0x21, 0xe5, 0xf6, 0xd7, 0xab,
-	0x3d, 0xd1, 0x13, 0xf9, 0xbe, 0x75, 0xf3, 0xcf, 0x3e, 0x52, 0x6f, 0x38, 0x8a, 0x2e, 0x55, 0x50,
-	0x40, 0x87, 0x82, 0x39, 0xc8, 0xfa, 0xb2, 0xcd, 0x07, 0xf6, 0x41, 0x87, 0x9f, 0x2f, 0xbc, 0x4f,
-	0xa7, 0x70, 0x65, 0x97, 0x4a, 0x9a, 0x28, 0x42, 0xf1, 0xed, 0x50, 0x24, 0x49, 0xc6, 0x99, 0x7e,
-	0x1a, 0x68, 0x7a, 0x54, 0x43, 0x2b, 0x68, 0xf5, 0x66, 0xfb, 0xed, 0xe7, 0xa7, 0xcd, 0xd2, 0xaf,
-	0xa7, 0xcd, 0xfb, 0x3d, 0xa6, 0xfb, 0x59, 0xb7, 0x15, 0x8a, 0xc4, 0x41, 0xb8, 0x9f, 0x35, 0x15,
-	0x1d, 0xac, 0xeb, 0xa7, 0x29, 0xa8, 0xd6, 0x36, 0x84, 0x2f, 0x4f, 0xd6, 0xb0, 0x63, 0xd8, 0x86,
-	0xd0, 0xff, 0x5f, 0x01, 0xf9, 0x3e, 0x3d, 0x22, 0x1c, 0x57, 0x8d, 0x46, 0x23, 0x24, 0x15, 0x0a,
-	0x64, 0x20, 0xe1, 0x90, 0xca, 0xa8, 0x76, 0xe3, 0x1a, 0x98, 0x88, 0x41, 0xde, 0x75, 0xc0, 0x7e,
-	0x8e, 0x4b, 0x52, 0xbc, 0xd8, 0x15, 0x3c, 0x53, 0xff, 0x20, 0x9c, 0xba, 0x06, 0xc2, 0x85, 0x1c,
-	0xfa, 0x6f, 0x8c, 0x8f, 0xf0, 0xe2, 0x21, 0xd3, 0xfd, 0x48, 0xd2, 0xc3, 0x80, 0x46, 0x91, 0x0c,
-	0x80, 0xd3, 0x6e, 0x0c, 0x51, 0xad, 0xbc, 0x82, 0x56, 0x67, 0xfd, 0x85, 0x61, 0x72, 0x2b, 0x8a,
-	0xe4, 0x8e, 0x4d, 0x6d, 0x96, 0xbf, 0x3e, 0x6e, 0x96, 0xbc, 0x9f, 0x10, 0xae, 0x7f, 0x40, 0x63,
-	0x16, 0x51, 0x2d, 0xe4, 0xbb, 0x4c, 0x69, 0x21, 0x59, 0x48, 0x63, 0x8b, 0xab, 0xc8, 0xe7, 0x08,
-	0xdf, 0x09, 0xb3, 0x24, 0x8b, 0xa9, 0x66, 0x03, 0x70, 0x3e, 0x02, 0x49, 0x35, 0x13, 0x35, 0xb4,
-	0x32, 0xb5, 0x7a, 0xeb, 0xd1, 0x3d, 0xd7, 0x69, 0x2d, 0x53, 0x88, 0x61, 0xc7, 0x18, 0xa5, 0x1d,
-	0xc1, 0x78, 0xfb, 0xb1, 0xf1, 0xfa, 0xfd, 0x6f, 0xcd, 0x07, 0xaf, 0xe6, 0xd5, 0x3c, 0xa3, 0xfc,
-	0xc5, 0x11, 0xa3, 0xd5, 0xe1, 0x1b, 0x3e, 0xf2, 0x06, 0x9e, 0x97, 0xb0, 0x0f, 0x12, 0x78, 0x08,
-	0x41, 0x28, 0x32, 0xae, 0xf3, 0x13, 0xbc, 0xed, 0xcf, 0x15, 0xe1, 0x8e, 0x89, 0x7a, 0xdf, 0x22,
-	0x7c, 0xa7, 0xf0, 0xd4, 0xc9, 0xa4, 0x04, 0xae, 0x87, 0x86, 0x0e, 0xf0, 0x8c, 0x35, 0xa1, 0x26,
-	0xa7, 0x7f, 0xc8, 0x40, 0x96, 0x70, 0x25, 0x05, 0xc9, 0x84, 0x6d, 0xb5, 0xb2, 0xef, 0x56, 0xde,
-	0x57, 0x08, 0x37, 0x0a, 0x81, 0x5b, 0xa1, 0xb3, 0x0b, 0x51, 0x47, 0x24, 0x09, 0x53, 0x8a, 0x09,
-	0x4e, 0x3e, 0xc1, 0x38, 0x2c, 0x56, 0x93, 0x93, 0x3a, 0x46, 0xe2, 0x7d, 0x81, 0xf0, 0xdd, 0x42,
-	0xd5, 0x93, 0x4c, 0x2b, 0x4d, 0x79, 0xc4, 0x78, 0xef, 0xbf, 0x28, 0x9d, 0xf7, 0x0d, 0xc2, 0x0b,
-	0x85, 0x98, 0xbd, 0x98, 0xaa, 0xfe, 0xce, 0x00, 0xb8, 0x26, 0x6f, 0xe2, 0xff, 0x0f, 0x86, 0xe1,
-	0xc0, 0x15, 0x17, 0xe5, 0xc5, 0x9d, 0x2f, 0xe2, 0xbb, 0x79, 0x98, 0x7c, 0x84, 0x67, 0xf7, 0x25,
-	0x0d, 0xcd, 0x24, 0xbb, 0x96, 0x57, 0xbd, 0x40, 0x33, 0x95, 0xaa, 0x5e, 0x22, 0x4e, 0x91, 0x18,
-	0x2f, 0x8d, 0xd4, 0x29, 0x93, 0x08, 0x20, 0xcf, 0xb8, 0x8a, 0x3d, 0x6c, 0x5d, 0x31, 0x66, 0x5b,
-	0x97, 0x40, 0xb6, 0xcb, 0x46, 0xb2, 0x5f, 0x1d, 0x5c, 0xc2, 0xe6, 0xde, 0xe0, 0x67, 0x08, 0xcf,
-	0xbc, 0x03, 0xb0, 0x2b, 0x44, 0x4c, 0x8e, 0xf0, 0xdc, 0x68, 0x98, 0xa6, 0x42, 0xc4, 0x93, 0x3b,
-	0xa9, 0xd1, 0xd4, 0x36, 0xcc, 0xde, 0xb3, 0x1b, 0xb8, 0xde, 0x19, 0x8f, 0xec, 0xa5, 0xc0, 0x23,
-	0x3b, 0xa6, 0x68, 0x4c, 0xaa, 0x78, 0x5a, 0x33, 0x1d, 0x83, 0x9d, 0xee, 0xbe, 0x5d, 0x90, 0x15,
-	0x7c, 0x2b, 0x02, 0x15, 0x4a, 0x96, 0x8e, 0x0e, 0xc9, 0x1f, 0x0f, 0x91, 0x7b, 0xf8, 0xa6, 0x84,
-	0x90, 0xa5, 0x0c, 0xb8, 0xb6, 0xe3, 0xd3, 0x1f, 0x05, 0x48, 0x88, 0x2b, 0x34, 0xc9, 0x07, 0x41,
-	0x39, 0xb7, 0xb9, 0x7c, 0xa9, 0xcd, 0xdc, 0xe3, 0x43, 0xe7, 0x71, 0xf5, 0x15, 0x3c, 0x5a, 0x83,
-	0x0e, 0x7a, 0xf3, 0xad, 0xcf, 0x8e, 0x9b, 0x25, 0x53, 0xe9, 0x3f, 0x8e, 0x9b, 0xa5, 0x1f, 0x4f,
-	0xd6, 0xea, 0x8e, 0xa3, 0x27, 0x06, 0x63, 0x14, 0x5c, 0x03, 0xd7, 0xde, 0x0f, 0x08, 0x2f, 0x6e,
-	0x43, 0x0c, 0xbd, 0xfc, 0xa8, 0x34, 0x95, 0x9a, 0xf1, 0xde, 0x7b, 0x7c, 0x3f, 0x1f, 0x5e, 0xa9,
-	0x84, 0x01, 0x13, 0xe6, 0x5a, 0x18, 0x6f, 0xdb, 0xb9, 0x61, 0xd8, 0x75, 0xad, 0x8f, 0xa7, 0x95,
-	0xa6, 0x07, 0x70, 0x2d, 0x2d, 0x6b, 0xa1, 0xc8, 0x03, 0x5c, 0xe9, 0x03, 0xeb, 0xf5, 0x6d, 0x09,
-	0xcb, 0xed, 0x85, 0x3f, 0x4f, 0x9b, 0xf3, 0xa1, 0x04, 0x33, 0x56, 0x79, 0x60, 0x53, 0xbe, 0xdb,
-	0xe2, 0xfd, 0x8c, 0xf0, 0xb2, 0xf3, 0xc0, 0x04, 0x2f, 0xdc, 0xb8, 0x9b, 0x66, 0x07, 0xbf, 0x36,
-	0xea, 0x70, 0x73, 0xd5, 0x80, 0x52, 0xee, 0xca, 0xae, 0xbd, 0x3c, 0x59, 0xab, 0x3a, 0xf2, 0x2d,
-	0x9b, 0xd9, 0xd3, 0xd2, 0x0c, 0x90, 0xd1, 0x2b, 0xeb, 0xe2, 0x84, 0xe1, 0x4a, 0x71, 0x09, 0x4f,
-	0xa8, 0x41, 0x1d, 0xc1, 0xe6, 0xac, 0x3b, 0x3f, 0x64, 0x9c, 0xbd, 0xfe, 0xef, 0x3d, 0xfa, 0x21,
-	0xd3, 0xfd, 0x6d, 0x48, 0x85, 0x62, 0x7a, 0x42, 0xed, 0xba, 0x34, 0xd6, 0xae, 0x26, 0xe5, 0x56,
-	0xa4, 0x86, 0x67, 0x22, 0x4b, 0x5c, 0x9b, 0xce, 0x13, 0xc3, 0xe5, 0xe6, 0xfd, 0xa1, 0xf6, 0xab,
-	0xfb, 0xae, 0xfd, 0xe4, 0xbb, 0xb3, 0x06, 0x7a, 0x7e, 0xd6, 0x40, 0x2f, 0xce, 0x1a, 0xe8, 0xf7,
-	0xb3, 0x06, 0xfa, 0xf2, 0xbc, 0x51, 0x7a, 0x71, 0xde, 0x28, 0xfd, 0x72, 0xde, 0x28, 0x7d, 0xbc,
-	0x71, 0x65, 0xd9, 0x8e, 0x2e, 0x7e, 0x23, 0xe6, 0x55, 0xec, 0x56, 0xf2, 0xef, 0xb4, 0xc7, 0x7f,
-	0x05, 0x00, 0x00, 0xff, 0xff, 0xc6, 0x8d, 0xa6, 0x48, 0x47, 0x0a, 0x00, 0x00,
+	// 1169 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0x26, 0x8e, 0xe3, 0x4c, 0x69, 0x02, 0x13, 0x3b, 0x71, 0xdc, 0xca, 0xb6, 0x2c, 0x51,
+	0x0c, 0x55, 0xec, 0x26, 0x45, 0x20, 0x05, 0x24, 0x54, 0x3b, 0xa9, 0xe8, 0xa9, 0xd1, 0xa6, 0x02,
+	0xc4, 0x65, 0x35, 0xde, 0x9d, 0xd8, 0xa3, 0xec, 0xce, 0x2c, 0x33, 0xb3, 0x4e, 0xda, 0x6b, 0x85,
+	0x04, 0x9c, 0x2a, 0x71, 0x41, 0x1c, 0x50, 0x8e, 0xa8, 0xe7, 0x7c, 0x01, 0x6e, 0x15, 0xa7, 0xd2,
+	0x0b, 0x88, 0x43, 0x8a, 0x92, 0x0b, 0xe2, 0x53, 0xa0, 0xd9, 0x99, 0x5d, 0xdb, 0x6d, 0xfa, 0x07,
+	0x29, 0x16, 0xa7, 0x64, 0xdf, 0x9b, 0xf7, 0x7e, 0xef, 0xf7, 0xfe, 0xcd, 0x18, 0x34, 0x5d, 0x26,
+	0x02, 0x26, 0x5a, 0x1e, 0x11, 0x92, 0x93, 0x6e, 0x24, 0x09, 0xa3, 0xad, 0xc1, 0x5a, 0x17, 0x4b,
+	0xb4, 0x36, 0x26, 0x6c, 0x86, 0x9c, 0x49, 0x06, 0x2f, 0xe9, 0xf3, 0xcd, 0x31, 0x95, 0x39, 0x5f,
+	0x2e, 0xf4, 0x58, 0x8f, 0xc5, 0xe7, 0x5a, 0xea, 0x3f, 0x6d, 0x52, 0xae, 0x18, 0x88, 0x2e, 0x12,
+	0x38, 0x75, 0xed, 0x32, 0x62, 0x5c, 0x96, 0x57, 0xb4, 0xde, 0xd1, 0x86, 0xc6, 0xbf, 0x56, 0x55,
+	0x7b, 0x8c, 0xf5, 0x7c, 0xdc, 0x8a, 0xbf, 0xba, 0xd1, 0x6e, 0x4b, 0x92, 0x00, 0x0b, 0x89, 0x82,
+	0x50, 0x1f, 0xa8, 0x7f, 0x3d, 0x03, 0x72, 0xdb, 0x88, 0xa3, 0x40, 0x40, 0x04, 0x2e, 0xba, 0x2c,
+	0x08, 0x22, 0x4a, 0xe4, 0x5d, 0x47, 0xa2, 0x83, 0x92, 0x55, 0xb3, 0x1a, 0x73, 0xed, 0x8f, 0x1f,
+	0x1d, 0x57, 0x33, 0x7f, 0x1e, 0x57, 0xaf, 0xf4, 0x88, 0xec, 0x47, 0xdd, 0xa6, 0xcb, 0x02, 0x83,
+	0x61, 0xfe, 0xac, 0x0a, 0x6f, 0xaf, 0x25, 0xef, 0x86, 0x58, 0x34, 0x37, 0xb1, 0xfb, 0xe4, 0x68,
+	0x15, 0x98, 0x10, 0x36, 0xb1, 0x6b, 0xbf, 0x91, 0xba, 0xbc, 0x83, 0x0e, 0x20, 0x05, 0x05, 0x45,
+	0x42, 0x45, 0x1a, 0x32, 0x81, 0xb9, 0xc3, 0xf1, 0x3e, 0xe2, 0x5e, 0x69, 0xea, 0x1c, 0x90, 0xa0,
+	0xf2, 0xbc, 0x6d, 0x1c, 0xdb, 0xb1, 0x5f, 0x18, 0x82, 0x62, 0x97, 0xd1, 0x48, 0x3c, 0x07, 0x38,
+	0x7d, 0x0e, 0x80, 0x8b, 0xb1, 0xeb, 0x67, 0x10, 0xd7, 0x41, 0x71, 0x9f, 0xc8, 0xbe, 0xc7, 0xd1,
+	0xbe, 0x83, 0x3c, 0x8f, 0x3b, 0x98, 0xa2, 0xae, 0x8f, 0xbd, 0x52, 0xb6, 0x66, 0x35, 0xf2, 0xf6,
+	0x62, 0xa2, 0xbc, 0xe1, 0x79, 0x7c, 0x4b, 0xab, 0xe0, 0xfb, 0x60, 0x09, 0x45, 0x92, 0x39, 0x2e,
+	0x0b, 0x42, 0x16, 0x51, 0xcf, 0x21, 0x54, 0x62, 0x3e, 0x40, 0x7e, 0x69, 0xa6, 0x66, 0x35, 0xb2,
+	0x76, 0x41, 0x69, 0x3b, 0x46, 0x79, 0xcb, 0xe8, 0xe0, 0x87, 0xa0, 0x34, 0x6e, 0xd5, 0x45, 0xd2,
+	0xed, 0x3b, 0x82, 0xdc, 0xc3, 0xa5, 0x5c, 0x6c, 0x57, 0x1c, 0xb5, 0x6b, 0x2b, 0xed, 0x0e, 0xb9,
+	0x87, 0xe1, 0x47, 0xa0, 0xec, 0x32, 0x2a, 0x09, 0x8d, 0x58, 0x24, 0x9c, 0xdd, 0x67, 0x4c, 0x67,
+	0x63, 0xd3, 0xe5, 0xe1, 0x89, 0x9b, 0x63, 0xc6, 0xdb, 0x60, 0x79, 0xd8, 0x24, 0x21, 0x63, 0xbe,
+	0x23, 0x42, 0x4c, 0x3d, 0xcc, 0x45, 0x29, 0x5f, 0x9b, 0x6e, 0xcc, 0xb5, 0x4b, 0x4f, 0x8e, 0x56,
+	0x0b, 0x26, 0x4b, 0x8a, 0x24, 0x16, 0x62, 0x47, 0x72, 0x42, 0x7b, 0x76, 0x31, 0x35, 0xdc, 0x66,
+	0xcc, 0xdf, 0x31, 0x66, 0x1b, 0xd9, 0x1f, 0x0e, 0xab, 0x99, 0xfa, 0x6f, 0x16, 0x28, 0x7f, 0x86,
+	0x7c, 0xe2, 0x21, 0xc9, 0xf8, 0xa7, 0x44, 0x48, 0xc6, 0x89, 0x8b, 0x7c, 0x9d, 0x55, 0x01, 0xbf,
+	0xb5, 0xc0, 0xb2, 0x1b, 0x05, 0x91, 0x8f, 0x24, 0x19, 0x60, 0x53, 0x45, 0x87, 0x23, 0x49, 0x58,
+	0xc9, 0xaa, 0x4d, 0x37, 0x2e, 0xac, 0x5f, 0x36, 0x83, 0xd8, 0x54, 0x6d, 0x90, 0x0c, 0x94, 0xaa,
+	0x53, 0x87, 0x11, 0xda, 0xbe, 0xae, 0x2a, 0xfd, 0xf0, 0x69, 0xf5, 0xea, 0xeb, 0x55, 0x5a, 0xd9,
+	0x08, 0xbb, 0x38, 0x44, 0xd4, 0x71, 0xd8, 0x0a, 0x0f, 0xbe, 0x03, 0x16, 0x38, 0xde, 0xc5, 0x1c,
+	0x53, 0x17, 0x3b, 0x2e, 0x8b, 0xa8, 0x8c, 0xfb, 0xf7, 0xa2, 0x3d, 0x9f, 0x8a, 0x3b, 0x4a, 0x5a,
+	0xff, 0xc9, 0x02, 0xcb, 0x29, 0xa7, 0x4e, 0xc4, 0x39, 0xa6, 0x32, 0x21, 0xb4, 0x07, 0x66, 0x35,
+	0x09, 0x31, 0xb9, 0xf8, 0x13, 0x04, 0xb8, 0x04, 0x72, 0x21, 0xe6, 0x84, 0xe9, 0x41, 0xcb, 0xda,
+	0xe6, 0xab, 0xfe, 0xbd, 0x05, 0x2a, 0x69, 0x80, 0x37, 0x5c, 0x43, 0x17, 0x7b, 0x1d, 0x16, 0x04,
+	0x44, 0x08, 0xc2, 0x28, 0xfc, 0x0a, 0x00, 0x37, 0xfd, 0x9a, 0x5c, 0xa8, 0x23, 0x20, 0xf5, 0xef,
+	0x2c, 0x70, 0x29, 0x8d, 0xea, 0x76, 0x24, 0x85, 0x44, 0xd4, 0x53, 0x0d, 0xf4, 0x3f, 0xa4, 0xae,
+	0xfe, 0xa3, 0x05, 0x16, 0xd3, 0x60, 0x76, 0x7c, 0x24, 0xfa, 0x5b, 0x03, 0x4c, 0x25, 0x7c, 0x17,
+	0xbc, 0x39, 0x48, 0xc4, 0x8e, 0x49, 0xae, 0x15, 0x27, 0x77, 0x21, 0x95, 0x6f, 0xc7, 0x62, 0xf8,
+	0x05, 0xc8, 0xef, 0x72, 0xe4, 0xaa, 0x45, 0x7f, 0x2e, 0x8b, 0x2e, 0xf5, 0xa6, 0x32, 0x55, 0x38,
+	0x23, 0x38, 0x01, 0x7d, 0xb0, 0x34, 0x8c, 0x4e, 0x28, 0x85, 0x83, 0x63, 0x8d, 0xc9, 0xd8, 0xb5,
+	0xe6, 0x4b, 0x6e, 0xa1, 0xe6, 0x19, 0x2e, 0xdb, 0x59, 0x15, 0xb2, 0x5d, 0x18, 0x9c, 0x81, 0x66,
+	0x26, 0xf8, 0xbe, 0x05, 0x66, 0x6f, 0x62, 0xac, 0x66, 0x1b, 0x1e, 0x80, 0xf9, 0xf1, 0x2d, 0x31,
+	0xb9, 0x4a, 0x5d, 0x1c, 0xdb, 0x2a, 0xf5, 0xfb, 0x53, 0xa0, 0xdc, 0x79, 0x6e, 0xcf, 0xe8, 0x25,
+	0x8d, 0x7c, 0x58, 0x00, 0x33, 0x92, 0x48, 0x1f, 0xeb, 0xbb, 0xcd, 0xd6, 0x1f, 0xb0, 0x06, 0x2e,
+	0x78, 0x58, 0xb8, 0x9c, 0x84, 0xc3, 0x22, 0xd9, 0xa3, 0x22, 0x78, 0x19, 0xcc, 0x71, 0xec, 0x92,
+	0x90, 0x60, 0x2a, 0xf5, 0xe5, 0x61, 0x0f, 0x05, 0xd0, 0x05, 0x39, 0x14, 0xc4, 0x8b, 0x20, 0x1b,
+	0xd3, 0x5c, 0x39, 0x93, 0x66, 0xcc, 0xf1, 0x9a, 0xe1, 0xd8, 0x78, 0x0d, 0x8e, 0x9a, 0xa0, 0x71,
+	0xbd, 0xf1, 0xde, 0x37, 0x87, 0xd5, 0x8c, 0xca, 0xf4, 0xdf, 0x87, 0xd5, 0xcc, 0xaf, 0x47, 0xab,
+	0x65, 0x83, 0xd1, 0x63, 0x83, 0x11, 0x08, 0x2a, 0x31, 0x95, 0xf5, 0x5f, 0x2c, 0x50, 0xdc, 0xc4,
+	0x3e, 0xee, 0xc5, 0xa5, 0x92, 0x88, 0x4b, 0x42, 0x7b, 0xb7, 0xe8, 0x6e, 0xbc, 0xbc, 0x42, 0x8e,
+	0x07, 0x44, 0xad, 0xfe, 0xb1, 0xb6, 0x9d, 0x4f, 0xc4, 0xa6, 0x6b, 0x6d, 0x30, 0x23, 0x24, 0xda,
+	0xc3, 0xe7, 0xd2, 0xb2, 0xda, 0x15, 0xbc, 0x0a, 0x72, 0x7d, 0x4c, 0x7a, 0x7d, 0x9d, 0xc2, 0x6c,
+	0x7b, 0xf1, 0x9f, 0xe3, 0xea, 0x82, 0xcb, 0xb1, 0x5a, 0xab, 0xd4, 0xd1, 0x2a, 0xdb, 0x1c, 0xa9,
+	0xff, 0x6e, 0x81, 0x15, 0xc3, 0x81, 0x30, 0x9a, 0xb2, 0x31, 0xf7, 0xec, 0x16, 0x78, 0x6b, 0xd8,
+	0xe1, 0x48, 0xdf, 0x33, 0xe6, 0xc1, 0xf2, 0xe2, 0x1b, 0x68, 0x38, 0xb2, 0x46, 0x0e, 0x09, 0xc8,
+	0xa5, 0x4f, 0x90, 0x09, 0x35, 0xa8, 0x01, 0xd8, 0xc8, 0x9b, 0xfa, 0x59, 0x8a, 0xd9, 0xdb, 0x2f,
+	0xee, 0xd1, 0xcf, 0x89, 0xec, 0x6f, 0xe2, 0x90, 0x09, 0x22, 0x27, 0xd4, 0xae, 0x4b, 0x23, 0xed,
+	0xaa, 0x54, 0xe6, 0x0b, 0x96, 0xc0, 0xac, 0xa7, 0x81, 0xe3, 0x87, 0xc7, 0x9c, 0x9d, 0x7c, 0x6e,
+	0x5c, 0x49, 0x62, 0x7f, 0x45, 0xdf, 0x3d, 0x9c, 0x06, 0xf3, 0x9d, 0xb1, 0x97, 0x03, 0x9c, 0x07,
+	0x53, 0x24, 0xe9, 0xb1, 0x29, 0xe2, 0xc1, 0x0f, 0x46, 0x43, 0x9b, 0x7a, 0x45, 0xc1, 0xce, 0x9c,
+	0xb1, 0xe9, 0x89, 0xcd, 0x18, 0xec, 0x00, 0x20, 0xd4, 0xb4, 0x38, 0x92, 0x04, 0x38, 0xce, 0xce,
+	0x85, 0xf5, 0x72, 0x53, 0xbf, 0xa1, 0x9b, 0xc9, 0x1b, 0xba, 0x79, 0x27, 0x79, 0x43, 0xb7, 0xf3,
+	0x0a, 0xe9, 0xc1, 0xd3, 0xaa, 0x65, 0xcf, 0xc5, 0x76, 0x4a, 0x03, 0x3f, 0x01, 0x79, 0x4c, 0x3d,
+	0xed, 0x62, 0xe6, 0x3f, 0xb8, 0x98, 0xc5, 0xd4, 0x8b, 0x1d, 0x38, 0x20, 0x1b, 0x22, 0xe2, 0x95,
+	0x72, 0xe7, 0x4f, 0x34, 0x76, 0xbc, 0x91, 0x55, 0xe5, 0x6c, 0xdf, 0xfe, 0xf9, 0xa4, 0x62, 0x3d,
+	0x3a, 0xa9, 0x58, 0x8f, 0x4f, 0x2a, 0xd6, 0x5f, 0x27, 0x15, 0xeb, 0xc1, 0x69, 0x25, 0xf3, 0xf8,
+	0xb4, 0x92, 0xf9, 0xe3, 0xb4, 0x92, 0xf9, 0x72, 0xed, 0xa5, 0x3e, 0x0f, 0xc6, 0x7f, 0xef, 0xc4,
+	0x10, 0xdd, 0x5c, 0x4c, 0xef, 0xfa, 0xbf, 0x03, 0x00, 0x63, 0x83, 0x6b, 0x39, 0x13, 0x0d, 0x00,
+	0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -719,6 +833,23 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.AutoCompoundInterval != that1.AutoCompoundInterval {
+		return false
+	}
+	if this.AutoCompoundBatchSize != that1.AutoCompoundBatchSize {
+		return false
+	}
+	if this.ContinuousFundBatchSize != that1.ContinuousFundBatchSize {
+		return false
+	}
+	if len(this.CommunityPoolSpenders) != len(that1.CommunityPoolSpenders) {
+		return false
+	}
+	for i := range this.CommunityPoolSpenders {
+		if this.CommunityPoolSpenders[i] != that1.CommunityPoolSpenders[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1026,6 +1157,55 @@ func (this *CommunityPoolSpendProposalWithDeposit) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *ContinuousFund) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContinuousFund)
+	if !ok {
+		that2, ok := that.(ContinuousFund)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	if !this.StartTime.Equal(that1.StartTime) {
+		return false
+	}
+	if !this.EndTime.Equal(that1.EndTime) {
+		return false
+	}
+	if len(this.Paid) != len(that1.Paid) {
+		return false
+	}
+	for i := range this.Paid {
+		if !this.Paid[i].Equal(&that1.Paid[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1046,6 +1226,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CommunityPoolSpenders) > 0 {
+		for iNdEx := len(m.CommunityPoolSpenders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CommunityPoolSpenders[iNdEx])
+			copy(dAtA[i:], m.CommunityPoolSpenders[iNdEx])
+			i = encodeVarintDistribution(dAtA, i, uint64(len(m.CommunityPoolSpenders[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.ContinuousFundBatchSize != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.ContinuousFundBatchSize))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AutoCompoundBatchSize != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundBatchSize))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AutoCompoundInterval != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundInterval))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1562,6 +1766,85 @@ func (m *CommunityPoolSpendProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *ContinuousFund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContinuousFund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContinuousFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Paid) > 0 {
+		for iNdEx := len(m.Paid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Paid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintDistribution(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintDistribution(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDistribution(v)
 	base := offset
@@ -1588,6 +1871,21 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	if m.AutoCompoundInterval != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundInterval))
+	}
+	if m.AutoCompoundBatchSize != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundBatchSize))
+	}
+	if m.ContinuousFundBatchSize != 0 {
+		n += 1 + sovDistribution(uint64(m.ContinuousFundBatchSize))
+	}
+	if len(m.CommunityPoolSpenders) > 0 {
+		for _, s := range m.CommunityPoolSpenders {
+			l = len(s)
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1793,6 +2091,38 @@ func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
 	return n
 }
 
+func (m *ContinuousFund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDistribution(uint64(m.Id))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovDistribution(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovDistribution(uint64(l))
+	if len(m.Paid) > 0 {
+		for _, e := range m.Paid {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovDistribution(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1950,6 +2280,95 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundInterval", wireType)
+			}
+			m.AutoCompoundInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundBatchSize", wireType)
+			}
+			m.AutoCompoundBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundBatchSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuousFundBatchSize", wireType)
+			}
+			m.ContinuousFundBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContinuousFundBatchSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolSpenders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityPoolSpenders = append(m.CommunityPoolSpenders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -3244,6 +3663,241 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ContinuousFund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContinuousFund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContinuousFund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paid = append(m.Paid, types.Coin{})
+			if err := m.Paid[len(m.Paid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDistribution(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,15 +2,27 @@ package types
 
 // distribution module event types
 const (
-	EventTypeSetWithdrawAddress = "set_withdraw_address"
-	EventTypeRewards            = "rewards"
-	EventTypeCommission         = "commission"
-	EventTypeWithdrawRewards    = "withdraw_rewards"
-	EventTypeWithdrawCommission = "withdraw_commission"
-	EventTypeProposerReward     = "proposer_reward"
+	EventTypeSetWithdrawAddress          = "set_withdraw_address"
+	EventTypeRewards                     = "rewards"
+	EventTypeCommission                  = "commission"
+	EventTypeWithdrawRewards             = "withdraw_rewards"
+	EventTypeWithdrawCommission          = "withdraw_commission"
+	EventTypeProposerReward              = "proposer_reward"
+	EventTypeWithdrawTokenizeShareReward = "withdraw_tokenize_share_reward"
+	EventTypeSetAutoCompound             = "set_auto_compound"
+	EventTypeAutoCompound                = "auto_compound"
+	EventTypeCommunityPoolSpend          = "community_pool_spend"
+	EventTypeDepositValidatorRewardsPool = "deposit_validator_rewards_pool"
+	EventTypeCreateContinuousFund        = "create_continuous_fund"
+	EventTypeCancelContinuousFund        = "cancel_continuous_fund"
+	EventTypeContinuousFundPayment       = "continuous_fund_payment"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
 	AttributeKeyDelegator       = "delegator"
+	AttributeKeyEnabled         = "enabled"
+	AttributeKeyRecipient       = "recipient"
+	AttributeKeyDepositor       = "depositor"
+	AttributeKeyFundID          = "fund_id"
 	AttributeValueCategory      = ModuleName
 )
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -37,6 +39,8 @@ func DefaultGenesisState() *GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		AutoCompoundDelegators:          []string{},
+		ContinuousFunds:                 []ContinuousFund{},
 	}
 }
 
@@ -45,5 +49,31 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+
+	seen := make(map[string]bool, len(gs.AutoCompoundDelegators))
+	for _, delegator := range gs.AutoCompoundDelegators {
+		if _, err := sdk.AccAddressFromBech32(delegator); err != nil {
+			return fmt.Errorf("invalid auto-compound delegator %s: %w", delegator, err)
+		}
+		if seen[delegator] {
+			return fmt.Errorf("duplicate auto-compound delegator: %s", delegator)
+		}
+		seen[delegator] = true
+	}
+
+	seenFunds := make(map[uint64]bool, len(gs.ContinuousFunds))
+	for _, fund := range gs.ContinuousFunds {
+		if err := fund.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid continuous fund %d: %w", fund.Id, err)
+		}
+		if fund.Id == 0 || fund.Id > gs.LastContinuousFundId {
+			return fmt.Errorf("continuous fund id %d must be between 1 and the last id %d", fund.Id, gs.LastContinuousFundId)
+		}
+		if seenFunds[fund.Id] {
+			return fmt.Errorf("duplicate continuous fund id: %d", fund.Id)
+		}
+		seenFunds[fund.Id] = true
+	}
+
 	return gs.FeePool.ValidateGenesis()
 }
@@ -347,6 +347,14 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
+	// auto_compound_delegators defines the delegators which opted in to the
+	// auto-compounding of their rewards at genesis.
+	AutoCompoundDelegators []string `protobuf:"bytes,11,rep,name=auto_compound_delegators,json=autoCompoundDelegators,proto3" json:"auto_compound_delegators,omitempty"`
+	// continuous_funds defines the continuous funds of the community pool at
+	// genesis.
+	ContinuousFunds []ContinuousFund `protobuf:"bytes,12,rep,name=continuous_funds,json=continuousFunds,proto3" json:"continuous_funds"`
+	// last_continuous_fund_id defines the id of the last continuous fund created.
+	LastContinuousFundId uint64 `protobuf:"varint,13,opt,name=last_continuous_fund_id,json=lastContinuousFundId,proto3" json:"last_continuous_fund_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -398,63 +406,69 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 896 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6e, 0xeb, 0x44,
-	0x14, 0x8e, 0x93, 0x92, 0x9b, 0x3b, 0xb9, 0x88, 0x8b, 0x6f, 0x6f, 0x71, 0x7b, 0x8b, 0xd3, 0x96,
-	0x2e, 0x8a, 0x50, 0x1d, 0x9a, 0x22, 0x40, 0x45, 0x20, 0x25, 0x69, 0xf9, 0x59, 0xb5, 0x4a, 0x10,
-	0x95, 0x90, 0x50, 0x34, 0xb1, 0x27, 0xce, 0x40, 0xe2, 0x89, 0x66, 0xc6, 0x6e, 0x91, 0x58, 0x21,
-	0x21, 0x75, 0x89, 0x04, 0x0f, 0xd0, 0x25, 0x42, 0x62, 0xc7, 0x33, 0xa0, 0x2e, 0x2b, 0x56, 0x2c,
-	0x50, 0x41, 0x29, 0x0b, 0x5e, 0x81, 0x1d, 0xf2, 0x78, 0xfc, 0xa7, 0xba, 0x26, 0x2d, 0xed, 0xaa,
-	0x1d, 0xcf, 0xf9, 0xf9, 0xbe, 0x73, 0xbe, 0x9c, 0x33, 0xe0, 0x55, 0x93, 0xb0, 0x31, 0x61, 0x75,
-	0x0b, 0x33, 0x4e, 0x71, 0xdf, 0xe5, 0x98, 0x38, 0x75, 0x6f, 0xab, 0x8f, 0x38, 0xdc, 0xaa, 0xdb,
-	0xc8, 0x41, 0x0c, 0x33, 0x63, 0x42, 0x09, 0x27, 0xea, 0xb3, 0xc0, 0xd4, 0x48, 0x9a, 0x1a, 0xd2,
-	0x74, 0x69, 0xde, 0x26, 0x36, 0x11, 0x76, 0x75, 0xff, 0xbf, 0xc0, 0x65, 0x49, 0x97, 0xd1, 0xfb,
-	0x90, 0xa1, 0x28, 0xaa, 0x49, 0xb0, 0x23, 0xef, 0x8d, 0xbc, 0xec, 0xa9, 0x3c, 0x81, 0xfd, 0x62,
-	0x60, 0xdf, 0x0b, 0x12, 0x49, 0x3c, 0xe2, 0xb0, 0xf6, 0x93, 0x02, 0x9e, 0xee, 0xa2, 0x11, 0xb2,
-	0x21, 0x27, 0xf4, 0x10, 0xf3, 0xa1, 0x45, 0xe1, 0xd1, 0x47, 0xce, 0x80, 0xa8, 0x7b, 0xe0, 0x45,
-	0x2b, 0xbc, 0xe8, 0x41, 0xcb, 0xa2, 0x88, 0x31, 0x4d, 0x59, 0x51, 0x36, 0x1e, 0xb6, 0xb4, 0x5f,
-	0x7f, 0xde, 0x9c, 0x97, 0x61, 0x9a, 0xc1, 0x4d, 0x97, 0x53, 0xec, 0xd8, 0x9d, 0xc7, 0x91, 0x8b,
-	0xfc, 0xae, 0xb6, 0xc1, 0xe3, 0x23, 0x19, 0x36, 0x8a, 0x52, 0xfc, 0x8f, 0x28, 0x2f, 0x84, 0x1e,
-	0xf2, 0xf3, 0x4e, 0xe5, 0xe4, 0xb4, 0x56, 0xf8, 0xfb, 0xb4, 0x56, 0x58, 0xfb, 0x47, 0x01, 0xab,
-	0x9f, 0xc0, 0x11, 0xb6, 0xfc, 0x1c, 0xfb, 0x2e, 0x67, 0x1c, 0x3a, 0x96, 0xef, 0x83, 0x8e, 0x20,
-	0xb5, 0x58, 0x07, 0x99, 0x84, 0x5a, 0x3e, 0x76, 0x2f, 0x34, 0x9a, 0x1d, 0x7b, 0xe4, 0x12, 0x62,
-	0xff, 0x5a, 0x01, 0x4f, 0x48, 0x9c, 0xa3, 0x47, 0x83, 0x24, 0x5a, 0x71, 0xa5, 0xb4, 0x51, 0x6d,
-	0x2c, 0xcb, 0x36, 0x18, 0x7e, 0x9b, 0xc2, 0x8e, 0x1a, 0xbb, 0xc8, 0x6c, 0x13, 0xec, 0xb4, 0xb6,
-	0xcf, 0x2e, 0x6a, 0x85, 0x1f, 0xff, 0xa8, 0xbd, 0x66, 0x63, 0x3e, 0x74, 0xfb, 0x86, 0x49, 0xc6,
-	0xb2, 0xf2, 0xf2, 0xcf, 0x26, 0xb3, 0xbe, 0xa8, 0xf3, 0x2f, 0x27, 0x88, 0x85, 0x3e, 0xac, 0xa3,
-	0x92, 0x2b, 0x8c, 0x12, 0xdc, 0x7f, 0x57, 0xc0, 0x7a, 0xc4, 0xbd, 0x69, 0x9a, 0xee, 0xd8, 0x1d,
-	0x41, 0x8e, 0xac, 0x36, 0x19, 0x8f, 0x31, 0x63, 0x98, 0x38, 0x77, 0x4b, 0xdf, 0x04, 0x55, 0x18,
-	0x67, 0x11, 0x5d, 0xab, 0x36, 0xde, 0x31, 0x72, 0xf4, 0x6c, 0xe4, 0xc3, 0x6b, 0xcd, 0xf9, 0x45,
-	0xe9, 0x24, 0xa3, 0x26, 0xe8, 0xfd, 0xa5, 0x80, 0x95, 0xc8, 0xff, 0x43, 0xcc, 0x38, 0xa1, 0xd8,
-	0x84, 0xa3, 0x7b, 0xe9, 0xec, 0x02, 0x28, 0x4f, 0x10, 0xc5, 0x24, 0x60, 0x35, 0xd7, 0x91, 0x27,
-	0xf5, 0x10, 0x3c, 0x08, 0x9b, 0x5c, 0x12, 0x74, 0xdf, 0x9a, 0x8d, 0xee, 0x15, 0xb8, 0x92, 0x6a,
-	0x18, 0x2d, 0x41, 0xf3, 0x17, 0x05, 0xbc, 0x1c, 0xf9, 0xb5, 0x5d, 0x4a, 0x91, 0xc3, 0xef, 0x85,
-	0xe3, 0xc7, 0x31, 0x97, 0xa0, 0x75, 0x6f, 0xcc, 0xc6, 0x25, 0x8d, 0xe9, 0x7a, 0x22, 0xdf, 0x17,
-	0xc1, 0xb3, 0x68, 0x74, 0x74, 0x39, 0xa4, 0x1c, 0x3b, 0xb6, 0x3f, 0x3a, 0x62, 0x1a, 0x77, 0x31,
-	0x40, 0x32, 0xab, 0x51, 0xbc, 0x71, 0x35, 0x3e, 0x03, 0xcf, 0x33, 0x89, 0xb1, 0x87, 0x9d, 0x01,
-	0x91, 0xfd, 0x6d, 0xe4, 0xd6, 0x24, 0x93, 0x9e, 0xac, 0xc8, 0x23, 0x96, 0xf8, 0x96, 0x28, 0xcb,
-	0x49, 0x11, 0x2c, 0x46, 0xb5, 0xec, 0x8e, 0x20, 0x1b, 0xee, 0x79, 0xa2, 0x9c, 0x77, 0xac, 0xdf,
-	0x21, 0xc2, 0xf6, 0x90, 0x87, 0xfa, 0x0d, 0x4e, 0x09, 0x5d, 0x97, 0x52, 0xba, 0xfe, 0x1c, 0x3c,
-	0x8d, 0xd3, 0x32, 0x1f, 0x54, 0x0f, 0xf9, 0xa8, 0xb4, 0x39, 0x51, 0x85, 0xd7, 0x67, 0x53, 0x46,
-	0xcc, 0x46, 0xd6, 0xe0, 0x89, 0x77, 0xf5, 0x2a, 0x51, 0x8a, 0x8b, 0x0a, 0x78, 0xf4, 0x41, 0xb0,
-	0x0c, 0xbb, 0x1c, 0x72, 0xa4, 0x36, 0x41, 0x79, 0x02, 0x29, 0x1c, 0x07, 0x94, 0xab, 0x8d, 0x57,
-	0x72, 0xf3, 0x1e, 0x08, 0x53, 0x99, 0x4a, 0x3a, 0xaa, 0x7b, 0xa0, 0x32, 0x40, 0xa8, 0x37, 0x21,
-	0x64, 0x24, 0x65, 0xbd, 0x9e, 0x1b, 0xe4, 0x7d, 0x84, 0x0e, 0x08, 0x19, 0x85, 0x32, 0x1e, 0x04,
-	0x47, 0x95, 0x02, 0x2d, 0x16, 0x67, 0xb4, 0xa0, 0x7c, 0x61, 0xf8, 0xbf, 0xfc, 0xd2, 0xec, 0xca,
-	0x48, 0xee, 0x4c, 0x99, 0x64, 0xc1, 0xca, 0xba, 0x14, 0x4a, 0x9e, 0x50, 0xe4, 0x61, 0xe2, 0x8a,
-	0x55, 0x3c, 0x21, 0x0c, 0x51, 0xd1, 0x80, 0xdc, 0xde, 0x87, 0x2e, 0x07, 0xd2, 0x43, 0x75, 0xb3,
-	0x97, 0xd2, 0x73, 0x02, 0xf5, 0x7b, 0xb3, 0x75, 0xf2, 0xba, 0xcd, 0x29, 0x19, 0x64, 0xec, 0x21,
-	0xf5, 0x3b, 0x05, 0xac, 0x26, 0xa4, 0x1b, 0x8f, 0xf0, 0x9e, 0x19, 0x0d, 0x78, 0xa6, 0x95, 0x05,
-	0x8a, 0xe6, 0xff, 0x58, 0x12, 0x29, 0x20, 0x35, 0x2f, 0xd7, 0x96, 0xa9, 0xdf, 0x28, 0x60, 0x39,
-	0x46, 0x35, 0x8c, 0xc6, 0x70, 0x54, 0x96, 0x07, 0x02, 0xd0, 0xbb, 0xb7, 0x1c, 0xe3, 0x29, 0x30,
-	0x4b, 0xde, 0xb5, 0x76, 0xea, 0x57, 0x60, 0x31, 0x86, 0x61, 0x06, 0x13, 0x34, 0xc2, 0x50, 0x11,
-	0x18, 0x76, 0x6e, 0x33, 0x7e, 0x53, 0x00, 0x5e, 0xf2, 0xb2, 0x8d, 0xd4, 0xe3, 0xa4, 0x9a, 0x53,
-	0x63, 0x8e, 0x69, 0x0f, 0x45, 0xf2, 0xb7, 0x6f, 0x3e, 0xe7, 0x52, 0xa9, 0x63, 0x4d, 0x27, 0x4d,
-	0x98, 0x4a, 0xc1, 0x42, 0xe6, 0x60, 0x61, 0x1a, 0x10, 0x79, 0xdf, 0xbc, 0xe9, 0x64, 0x49, 0x65,
-	0x9d, 0xcf, 0x98, 0x2f, 0x89, 0x15, 0xd4, 0xda, 0xff, 0x61, 0xaa, 0x2b, 0x67, 0x53, 0x5d, 0x39,
-	0x9f, 0xea, 0xca, 0x9f, 0x53, 0x5d, 0xf9, 0xf6, 0x52, 0x2f, 0x9c, 0x5f, 0xea, 0x85, 0xdf, 0x2e,
-	0xf5, 0xc2, 0xa7, 0x5b, 0xb9, 0x4f, 0xaf, 0xe3, 0xf4, 0xf3, 0x59, 0xbc, 0xc4, 0xfa, 0x65, 0xf1,
-	0x2a, 0xde, 0xfe, 0x37, 0x00, 0x00, 0xff, 0xff, 0x18, 0xd7, 0x00, 0x14, 0xe0, 0x0b, 0x00, 0x00,
+	// 982 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xda, 0x21, 0x4d, 0xc7, 0xa9, 0x1a, 0xa6, 0xa9, 0xbb, 0x49, 0x8b, 0x9d, 0x96, 0x1e,
+	0x8a, 0xaa, 0xae, 0x49, 0xca, 0x3f, 0x15, 0x81, 0x94, 0xb8, 0x29, 0xf4, 0xd4, 0xc8, 0x41, 0x54,
+	0x42, 0xa0, 0xd5, 0x78, 0x77, 0x6c, 0x0f, 0xd8, 0x3b, 0xd6, 0xbc, 0x59, 0xa7, 0x48, 0x9c, 0x90,
+	0x90, 0x7a, 0x44, 0x82, 0x0f, 0xd0, 0x23, 0x42, 0xe2, 0xc6, 0x67, 0x80, 0x1e, 0x2b, 0x4e, 0x1c,
+	0x10, 0xa0, 0x84, 0x03, 0x5f, 0x81, 0x1b, 0xda, 0xd9, 0xd9, 0xd9, 0x5d, 0xb2, 0xd9, 0x3a, 0x25,
+	0x3d, 0x25, 0xbb, 0xf3, 0xde, 0xfb, 0xfd, 0x7e, 0xef, 0xbd, 0x7d, 0x6f, 0x8c, 0x5e, 0xf1, 0x38,
+	0x8c, 0x39, 0xb4, 0x7d, 0x06, 0x52, 0xb0, 0x5e, 0x28, 0x19, 0x0f, 0xda, 0xd3, 0xf5, 0x1e, 0x95,
+	0x64, 0xbd, 0x3d, 0xa0, 0x01, 0x05, 0x06, 0xce, 0x44, 0x70, 0xc9, 0xf1, 0xc5, 0xd8, 0xd4, 0xc9,
+	0x9a, 0x3a, 0xda, 0x74, 0x75, 0x79, 0xc0, 0x07, 0x5c, 0xd9, 0xb5, 0xa3, 0xff, 0x62, 0x97, 0xd5,
+	0xa6, 0x8e, 0xde, 0x23, 0x40, 0x4d, 0x54, 0x8f, 0xb3, 0x40, 0x9f, 0x3b, 0x65, 0xe8, 0x39, 0x9c,
+	0xd8, 0x7e, 0x25, 0xb6, 0x77, 0x63, 0x20, 0xcd, 0x47, 0x3d, 0x5c, 0xf9, 0xc1, 0x42, 0xe7, 0x6f,
+	0xd3, 0x11, 0x1d, 0x10, 0xc9, 0xc5, 0x7d, 0x26, 0x87, 0xbe, 0x20, 0x7b, 0x77, 0x83, 0x3e, 0xc7,
+	0xdb, 0xe8, 0x45, 0x3f, 0x39, 0x70, 0x89, 0xef, 0x0b, 0x0a, 0x60, 0x5b, 0x6b, 0xd6, 0xb5, 0xd3,
+	0x5b, 0xf6, 0x2f, 0x3f, 0xde, 0x58, 0xd6, 0x61, 0x36, 0xe3, 0x93, 0x5d, 0x29, 0x58, 0x30, 0xe8,
+	0x2e, 0x19, 0x17, 0xfd, 0x1e, 0x77, 0xd0, 0xd2, 0x9e, 0x0e, 0x6b, 0xa2, 0x54, 0x9f, 0x12, 0xe5,
+	0x6c, 0xe2, 0xa1, 0x5f, 0xdf, 0x5a, 0x78, 0xf8, 0xa8, 0x55, 0xf9, 0xfb, 0x51, 0xab, 0x72, 0xe5,
+	0x1f, 0x0b, 0x5d, 0xfe, 0x90, 0x8c, 0x98, 0x1f, 0x61, 0xdc, 0x0b, 0x25, 0x48, 0x12, 0xf8, 0x91,
+	0x0f, 0xdd, 0x23, 0xc2, 0x87, 0x2e, 0xf5, 0xb8, 0xf0, 0x23, 0xee, 0xd3, 0xc4, 0x68, 0x76, 0xee,
+	0xc6, 0x25, 0xe1, 0xfe, 0xa5, 0x85, 0xce, 0xf1, 0x14, 0xc3, 0x15, 0x31, 0x88, 0x5d, 0x5d, 0xab,
+	0x5d, 0xab, 0x6f, 0x5c, 0xd2, 0x65, 0x70, 0xa2, 0x32, 0x25, 0x15, 0x75, 0x6e, 0x53, 0xaf, 0xc3,
+	0x59, 0xb0, 0x75, 0xf3, 0xf1, 0xef, 0xad, 0xca, 0xf7, 0x7f, 0xb4, 0xae, 0x0f, 0x98, 0x1c, 0x86,
+	0x3d, 0xc7, 0xe3, 0x63, 0x9d, 0x79, 0xfd, 0xe7, 0x06, 0xf8, 0x9f, 0xb5, 0xe5, 0xe7, 0x13, 0x0a,
+	0x89, 0x0f, 0x74, 0x31, 0x3f, 0xa4, 0x28, 0xa3, 0xfd, 0x37, 0x0b, 0x5d, 0x35, 0xda, 0x37, 0x3d,
+	0x2f, 0x1c, 0x87, 0x23, 0x22, 0xa9, 0xdf, 0xe1, 0xe3, 0x31, 0x03, 0x60, 0x3c, 0x38, 0x59, 0xf9,
+	0x1e, 0xaa, 0x93, 0x14, 0x45, 0x55, 0xad, 0xbe, 0xf1, 0xb6, 0x53, 0xd2, 0xcf, 0x4e, 0x39, 0xbd,
+	0xad, 0xb9, 0x28, 0x29, 0xdd, 0x6c, 0xd4, 0x8c, 0xbc, 0xbf, 0x2c, 0xb4, 0x66, 0xfc, 0xdf, 0x67,
+	0x20, 0xb9, 0x60, 0x1e, 0x19, 0x3d, 0x97, 0xca, 0x36, 0xd0, 0xfc, 0x84, 0x0a, 0xc6, 0x63, 0x55,
+	0x73, 0x5d, 0xfd, 0x84, 0xef, 0xa3, 0x53, 0x49, 0x91, 0x6b, 0x4a, 0xee, 0x9b, 0xb3, 0xc9, 0x3d,
+	0x44, 0x57, 0x4b, 0x4d, 0xa2, 0x65, 0x64, 0xfe, 0x64, 0xa1, 0x97, 0x8c, 0x5f, 0x27, 0x14, 0x82,
+	0x06, 0xf2, 0xb9, 0x68, 0xfc, 0x20, 0xd5, 0x12, 0x97, 0xee, 0xb5, 0xd9, 0xb4, 0xe4, 0x39, 0x1d,
+	0x2d, 0xe4, 0xdb, 0x2a, 0xba, 0x68, 0x46, 0xc7, 0xae, 0x24, 0x42, 0xb2, 0x60, 0x10, 0x8d, 0x8e,
+	0x54, 0xc6, 0x49, 0x0c, 0x90, 0xc2, 0x6c, 0x54, 0x8f, 0x9d, 0x8d, 0x4f, 0xd0, 0x19, 0xd0, 0x1c,
+	0x5d, 0x16, 0xf4, 0xb9, 0xae, 0xef, 0x46, 0x69, 0x4e, 0x0a, 0xe5, 0xe9, 0x8c, 0x2c, 0x42, 0xe6,
+	0x5d, 0x26, 0x2d, 0x0f, 0xab, 0x68, 0xc5, 0xe4, 0x72, 0x77, 0x44, 0x60, 0xb8, 0x3d, 0x55, 0xe9,
+	0x3c, 0xe1, 0xfe, 0x1d, 0x52, 0x36, 0x18, 0xca, 0xa4, 0x7f, 0xe3, 0xa7, 0x4c, 0x5f, 0xd7, 0x72,
+	0x7d, 0xfd, 0x29, 0x3a, 0x9f, 0xc2, 0x42, 0x44, 0xca, 0xa5, 0x11, 0x2b, 0x7b, 0x4e, 0x65, 0xe1,
+	0xd5, 0xd9, 0x3a, 0x23, 0x55, 0xa3, 0x73, 0x70, 0x6e, 0x7a, 0xf8, 0x28, 0x93, 0x8a, 0x9f, 0x11,
+	0x5a, 0x7c, 0x2f, 0x5e, 0x86, 0xbb, 0x92, 0x48, 0x8a, 0x37, 0xd1, 0xfc, 0x84, 0x08, 0x32, 0x8e,
+	0x25, 0xd7, 0x37, 0x5e, 0x2e, 0xc5, 0xdd, 0x51, 0xa6, 0x1a, 0x4a, 0x3b, 0xe2, 0x6d, 0xb4, 0xd0,
+	0xa7, 0xd4, 0x9d, 0x70, 0x3e, 0xd2, 0x6d, 0x7d, 0xb5, 0x34, 0xc8, 0x1d, 0x4a, 0x77, 0x38, 0x1f,
+	0x25, 0x6d, 0xdc, 0x8f, 0x1f, 0xb1, 0x40, 0x76, 0xda, 0x9c, 0x66, 0x41, 0x45, 0x8d, 0x11, 0x7d,
+	0xf9, 0xb5, 0xd9, 0x3b, 0x23, 0xbb, 0x33, 0x35, 0x48, 0xc3, 0x2f, 0x3a, 0x54, 0x9d, 0x3c, 0x11,
+	0x74, 0xca, 0x78, 0xa8, 0x56, 0xf1, 0x84, 0x03, 0x15, 0xf6, 0xdc, 0xd3, 0x6a, 0x9f, 0xb8, 0xec,
+	0x68, 0x0f, 0x1c, 0x16, 0x2f, 0xa5, 0x17, 0x14, 0xeb, 0x77, 0x67, 0xab, 0xe4, 0x51, 0x9b, 0x53,
+	0x2b, 0x28, 0xd8, 0x43, 0xf8, 0x1b, 0x0b, 0x5d, 0xce, 0xb4, 0x6e, 0x3a, 0xc2, 0x5d, 0xcf, 0x0c,
+	0x78, 0xb0, 0xe7, 0x15, 0x8b, 0xcd, 0xff, 0xb1, 0x24, 0x72, 0x44, 0x5a, 0xd3, 0x52, 0x5b, 0xc0,
+	0x5f, 0x59, 0xe8, 0x52, 0xca, 0x6a, 0x68, 0xc6, 0xb0, 0x49, 0xcb, 0x29, 0x45, 0xe8, 0x9d, 0x67,
+	0x1c, 0xe3, 0x39, 0x32, 0xab, 0xd3, 0x23, 0xed, 0xf0, 0x17, 0x68, 0x25, 0xa5, 0xe1, 0xc5, 0x13,
+	0xd4, 0x70, 0x58, 0x50, 0x1c, 0x6e, 0x3d, 0xcb, 0xf8, 0xcd, 0x11, 0xb8, 0x30, 0x2d, 0x36, 0xc2,
+	0x0f, 0xb2, 0xdd, 0x9c, 0x1b, 0x73, 0x60, 0x9f, 0x56, 0xe0, 0x6f, 0x1d, 0x7f, 0xce, 0xe5, 0xa0,
+	0x1b, 0x7e, 0x91, 0x09, 0x60, 0x81, 0x1a, 0x85, 0x83, 0x05, 0x6c, 0xa4, 0x70, 0xdf, 0x38, 0xee,
+	0x64, 0xc9, 0xa1, 0x2e, 0x17, 0xcc, 0x17, 0xc0, 0x5d, 0x64, 0x93, 0x50, 0xf2, 0xa8, 0xef, 0x26,
+	0x3c, 0x0c, 0x7c, 0xd7, 0x70, 0x03, 0xbb, 0xbe, 0x56, 0x2b, 0xfd, 0x9c, 0x1a, 0x91, 0x67, 0x47,
+	0x3b, 0x1a, 0xd9, 0x80, 0x3f, 0x46, 0x4b, 0x1e, 0x0f, 0x24, 0x0b, 0xc2, 0xe8, 0xeb, 0xec, 0x87,
+	0x81, 0x0f, 0xf6, 0xa2, 0x52, 0x70, 0xbd, 0x54, 0x41, 0xc7, 0x38, 0xdd, 0x09, 0x83, 0x84, 0xf6,
+	0x59, 0x2f, 0xf7, 0x16, 0xf0, 0xeb, 0xe8, 0xc2, 0x88, 0x80, 0x74, 0xff, 0x03, 0xe1, 0x32, 0xdf,
+	0x3e, 0xa3, 0xe6, 0xf4, 0x72, 0x74, 0x9c, 0x8f, 0x75, 0x37, 0x73, 0x37, 0xda, 0xba, 0xf7, 0xdd,
+	0x7e, 0xd3, 0x7a, 0xbc, 0xdf, 0xb4, 0x9e, 0xec, 0x37, 0xad, 0x3f, 0xf7, 0x9b, 0xd6, 0xd7, 0x07,
+	0xcd, 0xca, 0x93, 0x83, 0x66, 0xe5, 0xd7, 0x83, 0x66, 0xe5, 0xa3, 0xf5, 0xd2, 0x3b, 0xe6, 0x83,
+	0xfc, 0xef, 0x04, 0x75, 0xe5, 0xec, 0xcd, 0xab, 0xeb, 0xff, 0xcd, 0x7f, 0x07, 0x00, 0x75, 0x90,
+	0x1d, 0xa4, 0xc9, 0x0c, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -780,6 +794,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastContinuousFundId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastContinuousFundId))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.ContinuousFunds) > 0 {
+		for iNdEx := len(m.ContinuousFunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContinuousFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.AutoCompoundDelegators) > 0 {
+		for iNdEx := len(m.AutoCompoundDelegators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AutoCompoundDelegators[iNdEx])
+			copy(dAtA[i:], m.AutoCompoundDelegators[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AutoCompoundDelegators[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1099,6 +1141,21 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AutoCompoundDelegators) > 0 {
+		for _, s := range m.AutoCompoundDelegators {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ContinuousFunds) > 0 {
+		for _, e := range m.ContinuousFunds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastContinuousFundId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastContinuousFundId))
+	}
 	return n
 }
 
@@ -2367,6 +2424,91 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundDelegators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundDelegators = append(m.AutoCompoundDelegators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuousFunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContinuousFunds = append(m.ContinuousFunds, ContinuousFund{})
+			if err := m.ContinuousFunds[len(m.ContinuousFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastContinuousFundId", wireType)
+			}
+			m.LastContinuousFundId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastContinuousFundId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -42,6 +42,18 @@ const (
 // - 0x07<valAddrLen (1 Byte)><valAddr_Bytes>: ValidatorCurrentCommission
 //
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height>: ValidatorSlashEvent
+//
+// - 0x09: Params
+//
+// - 0x0A<accAddrLen (1 Byte)><accAddr_Bytes>: []byte{}
+//
+// - 0x0B: AutoCompoundCursor
+//
+// - 0x0C<fundID_Bytes>: ContinuousFund
+//
+// - 0x0D: LastContinuousFundID
+//
+// - 0x0E: ContinuousFundCursor
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -53,6 +65,15 @@ var (
 	ValidatorCurrentRewardsPrefix        = []byte{0x06} // key for current validator rewards
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
+
+	ParamsKey = []byte{0x09} // key for distribution module params
+
+	AutoCompoundDelegatorPrefix = []byte{0x0A} // key for the delegators opted in to auto-compounding
+	AutoCompoundCursorKey       = []byte{0x0B} // key for the next delegator of the auto-compounding round in progress
+
+	ContinuousFundPrefix    = []byte{0x0C} // key for the continuous funds of the community pool
+	LastContinuousFundIDKey = []byte{0x0D} // key for the id of the last continuous fund
+	ContinuousFundCursorKey = []byte{0x0E} // key for the id of the next continuous fund to pay
 )
 
 // GetValidatorOutstandingRewardsAddress creates an address from a validator's outstanding rewards key.
@@ -213,3 +234,30 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 
 	return append(prefix, periodBz...)
 }
+
+// GetAutoCompoundDelegatorKey creates the key for a delegator opted in to the
+// auto-compounding of its rewards.
+func GetAutoCompoundDelegatorKey(delAddr sdk.AccAddress) []byte {
+	return append(AutoCompoundDelegatorPrefix, address.MustLengthPrefix(delAddr.Bytes())...)
+}
+
+// GetAutoCompoundDelegatorAddress creates an address from the key of a
+// delegator opted in to auto-compounding.
+func GetAutoCompoundDelegatorAddress(key []byte) (delAddr sdk.AccAddress) {
+	// key is in the format:
+	// 0x0A<accAddrLen (1 Byte)><accAddr_Bytes>
+
+	// Remove prefix and address length.
+	kv.AssertKeyAtLeastLength(key, 3)
+	addr := key[2:]
+	kv.AssertKeyLength(addr, int(key[1]))
+
+	return sdk.AccAddress(addr)
+}
+
+// GetContinuousFundKey creates the key for a continuous fund.
+func GetContinuousFundKey(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return append(ContinuousFundPrefix, bz...)
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -93,3 +94,76 @@ func TestMsgDepositIntoCommunityPool(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgSetAutoCompound(t *testing.T) {
+	tests := []struct {
+		delegatorAddr sdk.AccAddress
+		enabled       bool
+		expectPass    bool
+	}{
+		{delAddr1, true, true},
+		{delAddr1, false, true},
+		{emptyDelAddr, true, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgSetAutoCompound(tc.delegatorAddr, tc.enabled)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+var coins = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+func TestMsgCommunityPoolSpend(t *testing.T) {
+	tests := []struct {
+		authority  sdk.AccAddress
+		recipient  sdk.AccAddress
+		amount     sdk.Coins
+		expectPass bool
+	}{
+		{delAddr1, delAddr2, coins, true},
+		{emptyDelAddr, delAddr2, coins, false},
+		{delAddr1, emptyDelAddr, coins, false},
+		{delAddr1, delAddr2, sdk.NewCoins(), false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgCommunityPoolSpend(tc.authority, tc.recipient, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+func TestMsgCreateContinuousFund(t *testing.T) {
+	start := time.Unix(0, 0).UTC()
+
+	tests := []struct {
+		authority  sdk.AccAddress
+		recipient  sdk.AccAddress
+		amount     sdk.Coins
+		endTime    time.Time
+		expectPass bool
+	}{
+		{delAddr1, delAddr2, coins, start.Add(time.Hour), true},
+		{emptyDelAddr, delAddr2, coins, start.Add(time.Hour), false},
+		{delAddr1, emptyDelAddr, coins, start.Add(time.Hour), false},
+		{delAddr1, delAddr2, sdk.NewCoins(), start.Add(time.Hour), false},
+		{delAddr1, delAddr2, coins, start, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgCreateContinuousFund(tc.authority, tc.recipient, tc.amount, start, tc.endTime)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
@@ -17,6 +17,13 @@ var (
 	ParamStoreKeyWithdrawAddrEnabled = []byte("withdrawaddrenabled")
 )
 
+// Default parameter values
+const (
+	DefaultAutoCompoundInterval    uint64 = 100
+	DefaultAutoCompoundBatchSize   uint64 = 100
+	DefaultContinuousFundBatchSize uint64 = 100
+)
+
 // ParamKeyTable returns the parameter key table.
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -25,10 +32,13 @@ func ParamKeyTable() paramtypes.KeyTable {
 // DefaultParams returns default distribution parameters
 func DefaultParams() Params {
 	return Params{
-		CommunityTax:        sdk.NewDecWithPrec(2, 2), // 2%
-		BaseProposerReward:  sdk.NewDecWithPrec(1, 2), // 1%
-		BonusProposerReward: sdk.NewDecWithPrec(4, 2), // 4%
-		WithdrawAddrEnabled: true,
+		CommunityTax:            sdk.NewDecWithPrec(2, 2), // 2%
+		BaseProposerReward:      sdk.NewDecWithPrec(1, 2), // 1%
+		BonusProposerReward:     sdk.NewDecWithPrec(4, 2), // 4%
+		WithdrawAddrEnabled:     true,
+		AutoCompoundInterval:    DefaultAutoCompoundInterval,
+		AutoCompoundBatchSize:   DefaultAutoCompoundBatchSize,
+		ContinuousFundBatchSize: DefaultContinuousFundBatchSize,
 	}
 }
 
@@ -38,6 +48,10 @@ func (p Params) String() string {
 }
 
 // ParamSetPairs returns the parameter set pairs.
+//
+// NOTE: The auto-compounding, continuous fund and community pool spenders params
+// were introduced after the migration of the params to the x/distribution module
+// store and are not part of the legacy param set.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(ParamStoreKeyCommunityTax, &p.CommunityTax, validateCommunityTax),
@@ -70,6 +84,16 @@ func (p Params) ValidateBasic() error {
 		)
 	}
 
+	seen := make(map[string]bool, len(p.CommunityPoolSpenders))
+	for _, spender := range p.CommunityPoolSpenders {
+		if _, err := sdk.AccAddressFromBech32(spender); err != nil {
+			return fmt.Errorf("invalid community pool spender %s: %w", spender, err)
+		}
+		if seen[spender] {
+			return fmt.Errorf("duplicate community pool spender %s", spender)
+		}
+		seen[spender] = true
+	}
 	return nil
 }
 
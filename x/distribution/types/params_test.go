@@ -44,6 +44,20 @@ func TestParams_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestParamsCommunityPoolSpenders(t *testing.T) {
+	spender := sdk.AccAddress("spender").String()
+
+	params := types.DefaultParams()
+	params.CommunityPoolSpenders = []string{spender}
+	require.NoError(t, params.ValidateBasic())
+
+	params.CommunityPoolSpenders = []string{spender, spender}
+	require.Error(t, params.ValidateBasic())
+
+	params.CommunityPoolSpenders = []string{"invalid"}
+	require.Error(t, params.ValidateBasic())
+}
+
 func TestDefaultParams(t *testing.T) {
 	require.NoError(t, types.DefaultParams().ValidateBasic())
 }
@@ -0,0 +1,89 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// maxNestedMsgDepth is the maximum depth of the nested messages checked by the
+// StakingMsgFilterDecorator, deeper messages are rejected.
+const maxNestedMsgDepth = 6
+
+// nestedMsgs is implemented by the messages carrying messages executed later,
+// i.e. the gov v1 and group proposals.
+type nestedMsgs interface {
+	GetMsgs() ([]sdk.Msg, error)
+}
+
+// execMsgs is implemented by the messages executing messages on behalf of
+// their signers, i.e. the authz MsgExec.
+type execMsgs interface {
+	GetMessages() ([]sdk.Msg, error)
+}
+
+// EpochingKeeper defines the expected epoching keeper.
+type EpochingKeeper interface {
+	GetParams(ctx sdk.Context) types.Params
+}
+
+// StakingMsgFilterDecorator rejects the staking messages changing the voting
+// power, MsgDelegate, MsgUndelegate, MsgBeginRedelegate and MsgCreateValidator,
+// if the RestrictStakingMsgs param is set. They must then be wrapped in the
+// x/epoching messages to be queued until the end of the epoch. The messages
+// nested in authz MsgExec and in gov and group proposals are checked too.
+// Genesis transactions are not checked, so that the genesis validators can be
+// created.
+type StakingMsgFilterDecorator struct {
+	ek EpochingKeeper
+}
+
+// NewStakingMsgFilterDecorator creates a new StakingMsgFilterDecorator.
+func NewStakingMsgFilterDecorator(ek EpochingKeeper) StakingMsgFilterDecorator {
+	return StakingMsgFilterDecorator{ek: ek}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (sfd StakingMsgFilterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if ctx.BlockHeight() == 0 || !sfd.ek.GetParams(ctx).RestrictStakingMsgs {
+		return next(ctx, tx, simulate)
+	}
+
+	if err := checkMsgs(tx.GetMsgs(), 0); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func checkMsgs(msgs []sdk.Msg, depth int) error {
+	if depth > maxNestedMsgDepth {
+		return types.ErrUnwrappedStakingMsg.Wrapf("messages nested more than %d levels deep", maxNestedMsgDepth)
+	}
+
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *stakingtypes.MsgDelegate, *stakingtypes.MsgUndelegate,
+			*stakingtypes.MsgBeginRedelegate, *stakingtypes.MsgCreateValidator:
+			return types.ErrUnwrappedStakingMsg.Wrapf("%s must be wrapped in a x/epoching message", sdk.MsgTypeURL(msg))
+		case execMsgs:
+			nested, err := msg.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := checkMsgs(nested, depth+1); err != nil {
+				return err
+			}
+		case nestedMsgs:
+			nested, err := msg.GetMsgs()
+			if err != nil {
+				return err
+			}
+			if err := checkMsgs(nested, depth+1); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
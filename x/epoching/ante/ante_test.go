@@ -0,0 +1,83 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/epoching/ante"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestStakingMsgFilterDecorator(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 5})
+	txConfig := simapp.MakeTestEncodingConfig().TxConfig
+
+	addr := sdk.AccAddress("addr________________")
+	valAddr := sdk.ValAddress("val_________________")
+	coin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	delegate := stakingtypes.NewMsgDelegate(addr, valAddr, coin)
+	undelegate := stakingtypes.NewMsgUndelegate(addr, valAddr, coin)
+	redelegate := stakingtypes.NewMsgBeginRedelegate(addr, valAddr, valAddr, coin)
+
+	exec := authz.NewMsgExec(addr, []sdk.Msg{undelegate})
+	govProposal, err := govv1.NewMsgSubmitProposal([]sdk.Msg{redelegate}, nil, addr.String(), "")
+	require.NoError(t, err)
+	groupProposal, err := group.NewMsgSubmitProposal(addr.String(), []string{addr.String()}, []sdk.Msg{delegate}, "", group.Exec_EXEC_UNSPECIFIED)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		msgs     []sdk.Msg
+		height   int64
+		restrict bool
+		expErr   bool
+	}{
+		{"unrestricted", []sdk.Msg{delegate}, 5, false, false},
+		{"delegate", []sdk.Msg{delegate}, 5, true, true},
+		{"undelegate", []sdk.Msg{undelegate}, 5, true, true},
+		{"redelegate", []sdk.Msg{redelegate}, 5, true, true},
+		{"wrapped delegate", []sdk.Msg{types.NewMsgWrappedDelegate(delegate)}, 5, true, false},
+		{"edit validator", []sdk.Msg{stakingtypes.NewMsgEditValidator(valAddr, stakingtypes.Description{}, nil, nil)}, 5, true, false},
+		{"authz exec", []sdk.Msg{&exec}, 5, true, true},
+		{"gov proposal", []sdk.Msg{govProposal}, 5, true, true},
+		{"group proposal", []sdk.Msg{groupProposal}, 5, true, true},
+		{"genesis", []sdk.Msg{delegate}, 0, true, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			params := app.EpochingKeeper.GetParams(ctx)
+			params.RestrictStakingMsgs = tc.restrict
+			app.EpochingKeeper.SetParams(ctx, params)
+
+			txBuilder := txConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(tc.msgs...))
+
+			called := false
+			next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+				called = true
+				return ctx, nil
+			}
+
+			decorator := ante.NewStakingMsgFilterDecorator(app.EpochingKeeper)
+			_, err := decorator.AnteHandle(ctx.WithBlockHeight(tc.height), txBuilder.GetTx(), false, next)
+			if tc.expErr {
+				require.ErrorIs(t, err, types.ErrUnwrappedStakingMsg)
+				require.False(t, called)
+			} else {
+				require.NoError(t, err)
+				require.True(t, called)
+			}
+		})
+	}
+}
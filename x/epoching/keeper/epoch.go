@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// EndEpoch executes the queued messages in order, at most MaxMsgsPerEpoch of
+// them, dequeues them and starts the next epoch. The other queued messages are
+// carried over to the next epoch. The escrowed coins of each message are
+// returned to their owner before its execution, so that they are kept by the
+// owner if the execution fails.
+func (k Keeper) EndEpoch(ctx sdk.Context) {
+	epochNumber := k.GetEpochNumber(ctx)
+	params := k.GetParams(ctx)
+
+	for _, qm := range k.DequeueEpochActions(ctx, params.MaxMsgsPerEpoch) {
+		msg, err := qm.GetMsg()
+		if err == nil {
+			err = k.executeQueuedMsg(ctx, msg, params.MaxGasPerMsg)
+		}
+
+		event := &types.EventMessageExecuted{Id: qm.Id, MsgTypeUrl: qm.Msg.TypeUrl}
+		if err != nil {
+			k.Logger(ctx).Info("queued message execution failed", "id", qm.Id, "err", err)
+			event.Error = err.Error()
+		}
+		if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+			panic(err)
+		}
+	}
+
+	k.IncreaseEpochNumber(ctx)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventEpochEnd{EpochNumber: epochNumber}); err != nil {
+		panic(err)
+	}
+}
+
+// executeQueuedMsg returns the escrowed coins of msg to their owner and executes
+// msg in a cached context, with a gas meter limited to maxGas. The state changes
+// of the execution are discarded if it fails.
+func (k Keeper) executeQueuedMsg(ctx sdk.Context, msg sdk.Msg, maxGas uint64) error {
+	owner, escrowed, err := types.EscrowedCoins(msg)
+	if err != nil {
+		return err
+	}
+	if !escrowed.IsZero() {
+		if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.ModuleName, owner, escrowed); err != nil {
+			return sdkerrors.Wrap(err, "failed to return the escrowed coins")
+		}
+	}
+
+	handler := k.router.Handler(msg)
+	if handler == nil {
+		return sdkerrors.ErrUnknownRequest.Wrapf("unrecognized message route: %s", sdk.MsgTypeURL(msg))
+	}
+
+	cacheCtx, writeCache := ctx.WithGasMeter(sdk.NewGasMeter(maxGas)).CacheContext()
+	if err := runQueuedMsg(cacheCtx, handler, msg); err != nil {
+		return err
+	}
+
+	writeCache()
+	return nil
+}
+
+// runQueuedMsg runs the handler of a queued message, recovering from the out of
+// gas and other panics so that they do not halt the chain.
+func runQueuedMsg(ctx sdk.Context, handler baseapp.MsgServiceHandler, msg sdk.Msg) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if oog, ok := r.(sdk.ErrorOutOfGas); ok {
+				err = sdkerrors.ErrOutOfGas.Wrapf("out of gas in location: %v; gasUsed: %d", oog.Descriptor, ctx.GasMeter().GasConsumed())
+				return
+			}
+			err = sdkerrors.ErrPanic.Wrap(fmt.Sprint(r))
+		}
+	}()
+
+	_, err = handler(ctx, msg)
+	return err
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// InitGenesis initializes the epoching module's state from a given genesis
+// state. The queued messages are restored in the current epoch.
+func (k Keeper) InitGenesis(ctx sdk.Context, ak types.AccountKeeper, data *types.GenesisState) {
+	k.SetParams(ctx, data.Params)
+	k.SetEpochNumber(ctx, data.EpochNumber)
+	for _, qm := range data.QueuedMessages {
+		k.RestoreEpochAction(ctx, data.EpochNumber, qm)
+	}
+
+	// ensure the escrow module account is set
+	ak.GetModuleAccount(ctx, types.ModuleName)
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx), k.GetEpochNumber(ctx), k.GetEpochActions(ctx))
+}
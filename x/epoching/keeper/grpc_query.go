@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns params of the epoching module.
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// CurrentEpoch returns the number and end height of the current epoch.
+func (k Keeper) CurrentEpoch(c context.Context, _ *types.QueryCurrentEpochRequest) (*types.QueryCurrentEpochResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryCurrentEpochResponse{
+		EpochNumber: k.GetEpochNumber(ctx),
+		EndHeight:   k.GetEpochEndHeight(ctx),
+	}, nil
+}
+
+// QueuedMessages returns the messages queued for execution at the end of the
+// current epoch.
+func (k Keeper) QueuedMessages(c context.Context, req *types.QueryQueuedMessagesRequest) (*types.QueryQueuedMessagesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EpochActionQueuePrefix)
+
+	var queuedMessages []types.QueuedMessage
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var qm types.QueuedMessage
+		if err := k.cdc.Unmarshal(value, &qm); err != nil {
+			return err
+		}
+
+		queuedMessages = append(queuedMessages, qm)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryQueuedMessagesResponse{QueuedMessages: queuedMessages, Pagination: pageRes}, nil
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// RegisterInvariants registers all epoching invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper, ak types.AccountKeeper) {
+	ir.RegisterRoute(types.ModuleName, "escrow", EscrowInvariant(k, ak))
+}
+
+// EscrowInvariant checks that the epoching module account holds exactly the
+// coins escrowed by the queued messages.
+func EscrowInvariant(k Keeper, ak types.AccountKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := sdk.NewCoins()
+		k.IterateEpochActions(ctx, func(qm types.QueuedMessage) bool {
+			msg, err := qm.GetMsg()
+			if err != nil {
+				return false
+			}
+			if _, escrowed, err := types.EscrowedCoins(msg); err == nil {
+				expected = expected.Add(escrowed...)
+			}
+			return false
+		})
+
+		balance := k.bankKeeper.GetAllBalances(ctx, ak.GetModuleAddress(types.ModuleName))
+		broken := !balance.IsEqual(expected)
+
+		return sdk.FormatInvariant(types.ModuleName, "escrow",
+			fmt.Sprintf("\tescrow module account balance: %s\n\texpected escrowed coins: %s\n", balance, expected)), broken
+	}
+}
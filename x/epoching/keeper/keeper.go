@@ -1,171 +1,211 @@
 package keeper
 
 import (
-	"time"
+	"fmt"
 
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
-	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	db "github.com/tendermint/tm-db"
-)
-
-const (
-	DefaultEpochActionID = 1
-	DefaultEpochNumber   = 0
-)
-
-var (
-	NextEpochActionID      = []byte{0x11}
-	EpochNumberID          = []byte{0x12}
-	EpochActionQueuePrefix = []byte{0x13} // prefix for the epoch
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
 )
 
 // Keeper of the store
 type Keeper struct {
 	storeKey storetypes.StoreKey
 	cdc      codec.BinaryCodec
-	// Used to calculate the estimated next epoch time.
-	// This is local to every node
-	// TODO: remove in favor of consensus param when its added
-	commitTimeout time.Duration
+	// router executes the queued messages at the end of the epochs.
+	router        baseapp.IMsgServiceRouter
+	bankKeeper    types.BankKeeper
+	stakingKeeper types.StakingKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
 }
 
 // NewKeeper creates a epoch queue manager
-func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, commitTimeout time.Duration) Keeper {
+func NewKeeper(
+	cdc codec.BinaryCodec, key storetypes.StoreKey, router baseapp.IMsgServiceRouter,
+	ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, authority string,
+) Keeper {
+	// ensure the escrow module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic("the epoching module account has not been set")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Errorf("invalid epoching authority address: %w", err))
+	}
+
 	return Keeper{
 		storeKey:      key,
 		cdc:           cdc,
-		commitTimeout: commitTimeout,
+		router:        router,
+		bankKeeper:    bk,
+		stakingKeeper: sk,
+		authority:     authority,
 	}
 }
 
+// GetAuthority returns the x/epoching module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetParams returns the epoching parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the epoching parameters.
+// CONTRACT: This method performs no validation of the parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&params)
+	store.Set(types.ParamsKey, bz)
+}
+
 // GetNewActionID returns ID to be used for next epoch
 func (k Keeper) GetNewActionID(ctx sdk.Context) uint64 {
 	store := ctx.KVStore(k.storeKey)
 
-	bz := store.Get(NextEpochActionID)
-	if bz == nil {
-		// return default action ID to 1
-		return DefaultEpochActionID
+	id := uint64(types.DefaultEpochActionID)
+	if bz := store.Get(types.NextEpochActionID); bz != nil {
+		id = sdk.BigEndianToUint64(bz)
 	}
-	id := sdk.BigEndianToUint64(bz)
 
 	// increment next action ID
-	store.Set(NextEpochActionID, sdk.Uint64ToBigEndian(id+1))
+	k.setNextActionID(ctx, id+1)
 
 	return id
 }
 
-// ActionStoreKey returns action store key from ID
-func ActionStoreKey(epochNumber int64, actionID uint64) []byte {
-	return append(EpochActionQueuePrefix, byte(epochNumber), byte(actionID))
+func (k Keeper) setNextActionID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextEpochActionID, sdk.Uint64ToBigEndian(id))
 }
 
 // QueueMsgForEpoch save the actions that need to be executed on next epoch
-func (k Keeper) QueueMsgForEpoch(ctx sdk.Context, epochNumber int64, msg sdk.Msg) {
-	store := ctx.KVStore(k.storeKey)
-
-	bz, err := k.cdc.MarshalInterface(msg)
+func (k Keeper) QueueMsgForEpoch(ctx sdk.Context, epochNumber int64, msg sdk.Msg) (uint64, error) {
+	actionID := k.GetNewActionID(ctx)
+	qm, err := types.NewQueuedMessage(actionID, msg, ctx.BlockHeight(), ctx.BlockTime())
 	if err != nil {
-		panic(err)
+		return 0, err
 	}
 
-	actionID := k.GetNewActionID(ctx)
-	store.Set(ActionStoreKey(epochNumber, actionID), bz)
+	k.setEpochAction(ctx, epochNumber, qm)
+	return actionID, nil
 }
 
 // RestoreEpochAction restore the actions that need to be executed on next epoch
-func (k Keeper) RestoreEpochAction(ctx sdk.Context, epochNumber int64, action *codectypes.Any) {
-	store := ctx.KVStore(k.storeKey)
+func (k Keeper) RestoreEpochAction(ctx sdk.Context, epochNumber int64, qm types.QueuedMessage) {
+	k.setEpochAction(ctx, epochNumber, qm)
 
-	// reference from TestMarshalAny(t *testing.T)
-	bz, err := k.cdc.MarshalInterface(action)
-	if err != nil {
-		panic(err)
+	store := ctx.KVStore(k.storeKey)
+	if bz := store.Get(types.NextEpochActionID); bz == nil || sdk.BigEndianToUint64(bz) <= qm.Id {
+		k.setNextActionID(ctx, qm.Id+1)
 	}
+}
 
-	actionID := k.GetNewActionID(ctx)
-	store.Set(ActionStoreKey(epochNumber, actionID), bz)
+func (k Keeper) setEpochAction(ctx sdk.Context, epochNumber int64, qm types.QueuedMessage) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ActionStoreKey(epochNumber, qm.Id), k.cdc.MustMarshal(&qm))
 }
 
-// GetEpochMsg gets a msg by ID
-func (k Keeper) GetEpochMsg(ctx sdk.Context, epochNumber int64, actionID uint64) sdk.Msg {
+// GetEpochAction gets a queued message by ID
+func (k Keeper) GetEpochAction(ctx sdk.Context, epochNumber int64, actionID uint64) (types.QueuedMessage, bool) {
 	store := ctx.KVStore(k.storeKey)
 
-	bz := store.Get(ActionStoreKey(epochNumber, actionID))
+	bz := store.Get(types.ActionStoreKey(epochNumber, actionID))
 	if bz == nil {
-		return nil
+		return types.QueuedMessage{}, false
 	}
 
-	var action sdk.Msg
-	k.cdc.UnmarshalInterface(bz, &action)
+	var qm types.QueuedMessage
+	k.cdc.MustUnmarshal(bz, &qm)
 
-	return action
+	return qm, true
 }
 
-// GetEpochActions get all actions
-func (k Keeper) GetEpochActions(ctx sdk.Context) []sdk.Msg {
-	actions := []sdk.Msg{}
-	iterator := k.GetEpochActionsIterator(ctx)
+// IterateEpochActions iterates over all the queued messages in order, until
+// cb returns true.
+func (k Keeper) IterateEpochActions(ctx sdk.Context, cb func(qm types.QueuedMessage) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.EpochActionQueuePrefix)
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
-		var action sdk.Msg
-		bz := iterator.Value()
-		k.cdc.UnmarshalInterface(bz, &action)
-		actions = append(actions, action)
+		var qm types.QueuedMessage
+		k.cdc.MustUnmarshal(iterator.Value(), &qm)
+		if cb(qm) {
+			break
+		}
 	}
-
-	return actions
 }
 
-// GetEpochActionsIterator returns iterator for EpochActions
-func (k Keeper) GetEpochActionsIterator(ctx sdk.Context) db.Iterator {
-	return sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), EpochActionQueuePrefix)
+// GetEpochActions get all actions
+func (k Keeper) GetEpochActions(ctx sdk.Context) []types.QueuedMessage {
+	actions := []types.QueuedMessage{}
+	k.IterateEpochActions(ctx, func(qm types.QueuedMessage) bool {
+		actions = append(actions, qm)
+		return false
+	})
+
+	return actions
 }
 
-// DequeueEpochActions dequeue all the actions store on epoch
-func (k Keeper) DequeueEpochActions(ctx sdk.Context) {
+// DequeueEpochActions dequeues and returns the first queued messages in order,
+// at most limit of them.
+func (k Keeper) DequeueEpochActions(ctx sdk.Context, limit uint32) []types.QueuedMessage {
 	store := ctx.KVStore(k.storeKey)
-	iterator := sdk.KVStorePrefixIterator(store, EpochActionQueuePrefix)
-	defer iterator.Close()
+	iterator := sdk.KVStorePrefixIterator(store, types.EpochActionQueuePrefix)
+
+	var (
+		keys    [][]byte
+		actions []types.QueuedMessage
+	)
+	for ; iterator.Valid() && uint32(len(actions)) < limit; iterator.Next() {
+		var qm types.QueuedMessage
+		k.cdc.MustUnmarshal(iterator.Value(), &qm)
+		keys = append(keys, iterator.Key())
+		actions = append(actions, qm)
+	}
+	iterator.Close()
 
-	for ; iterator.Valid(); iterator.Next() {
-		key := iterator.Key()
+	for _, key := range keys {
 		store.Delete(key)
 	}
-}
-
-// DeleteByKey delete item by key
-func (k Keeper) DeleteByKey(ctx sdk.Context, key []byte) {
-	store := ctx.KVStore(k.storeKey)
-	store.Delete(key)
-}
 
-// GetEpochActionByIterator get action by iterator
-func (k Keeper) GetEpochActionByIterator(iterator db.Iterator) sdk.Msg {
-	bz := iterator.Value()
-
-	var action sdk.Msg
-	k.cdc.UnmarshalInterface(bz, &action)
-
-	return action
+	return actions
 }
 
 // SetEpochNumber set epoch number
 func (k Keeper) SetEpochNumber(ctx sdk.Context, epochNumber int64) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(EpochNumberID, sdk.Uint64ToBigEndian(uint64(epochNumber)))
+	store.Set(types.EpochNumberID, sdk.Uint64ToBigEndian(uint64(epochNumber)))
 }
 
 // GetEpochNumber fetches epoch number
 func (k Keeper) GetEpochNumber(ctx sdk.Context) int64 {
 	store := ctx.KVStore(k.storeKey)
 
-	bz := store.Get(EpochNumberID)
+	bz := store.Get(types.EpochNumberID)
 	if bz == nil {
-		return DefaultEpochNumber
+		return types.DefaultEpochNumber
 	}
 
 	return int64(sdk.BigEndianToUint64(bz))
@@ -177,16 +217,17 @@ func (k Keeper) IncreaseEpochNumber(ctx sdk.Context) {
 	k.SetEpochNumber(ctx, epochNumber+1)
 }
 
-// GetNextEpochHeight returns next epoch block height
-func (k Keeper) GetNextEpochHeight(ctx sdk.Context, epochInterval int64) int64 {
+// GetEpochEndHeight returns the height of the last block of the current epoch.
+func (k Keeper) GetEpochEndHeight(ctx sdk.Context) int64 {
+	epochLength := k.GetParams(ctx).EpochLength
 	currentHeight := ctx.BlockHeight()
-	return currentHeight + (epochInterval - currentHeight%epochInterval)
+	if currentHeight%epochLength == 0 {
+		return currentHeight
+	}
+	return currentHeight + (epochLength - currentHeight%epochLength)
 }
 
-// GetNextEpochTime returns estimated next epoch time
-func (k Keeper) GetNextEpochTime(ctx sdk.Context, epochInterval int64) time.Time {
-	currentTime := ctx.BlockTime()
-	currentHeight := ctx.BlockHeight()
-
-	return currentTime.Add(k.commitTimeout * time.Duration(k.GetNextEpochHeight(ctx, epochInterval)-currentHeight))
+// IsEpochEnd returns true if the current block is the last block of an epoch.
+func (k Keeper) IsEpochEnd(ctx sdk.Context) bool {
+	return k.GetEpochEndHeight(ctx) == ctx.BlockHeight()
 }
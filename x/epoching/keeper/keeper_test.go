@@ -0,0 +1,302 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+	"github.com/cosmos/cosmos-sdk/x/epoching/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	msgServer   types.MsgServer
+	queryClient types.QueryClient
+	addrs       []sdk.AccAddress
+	valAddr     sdk.ValAddress
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(suite.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.EpochingKeeper)
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.msgServer = keeper.NewMsgServerImpl(app.EpochingKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+	suite.addrs = simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000))
+	suite.valAddr = app.StakingKeeper.GetAllValidators(ctx)[0].GetOperator()
+}
+
+func (suite *KeeperTestSuite) bondCoin(amt int64) sdk.Coin {
+	return sdk.NewInt64Coin(suite.app.StakingKeeper.BondDenom(suite.ctx), amt)
+}
+
+func (suite *KeeperTestSuite) escrowBalance() sdk.Coins {
+	escrow := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	return suite.app.BankKeeper.GetAllBalances(suite.ctx, escrow)
+}
+
+func (suite *KeeperTestSuite) TestWrappedDelegate() {
+	app, ctx, delAddr := suite.app, suite.ctx, suite.addrs[0]
+
+	msg := stakingtypes.NewMsgDelegate(delAddr, suite.valAddr, suite.bondCoin(1000))
+	res, err := suite.msgServer.WrappedDelegate(sdk.WrapSDKContext(ctx), types.NewMsgWrappedDelegate(msg))
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(types.DefaultEpochActionID), res.Id)
+
+	// the delegated coins are escrowed until the end of the epoch
+	suite.Require().Equal(sdk.NewCoins(suite.bondCoin(1000)), suite.escrowBalance())
+	suite.Require().Equal(sdk.NewCoins(suite.bondCoin(9000)), app.BankKeeper.GetAllBalances(ctx, delAddr))
+	_, found := app.StakingKeeper.GetDelegation(ctx, delAddr, suite.valAddr)
+	suite.Require().False(found)
+
+	qm, found := app.EpochingKeeper.GetEpochAction(ctx, types.DefaultEpochNumber, res.Id)
+	suite.Require().True(found)
+	queued, err := qm.GetMsg()
+	suite.Require().NoError(err)
+	suite.Require().Equal(msg, queued)
+
+	// nothing happens before the end of the epoch
+	epoching.EndBlocker(ctx.WithBlockHeight(5), app.EpochingKeeper)
+	suite.Require().Len(app.EpochingKeeper.GetEpochActions(ctx), 1)
+
+	epoching.EndBlocker(ctx.WithBlockHeight(types.DefaultEpochLength), app.EpochingKeeper)
+	suite.Require().Empty(app.EpochingKeeper.GetEpochActions(ctx))
+	suite.Require().Equal(int64(types.DefaultEpochNumber+1), app.EpochingKeeper.GetEpochNumber(ctx))
+	suite.Require().True(suite.escrowBalance().IsZero())
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddr, suite.valAddr)
+	suite.Require().True(found)
+	validator, _ := app.StakingKeeper.GetValidator(ctx, suite.valAddr)
+	suite.Require().Equal(sdk.NewInt(1000), validator.TokensFromShares(delegation.Shares).TruncateInt())
+}
+
+func (suite *KeeperTestSuite) TestWrappedDelegateFailure() {
+	app, ctx, delAddr := suite.app, suite.ctx, suite.addrs[0]
+
+	// require the delegation to be validated when queued
+	msg := stakingtypes.NewMsgDelegate(delAddr, suite.valAddr, sdk.NewInt64Coin("other", 1000))
+	_, err := suite.msgServer.WrappedDelegate(sdk.WrapSDKContext(ctx), types.NewMsgWrappedDelegate(msg))
+	suite.Require().Error(err)
+
+	msg = stakingtypes.NewMsgDelegate(delAddr, sdk.ValAddress(delAddr), suite.bondCoin(1000))
+	_, err = suite.msgServer.WrappedDelegate(sdk.WrapSDKContext(ctx), types.NewMsgWrappedDelegate(msg))
+	suite.Require().ErrorIs(err, stakingtypes.ErrNoValidatorFound)
+
+	balance := app.BankKeeper.GetAllBalances(ctx, delAddr)
+	msg = stakingtypes.NewMsgDelegate(delAddr, suite.valAddr, suite.bondCoin(1000))
+	_, err = suite.msgServer.WrappedDelegate(sdk.WrapSDKContext(ctx), types.NewMsgWrappedDelegate(msg))
+	suite.Require().NoError(err)
+
+	// change the bond denom so that the queued delegation fails
+	params := app.StakingKeeper.GetParams(ctx)
+	params.BondDenom = "other"
+	app.StakingKeeper.SetParams(ctx, params)
+
+	epoching.EndBlocker(ctx.WithBlockHeight(types.DefaultEpochLength), app.EpochingKeeper)
+	suite.Require().Empty(app.EpochingKeeper.GetEpochActions(ctx))
+
+	// the escrowed coins are returned to the delegator
+	suite.Require().True(suite.escrowBalance().IsZero())
+	suite.Require().Equal(balance, app.BankKeeper.GetAllBalances(ctx, delAddr))
+	_, found := app.StakingKeeper.GetDelegation(ctx, delAddr, suite.valAddr)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestWrappedDelegateVestingAccount() {
+	app, ctx := suite.app, suite.ctx
+
+	// a vesting account whose coins are all locked
+	vestingAddr := sdk.AccAddress("vesting_____________")
+	vestingCoins := sdk.NewCoins(suite.bondCoin(1000))
+	baseAcc := authtypes.NewBaseAccountWithAddress(vestingAddr)
+	vestingAcc := vestingtypes.NewContinuousVestingAccount(baseAcc, vestingCoins, ctx.BlockTime().Unix(), ctx.BlockTime().Add(time.Hour).Unix())
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccount(ctx, vestingAcc))
+	suite.Require().NoError(banktestutil.FundAccount(app.BankKeeper, ctx, vestingAddr, vestingCoins))
+	suite.Require().True(app.BankKeeper.SpendableCoins(ctx, vestingAddr).IsZero())
+
+	// the locked coins are escrowed as a delegation
+	msg := stakingtypes.NewMsgDelegate(vestingAddr, suite.valAddr, suite.bondCoin(1000))
+	_, err := suite.msgServer.WrappedDelegate(sdk.WrapSDKContext(ctx), types.NewMsgWrappedDelegate(msg))
+	suite.Require().NoError(err)
+	suite.Require().Equal(vestingCoins, suite.escrowBalance())
+
+	epoching.EndBlocker(ctx.WithBlockHeight(types.DefaultEpochLength), app.EpochingKeeper)
+	suite.Require().True(suite.escrowBalance().IsZero())
+
+	_, found := app.StakingKeeper.GetDelegation(ctx, vestingAddr, suite.valAddr)
+	suite.Require().True(found)
+	acc := app.AccountKeeper.GetAccount(ctx, vestingAddr).(*vestingtypes.ContinuousVestingAccount)
+	suite.Require().Equal(vestingCoins, acc.GetDelegatedVesting())
+}
+
+func (suite *KeeperTestSuite) TestEndEpochLimits() {
+	app, ctx := suite.app, suite.ctx
+
+	params := app.EpochingKeeper.GetParams(ctx)
+	params.MaxMsgsPerEpoch = 1
+	app.EpochingKeeper.SetParams(ctx, params)
+
+	for _, addr := range suite.addrs {
+		msg := stakingtypes.NewMsgDelegate(addr, suite.valAddr, suite.bondCoin(100))
+		_, err := suite.msgServer.WrappedDelegate(sdk.WrapSDKContext(ctx), types.NewMsgWrappedDelegate(msg))
+		suite.Require().NoError(err)
+	}
+
+	// the messages over the limit are carried over to the next epoch
+	epoching.EndBlocker(ctx.WithBlockHeight(types.DefaultEpochLength), app.EpochingKeeper)
+	suite.Require().Len(app.EpochingKeeper.GetEpochActions(ctx), 1)
+	_, found := app.StakingKeeper.GetDelegation(ctx, suite.addrs[0], suite.valAddr)
+	suite.Require().True(found)
+	_, found = app.StakingKeeper.GetDelegation(ctx, suite.addrs[1], suite.valAddr)
+	suite.Require().False(found)
+
+	epoching.EndBlocker(ctx.WithBlockHeight(2*types.DefaultEpochLength), app.EpochingKeeper)
+	suite.Require().Empty(app.EpochingKeeper.GetEpochActions(ctx))
+	_, found = app.StakingKeeper.GetDelegation(ctx, suite.addrs[1], suite.valAddr)
+	suite.Require().True(found)
+
+	// the messages running out of gas fail without halting the chain, and the
+	// escrowed coins are returned
+	params.MaxGasPerMsg = 1000
+	app.EpochingKeeper.SetParams(ctx, params)
+
+	balance := app.BankKeeper.GetAllBalances(ctx, suite.addrs[0])
+	msg := stakingtypes.NewMsgDelegate(suite.addrs[0], suite.valAddr, suite.bondCoin(100))
+	_, err := suite.msgServer.WrappedDelegate(sdk.WrapSDKContext(ctx), types.NewMsgWrappedDelegate(msg))
+	suite.Require().NoError(err)
+
+	delegation, _ := app.StakingKeeper.GetDelegation(ctx, suite.addrs[0], suite.valAddr)
+	suite.Require().NotPanics(func() {
+		epoching.EndBlocker(ctx.WithBlockHeight(3*types.DefaultEpochLength), app.EpochingKeeper)
+	})
+	suite.Require().Empty(app.EpochingKeeper.GetEpochActions(ctx))
+	suite.Require().True(suite.escrowBalance().IsZero())
+	suite.Require().Equal(balance, app.BankKeeper.GetAllBalances(ctx, suite.addrs[0]))
+	unchanged, _ := app.StakingKeeper.GetDelegation(ctx, suite.addrs[0], suite.valAddr)
+	suite.Require().Equal(delegation, unchanged)
+}
+
+func (suite *KeeperTestSuite) TestWrappedUndelegate() {
+	app, ctx, delAddr := suite.app, suite.ctx, suite.addrs[0]
+
+	// require a delegation to exist
+	msg := stakingtypes.NewMsgUndelegate(delAddr, suite.valAddr, suite.bondCoin(100))
+	_, err := suite.msgServer.WrappedUndelegate(sdk.WrapSDKContext(ctx), types.NewMsgWrappedUndelegate(msg))
+	suite.Require().ErrorIs(err, stakingtypes.ErrNoDelegation)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, suite.valAddr)
+	suite.Require().True(found)
+	_, err = app.StakingKeeper.Delegate(ctx, delAddr, sdk.NewInt(1000), stakingtypes.Unbonded, validator, true)
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.WrappedUndelegate(sdk.WrapSDKContext(ctx), types.NewMsgWrappedUndelegate(msg))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.escrowBalance().IsZero())
+
+	epoching.EndBlocker(ctx.WithBlockHeight(types.DefaultEpochLength), app.EpochingKeeper)
+
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, suite.valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(100), ubd.Entries[0].Balance)
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	app, ctx := suite.app, suite.ctx
+
+	params := types.NewParams(20, true, 10, 500_000)
+	_, err := suite.msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{Authority: suite.addrs[0].String(), Params: params})
+	suite.Require().Error(err)
+
+	_, err = suite.msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{Authority: app.EpochingKeeper.GetAuthority(), Params: params})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, app.EpochingKeeper.GetParams(ctx))
+
+	suite.Require().False(app.EpochingKeeper.IsEpochEnd(ctx.WithBlockHeight(types.DefaultEpochLength)))
+	suite.Require().True(app.EpochingKeeper.IsEpochEnd(ctx.WithBlockHeight(20)))
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueries() {
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	params, err := queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultParams(), params.Params)
+
+	epoch, err := queryClient.CurrentEpoch(gocontext.Background(), &types.QueryCurrentEpochRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(types.DefaultEpochNumber), epoch.EpochNumber)
+	suite.Require().Equal(int64(types.DefaultEpochLength), epoch.EndHeight)
+
+	for _, addr := range suite.addrs {
+		msg := stakingtypes.NewMsgDelegate(addr, suite.valAddr, suite.bondCoin(100))
+		_, err := suite.msgServer.WrappedDelegate(sdk.WrapSDKContext(ctx), types.NewMsgWrappedDelegate(msg))
+		suite.Require().NoError(err)
+	}
+
+	queued, err := queryClient.QueuedMessages(gocontext.Background(), &types.QueryQueuedMessagesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(queued.QueuedMessages, len(suite.addrs))
+	suite.Require().Equal(suite.app.EpochingKeeper.GetEpochActions(ctx), queued.QueuedMessages)
+}
+
+func (suite *KeeperTestSuite) TestExportImportGenesis() {
+	app, ctx := suite.app, suite.ctx
+
+	msg := stakingtypes.NewMsgDelegate(suite.addrs[0], suite.valAddr, suite.bondCoin(100))
+	_, err := suite.msgServer.WrappedDelegate(sdk.WrapSDKContext(ctx), types.NewMsgWrappedDelegate(msg))
+	suite.Require().NoError(err)
+	app.EpochingKeeper.SetEpochNumber(ctx, 3)
+
+	genesis := app.EpochingKeeper.ExportGenesis(ctx)
+	suite.Require().NoError(types.ValidateGenesis(*genesis))
+	suite.Require().Equal(int64(3), genesis.EpochNumber)
+	suite.Require().Len(genesis.QueuedMessages, 1)
+
+	app.EpochingKeeper.DequeueEpochActions(ctx, math.MaxUint32)
+	app.EpochingKeeper.InitGenesis(ctx, app.AccountKeeper, genesis)
+	suite.Require().Equal(genesis, app.EpochingKeeper.ExportGenesis(ctx))
+
+	// restored messages must not have their ID reused
+	suite.Require().Equal(genesis.QueuedMessages[0].Id+1, app.EpochingKeeper.GetNewActionID(ctx))
+}
+
+func (suite *KeeperTestSuite) TestEscrowInvariant() {
+	app, ctx := suite.app, suite.ctx
+
+	msg := stakingtypes.NewMsgDelegate(suite.addrs[0], suite.valAddr, suite.bondCoin(100))
+	_, err := suite.msgServer.WrappedDelegate(sdk.WrapSDKContext(ctx), types.NewMsgWrappedDelegate(msg))
+	suite.Require().NoError(err)
+
+	invariant := keeper.EscrowInvariant(app.EpochingKeeper, app.AccountKeeper)
+	_, broken := invariant(ctx)
+	suite.Require().False(broken)
+
+	app.EpochingKeeper.DequeueEpochActions(ctx, math.MaxUint32)
+	_, broken = invariant(ctx)
+	suite.Require().True(broken)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
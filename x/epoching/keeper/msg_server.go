@@ -0,0 +1,208 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer is a wrapper of Keeper.
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/epoching MsgServer interface.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// WrappedDelegate escrows the delegated coins and queues the MsgDelegate.
+func (ms msgServer) WrappedDelegate(goCtx context.Context, msg *types.MsgWrappedDelegate) (*types.MsgWrappedDelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.checkBondDenom(ctx, msg.Msg.Amount); err != nil {
+		return nil, err
+	}
+	if _, err := ms.getValidator(ctx, msg.Msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+
+	id, err := ms.queueMsg(ctx, msg.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedDelegateResponse{Id: id}, nil
+}
+
+// WrappedUndelegate queues the MsgUndelegate.
+func (ms msgServer) WrappedUndelegate(goCtx context.Context, msg *types.MsgWrappedUndelegate) (*types.MsgWrappedUndelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.checkBondDenom(ctx, msg.Msg.Amount); err != nil {
+		return nil, err
+	}
+	if err := ms.checkDelegation(ctx, msg.Msg.DelegatorAddress, msg.Msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+
+	id, err := ms.queueMsg(ctx, msg.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedUndelegateResponse{Id: id}, nil
+}
+
+// WrappedBeginRedelegate queues the MsgBeginRedelegate.
+func (ms msgServer) WrappedBeginRedelegate(goCtx context.Context, msg *types.MsgWrappedBeginRedelegate) (*types.MsgWrappedBeginRedelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.checkBondDenom(ctx, msg.Msg.Amount); err != nil {
+		return nil, err
+	}
+	if err := ms.checkDelegation(ctx, msg.Msg.DelegatorAddress, msg.Msg.ValidatorSrcAddress); err != nil {
+		return nil, err
+	}
+	if _, err := ms.getValidator(ctx, msg.Msg.ValidatorDstAddress); err != nil {
+		return nil, err
+	}
+
+	id, err := ms.queueMsg(ctx, msg.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedBeginRedelegateResponse{Id: id}, nil
+}
+
+// WrappedCreateValidator escrows the self delegated coins and queues the
+// MsgCreateValidator.
+func (ms msgServer) WrappedCreateValidator(goCtx context.Context, msg *types.MsgWrappedCreateValidator) (*types.MsgWrappedCreateValidatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.checkBondDenom(ctx, msg.Msg.Value); err != nil {
+		return nil, err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(msg.Msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if _, found := ms.stakingKeeper.GetValidator(ctx, valAddr); found {
+		return nil, stakingtypes.ErrValidatorOwnerExists
+	}
+
+	id, err := ms.queueMsg(ctx, msg.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedCreateValidatorResponse{Id: id}, nil
+}
+
+// WrappedEditValidator queues the MsgEditValidator.
+func (ms msgServer) WrappedEditValidator(goCtx context.Context, msg *types.MsgWrappedEditValidator) (*types.MsgWrappedEditValidatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := ms.getValidator(ctx, msg.Msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+
+	id, err := ms.queueMsg(ctx, msg.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedEditValidatorResponse{Id: id}, nil
+}
+
+// UpdateParams updates the x/epoching module parameters if the message is signed by the authority.
+func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", ms.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ms.SetParams(ctx, msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// queueMsg escrows the coins delegated by msg, if any, and queues msg for
+// execution at the end of the current epoch. The coins are escrowed as a
+// delegation, so that vesting accounts can escrow their locked coins.
+func (ms msgServer) queueMsg(ctx sdk.Context, msg sdk.Msg) (uint64, error) {
+	owner, escrowed, err := types.EscrowedCoins(msg)
+	if err != nil {
+		return 0, err
+	}
+	if !escrowed.IsZero() {
+		if err := ms.bankKeeper.DelegateCoinsFromAccountToModule(ctx, owner, types.ModuleName, escrowed); err != nil {
+			return 0, err
+		}
+	}
+
+	epochNumber := ms.GetEpochNumber(ctx)
+	id, err := ms.QueueMsgForEpoch(ctx, epochNumber, msg)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMessageQueued{
+		Id:          id,
+		MsgTypeUrl:  sdk.MsgTypeURL(msg),
+		EpochNumber: epochNumber,
+	}); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (ms msgServer) checkBondDenom(ctx sdk.Context, amount sdk.Coin) error {
+	if bondDenom := ms.stakingKeeper.BondDenom(ctx); amount.Denom != bondDenom {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", amount.Denom, bondDenom)
+	}
+	return nil
+}
+
+func (ms msgServer) getValidator(ctx sdk.Context, validator string) (stakingtypes.Validator, error) {
+	valAddr, err := sdk.ValAddressFromBech32(validator)
+	if err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	val, found := ms.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound
+	}
+	return val, nil
+}
+
+func (ms msgServer) checkDelegation(ctx sdk.Context, delegator, validator string) error {
+	delAddr, err := sdk.AccAddressFromBech32(delegator)
+	if err != nil {
+		return err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(validator)
+	if err != nil {
+		return err
+	}
+
+	if _, found := ms.stakingKeeper.GetDelegation(ctx, delAddr, valAddr); !found {
+		return stakingtypes.ErrNoDelegation
+	}
+	return nil
+}
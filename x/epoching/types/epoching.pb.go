@@ -0,0 +1,723 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/epoching/v1beta1/epoching.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the x/epoching module.
+type Params struct {
+	// epoch_length is the number of blocks of an epoch. The queued messages are
+	// executed at the end of the blocks whose height is a multiple of it.
+	EpochLength int64 `protobuf:"varint,1,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	// restrict_staking_msgs rejects the MsgDelegate, MsgUndelegate,
+	// MsgBeginRedelegate and MsgCreateValidator not wrapped in the x/epoching
+	// messages, so that they can only be executed at the end of the epochs.
+	RestrictStakingMsgs bool `protobuf:"varint,2,opt,name=restrict_staking_msgs,json=restrictStakingMsgs,proto3" json:"restrict_staking_msgs,omitempty"`
+	// max_msgs_per_epoch is the maximum number of queued messages executed at
+	// the end of an epoch. The other messages are carried over to the next epoch.
+	MaxMsgsPerEpoch uint32 `protobuf:"varint,3,opt,name=max_msgs_per_epoch,json=maxMsgsPerEpoch,proto3" json:"max_msgs_per_epoch,omitempty"`
+	// max_gas_per_msg is the gas limit of the execution of a queued message.
+	MaxGasPerMsg uint64 `protobuf:"varint,4,opt,name=max_gas_per_msg,json=maxGasPerMsg,proto3" json:"max_gas_per_msg,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_525f09a6ad1d0fea, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEpochLength() int64 {
+	if m != nil {
+		return m.EpochLength
+	}
+	return 0
+}
+
+func (m *Params) GetRestrictStakingMsgs() bool {
+	if m != nil {
+		return m.RestrictStakingMsgs
+	}
+	return false
+}
+
+func (m *Params) GetMaxMsgsPerEpoch() uint32 {
+	if m != nil {
+		return m.MaxMsgsPerEpoch
+	}
+	return 0
+}
+
+func (m *Params) GetMaxGasPerMsg() uint64 {
+	if m != nil {
+		return m.MaxGasPerMsg
+	}
+	return 0
+}
+
+// QueuedMessage defines a message queued for execution at the end of the
+// current epoch.
+type QueuedMessage struct {
+	// id is the unique id of the queued message.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// msg is the queued message.
+	Msg *types.Any `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// block_height is the height at which the message was queued.
+	BlockHeight int64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// block_time is the time at which the message was queued.
+	BlockTime time.Time `protobuf:"bytes,4,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+}
+
+func (m *QueuedMessage) Reset()         { *m = QueuedMessage{} }
+func (m *QueuedMessage) String() string { return proto.CompactTextString(m) }
+func (*QueuedMessage) ProtoMessage()    {}
+func (*QueuedMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_525f09a6ad1d0fea, []int{1}
+}
+func (m *QueuedMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueuedMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedMessage.Merge(m, src)
+}
+func (m *QueuedMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuedMessage proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Params)(nil), "cosmos.epoching.v1beta1.Params")
+	proto.RegisterType((*QueuedMessage)(nil), "cosmos.epoching.v1beta1.QueuedMessage")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/epoching/v1beta1/epoching.proto", fileDescriptor_525f09a6ad1d0fea)
+}
+
+var fileDescriptor_525f09a6ad1d0fea = []byte{
+	// 441 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xbd, 0x49, 0x54, 0xca, 0xba, 0x05, 0x69, 0x29, 0x22, 0xe4, 0xe0, 0x84, 0x4a, 0xa0,
+	0x48, 0x28, 0xb6, 0x9a, 0xde, 0xb8, 0x11, 0x84, 0xca, 0x81, 0x48, 0xc5, 0x70, 0xe2, 0x62, 0xad,
+	0xed, 0x65, 0x6c, 0x25, 0xeb, 0xb5, 0x3c, 0x1b, 0x94, 0xbc, 0x01, 0xc7, 0x3e, 0x02, 0xef, 0x00,
+	0x4f, 0xc0, 0xa9, 0xe2, 0xd4, 0x23, 0x27, 0x40, 0xc9, 0x8b, 0xa0, 0x5d, 0xdb, 0x41, 0x82, 0x93,
+	0xbd, 0xff, 0xff, 0xcd, 0xcc, 0xaf, 0xd9, 0xa5, 0x4f, 0x12, 0x85, 0x52, 0x61, 0x20, 0x4a, 0x95,
+	0x64, 0x79, 0x01, 0xc1, 0xc7, 0xb3, 0x58, 0x68, 0x7e, 0xb6, 0x17, 0xfc, 0xb2, 0x52, 0x5a, 0xb1,
+	0x07, 0x35, 0xe7, 0xef, 0xe5, 0x86, 0x1b, 0x9c, 0x80, 0x02, 0x65, 0x99, 0xc0, 0xfc, 0xd5, 0xf8,
+	0xe0, 0x21, 0x28, 0x05, 0x4b, 0x11, 0xd8, 0x53, 0xbc, 0xfa, 0x10, 0xf0, 0x62, 0xd3, 0x58, 0xc3,
+	0x7f, 0x2d, 0x9d, 0x4b, 0x81, 0x9a, 0xcb, 0xb2, 0xad, 0xad, 0x47, 0x45, 0x75, 0xd3, 0x66, 0xae,
+	0x3d, 0x9c, 0x7e, 0x21, 0xf4, 0xe0, 0x92, 0x57, 0x5c, 0x22, 0x7b, 0x44, 0x8f, 0x6c, 0x96, 0x68,
+	0x29, 0x0a, 0xd0, 0x59, 0x9f, 0x8c, 0xc8, 0xb8, 0x1b, 0xba, 0x56, 0x7b, 0x6d, 0x25, 0x36, 0xa5,
+	0xf7, 0x2b, 0x81, 0xba, 0xca, 0x13, 0x1d, 0xa1, 0xe6, 0x8b, 0xbc, 0x80, 0x48, 0x22, 0x60, 0xbf,
+	0x33, 0x22, 0xe3, 0xc3, 0xf0, 0x5e, 0x6b, 0xbe, 0xad, 0xbd, 0x39, 0x02, 0xb2, 0xa7, 0x94, 0x49,
+	0xbe, 0xb6, 0x58, 0x54, 0x8a, 0x2a, 0xb2, 0xfd, 0xfa, 0xdd, 0x11, 0x19, 0x1f, 0x87, 0x77, 0x25,
+	0x5f, 0x1b, 0xe8, 0x52, 0x54, 0x2f, 0x8d, 0xcc, 0x1e, 0x53, 0x23, 0x45, 0xc0, 0x6b, 0x56, 0x22,
+	0xf4, 0x7b, 0x23, 0x32, 0xee, 0x85, 0x47, 0x92, 0xaf, 0x2f, 0xb8, 0x01, 0xe7, 0x08, 0xa7, 0xdf,
+	0x08, 0x3d, 0x7e, 0xb3, 0x12, 0x2b, 0x91, 0xce, 0x05, 0x22, 0x07, 0xc1, 0xee, 0xd0, 0x4e, 0x9e,
+	0xda, 0xc8, 0xbd, 0xb0, 0x93, 0xa7, 0xec, 0x9c, 0x76, 0x4d, 0xb1, 0xc9, 0xe5, 0x4e, 0x4f, 0xfc,
+	0x7a, 0x43, 0x7e, 0xbb, 0x21, 0xff, 0x79, 0xb1, 0x99, 0xb9, 0xdf, 0xbf, 0x4e, 0x6e, 0x61, 0xba,
+	0xf0, 0xe7, 0x08, 0xa1, 0xa1, 0xcd, 0x06, 0xe2, 0xa5, 0x4a, 0x16, 0x51, 0x26, 0x72, 0xc8, 0xb4,
+	0x0d, 0xd9, 0x0d, 0x5d, 0xab, 0xbd, 0xb2, 0x12, 0x7b, 0x41, 0x69, 0x8d, 0x98, 0x1d, 0xdb, 0x6c,
+	0xee, 0x74, 0xf0, 0x5f, 0xfb, 0x77, 0xed, 0x05, 0xcc, 0x0e, 0xaf, 0x7f, 0x0e, 0x9d, 0xab, 0x5f,
+	0x43, 0x12, 0xde, 0xb6, 0x75, 0xc6, 0x79, 0xd6, 0xfb, 0xf4, 0x79, 0xe8, 0xcc, 0x2e, 0xae, 0xb7,
+	0x1e, 0xb9, 0xd9, 0x7a, 0xe4, 0xf7, 0xd6, 0x23, 0x57, 0x3b, 0xcf, 0xb9, 0xd9, 0x79, 0xce, 0x8f,
+	0x9d, 0xe7, 0xbc, 0x9f, 0x40, 0xae, 0xb3, 0x55, 0xec, 0x27, 0x4a, 0x36, 0xb7, 0xd5, 0x7c, 0x26,
+	0x98, 0x2e, 0x82, 0xf5, 0xdf, 0xa7, 0xa5, 0x37, 0xa5, 0xc0, 0xf8, 0xc0, 0xce, 0x3d, 0xff, 0x33,
+	0x00, 0x8e, 0xa3, 0x0c, 0xda, 0x7a, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxGasPerMsg != 0 {
+		i = encodeVarintEpoching(dAtA, i, uint64(m.MaxGasPerMsg))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxMsgsPerEpoch != 0 {
+		i = encodeVarintEpoching(dAtA, i, uint64(m.MaxMsgsPerEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RestrictStakingMsgs {
+		i--
+		if m.RestrictStakingMsgs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochLength != 0 {
+		i = encodeVarintEpoching(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueuedMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintEpoching(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if m.BlockHeight != 0 {
+		i = encodeVarintEpoching(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEpoching(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintEpoching(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEpoching(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEpoching(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochLength != 0 {
+		n += 1 + sovEpoching(uint64(m.EpochLength))
+	}
+	if m.RestrictStakingMsgs {
+		n += 2
+	}
+	if m.MaxMsgsPerEpoch != 0 {
+		n += 1 + sovEpoching(uint64(m.MaxMsgsPerEpoch))
+	}
+	if m.MaxGasPerMsg != 0 {
+		n += 1 + sovEpoching(uint64(m.MaxGasPerMsg))
+	}
+	return n
+}
+
+func (m *QueuedMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEpoching(uint64(m.Id))
+	}
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovEpoching(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEpoching(uint64(m.BlockHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovEpoching(uint64(l))
+	return n
+}
+
+func sovEpoching(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEpoching(x uint64) (n int) {
+	return sovEpoching(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEpoching
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestrictStakingMsgs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RestrictStakingMsgs = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMsgsPerEpoch", wireType)
+			}
+			m.MaxMsgsPerEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMsgsPerEpoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerMsg", wireType)
+			}
+			m.MaxGasPerMsg = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerMsg |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEpoching(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEpoching
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEpoching
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEpoching
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEpoching
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &types.Any{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEpoching
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEpoching
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEpoching(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEpoching
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEpoching(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEpoching
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEpoching
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEpoching
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEpoching
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEpoching        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEpoching          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEpoching = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/epoching module sentinel errors
+var (
+	ErrInvalidQueuedMessage = sdkerrors.Register(ModuleName, 2, "invalid queued message")
+	ErrUnwrappedStakingMsg  = sdkerrors.Register(ModuleName, 3, "staking message not wrapped in an epoching message")
+)
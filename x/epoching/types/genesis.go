@@ -0,0 +1,59 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var _ types.UnpackInterfacesMessage = GenesisState{}
+
+// NewGenesisState creates a new GenesisState object.
+func NewGenesisState(params Params, epochNumber int64, queuedMessages []QueuedMessage) *GenesisState {
+	return &GenesisState{
+		Params:         params,
+		EpochNumber:    epochNumber,
+		QueuedMessages: queuedMessages,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), DefaultEpochNumber, nil)
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	if data.EpochNumber < 0 {
+		return fmt.Errorf("epoch number cannot be negative: %d", data.EpochNumber)
+	}
+
+	ids := make(map[uint64]bool, len(data.QueuedMessages))
+	for _, qm := range data.QueuedMessages {
+		if ids[qm.Id] {
+			return fmt.Errorf("duplicate queued message id: %d", qm.Id)
+		}
+		ids[qm.Id] = true
+
+		if err := qm.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (data GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, qm := range data.QueuedMessages {
+		if err := qm.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the epoching module, which is also the name
+	// of its module account escrowing the queued delegations.
+	ModuleName = "epoching"
+
+	// StoreKey is the default store key for epoching
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for epoching
+	RouterKey = ModuleName
+
+	// DefaultEpochActionID is the id of the first queued message.
+	DefaultEpochActionID = 1
+
+	// DefaultEpochNumber is the number of the first epoch.
+	DefaultEpochNumber = 0
+)
+
+var (
+	NextEpochActionID      = []byte{0x11}
+	EpochNumberID          = []byte{0x12}
+	EpochActionQueuePrefix = []byte{0x13} // prefix for the epoch
+	ParamsKey              = []byte{0x14}
+)
+
+// ActionStoreKey returns action store key from ID
+func ActionStoreKey(epochNumber int64, actionID uint64) []byte {
+	key := make([]byte, 0, len(EpochActionQueuePrefix)+16)
+	key = append(key, EpochActionQueuePrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(epochNumber))...)
+	return append(key, sdk.Uint64ToBigEndian(actionID)...)
+}
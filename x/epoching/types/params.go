@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// DefaultEpochLength is the default number of blocks of an epoch.
+	DefaultEpochLength int64 = 10
+
+	// DefaultMaxMsgsPerEpoch is the default maximum number of queued messages
+	// executed at the end of an epoch.
+	DefaultMaxMsgsPerEpoch uint32 = 1000
+
+	// DefaultMaxGasPerMsg is the default gas limit of the execution of a queued
+	// message.
+	DefaultMaxGasPerMsg uint64 = 1_000_000
+)
+
+// NewParams creates a new Params object.
+func NewParams(epochLength int64, restrictStakingMsgs bool, maxMsgsPerEpoch uint32, maxGasPerMsg uint64) Params {
+	return Params{
+		EpochLength:         epochLength,
+		RestrictStakingMsgs: restrictStakingMsgs,
+		MaxMsgsPerEpoch:     maxMsgsPerEpoch,
+		MaxGasPerMsg:        maxGasPerMsg,
+	}
+}
+
+// DefaultParams returns the default epoching module parameters. The staking
+// messages are not restricted by default, chains opt in by setting
+// RestrictStakingMsgs.
+func DefaultParams() Params {
+	return NewParams(DefaultEpochLength, false, DefaultMaxMsgsPerEpoch, DefaultMaxGasPerMsg)
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	if p.EpochLength <= 0 {
+		return fmt.Errorf("epoch length must be positive: %d", p.EpochLength)
+	}
+	if p.MaxMsgsPerEpoch == 0 {
+		return fmt.Errorf("max msgs per epoch must be positive: %d", p.MaxMsgsPerEpoch)
+	}
+	if p.MaxGasPerMsg == 0 {
+		return fmt.Errorf("max gas per msg must be positive: %d", p.MaxGasPerMsg)
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	_ types.UnpackInterfacesMessage = QueuedMessage{}
+	_ types.UnpackInterfacesMessage = QueryQueuedMessagesResponse{}
+)
+
+// NewQueuedMessage creates a new QueuedMessage wrapping msg.
+func NewQueuedMessage(id uint64, msg sdk.Msg, blockHeight int64, blockTime time.Time) (QueuedMessage, error) {
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return QueuedMessage{}, err
+	}
+
+	return QueuedMessage{
+		Id:          id,
+		Msg:         any,
+		BlockHeight: blockHeight,
+		BlockTime:   blockTime,
+	}, nil
+}
+
+// GetMsg returns the queued message.
+func (qm QueuedMessage) GetMsg() (sdk.Msg, error) {
+	if qm.Msg == nil {
+		return nil, fmt.Errorf("queued message %d is empty", qm.Id)
+	}
+
+	msg, ok := qm.Msg.GetCachedValue().(sdk.Msg)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", (sdk.Msg)(nil), qm.Msg.GetCachedValue())
+	}
+	return msg, nil
+}
+
+// ValidateBasic performs a stateless validation of the queued message.
+func (qm QueuedMessage) ValidateBasic() error {
+	msg, err := qm.GetMsg()
+	if err != nil {
+		return err
+	}
+
+	return msg.ValidateBasic()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (qm QueuedMessage) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var msg sdk.Msg
+	return unpacker.UnpackAny(qm.Msg, &msg)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (res QueryQueuedMessagesResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, qm := range res.QueuedMessages {
+		if err := qm.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EscrowedCoins returns the coins escrowed while the staking msg is queued and
+// the address they are escrowed from, which are the delegated coins of a
+// MsgDelegate or a MsgCreateValidator.
+func EscrowedCoins(msg sdk.Msg) (sdk.AccAddress, sdk.Coins, error) {
+	var (
+		delegator string
+		amount    sdk.Coin
+	)
+	switch msg := msg.(type) {
+	case *stakingtypes.MsgDelegate:
+		delegator, amount = msg.DelegatorAddress, msg.Amount
+	case *stakingtypes.MsgCreateValidator:
+		delegator, amount = msg.DelegatorAddress, msg.Value
+	default:
+		return nil, nil, nil
+	}
+
+	addr, err := sdk.AccAddressFromBech32(delegator)
+	if err != nil {
+		return nil, nil, err
+	}
+	return addr, sdk.NewCoins(amount), nil
+}
@@ -13,18 +13,23 @@ import (
 )
 
 // BeginBlocker iterates through and handles any newly discovered evidence of
-// misbehavior submitted by Tendermint. Currently, only equivocation is handled.
+// misbehavior submitted by Tendermint, i.e. equivocations and light client
+// attacks.
 func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
 	for _, tmEvidence := range req.ByzantineValidators {
 		switch tmEvidence.Type {
-		// It's still ongoing discussion how should we treat and slash attacks with
-		// premeditation. So for now we agree to treat them in the same way.
-		case abci.EvidenceType_DUPLICATE_VOTE, abci.EvidenceType_LIGHT_CLIENT_ATTACK:
+		case abci.EvidenceType_DUPLICATE_VOTE:
 			evidence := types.FromABCIEvidence(tmEvidence)
 			k.HandleEquivocationEvidence(ctx, evidence.(*types.Equivocation))
 
+		// Light client attacks are punished as defined by their own policy
+		// parameter, by default the same way as equivocations.
+		case abci.EvidenceType_LIGHT_CLIENT_ATTACK:
+			evidence := types.LightClientAttackFromABCIEvidence(tmEvidence)
+			k.HandleLightClientAttackEvidence(ctx, evidence.(*types.LightClientAttack))
+
 		default:
 			k.Logger(ctx).Error(fmt.Sprintf("ignored unknown evidence type: %s", tmEvidence.Type))
 		}
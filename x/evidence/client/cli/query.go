@@ -39,6 +39,41 @@ $ %s query %s --page=2 --limit=50
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "evidence")
 
+	cmd.AddCommand(GetCmdQueryParams())
+
+	return cmd
+}
+
+// GetCmdQueryParams implements a command to return the current evidence
+// parameters.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current evidence parameters",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(`Query the current evidence policy parameters, i.e. how validators are
+punished for each type of evidence:
+
+$ <appd> query evidence params
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
 	return cmd
 }
 
@@ -2,10 +2,13 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // HandleEquivocationEvidence implements an equivocation evidence handler. Assuming the
@@ -39,28 +42,18 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 		return
 	}
 
-	// calculate the age of the evidence
 	infractionHeight := evidence.GetHeight()
 	infractionTime := evidence.GetTime()
-	ageDuration := ctx.BlockHeader().Time.Sub(infractionTime)
-	ageBlocks := ctx.BlockHeader().Height - infractionHeight
 
-	// Reject evidence if the double-sign is too old. Evidence is considered stale
-	// if the difference in time and number of blocks is greater than the allowed
-	// parameters defined.
-	cp := ctx.ConsensusParams()
-	if cp != nil && cp.Evidence != nil {
-		if ageDuration > cp.Evidence.MaxAgeDuration && ageBlocks > cp.Evidence.MaxAgeNumBlocks {
-			logger.Info(
-				"ignored equivocation; evidence too old",
-				"validator", consAddr,
-				"infraction_height", infractionHeight,
-				"max_age_num_blocks", cp.Evidence.MaxAgeNumBlocks,
-				"infraction_time", infractionTime,
-				"max_age_duration", cp.Evidence.MaxAgeDuration,
-			)
-			return
-		}
+	// Reject evidence if the double-sign is too old.
+	if k.isEvidenceTooOld(ctx, infractionHeight, infractionTime) {
+		logger.Info(
+			"ignored equivocation; evidence too old",
+			"validator", consAddr,
+			"infraction_height", infractionHeight,
+			"infraction_time", infractionTime,
+		)
+		return
 	}
 
 	validator := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
@@ -114,10 +107,114 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 	// Jail the validator if not already jailed. This will begin unbonding the
 	// validator if not already unbonding (tombstoned).
 	if !validator.IsJailed() {
-		k.slashingKeeper.Jail(ctx, consAddr)
+		k.slashingKeeper.JailWithReason(ctx, consAddr, slashingtypes.InfractionDoubleSign, evidence.Type())
 	}
 
 	k.slashingKeeper.JailUntil(ctx, consAddr, types.DoubleSignJailEndTime)
 	k.slashingKeeper.Tombstone(ctx, consAddr)
 	k.SetEvidence(ctx, evidence)
 }
+
+// HandleLightClientAttackEvidence implements a light client attack evidence
+// handler. Assuming the evidence is valid, the validator committing the
+// misbehavior will be slashed, jailed and possibly tombstoned as defined by the
+// light client attack policy parameter. The evidence is ignored under the same
+// conditions as equivocation evidence.
+func (k Keeper) HandleLightClientAttackEvidence(ctx sdk.Context, evidence *types.LightClientAttack) {
+	logger := k.Logger(ctx)
+	consAddr := evidence.GetConsensusAddress()
+
+	// Ignore evidence that cannot be handled, see HandleEquivocationEvidence.
+	if _, err := k.slashingKeeper.GetPubkey(ctx, consAddr.Bytes()); err != nil {
+		return
+	}
+
+	infractionHeight := evidence.GetHeight()
+	infractionTime := evidence.GetTime()
+	if k.isEvidenceTooOld(ctx, infractionHeight, infractionTime) {
+		logger.Info(
+			"ignored light client attack; evidence too old",
+			"validator", consAddr,
+			"infraction_height", infractionHeight,
+			"infraction_time", infractionTime,
+		)
+		return
+	}
+
+	validator := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
+	if validator == nil || validator.IsUnbonded() {
+		return
+	}
+
+	if ok := k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr); !ok {
+		panic(fmt.Sprintf("expected signing info for validator %s but not found", consAddr))
+	}
+
+	if k.slashingKeeper.IsTombstoned(ctx, consAddr) {
+		logger.Info(
+			"ignored light client attack; validator already tombstoned",
+			"validator", consAddr,
+			"infraction_height", infractionHeight,
+			"infraction_time", infractionTime,
+		)
+		return
+	}
+
+	logger.Info(
+		"confirmed light client attack",
+		"validator", consAddr,
+		"infraction_height", infractionHeight,
+		"infraction_time", infractionTime,
+	)
+
+	k.punishValidator(
+		ctx, validator, evidence.GetValidatorPower(), infractionHeight-sdk.ValidatorUpdateDelay,
+		evidence.Type(), k.GetParams(ctx).LightClientAttack,
+	)
+	k.SetEvidence(ctx, evidence)
+}
+
+// isEvidenceTooOld returns true if evidence of an infraction at the given
+// height and time is stale, i.e. if the difference in time and number of blocks
+// is greater than the allowed consensus parameters.
+func (k Keeper) isEvidenceTooOld(ctx sdk.Context, infractionHeight int64, infractionTime time.Time) bool {
+	cp := ctx.ConsensusParams()
+	if cp == nil || cp.Evidence == nil {
+		return false
+	}
+
+	ageDuration := ctx.BlockHeader().Time.Sub(infractionTime)
+	ageBlocks := ctx.BlockHeader().Height - infractionHeight
+	return ageDuration > cp.Evidence.MaxAgeDuration && ageBlocks > cp.Evidence.MaxAgeNumBlocks
+}
+
+// punishValidator slashes, jails and possibly tombstones a validator for the
+// misbehavior proven by evidence of the given type, as defined by the policy of
+// the evidence type. The jail of a validator already jailed for longer is not
+// shortened.
+func (k Keeper) punishValidator(
+	ctx sdk.Context, validator stakingtypes.ValidatorI, power, distributionHeight int64,
+	evidenceType string, policy types.EvidencePolicy,
+) {
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		panic(err)
+	}
+
+	if policy.SlashFraction.IsPositive() {
+		k.slashingKeeper.Slash(ctx, consAddr, policy.SlashFraction, power, distributionHeight)
+	}
+
+	if !validator.IsJailed() {
+		k.slashingKeeper.JailWithReason(ctx, consAddr, slashingtypes.InfractionEvidence, evidenceType)
+	}
+
+	jailEndTime := policy.JailEndTime(ctx.BlockTime())
+	if info, found := k.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr); found && info.JailedUntil.Before(jailEndTime) {
+		k.slashingKeeper.JailUntil(ctx, consAddr, jailEndTime)
+	}
+
+	if policy.Tombstone {
+		k.slashingKeeper.Tombstone(ctx, consAddr)
+	}
+}
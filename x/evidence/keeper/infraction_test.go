@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 )
@@ -45,6 +46,13 @@ func (suite *KeeperTestSuite) TestHandleDoubleSign() {
 	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
 	suite.True(suite.app.SlashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())))
 
+	// the double sign is recorded as the jail reason
+	info, found := suite.app.SlashingKeeper.GetValidatorSigningInfo(ctx, sdk.ConsAddress(val.Address()))
+	suite.True(found)
+	suite.Require().NotNil(info.JailReason)
+	suite.Equal(slashingtypes.InfractionDoubleSign, info.JailReason.Type)
+	suite.Equal(evidence.Type(), info.JailReason.EvidenceType)
+
 	// tokens should be decreased
 	newTokens := suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens()
 	suite.True(newTokens.LT(oldTokens))
@@ -111,3 +119,80 @@ func (suite *KeeperTestSuite) TestHandleDoubleSign_TooOld() {
 	suite.False(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
 	suite.False(suite.app.SlashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())))
 }
+
+func (suite *KeeperTestSuite) TestHandleLightClientAttack() {
+	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(1).WithBlockTime(time.Unix(1000, 0))
+	suite.populateValidators(ctx)
+
+	// punish light client attacks without tombstoning
+	params := types.DefaultParams()
+	params.LightClientAttack = types.NewEvidencePolicy(sdk.NewDecWithPrec(1, 1), time.Hour, false)
+	suite.app.EvidenceKeeper.SetParams(ctx, params)
+
+	power := int64(100)
+	operatorAddr, val := valAddresses[0], pubkeys[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	tstaking := teststaking.NewHelper(suite.T(), ctx, suite.app.StakingKeeper)
+
+	selfDelegation := tstaking.CreateValidatorWithValPower(operatorAddr, val, power, true)
+	staking.EndBlocker(ctx, suite.app.StakingKeeper)
+
+	// handle a signature to set signing info
+	suite.app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), selfDelegation.Int64(), true)
+
+	oldTokens := suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+	evidence := &types.LightClientAttack{
+		Height:           1,
+		Time:             ctx.BlockTime(),
+		Power:            power,
+		TotalPower:       power,
+		ConsensusAddress: consAddr.String(),
+	}
+	suite.app.EvidenceKeeper.HandleLightClientAttackEvidence(ctx, evidence)
+
+	// should be jailed for the policy jail duration but not tombstoned
+	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	suite.False(suite.app.SlashingKeeper.IsTombstoned(ctx, consAddr))
+	info, found := suite.app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	suite.True(found)
+	suite.Equal(ctx.BlockTime().Add(time.Hour), info.JailedUntil)
+	suite.Require().NotNil(info.JailReason)
+	suite.Equal(slashingtypes.InfractionEvidence, info.JailReason.Type)
+	suite.Equal(types.TypeLightClientAttack, info.JailReason.EvidenceType)
+
+	// tokens should be slashed by the policy slash fraction
+	newTokens := suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+	suite.Equal(oldTokens.Sub(suite.app.StakingKeeper.TokensFromConsensusPower(ctx, 10)), newTokens)
+	suite.Len(suite.app.EvidenceKeeper.GetAllEvidence(ctx), 1)
+
+	// the validator can unjail after the jail duration
+	ctx = ctx.WithBlockTime(info.JailedUntil)
+	suite.NoError(suite.app.SlashingKeeper.Unjail(ctx, operatorAddr))
+}
+
+func (suite *KeeperTestSuite) TestHandleLightClientAttack_DefaultPolicy() {
+	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(1)
+	suite.populateValidators(ctx)
+	suite.app.EvidenceKeeper.SetParams(ctx, types.DefaultParams())
+
+	power := int64(100)
+	operatorAddr, val := valAddresses[0], pubkeys[0]
+	tstaking := teststaking.NewHelper(suite.T(), ctx, suite.app.StakingKeeper)
+
+	selfDelegation := tstaking.CreateValidatorWithValPower(operatorAddr, val, power, true)
+	staking.EndBlocker(ctx, suite.app.StakingKeeper)
+	suite.app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), selfDelegation.Int64(), true)
+
+	evidence := &types.LightClientAttack{
+		Height:           1,
+		Time:             time.Unix(0, 0),
+		Power:            power,
+		TotalPower:       power,
+		ConsensusAddress: sdk.ConsAddress(val.Address()).String(),
+	}
+	suite.app.EvidenceKeeper.HandleLightClientAttackEvidence(ctx, evidence)
+
+	// should be jailed and tombstoned like for an equivocation
+	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	suite.True(suite.app.SlashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())))
+}
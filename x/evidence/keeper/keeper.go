@@ -24,20 +24,34 @@ type Keeper struct {
 	router         types.Router
 	stakingKeeper  types.StakingKeeper
 	slashingKeeper types.SlashingKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
 }
 
 func NewKeeper(
 	cdc codec.BinaryCodec, storeKey storetypes.StoreKey, stakingKeeper types.StakingKeeper,
-	slashingKeeper types.SlashingKeeper,
+	slashingKeeper types.SlashingKeeper, authority string,
 ) *Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Errorf("invalid evidence authority address: %w", err))
+	}
+
 	return &Keeper{
 		cdc:            cdc,
 		storeKey:       storeKey,
 		stakingKeeper:  stakingKeeper,
 		slashingKeeper: slashingKeeper,
+		authority:      authority,
 	}
 }
 
+// GetAuthority returns the x/evidence module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -99,10 +113,36 @@ func (k Keeper) SubmitEvidence(ctx sdk.Context, evidence exported.Evidence) erro
 	return nil
 }
 
-// SetEvidence sets Evidence by hash in the module's KVStore.
+// GetParams returns the total set of evidence parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the total set of evidence parameters.
+// CONTRACT: This method performs no validation of the parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&params)
+	store.Set(types.ParamsKey, bz)
+}
+
+// SetEvidence sets Evidence by hash in the module's KVStore. OracleMisreport
+// evidence is also indexed by validator, feed and height.
 func (k Keeper) SetEvidence(ctx sdk.Context, evidence exported.Evidence) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEvidence)
 	store.Set(evidence.Hash(), k.MustMarshalEvidence(evidence))
+
+	if misreport, ok := evidence.(*types.OracleMisreport); ok {
+		key := types.GetOracleMisreportKey(misreport.GetConsensusAddress(), misreport.Feed, misreport.Height)
+		ctx.KVStore(k.storeKey).Set(key, misreport.Hash())
+	}
 }
 
 // GetEvidence retrieves Evidence by hash if it exists. If no Evidence exists for
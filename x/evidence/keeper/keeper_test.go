@@ -19,6 +19,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
 	"github.com/cosmos/cosmos-sdk/x/evidence/keeper"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 var (
@@ -76,6 +77,13 @@ type KeeperTestSuite struct {
 	app     *simapp.SimApp
 
 	queryClient types.QueryClient
+
+	// oracleMisreportErr is returned by the test oracle misreport verifier
+	oracleMisreportErr error
+}
+
+func (suite *KeeperTestSuite) verifyOracleMisreport(_ sdk.Context, _ *types.OracleMisreport) error {
+	return suite.oracleMisreportErr
 }
 
 func (suite *KeeperTestSuite) SetupTest() {
@@ -85,13 +93,16 @@ func (suite *KeeperTestSuite) SetupTest() {
 	// recreate keeper in order to use custom testing types
 	evidenceKeeper := keeper.NewKeeper(
 		app.AppCodec(), app.GetKey(types.StoreKey), app.StakingKeeper, app.SlashingKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	router := types.NewRouter()
 	router = router.AddRoute(types.RouteEquivocation, testEquivocationHandler(*evidenceKeeper))
+	router = router.AddRoute(types.RouteOracleMisreport, keeper.NewOracleMisreportHandler(*evidenceKeeper, suite.verifyOracleMisreport))
 	evidenceKeeper.SetRouter(router)
 
 	app.EvidenceKeeper = *evidenceKeeper
 
+	suite.oracleMisreportErr = nil
 	suite.ctx = app.BaseApp.NewContext(checkTx, tmproto.Header{Height: 1})
 	suite.querier = keeper.NewQuerier(*evidenceKeeper, app.LegacyAmino())
 	suite.app = app
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v047 "github.com/cosmos/cosmos-sdk/x/evidence/migrations/v047"
+)
+
+// Migrator is a struct for handling in-place state migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{
+		keeper: k,
+	}
+}
+
+// Migrate1to2 migrates the x/evidence module state from the consensus version 1
+// to version 2. Specifically, it sets the default evidence policy parameters,
+// with the current x/slashing double sign slash fraction for light client
+// attacks.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	slashFraction := m.keeper.slashingKeeper.SlashFractionDoubleSign(ctx)
+	return v047.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, slashFraction)
+}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+)
+
+// OracleMisreportVerifier verifies that the value reported to an oracle feed by
+// the validator of an OracleMisreport evidence is wrong, returning an error
+// otherwise. It is provided by the application owning the oracle.
+type OracleMisreportVerifier func(ctx sdk.Context, evidence *types.OracleMisreport) error
+
+// NewOracleMisreportHandler returns an evidence Handler for OracleMisreport
+// evidence, to be registered on the RouteOracleMisreport route of the
+// evidence router:
+//
+//	router := types.NewRouter().
+//		AddRoute(types.RouteOracleMisreport, keeper.NewOracleMisreportHandler(k, verify))
+//	k.SetRouter(router)
+func NewOracleMisreportHandler(k Keeper, verify OracleMisreportVerifier) types.Handler {
+	return func(ctx sdk.Context, evidence exported.Evidence) error {
+		misreport, ok := evidence.(*types.OracleMisreport)
+		if !ok {
+			return sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", &types.OracleMisreport{}, evidence)
+		}
+
+		return k.HandleOracleMisreportEvidence(ctx, misreport, verify)
+	}
+}
+
+// HandleOracleMisreportEvidence handles OracleMisreport evidence submitted with
+// MsgSubmitEvidence. Once the application verified the misreport, the validator
+// is slashed, jailed and possibly tombstoned as defined by the oracle misreport
+// policy parameter. The validator power at the time of the submission is used
+// since the submitter cannot be trusted to provide it.
+//
+// The evidence is rejected if:
+// - the evidence is from the future or too old
+// - a misreport of the validator for the same feed and height was already
+// handled, whatever the reported and expected values
+// - the validator is unbonded, does not exist or has no signing info
+// - the validator is already tombstoned
+// - the application fails to verify the misreport
+func (k Keeper) HandleOracleMisreportEvidence(ctx sdk.Context, evidence *types.OracleMisreport, verify OracleMisreportVerifier) error {
+	consAddr := evidence.GetConsensusAddress()
+	infractionHeight := evidence.GetHeight()
+
+	if infractionHeight > ctx.BlockHeight() {
+		return sdkerrors.Wrapf(types.ErrInvalidEvidence, "oracle misreport height %d is in the future", infractionHeight)
+	}
+	if k.isEvidenceTooOld(ctx, infractionHeight, evidence.GetTime()) {
+		return sdkerrors.Wrap(types.ErrInvalidEvidence, "oracle misreport evidence is too old")
+	}
+
+	if k.HasOracleMisreport(ctx, consAddr, evidence.Feed, infractionHeight) {
+		return sdkerrors.Wrapf(
+			types.ErrEvidenceExists, "oracle misreport of validator %s for feed %s at height %d",
+			consAddr, evidence.Feed, infractionHeight,
+		)
+	}
+
+	validator := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
+	if validator == nil || validator.IsUnbonded() {
+		return sdkerrors.Wrapf(types.ErrInvalidValidator, "validator %s is not bonded", consAddr)
+	}
+	if !k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr) {
+		return sdkerrors.Wrapf(types.ErrInvalidValidator, "no signing info for validator %s", consAddr)
+	}
+	if k.slashingKeeper.IsTombstoned(ctx, consAddr) {
+		return sdkerrors.Wrapf(types.ErrInvalidValidator, "validator %s is already tombstoned", consAddr)
+	}
+
+	if err := verify(ctx, evidence); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info(
+		"confirmed oracle misreport",
+		"validator", consAddr,
+		"feed", evidence.Feed,
+		"infraction_height", infractionHeight,
+	)
+
+	power := validator.GetConsensusPower(k.stakingKeeper.PowerReduction(ctx))
+	k.punishValidator(
+		ctx, validator, power, infractionHeight-sdk.ValidatorUpdateDelay,
+		evidence.Type(), k.GetParams(ctx).OracleMisreport,
+	)
+	return nil
+}
+
+// HasOracleMisreport returns whether a misreport of the validator for the feed
+// at the given height was already handled.
+func (k Keeper) HasOracleMisreport(ctx sdk.Context, consAddr sdk.ConsAddress, feed string, height int64) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetOracleMisreportKey(consAddr, feed, height))
+}
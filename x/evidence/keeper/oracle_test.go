@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+)
+
+func (suite *KeeperTestSuite) TestSubmitOracleMisreport() {
+	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+	suite.populateValidators(ctx)
+	suite.app.EvidenceKeeper.SetParams(ctx, types.DefaultParams())
+
+	power := int64(100)
+	operatorAddr, val := valAddresses[0], pubkeys[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	tstaking := teststaking.NewHelper(suite.T(), ctx, suite.app.StakingKeeper)
+
+	selfDelegation := tstaking.CreateValidatorWithValPower(operatorAddr, val, power, true)
+	staking.EndBlocker(ctx, suite.app.StakingKeeper)
+	suite.app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), selfDelegation.Int64(), true)
+
+	evidence := &types.OracleMisreport{
+		Height:           9,
+		Time:             ctx.BlockTime(),
+		ConsensusAddress: consAddr.String(),
+		Feed:             "atom/usd",
+		ReportedValue:    "100.0",
+		ExpectedValue:    "10.0",
+	}
+
+	// rejected if the application cannot verify the misreport
+	suite.oracleMisreportErr = errors.New("value was correctly reported")
+	suite.Error(suite.app.EvidenceKeeper.SubmitEvidence(ctx, evidence))
+	suite.False(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	suite.Empty(suite.app.EvidenceKeeper.GetAllEvidence(ctx))
+
+	// rejected if from the future
+	suite.oracleMisreportErr = nil
+	future := *evidence
+	future.Height = ctx.BlockHeight() + 1
+	suite.Error(suite.app.EvidenceKeeper.SubmitEvidence(ctx, &future))
+
+	// punished as defined by the oracle misreport policy once verified
+	oldTokens := suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+	suite.NoError(suite.app.EvidenceKeeper.SubmitEvidence(ctx, evidence))
+
+	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	suite.False(suite.app.SlashingKeeper.IsTombstoned(ctx, consAddr))
+	info, found := suite.app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	suite.True(found)
+	suite.Equal(ctx.BlockTime().Add(types.DefaultOracleMisreportPolicy.JailDuration), info.JailedUntil)
+	suite.Require().NotNil(info.JailReason)
+	suite.Equal(slashingtypes.InfractionEvidence, info.JailReason.Type)
+	suite.Equal(types.TypeOracleMisreport, info.JailReason.EvidenceType)
+
+	newTokens := suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+	suite.Equal(oldTokens.Sub(suite.app.StakingKeeper.TokensFromConsensusPower(ctx, 1)), newTokens)
+	suite.Len(suite.app.EvidenceKeeper.GetAllEvidence(ctx), 1)
+
+	// the same evidence cannot be submitted twice
+	suite.Error(suite.app.EvidenceKeeper.SubmitEvidence(ctx, evidence))
+
+	// nor the misreport of the same feed at the same height with other values
+	suite.app.StakingKeeper.Unjail(ctx, consAddr)
+	duplicate := *evidence
+	duplicate.ReportedValue = "1000.0"
+	err := suite.app.EvidenceKeeper.SubmitEvidence(ctx, &duplicate)
+	suite.Require().Error(err)
+	suite.Contains(err.Error(), types.ErrEvidenceExists.Error())
+	suite.False(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	suite.Equal(newTokens, suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens())
+	suite.Len(suite.app.EvidenceKeeper.GetAllEvidence(ctx), 1)
+
+	// while a misreport of another feed is handled
+	other := *evidence
+	other.Feed = "osmo/usd"
+	suite.NoError(suite.app.EvidenceKeeper.SubmitEvidence(ctx, &other))
+	suite.True(suite.app.EvidenceKeeper.HasOracleMisreport(ctx, consAddr, other.Feed, other.Height))
+}
@@ -0,0 +1,28 @@
+package v047
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2. The
+// migration includes:
+//
+// - Set the evidence policy params to their default values, light client
+// attacks being punished the same way as equivocations were, with the given
+// x/slashing double sign slash fraction.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, slashFractionDoubleSign sdk.Dec) error {
+	store := ctx.KVStore(storeKey)
+
+	params := types.DefaultParams()
+	params.LightClientAttack.SlashFraction = slashFractionDoubleSign
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+	return nil
+}
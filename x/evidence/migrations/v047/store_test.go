@@ -0,0 +1,33 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v047evidence "github.com/cosmos/cosmos-sdk/x/evidence/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+
+	// Run migrations.
+	slashFraction := sdk.NewDecWithPrec(2, 2)
+	require.NoError(t, v047evidence.MigrateStore(ctx, storeKey, encCfg.Codec, slashFraction))
+
+	// Make sure the default params are set in the module store, with the
+	// double sign slash fraction for light client attacks.
+	var res types.Params
+	bz := ctx.KVStore(storeKey).Get(types.ParamsKey)
+	require.NoError(t, encCfg.Codec.Unmarshal(bz, &res))
+	expected := types.DefaultParams()
+	expected.LightClientAttack.SlashFraction = slashFraction
+	require.Equal(t, expected, res)
+}
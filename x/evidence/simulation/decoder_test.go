@@ -31,12 +31,20 @@ func TestDecodeStore(t *testing.T) {
 	evBz, err := app.EvidenceKeeper.MarshalEvidence(ev)
 	require.NoError(t, err)
 
+	params := types.DefaultParams()
+	paramsBz, err := params.Marshal()
+	require.NoError(t, err)
+
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{
 				Key:   types.KeyPrefixEvidence,
 				Value: evBz,
 			},
+			{
+				Key:   types.ParamsKey,
+				Value: paramsBz,
+			},
 			{
 				Key:   []byte{0x99},
 				Value: []byte{0x99},
@@ -48,6 +56,7 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"Evidence", fmt.Sprintf("%v\n%v", ev, ev)},
+		{"Params", fmt.Sprintf("%v\n%v", params, params)},
 		{"other", ""},
 	}
 
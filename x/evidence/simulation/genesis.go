@@ -6,7 +6,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
@@ -14,13 +16,26 @@ import (
 )
 
 // Simulation parameter constants
-const evidence = "evidence"
+const (
+	evidence          = "evidence"
+	lightClientAttack = "light_client_attack"
+	oracleMisreport   = "oracle_misreport"
+)
 
 // GenEvidences returns an empty slice of evidences.
 func GenEvidences(_ *rand.Rand, _ []simtypes.Account) []exported.Evidence {
 	return []exported.Evidence{}
 }
 
+// GenEvidencePolicy randomized EvidencePolicy
+func GenEvidencePolicy(r *rand.Rand) types.EvidencePolicy {
+	return types.NewEvidencePolicy(
+		sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 10)), 2),
+		time.Duration(simtypes.RandIntBetween(r, 60, 3600))*time.Second,
+		r.Intn(2) == 0,
+	)
+}
+
 // RandomizedGenState generates a random GenesisState for evidence
 func RandomizedGenState(simState *module.SimulationState) {
 	var ev []exported.Evidence
@@ -30,7 +45,20 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { ev = GenEvidences(r, simState.Accounts) },
 	)
 
-	evidenceGenesis := types.NewGenesisState(ev)
+	var lightClientAttackPolicy types.EvidencePolicy
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, lightClientAttack, &lightClientAttackPolicy, simState.Rand,
+		func(r *rand.Rand) { lightClientAttackPolicy = GenEvidencePolicy(r) },
+	)
+
+	var oracleMisreportPolicy types.EvidencePolicy
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, oracleMisreport, &oracleMisreportPolicy, simState.Rand,
+		func(r *rand.Rand) { oracleMisreportPolicy = GenEvidencePolicy(r) },
+	)
+
+	params := types.NewParams(lightClientAttackPolicy, oracleMisreportPolicy)
+	evidenceGenesis := types.NewGenesisState(params, ev)
 
 	bz, err := json.MarshalIndent(&evidenceGenesis, "", " ")
 	if err != nil {
@@ -11,4 +11,5 @@ var (
 	ErrInvalidEvidence         = sdkerrors.Register(ModuleName, 3, "invalid evidence")
 	ErrNoEvidenceExists        = sdkerrors.Register(ModuleName, 4, "evidence does not exist")
 	ErrEvidenceExists          = sdkerrors.Register(ModuleName, 5, "evidence already exists")
+	ErrInvalidValidator        = sdkerrors.Register(ModuleName, 6, "invalid validator for evidence")
 )
@@ -10,16 +10,29 @@ import (
 	"sigs.k8s.io/yaml"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
 )
 
 // Evidence type constants
 const (
-	RouteEquivocation = "equivocation"
-	TypeEquivocation  = "equivocation"
+	RouteEquivocation      = "equivocation"
+	TypeEquivocation       = "equivocation"
+	RouteLightClientAttack = "lightclientattack"
+	TypeLightClientAttack  = "light_client_attack"
+	RouteOracleMisreport   = "oraclemisreport"
+	TypeOracleMisreport    = "oracle_misreport"
+
+	// MaxOracleFeedLength is the maximum length in bytes of the feed of an
+	// OracleMisreport, which is length-prefixed in the misreport store key.
+	MaxOracleFeedLength = address.MaxAddrLen
 )
 
-var _ exported.Evidence = &Equivocation{}
+var (
+	_ exported.Evidence          = &Equivocation{}
+	_ exported.ValidatorEvidence = &LightClientAttack{}
+	_ exported.Evidence          = &OracleMisreport{}
+)
 
 // Route returns the Evidence Handler route for an Equivocation type.
 func (e *Equivocation) Route() string { return RouteEquivocation }
@@ -101,3 +114,150 @@ func FromABCIEvidence(e abci.Evidence) exported.Evidence {
 		Time:             e.Time,
 	}
 }
+
+// Route returns the Evidence Handler route for a LightClientAttack type.
+func (e *LightClientAttack) Route() string { return RouteLightClientAttack }
+
+// Type returns the Evidence Handler type for a LightClientAttack type.
+func (e *LightClientAttack) Type() string { return TypeLightClientAttack }
+
+func (e *LightClientAttack) String() string {
+	bz, _ := yaml.Marshal(e)
+	return string(bz)
+}
+
+// Hash returns the hash of a LightClientAttack object.
+func (e *LightClientAttack) Hash() tmbytes.HexBytes {
+	bz, err := e.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return tmhash.Sum(bz)
+}
+
+// ValidateBasic performs basic stateless validation checks on a LightClientAttack object.
+func (e *LightClientAttack) ValidateBasic() error {
+	if e.Time.Unix() <= 0 {
+		return fmt.Errorf("invalid light client attack time: %s", e.Time)
+	}
+	if e.Height < 1 {
+		return fmt.Errorf("invalid light client attack height: %d", e.Height)
+	}
+	if e.Power < 1 {
+		return fmt.Errorf("invalid light client attack validator power: %d", e.Power)
+	}
+	if e.TotalPower < e.Power {
+		return fmt.Errorf("invalid light client attack total power: %d", e.TotalPower)
+	}
+	if e.ConsensusAddress == "" {
+		return fmt.Errorf("invalid light client attack validator consensus address: %s", e.ConsensusAddress)
+	}
+
+	return nil
+}
+
+// GetConsensusAddress returns the validator's consensus address at time of the
+// LightClientAttack infraction.
+func (e LightClientAttack) GetConsensusAddress() sdk.ConsAddress {
+	addr, _ := sdk.ConsAddressFromBech32(e.ConsensusAddress)
+	return addr
+}
+
+// GetHeight returns the height at time of the LightClientAttack infraction.
+func (e LightClientAttack) GetHeight() int64 {
+	return e.Height
+}
+
+// GetTime returns the time at time of the LightClientAttack infraction.
+func (e LightClientAttack) GetTime() time.Time {
+	return e.Time
+}
+
+// GetValidatorPower returns the validator's power at time of the
+// LightClientAttack infraction.
+func (e LightClientAttack) GetValidatorPower() int64 {
+	return e.Power
+}
+
+// GetTotalPower returns the total validator set power at time of the
+// LightClientAttack infraction.
+func (e LightClientAttack) GetTotalPower() int64 { return e.TotalPower }
+
+// Route returns the Evidence Handler route for an OracleMisreport type.
+func (e *OracleMisreport) Route() string { return RouteOracleMisreport }
+
+// Type returns the Evidence Handler type for an OracleMisreport type.
+func (e *OracleMisreport) Type() string { return TypeOracleMisreport }
+
+func (e *OracleMisreport) String() string {
+	bz, _ := yaml.Marshal(e)
+	return string(bz)
+}
+
+// Hash returns the hash of an OracleMisreport object.
+func (e *OracleMisreport) Hash() tmbytes.HexBytes {
+	bz, err := e.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return tmhash.Sum(bz)
+}
+
+// ValidateBasic performs basic stateless validation checks on an OracleMisreport object.
+func (e *OracleMisreport) ValidateBasic() error {
+	if e.Time.Unix() <= 0 {
+		return fmt.Errorf("invalid oracle misreport time: %s", e.Time)
+	}
+	if e.Height < 1 {
+		return fmt.Errorf("invalid oracle misreport height: %d", e.Height)
+	}
+	if _, err := sdk.ConsAddressFromBech32(e.ConsensusAddress); err != nil {
+		return fmt.Errorf("invalid oracle misreport validator consensus address: %w", err)
+	}
+	if e.Feed == "" {
+		return fmt.Errorf("oracle misreport feed cannot be blank")
+	}
+	if len(e.Feed) > MaxOracleFeedLength {
+		return fmt.Errorf("oracle misreport feed too long: %d > %d", len(e.Feed), MaxOracleFeedLength)
+	}
+	if e.ReportedValue == e.ExpectedValue {
+		return fmt.Errorf("oracle misreport reported value must differ from the expected value")
+	}
+
+	return nil
+}
+
+// GetConsensusAddress returns the validator's consensus address at time of the
+// OracleMisreport infraction.
+func (e OracleMisreport) GetConsensusAddress() sdk.ConsAddress {
+	addr, _ := sdk.ConsAddressFromBech32(e.ConsensusAddress)
+	return addr
+}
+
+// GetHeight returns the height at time of the OracleMisreport infraction.
+func (e OracleMisreport) GetHeight() int64 {
+	return e.Height
+}
+
+// GetTime returns the time at time of the OracleMisreport infraction.
+func (e OracleMisreport) GetTime() time.Time {
+	return e.Time
+}
+
+// LightClientAttackFromABCIEvidence converts a Tendermint light client attack
+// Evidence type to SDK Evidence using LightClientAttack as the concrete type.
+func LightClientAttackFromABCIEvidence(e abci.Evidence) exported.Evidence {
+	bech32PrefixConsAddr := sdk.GetConfig().GetBech32ConsensusAddrPrefix()
+	consAddr, err := sdk.Bech32ifyAddressBytes(bech32PrefixConsAddr, e.Validator.Address)
+	if err != nil {
+		panic(err)
+	}
+
+	return &LightClientAttack{
+		Height:           e.Height,
+		Power:            e.Validator.Power,
+		TotalPower:       e.TotalVotingPower,
+		ConsensusAddress: consAddr,
+		Time:             e.Time,
+	}
+}
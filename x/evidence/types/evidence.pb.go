@@ -6,9 +6,11 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -69,8 +71,217 @@ func (m *Equivocation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Equivocation proto.InternalMessageInfo
 
+// LightClientAttack implements the Evidence interface and defines evidence of a
+// light client attack, i.e. of a validator signing a conflicting block to fool
+// light clients, as reported by Tendermint.
+type LightClientAttack struct {
+	Height           int64     `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Time             time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	Power            int64     `protobuf:"varint,3,opt,name=power,proto3" json:"power,omitempty"`
+	TotalPower       int64     `protobuf:"varint,4,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+	ConsensusAddress string    `protobuf:"bytes,5,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+}
+
+func (m *LightClientAttack) Reset()      { *m = LightClientAttack{} }
+func (*LightClientAttack) ProtoMessage() {}
+func (*LightClientAttack) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{1}
+}
+func (m *LightClientAttack) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LightClientAttack) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LightClientAttack.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LightClientAttack) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightClientAttack.Merge(m, src)
+}
+func (m *LightClientAttack) XXX_Size() int {
+	return m.Size()
+}
+func (m *LightClientAttack) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightClientAttack.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LightClientAttack proto.InternalMessageInfo
+
+// OracleMisreport implements the Evidence interface and defines evidence of a
+// validator reporting a wrong value to an oracle. It is an example of an
+// application-defined evidence type, submitted with MsgSubmitEvidence and
+// verified by the application.
+type OracleMisreport struct {
+	Height           int64     `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Time             time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	ConsensusAddress string    `protobuf:"bytes,3,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+	// feed is the oracle feed the value was reported to.
+	Feed string `protobuf:"bytes,4,opt,name=feed,proto3" json:"feed,omitempty"`
+	// reported_value is the value reported by the validator.
+	ReportedValue string `protobuf:"bytes,5,opt,name=reported_value,json=reportedValue,proto3" json:"reported_value,omitempty"`
+	// expected_value is the value the validator should have reported.
+	ExpectedValue string `protobuf:"bytes,6,opt,name=expected_value,json=expectedValue,proto3" json:"expected_value,omitempty"`
+}
+
+func (m *OracleMisreport) Reset()      { *m = OracleMisreport{} }
+func (*OracleMisreport) ProtoMessage() {}
+func (*OracleMisreport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{2}
+}
+func (m *OracleMisreport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleMisreport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleMisreport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleMisreport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleMisreport.Merge(m, src)
+}
+func (m *OracleMisreport) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleMisreport) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleMisreport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleMisreport proto.InternalMessageInfo
+
+// EvidencePolicy defines how a validator is punished for the misbehavior
+// proven by a type of evidence.
+type EvidencePolicy struct {
+	// slash_fraction is the fraction of the stake of the validator to slash.
+	SlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction"`
+	// jail_duration is the duration the validator is jailed for. It is ignored if
+	// the validator is tombstoned.
+	JailDuration time.Duration `protobuf:"bytes,2,opt,name=jail_duration,json=jailDuration,proto3,stdduration" json:"jail_duration"`
+	// tombstone defines whether the validator is tombstoned, i.e. jailed forever.
+	Tombstone bool `protobuf:"varint,3,opt,name=tombstone,proto3" json:"tombstone,omitempty"`
+}
+
+func (m *EvidencePolicy) Reset()         { *m = EvidencePolicy{} }
+func (m *EvidencePolicy) String() string { return proto.CompactTextString(m) }
+func (*EvidencePolicy) ProtoMessage()    {}
+func (*EvidencePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{3}
+}
+func (m *EvidencePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvidencePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvidencePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EvidencePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvidencePolicy.Merge(m, src)
+}
+func (m *EvidencePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvidencePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvidencePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvidencePolicy proto.InternalMessageInfo
+
+func (m *EvidencePolicy) GetJailDuration() time.Duration {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
+}
+
+func (m *EvidencePolicy) GetTombstone() bool {
+	if m != nil {
+		return m.Tombstone
+	}
+	return false
+}
+
+// Params defines the parameters for the evidence module.
+type Params struct {
+	// light_client_attack is the policy for light client attack evidence.
+	LightClientAttack EvidencePolicy `protobuf:"bytes,1,opt,name=light_client_attack,json=lightClientAttack,proto3" json:"light_client_attack"`
+	// oracle_misreport is the policy for oracle misreport evidence.
+	OracleMisreport EvidencePolicy `protobuf:"bytes,2,opt,name=oracle_misreport,json=oracleMisreport,proto3" json:"oracle_misreport"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{4}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetLightClientAttack() EvidencePolicy {
+	if m != nil {
+		return m.LightClientAttack
+	}
+	return EvidencePolicy{}
+}
+
+func (m *Params) GetOracleMisreport() EvidencePolicy {
+	if m != nil {
+		return m.OracleMisreport
+	}
+	return EvidencePolicy{}
+}
+
 func init() {
 	proto.RegisterType((*Equivocation)(nil), "cosmos.evidence.v1beta1.Equivocation")
+	proto.RegisterType((*LightClientAttack)(nil), "cosmos.evidence.v1beta1.LightClientAttack")
+	proto.RegisterType((*OracleMisreport)(nil), "cosmos.evidence.v1beta1.OracleMisreport")
+	proto.RegisterType((*EvidencePolicy)(nil), "cosmos.evidence.v1beta1.EvidencePolicy")
+	proto.RegisterType((*Params)(nil), "cosmos.evidence.v1beta1.Params")
 }
 
 func init() {
@@ -78,30 +289,106 @@ func init() {
 }
 
 var fileDescriptor_dd143e71a177f0dd = []byte{
-	// 333 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x51, 0xbb, 0x4e, 0xf3, 0x30,
-	0x18, 0xb5, 0xff, 0xf6, 0xaf, 0x20, 0x74, 0x80, 0xa8, 0x82, 0xd0, 0xc1, 0xa9, 0x18, 0x50, 0x97,
-	0x26, 0x2a, 0x2c, 0x88, 0x8d, 0x4a, 0x9d, 0xd8, 0x0a, 0x13, 0x4b, 0x95, 0x8b, 0x71, 0x2d, 0x48,
-	0xbe, 0x10, 0x3b, 0x05, 0xde, 0x80, 0xb1, 0x23, 0x63, 0x47, 0x1e, 0x80, 0x87, 0xa8, 0xc4, 0x52,
-	0x31, 0x31, 0x01, 0x4a, 0x17, 0x1e, 0x03, 0xd5, 0x76, 0xcb, 0x64, 0x9f, 0xe3, 0x73, 0xb1, 0xfd,
-	0x59, 0x87, 0x11, 0x88, 0x04, 0x84, 0x4f, 0xc7, 0x3c, 0xa6, 0x69, 0x44, 0xfd, 0x71, 0x37, 0xa4,
-	0x32, 0xe8, 0xae, 0x09, 0x2f, 0xcb, 0x41, 0x82, 0xbd, 0xa7, 0x75, 0xde, 0x9a, 0x36, 0xba, 0x66,
-	0x83, 0x01, 0x03, 0xa5, 0xf1, 0x97, 0x3b, 0x2d, 0x6f, 0xba, 0x0c, 0x80, 0xdd, 0x52, 0x5f, 0xa1,
-	0xb0, 0xb8, 0xf6, 0x25, 0x4f, 0xa8, 0x90, 0x41, 0x92, 0x19, 0xc1, 0xbe, 0xce, 0x1b, 0x6a, 0xa7,
-	0x09, 0x57, 0xe0, 0xe0, 0x0d, 0x5b, 0xf5, 0xfe, 0x5d, 0xc1, 0xc7, 0x10, 0x05, 0x92, 0x43, 0x6a,
-	0xef, 0x5a, 0xb5, 0x11, 0xe5, 0x6c, 0x24, 0x1d, 0xdc, 0xc2, 0xed, 0xca, 0xc0, 0x20, 0xfb, 0xc4,
-	0xaa, 0x2e, 0x63, 0x9d, 0x7f, 0x2d, 0xdc, 0xde, 0x3a, 0x6a, 0x7a, 0xba, 0xd3, 0x5b, 0x75, 0x7a,
-	0x97, 0xab, 0xce, 0xde, 0xc6, 0xec, 0xd3, 0x45, 0x93, 0x2f, 0x17, 0x0f, 0x94, 0xc3, 0x6e, 0x58,
-	0xff, 0x33, 0xb8, 0xa7, 0xb9, 0x53, 0x51, 0x81, 0x1a, 0xd8, 0x7d, 0x6b, 0x27, 0x82, 0x54, 0xd0,
-	0x54, 0x14, 0x62, 0x18, 0xc4, 0x71, 0x4e, 0x85, 0x70, 0xaa, 0x2d, 0xdc, 0xde, 0xec, 0x39, 0xef,
-	0xaf, 0x9d, 0x86, 0xb9, 0xe5, 0x99, 0x3e, 0xb9, 0x90, 0x39, 0x4f, 0xd9, 0x60, 0x7b, 0x6d, 0x31,
-	0xfc, 0x69, 0xfd, 0x69, 0xea, 0xa2, 0xe7, 0xa9, 0x8b, 0x7e, 0xa6, 0x2e, 0xea, 0x9d, 0xbf, 0x94,
-	0x04, 0xcf, 0x4a, 0x82, 0xe7, 0x25, 0xc1, 0xdf, 0x25, 0xc1, 0x93, 0x05, 0x41, 0xf3, 0x05, 0x41,
-	0x1f, 0x0b, 0x82, 0xae, 0x3a, 0x8c, 0xcb, 0x51, 0x11, 0x7a, 0x11, 0x24, 0xe6, 0x13, 0xcc, 0xd2,
-	0x11, 0xf1, 0x8d, 0xff, 0xf0, 0x37, 0x16, 0xf9, 0x98, 0x51, 0x11, 0xd6, 0xd4, 0xdb, 0x8e, 0x7f,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0xbe, 0x32, 0xf0, 0x2c, 0xb6, 0x01, 0x00, 0x00,
+	// 631 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x4f, 0x4f, 0xd4, 0x40,
+	0x14, 0xef, 0xc0, 0xb2, 0x81, 0xe1, 0xff, 0x48, 0xb4, 0x10, 0xd3, 0x12, 0x12, 0x91, 0xcb, 0xb6,
+	0x01, 0x2f, 0xc6, 0x78, 0x61, 0x05, 0x63, 0xa2, 0x46, 0x52, 0x8d, 0x31, 0x26, 0xa6, 0x99, 0x9d,
+	0x0e, 0xdd, 0x4a, 0xdb, 0xa9, 0x9d, 0xe9, 0x0a, 0xdf, 0xc0, 0x23, 0x47, 0xe2, 0x89, 0xa3, 0x1f,
+	0x80, 0x2f, 0xe0, 0x8d, 0xc4, 0x0b, 0xe1, 0x64, 0x4c, 0x44, 0xb3, 0x5c, 0xbc, 0xfa, 0x0d, 0x4c,
+	0x67, 0xa6, 0x6c, 0x00, 0x31, 0x51, 0xa3, 0xa7, 0x76, 0x7e, 0xef, 0x37, 0xef, 0xcf, 0xef, 0xbd,
+	0x37, 0x70, 0x9e, 0x30, 0x9e, 0x30, 0xee, 0xd2, 0x4e, 0x14, 0xd0, 0x94, 0x50, 0xb7, 0xb3, 0xd8,
+	0xa2, 0x02, 0x2f, 0x9e, 0x00, 0x4e, 0x96, 0x33, 0xc1, 0xd0, 0x15, 0xc5, 0x73, 0x4e, 0x60, 0xcd,
+	0x9b, 0x99, 0x0a, 0x59, 0xc8, 0x24, 0xc7, 0x2d, 0xff, 0x14, 0x7d, 0xc6, 0x0a, 0x19, 0x0b, 0x63,
+	0xea, 0xca, 0x53, 0xab, 0x58, 0x77, 0x83, 0x22, 0xc7, 0x22, 0x62, 0xa9, 0xb6, 0xdb, 0x67, 0xed,
+	0x22, 0x4a, 0x28, 0x17, 0x38, 0xc9, 0x34, 0x61, 0x5a, 0xc5, 0xf3, 0x95, 0x67, 0x1d, 0x5c, 0x1e,
+	0xe6, 0x3e, 0x00, 0x38, 0xb2, 0xfa, 0xaa, 0x88, 0x3a, 0x8c, 0x48, 0x97, 0xe8, 0x32, 0xac, 0xb7,
+	0x69, 0x14, 0xb6, 0x85, 0x09, 0x66, 0xc1, 0x42, 0xbf, 0xa7, 0x4f, 0xe8, 0x26, 0xac, 0x95, 0x6e,
+	0xcd, 0xbe, 0x59, 0xb0, 0x30, 0xbc, 0x34, 0xe3, 0xa8, 0x98, 0x4e, 0x15, 0xd3, 0x79, 0x52, 0xc5,
+	0x6c, 0x0e, 0xee, 0x1f, 0xd9, 0xc6, 0xf6, 0x17, 0x1b, 0x78, 0xf2, 0x06, 0x9a, 0x82, 0x03, 0x19,
+	0x7b, 0x4d, 0x73, 0xb3, 0x5f, 0x3a, 0x54, 0x07, 0xb4, 0x0a, 0x27, 0x09, 0x4b, 0x39, 0x4d, 0x79,
+	0xc1, 0x7d, 0x1c, 0x04, 0x39, 0xe5, 0xdc, 0xac, 0xcd, 0x82, 0x85, 0xa1, 0xa6, 0x79, 0xb8, 0xd7,
+	0x98, 0xd2, 0x59, 0x2e, 0x2b, 0xcb, 0x63, 0x91, 0x47, 0x69, 0xe8, 0x4d, 0x9c, 0x5c, 0xd1, 0xf8,
+	0xad, 0x91, 0x37, 0xbb, 0xb6, 0xb1, 0xb3, 0x6b, 0x1b, 0xdf, 0x76, 0x6d, 0x63, 0xee, 0x3b, 0x80,
+	0x93, 0x0f, 0xca, 0x74, 0xef, 0xc4, 0x11, 0x4d, 0xc5, 0xb2, 0x10, 0x98, 0x6c, 0xfc, 0xb7, 0x92,
+	0x6c, 0x38, 0x2c, 0x98, 0xc0, 0xb1, 0xaf, 0x6c, 0x35, 0x69, 0x83, 0x12, 0x5a, 0xbb, 0xb8, 0xe6,
+	0x81, 0xbf, 0xac, 0xf9, 0x6d, 0x1f, 0x1c, 0x7f, 0x94, 0x63, 0x12, 0xd3, 0x87, 0x11, 0xcf, 0x69,
+	0xc6, 0x72, 0xf1, 0x0f, 0x2a, 0xfe, 0x69, 0xea, 0xfd, 0xbf, 0x9b, 0x3a, 0x42, 0xb0, 0xb6, 0x4e,
+	0x69, 0xa0, 0x1a, 0xed, 0xc9, 0x7f, 0x74, 0x0d, 0x8e, 0xa9, 0xb4, 0x69, 0xe0, 0x77, 0x70, 0x5c,
+	0x50, 0x25, 0x89, 0x37, 0x5a, 0xa1, 0x4f, 0x4b, 0xb0, 0xa4, 0xd1, 0xcd, 0x8c, 0x92, 0x1e, 0xad,
+	0xae, 0x68, 0x15, 0x2a, 0x69, 0x67, 0xc4, 0xf9, 0x0c, 0xe0, 0xd8, 0xaa, 0xde, 0xb2, 0x35, 0x16,
+	0x47, 0x64, 0x0b, 0x11, 0x38, 0xc6, 0x63, 0xcc, 0xdb, 0xfe, 0x7a, 0x8e, 0x49, 0x39, 0xf2, 0x52,
+	0xa3, 0xa1, 0xe6, 0xed, 0xb2, 0xe2, 0x4f, 0x47, 0xf6, 0x7c, 0x18, 0x89, 0x76, 0xd1, 0x72, 0x08,
+	0x4b, 0xf4, 0xaa, 0xe8, 0x4f, 0x83, 0x07, 0x1b, 0xae, 0xd8, 0xca, 0x28, 0x77, 0x56, 0x28, 0x39,
+	0xdc, 0x6b, 0x40, 0x5d, 0xf4, 0x0a, 0x25, 0xde, 0xa8, 0xf4, 0x79, 0x57, 0xbb, 0x44, 0xf7, 0xe0,
+	0xe8, 0x4b, 0x1c, 0xc5, 0x7e, 0xb5, 0xa9, 0x5a, 0xf1, 0xe9, 0x73, 0x8a, 0xaf, 0x68, 0x82, 0x12,
+	0x7c, 0xa7, 0x14, 0x7c, 0xa4, 0xbc, 0x59, 0xe1, 0xe8, 0x2a, 0x1c, 0x12, 0x2c, 0x69, 0x71, 0xc1,
+	0x52, 0x2a, 0x05, 0x1f, 0xf4, 0x7a, 0xc0, 0xdc, 0x7b, 0x00, 0xeb, 0x6b, 0x38, 0xc7, 0x09, 0x47,
+	0x2f, 0xe0, 0xa5, 0xb8, 0x6c, 0xb2, 0x4f, 0xe4, 0xec, 0xfb, 0x58, 0x0e, 0xbf, 0x2c, 0x6e, 0x78,
+	0xe9, 0xba, 0x73, 0xc1, 0x93, 0xe3, 0x9c, 0x56, 0xa7, 0x59, 0x2b, 0xd3, 0xf0, 0x26, 0xe3, 0x73,
+	0x4b, 0xf4, 0x0c, 0x4e, 0x30, 0x39, 0x65, 0x7e, 0x52, 0x8d, 0x99, 0xd9, 0xf7, 0x27, 0xbe, 0xc7,
+	0xd9, 0xe9, 0x61, 0x6d, 0xde, 0x7f, 0xd7, 0xb5, 0xc0, 0x7e, 0xd7, 0x02, 0x07, 0x5d, 0x0b, 0x7c,
+	0xed, 0x5a, 0x60, 0xfb, 0xd8, 0x32, 0x0e, 0x8e, 0x2d, 0xe3, 0xe3, 0xb1, 0x65, 0x3c, 0x6f, 0xfc,
+	0xb2, 0x1d, 0x9b, 0xbd, 0xb7, 0x56, 0x76, 0xa6, 0x55, 0x97, 0xca, 0xde, 0xf8, 0x31, 0x00, 0x15,
+	0xee, 0xaa, 0x43, 0x8b, 0x05, 0x00, 0x00,
+}
+
+func (this *EvidencePolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EvidencePolicy)
+	if !ok {
+		that2, ok := that.(EvidencePolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.SlashFraction.Equal(that1.SlashFraction) {
+		return false
+	}
+	if this.JailDuration != that1.JailDuration {
+		return false
+	}
+	if this.Tombstone != that1.Tombstone {
+		return false
+	}
+	return true
 }
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.LightClientAttack.Equal(&that1.LightClientAttack) {
+		return false
+	}
+	if !this.OracleMisreport.Equal(&that1.OracleMisreport) {
+		return false
+	}
+	return true
+}
 func (m *Equivocation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -147,48 +434,897 @@ func (m *Equivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x8
 	}
-	return len(dAtA) - i, nil
-}
+	return len(dAtA) - i, nil
+}
+
+func (m *LightClientAttack) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LightClientAttack) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LightClientAttack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TotalPower != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Power != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x18
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintEvidence(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleMisreport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleMisreport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleMisreport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExpectedValue) > 0 {
+		i -= len(m.ExpectedValue)
+		copy(dAtA[i:], m.ExpectedValue)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ExpectedValue)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ReportedValue) > 0 {
+		i -= len(m.ReportedValue)
+		copy(dAtA[i:], m.ReportedValue)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ReportedValue)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Feed) > 0 {
+		i -= len(m.Feed)
+		copy(dAtA[i:], m.Feed)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.Feed)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintEvidence(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvidencePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvidencePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvidencePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tombstone {
+		i--
+		if m.Tombstone {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.JailDuration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintEvidence(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvidence(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.OracleMisreport.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvidence(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.LightClientAttack.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvidence(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvidence(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Equivocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovEvidence(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovEvidence(uint64(l))
+	if m.Power != 0 {
+		n += 1 + sovEvidence(uint64(m.Power))
+	}
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
+
+func (m *LightClientAttack) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovEvidence(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovEvidence(uint64(l))
+	if m.Power != 0 {
+		n += 1 + sovEvidence(uint64(m.Power))
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovEvidence(uint64(m.TotalPower))
+	}
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
+
+func (m *OracleMisreport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovEvidence(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovEvidence(uint64(l))
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.Feed)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.ReportedValue)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.ExpectedValue)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
+
+func (m *EvidencePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovEvidence(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.JailDuration)
+	n += 1 + l + sovEvidence(uint64(l))
+	if m.Tombstone {
+		n += 2
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LightClientAttack.Size()
+	n += 1 + l + sovEvidence(uint64(l))
+	l = m.OracleMisreport.Size()
+	n += 1 + l + sovEvidence(uint64(l))
+	return n
+}
+
+func sovEvidence(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvidence(x uint64) (n int) {
+	return sovEvidence(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Equivocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Equivocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Equivocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LightClientAttack) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LightClientAttack: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LightClientAttack: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleMisreport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleMisreport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleMisreport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Feed = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportedValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReportedValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvidence(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Equivocation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovEvidence(uint64(m.Height))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
-	n += 1 + l + sovEvidence(uint64(l))
-	if m.Power != 0 {
-		n += 1 + sovEvidence(uint64(m.Power))
-	}
-	l = len(m.ConsensusAddress)
-	if l > 0 {
-		n += 1 + l + sovEvidence(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvidence(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvidence(x uint64) (n int) {
-	return sovEvidence(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Equivocation) Unmarshal(dAtA []byte) error {
+func (m *EvidencePolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -211,17 +1347,17 @@ func (m *Equivocation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Equivocation: wiretype end group for non-group")
+			return fmt.Errorf("proto: EvidencePolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Equivocation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EvidencePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvidence
@@ -231,14 +1367,29 @@ func (m *Equivocation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -265,15 +1416,15 @@ func (m *Equivocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.JailDuration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstone", wireType)
 			}
-			m.Power = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvidence
@@ -283,16 +1434,67 @@ func (m *Equivocation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Power |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			m.Tombstone = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LightClientAttack", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvidence
@@ -302,23 +1504,57 @@ func (m *Equivocation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvidence
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvidence
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.LightClientAttack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleMisreport", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OracleMisreport.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -88,3 +89,90 @@ func TestEvidenceAddressConversion(t *testing.T) {
 	require.Equal(t, tmEvidence.Validator.Address, consAddr.Bytes())
 	sdk.GetConfig().SetBech32PrefixForConsensusNode(sdk.Bech32PrefixConsAddr, sdk.Bech32PrefixConsPub)
 }
+
+func TestLightClientAttackValidateBasic(t *testing.T) {
+	var zeroTime time.Time
+	addr := sdk.ConsAddress("foo_________________")
+
+	n, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+	testCases := []struct {
+		name      string
+		e         types.LightClientAttack
+		expectErr bool
+	}{
+		{"valid", types.LightClientAttack{100, n, 1000000, 3000000, addr.String()}, false},
+		{"invalid time", types.LightClientAttack{100, zeroTime, 1000000, 3000000, addr.String()}, true},
+		{"invalid height", types.LightClientAttack{0, n, 1000000, 3000000, addr.String()}, true},
+		{"invalid power", types.LightClientAttack{100, n, 0, 3000000, addr.String()}, true},
+		{"invalid total power", types.LightClientAttack{100, n, 1000000, 100, addr.String()}, true},
+		{"invalid address", types.LightClientAttack{100, n, 1000000, 3000000, ""}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectErr, tc.e.ValidateBasic() != nil)
+		})
+	}
+}
+
+func TestOracleMisreportValidateBasic(t *testing.T) {
+	var zeroTime time.Time
+	addr := sdk.ConsAddress("foo_________________")
+
+	n, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+	testCases := []struct {
+		name      string
+		e         types.OracleMisreport
+		expectErr bool
+	}{
+		{"valid", types.OracleMisreport{100, n, addr.String(), "atom/usd", "100", "10"}, false},
+		{"invalid time", types.OracleMisreport{100, zeroTime, addr.String(), "atom/usd", "100", "10"}, true},
+		{"invalid height", types.OracleMisreport{0, n, addr.String(), "atom/usd", "100", "10"}, true},
+		{"invalid address", types.OracleMisreport{100, n, "foo", "atom/usd", "100", "10"}, true},
+		{"blank feed", types.OracleMisreport{100, n, addr.String(), "", "100", "10"}, true},
+		{"max length feed", types.OracleMisreport{100, n, addr.String(), strings.Repeat("a", types.MaxOracleFeedLength), "100", "10"}, false},
+		{"too long feed", types.OracleMisreport{100, n, addr.String(), strings.Repeat("a", types.MaxOracleFeedLength+1), "100", "10"}, true},
+		{"correct value", types.OracleMisreport{100, n, addr.String(), "atom/usd", "10", "10"}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectErr, tc.e.ValidateBasic() != nil)
+		})
+	}
+}
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		params    types.Params
+		expectErr bool
+	}{
+		{"default", types.DefaultParams(), false},
+		{
+			"negative slash fraction",
+			types.NewParams(types.NewEvidencePolicy(sdk.NewDec(-1), 0, true), types.DefaultOracleMisreportPolicy),
+			true,
+		},
+		{
+			"slash fraction above one",
+			types.NewParams(types.DefaultLightClientAttackPolicy, types.NewEvidencePolicy(sdk.NewDec(2), time.Hour, false)),
+			true,
+		},
+		{
+			"negative jail duration",
+			types.NewParams(types.DefaultLightClientAttackPolicy, types.NewEvidencePolicy(sdk.OneDec(), -time.Hour, false)),
+			true,
+		},
+		{"nil slash fraction", types.Params{}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectErr, tc.params.Validate() != nil)
+		})
+	}
+}
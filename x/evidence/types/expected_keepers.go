@@ -3,8 +3,11 @@ package types
 import (
 	"time"
 
+	"cosmossdk.io/math"
+
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -13,6 +16,7 @@ type (
 	// evidence module.
 	StakingKeeper interface {
 		ValidatorByConsAddr(sdk.Context, sdk.ConsAddress) stakingtypes.ValidatorI
+		PowerReduction(sdk.Context) math.Int
 	}
 
 	// SlashingKeeper defines the slashing module interface contract needed by the
@@ -24,7 +28,8 @@ type (
 		Tombstone(sdk.Context, sdk.ConsAddress)
 		Slash(sdk.Context, sdk.ConsAddress, sdk.Dec, int64, int64)
 		SlashFractionDoubleSign(sdk.Context) sdk.Dec
-		Jail(sdk.Context, sdk.ConsAddress)
+		JailWithReason(sdk.Context, sdk.ConsAddress, slashingtypes.InfractionType, string)
 		JailUntil(sdk.Context, sdk.ConsAddress, time.Time)
+		GetValidatorSigningInfo(sdk.Context, sdk.ConsAddress) (slashingtypes.ValidatorSigningInfo, bool)
 	}
 )
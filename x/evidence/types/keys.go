@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "evidence"
@@ -16,5 +21,17 @@ const (
 
 // KVStore key prefixes
 var (
-	KeyPrefixEvidence = []byte{0x00}
+	KeyPrefixEvidence        = []byte{0x00}
+	ParamsKey                = []byte{0x01}
+	KeyPrefixOracleMisreport = []byte{0x02}
 )
+
+// GetOracleMisreportKey returns the key indexing the oracle misreport of a
+// validator for a feed at a height: 0x02 | len(consAddr) | consAddr |
+// len(feed) | feed | height.
+func GetOracleMisreportKey(consAddr sdk.ConsAddress, feed string, height int64) []byte {
+	key := append([]byte{}, KeyPrefixOracleMisreport...)
+	key = append(key, address.MustLengthPrefix(consAddr)...)
+	key = append(key, address.MustLengthPrefix([]byte(feed))...)
+	return append(key, sdk.Uint64ToBigEndian(uint64(height))...)
+}
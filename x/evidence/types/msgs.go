@@ -14,10 +14,12 @@ import (
 // Message types for the evidence module
 const (
 	TypeMsgSubmitEvidence = "submit_evidence"
+	TypeMsgUpdateParams   = "update_params"
 )
 
 var (
 	_ sdk.Msg                       = &MsgSubmitEvidence{}
+	_ sdk.Msg                       = &MsgUpdateParams{}
 	_ types.UnpackInterfacesMessage = MsgSubmitEvidence{}
 	_ exported.MsgSubmitEvidenceI   = &MsgSubmitEvidence{}
 )
@@ -92,3 +94,29 @@ func (m MsgSubmitEvidence) UnpackInterfaces(ctx types.AnyUnpacker) error {
 	var evi exported.Evidence
 	return ctx.UnpackAny(m.Evidence, &evi)
 }
+
+// Route implements the sdk.Msg interface.
+func (m MsgUpdateParams) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (m MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+// GetSignBytes implements the sdk.Msg interface.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (m MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return m.Params.Validate()
+}
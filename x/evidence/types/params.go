@@ -1,11 +1,77 @@
 package types
 
 import (
+	"fmt"
 	"time"
-)
 
-// DONTCOVER
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
 
 // DoubleSignJailEndTime period ends at Max Time supported by Amino
 // (Dec 31, 9999 - 23:59:59 GMT).
 var DoubleSignJailEndTime = time.Unix(253402300799, 0)
+
+// Default parameter values
+var (
+	// DefaultLightClientAttackPolicy slashes light client attacks by the default
+	// double sign slash fraction of x/slashing and tombstones the validator, the
+	// same as equivocations.
+	DefaultLightClientAttackPolicy = NewEvidencePolicy(slashingtypes.DefaultSlashFractionDoubleSign, 0, true)
+	DefaultOracleMisreportPolicy   = NewEvidencePolicy(sdk.NewDecWithPrec(1, 2), 10*time.Minute, false)
+)
+
+// NewEvidencePolicy returns a new EvidencePolicy.
+func NewEvidencePolicy(slashFraction sdk.Dec, jailDuration time.Duration, tombstone bool) EvidencePolicy {
+	return EvidencePolicy{
+		SlashFraction: slashFraction,
+		JailDuration:  jailDuration,
+		Tombstone:     tombstone,
+	}
+}
+
+// Validate performs basic validation on an evidence policy.
+func (p EvidencePolicy) Validate() error {
+	if p.SlashFraction.IsNil() || p.SlashFraction.IsNegative() || p.SlashFraction.GT(sdk.OneDec()) {
+		return fmt.Errorf("slash fraction must be between 0 and 1: %s", p.SlashFraction)
+	}
+	if p.JailDuration < 0 {
+		return fmt.Errorf("jail duration cannot be negative: %s", p.JailDuration)
+	}
+
+	return nil
+}
+
+// JailEndTime returns the time a validator punished at the given time is
+// jailed until.
+func (p EvidencePolicy) JailEndTime(infractionTime time.Time) time.Time {
+	if p.Tombstone {
+		return DoubleSignJailEndTime
+	}
+	return infractionTime.Add(p.JailDuration)
+}
+
+// NewParams creates a new Params instance.
+func NewParams(lightClientAttack, oracleMisreport EvidencePolicy) Params {
+	return Params{
+		LightClientAttack: lightClientAttack,
+		OracleMisreport:   oracleMisreport,
+	}
+}
+
+// DefaultParams returns default evidence parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultLightClientAttackPolicy, DefaultOracleMisreportPolicy)
+}
+
+// Validate performs basic validation on evidence parameters.
+func (p Params) Validate() error {
+	if err := p.LightClientAttack.Validate(); err != nil {
+		return fmt.Errorf("invalid light client attack policy: %w", err)
+	}
+	if err := p.OracleMisreport.Validate(); err != nil {
+		return fmt.Errorf("invalid oracle misreport policy: %w", err)
+	}
+
+	return nil
+}
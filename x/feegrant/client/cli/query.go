@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -27,6 +28,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryFeeGrant(),
 		GetCmdQueryFeeGrantsByGrantee(),
 		GetCmdQueryFeeGrantsByGranter(),
+		GetCmdQueryFeePool(),
+		GetCmdQueryFeePools(),
 	)
 
 	return feegrantQueryCmd
@@ -175,3 +178,85 @@ $ %s query feegrant grants-by-granter [granter]
 
 	return cmd
 }
+
+// GetCmdQueryFeePool returns cmd to query for a fee pool.
+func GetCmdQueryFeePool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-pool [pool-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a fee pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries the fee pool with the given ID.
+
+Example:
+$ %s query feegrant fee-pool [pool-id]
+`, version.AppName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := feegrant.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FeePool(
+				cmd.Context(),
+				&feegrant.QueryFeePoolRequest{
+					PoolId: poolID,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.FeePool)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryFeePools returns cmd to query for all fee pools.
+func GetCmdQueryFeePools() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-pools",
+		Args:  cobra.NoArgs,
+		Short: "Query all fee pools",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries all the fee pools.
+
+Example:
+$ %s query feegrant fee-pools
+`, version.AppName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := feegrant.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FeePools(
+				cmd.Context(),
+				&feegrant.QueryFeePoolsRequest{
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "fee pools")
+
+	return cmd
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -22,6 +23,9 @@ const (
 	FlagPeriodLimit = "period-limit"
 	FlagSpendLimit  = "spend-limit"
 	FlagAllowedMsgs = "allowed-messages"
+	FlagPredicates  = "field-predicates"
+	FlagMaxGas      = "max-gas"
+	FlagMaxFee      = "max-fee"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -38,6 +42,11 @@ func GetTxCmd() *cobra.Command {
 	feegrantTxCmd.AddCommand(
 		NewCmdFeeGrant(),
 		NewCmdRevokeFeegrant(),
+		NewCmdCreateFeePool(),
+		NewCmdDepositFeePool(),
+		NewCmdWithdrawFeePool(),
+		NewCmdGrantPoolAllowance(),
+		NewCmdRevokePoolAllowance(),
 	)
 
 	return feegrantTxCmd
@@ -57,8 +66,10 @@ Examples:
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --period 3600 --period-limit 10stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z 
-	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote"
-				`, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
+	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote" or
+%s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --max-gas 200000 --max-fee 10stake
+	--field-predicates "/cosmos.bank.v1beta1.MsgSend:to_address=cosmos1skjw..."
+				`, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
 			),
 		),
 		Args: cobra.ExactArgs(2),
@@ -75,124 +86,247 @@ Examples:
 			}
 
 			granter := clientCtx.GetFromAddress()
-			sl, err := cmd.Flags().GetString(FlagSpendLimit)
+			grant, err := getFeeAllowance(cmd)
 			if err != nil {
 				return err
 			}
 
-			// if `FlagSpendLimit` isn't set, limit will be nil
-			limit, err := sdk.ParseCoinsNormalized(sl)
+			msg, err := feegrant.NewMsgGrantAllowance(grant, granter, grantee)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	addFeeAllowanceFlags(cmd)
+	return cmd
+}
+
+// NewCmdRevokeFeegrant returns a CLI command handler for creating a MsgRevokeAllowance transaction.
+func NewCmdRevokeFeegrant() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [granter] [grantee]",
+		Short: "revoke fee-grant",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`revoke fee grant from a granter to a grantee. Note, the'--from' flag is
+			ignored as it is implied from [granter].
+
+Example:
+ $ %s tx %s revoke cosmos1skj.. cosmos1skj..
+			`, version.AppName, feegrant.ModuleName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			exp, err := cmd.Flags().GetString(FlagExpiration)
+			grantee, err := sdk.AccAddressFromBech32(args[1])
 			if err != nil {
 				return err
 			}
 
-			basic := feegrant.BasicAllowance{
-				SpendLimit: limit,
+			msg := feegrant.NewMsgRevokeAllowance(clientCtx.GetFromAddress(), grantee)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdCreateFeePool returns a CLI command handler for creating a MsgCreateFeePool transaction.
+func NewCmdCreateFeePool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-fee-pool [admin] [metadata]",
+		Short: "Create a fee pool administered by an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a fee pool administered by [admin], e.g. a group policy address. Note, the'--from' flag is
+			ignored as it is implied from [admin].
+
+Example:
+ $ %s tx %s create-fee-pool cosmos1skj.. "community fee pool"
+			`, version.AppName, feegrant.ModuleName),
+		),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
 			}
 
-			var expiresAtTime time.Time
-			if exp != "" {
-				expiresAtTime, err = time.Parse(time.RFC3339, exp)
-				if err != nil {
-					return err
-				}
-				basic.Expiration = &expiresAtTime
+			var metadata string
+			if len(args) > 1 {
+				metadata = args[1]
 			}
 
-			var grant feegrant.FeeAllowanceI
-			grant = &basic
+			msg := feegrant.NewMsgCreateFeePool(clientCtx.GetFromAddress(), metadata)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdDepositFeePool returns a CLI command handler for creating a MsgDepositFeePool transaction.
+func NewCmdDepositFeePool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-fee-pool [pool-id] [amount]",
+		Short: "Deposit funds into a fee pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Deposit funds from the '--from' address into a fee pool.
 
-			periodClock, err := cmd.Flags().GetInt64(FlagPeriod)
+Example:
+ $ %s tx %s deposit-fee-pool 1 1000stake --from mykey
+			`, version.AppName, feegrant.ModuleName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			periodLimitVal, err := cmd.Flags().GetString(FlagPeriodLimit)
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
 			if err != nil {
 				return err
 			}
 
-			// Check any of period or periodLimit flags set, If set consider it as periodic fee allowance.
-			if periodClock > 0 || periodLimitVal != "" {
-				periodLimit, err := sdk.ParseCoinsNormalized(periodLimitVal)
-				if err != nil {
-					return err
-				}
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
 
-				if periodClock <= 0 {
-					return fmt.Errorf("period clock was not set")
-				}
+			msg := feegrant.NewMsgDepositFeePool(clientCtx.GetFromAddress(), poolID, amount)
 
-				if periodLimit == nil {
-					return fmt.Errorf("period limit was not set")
-				}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
 
-				periodReset := getPeriodReset(periodClock)
-				if exp != "" && periodReset.Sub(expiresAtTime) > 0 {
-					return fmt.Errorf("period (%d) cannot reset after expiration (%v)", periodClock, exp)
-				}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
 
-				periodic := feegrant.PeriodicAllowance{
-					Basic:            basic,
-					Period:           getPeriod(periodClock),
-					PeriodReset:      getPeriodReset(periodClock),
-					PeriodSpendLimit: periodLimit,
-					PeriodCanSpend:   periodLimit,
-				}
+// NewCmdWithdrawFeePool returns a CLI command handler for creating a MsgWithdrawFeePool transaction.
+func NewCmdWithdrawFeePool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-fee-pool [admin] [pool-id] [recipient] [amount]",
+		Short: "Withdraw funds from a fee pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw funds from a fee pool to a recipient. Note, the'--from' flag is
+			ignored as it is implied from [admin].
 
-				grant = &periodic
+Example:
+ $ %s tx %s withdraw-fee-pool cosmos1skj.. 1 cosmos1skj.. 1000stake
+			`, version.AppName, feegrant.ModuleName),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
 			}
 
-			allowedMsgs, err := cmd.Flags().GetStringSlice(FlagAllowedMsgs)
+			poolID, err := strconv.ParseUint(args[1], 10, 64)
 			if err != nil {
 				return err
 			}
 
-			if len(allowedMsgs) > 0 {
-				grant, err = feegrant.NewAllowedMsgAllowance(grant, allowedMsgs)
-				if err != nil {
-					return err
-				}
+			recipient, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
 			}
 
-			msg, err := feegrant.NewMsgGrantAllowance(grant, granter, grantee)
+			amount, err := sdk.ParseCoinsNormalized(args[3])
 			if err != nil {
 				return err
 			}
 
+			msg := feegrant.NewMsgWithdrawFeePool(clientCtx.GetFromAddress(), poolID, recipient, amount)
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
-	cmd.Flags().StringSlice(FlagAllowedMsgs, []string{}, "Set of allowed messages for fee allowance")
-	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 timestamp after which the grant expires for the user")
-	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max limit can be used, if not mentioned there is no limit")
-	cmd.Flags().Int64(FlagPeriod, 0, "period specifies the time duration(in seconds) in which period_limit coins can be spent before that allowance is reset (ex: 3600)")
-	cmd.Flags().String(FlagPeriodLimit, "", "period limit specifies the maximum number of coins that can be spent in the period")
+	return cmd
+}
+
+// NewCmdGrantPoolAllowance returns a CLI command handler for creating a MsgGrantPoolAllowance transaction.
+func NewCmdGrantPoolAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-pool-allowance [admin] [pool-id] [grantee]",
+		Short: "Grant fee allowance from a fee pool to an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant authorization to pay fees from a fee pool. It takes the same allowance
+			flags as the grant command. Note, the'--from' flag is ignored as it is implied from [admin].
+
+Example:
+ $ %s tx %s grant-pool-allowance cosmos1skj.. 1 cosmos1skj.. --spend-limit 100stake
+			`, version.AppName, feegrant.ModuleName),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			grant, err := getFeeAllowance(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := feegrant.NewMsgGrantPoolAllowance(grant, clientCtx.GetFromAddress(), poolID, grantee)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	addFeeAllowanceFlags(cmd)
 
 	return cmd
 }
 
-// NewCmdRevokeFeegrant returns a CLI command handler for creating a MsgRevokeAllowance transaction.
-func NewCmdRevokeFeegrant() *cobra.Command {
+// NewCmdRevokePoolAllowance returns a CLI command handler for creating a MsgRevokePoolAllowance transaction.
+func NewCmdRevokePoolAllowance() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "revoke [granter] [grantee]",
-		Short: "revoke fee-grant",
+		Use:   "revoke-pool-allowance [admin] [pool-id] [grantee]",
+		Short: "revoke fee allowance from a fee pool",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`revoke fee grant from a granter to a grantee. Note, the'--from' flag is
-			ignored as it is implied from [granter].
+			fmt.Sprintf(`revoke fee allowance from a fee pool to a grantee. Note, the'--from' flag is
+			ignored as it is implied from [admin].
 
 Example:
- $ %s tx %s revoke cosmos1skj.. cosmos1skj..
+ $ %s tx %s revoke-pool-allowance cosmos1skj.. 1 cosmos1skj..
 			`, version.AppName, feegrant.ModuleName),
 		),
-		Args: cobra.ExactArgs(2),
+		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.Flags().Set(flags.FlagFrom, args[0])
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -200,12 +334,17 @@ Example:
 				return err
 			}
 
-			grantee, err := sdk.AccAddressFromBech32(args[1])
+			poolID, err := strconv.ParseUint(args[1], 10, 64)
 			if err != nil {
 				return err
 			}
 
-			msg := feegrant.NewMsgRevokeAllowance(clientCtx.GetFromAddress(), grantee)
+			grantee, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := feegrant.NewMsgRevokePoolAllowance(clientCtx.GetFromAddress(), poolID, grantee)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
@@ -215,6 +354,140 @@ Example:
 	return cmd
 }
 
+// getFeeAllowance builds the fee allowance from the allowance flags.
+func getFeeAllowance(cmd *cobra.Command) (feegrant.FeeAllowanceI, error) {
+	sl, err := cmd.Flags().GetString(FlagSpendLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	// if `FlagSpendLimit` isn't set, limit will be nil
+	limit, err := sdk.ParseCoinsNormalized(sl)
+	if err != nil {
+		return nil, err
+	}
+
+	exp, err := cmd.Flags().GetString(FlagExpiration)
+	if err != nil {
+		return nil, err
+	}
+
+	basic := feegrant.BasicAllowance{
+		SpendLimit: limit,
+	}
+
+	var expiresAtTime time.Time
+	if exp != "" {
+		expiresAtTime, err = time.Parse(time.RFC3339, exp)
+		if err != nil {
+			return nil, err
+		}
+		basic.Expiration = &expiresAtTime
+	}
+
+	var grant feegrant.FeeAllowanceI
+	grant = &basic
+
+	periodClock, err := cmd.Flags().GetInt64(FlagPeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	periodLimitVal, err := cmd.Flags().GetString(FlagPeriodLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check any of period or periodLimit flags set, If set consider it as periodic fee allowance.
+	if periodClock > 0 || periodLimitVal != "" {
+		periodLimit, err := sdk.ParseCoinsNormalized(periodLimitVal)
+		if err != nil {
+			return nil, err
+		}
+
+		if periodClock <= 0 {
+			return nil, fmt.Errorf("period clock was not set")
+		}
+
+		if periodLimit == nil {
+			return nil, fmt.Errorf("period limit was not set")
+		}
+
+		periodReset := getPeriodReset(periodClock)
+		if exp != "" && periodReset.Sub(expiresAtTime) > 0 {
+			return nil, fmt.Errorf("period (%d) cannot reset after expiration (%v)", periodClock, exp)
+		}
+
+		periodic := feegrant.PeriodicAllowance{
+			Basic:            basic,
+			Period:           getPeriod(periodClock),
+			PeriodReset:      getPeriodReset(periodClock),
+			PeriodSpendLimit: periodLimit,
+			PeriodCanSpend:   periodLimit,
+		}
+
+		grant = &periodic
+	}
+
+	allowedMsgs, err := cmd.Flags().GetStringSlice(FlagAllowedMsgs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(allowedMsgs) > 0 {
+		grant, err = feegrant.NewAllowedMsgAllowance(grant, allowedMsgs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	predicates, err := cmd.Flags().GetStringSlice(FlagPredicates)
+	if err != nil {
+		return nil, err
+	}
+	maxGas, err := cmd.Flags().GetUint64(FlagMaxGas)
+	if err != nil {
+		return nil, err
+	}
+	maxFeeStr, err := cmd.Flags().GetString(FlagMaxFee)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(predicates) > 0 || maxGas > 0 || maxFeeStr != "" {
+		fieldPredicates := make([]feegrant.FieldPredicate, len(predicates))
+		for i, p := range predicates {
+			if fieldPredicates[i], err = parseFieldPredicate(p); err != nil {
+				return nil, err
+			}
+		}
+		maxFee, err := sdk.ParseCoinsNormalized(maxFeeStr)
+		if err != nil {
+			return nil, err
+		}
+
+		grant, err = feegrant.NewFieldPredicateAllowance(grant, fieldPredicates, maxGas, maxFee)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return grant, nil
+}
+
+// addFeeAllowanceFlags adds the flags used by getFeeAllowance to the command.
+func addFeeAllowanceFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice(FlagAllowedMsgs, []string{}, "Set of allowed messages for fee allowance")
+	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 timestamp after which the grant expires for the user")
+	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max limit can be used, if not mentioned there is no limit")
+	cmd.Flags().Int64(FlagPeriod, 0, "period specifies the time duration(in seconds) in which period_limit coins can be spent before that allowance is reset (ex: 3600)")
+	cmd.Flags().String(FlagPeriodLimit, "", "period limit specifies the maximum number of coins that can be spent in the period")
+	cmd.Flags().StringSlice(FlagPredicates, []string{}, "Set of field predicates ([msg-type-url:]field.path=value), each message must match one of them")
+	cmd.Flags().Uint64(FlagMaxGas, 0, "max gas specifies the maximum gas limit of each transaction")
+	cmd.Flags().String(FlagMaxFee, "", "max fee specifies the maximum fee of each transaction")
+
+}
+
 func getPeriodReset(duration int64) time.Time {
 	return time.Now().Add(getPeriod(duration))
 }
@@ -222,3 +495,21 @@ func getPeriodReset(duration int64) time.Time {
 func getPeriod(duration int64) time.Duration {
 	return time.Duration(duration) * time.Second
 }
+
+// parseFieldPredicate parses a field predicate in the [msg-type-url:]field.path=value format.
+func parseFieldPredicate(s string) (feegrant.FieldPredicate, error) {
+	field, value, found := strings.Cut(s, "=")
+	if !found {
+		return feegrant.FieldPredicate{}, fmt.Errorf("invalid field predicate %s, expected [msg-type-url:]field.path=value", s)
+	}
+
+	var predicate feegrant.FieldPredicate
+	if msgType, path, found := strings.Cut(field, ":"); found {
+		predicate.MsgTypeUrl, predicate.FieldPath = msgType, path
+	} else {
+		predicate.FieldPath = field
+	}
+	predicate.Value = value
+
+	return predicate, predicate.ValidateBasic()
+}
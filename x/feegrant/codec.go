@@ -15,11 +15,17 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgGrantAllowance{}, "cosmos-sdk/MsgGrantAllowance")
 	legacy.RegisterAminoMsg(cdc, &MsgRevokeAllowance{}, "cosmos-sdk/MsgRevokeAllowance")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateFeePool{}, "cosmos-sdk/MsgCreateFeePool")
+	legacy.RegisterAminoMsg(cdc, &MsgDepositFeePool{}, "cosmos-sdk/MsgDepositFeePool")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawFeePool{}, "cosmos-sdk/MsgWithdrawFeePool")
+	legacy.RegisterAminoMsg(cdc, &MsgGrantPoolAllowance{}, "cosmos-sdk/MsgGrantPoolAllowance")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokePoolAllowance{}, "cosmos-sdk/MsgRevokePoolAllowance")
 
 	cdc.RegisterInterface((*FeeAllowanceI)(nil), nil)
 	cdc.RegisterConcrete(&BasicAllowance{}, "cosmos-sdk/BasicAllowance", nil)
 	cdc.RegisterConcrete(&PeriodicAllowance{}, "cosmos-sdk/PeriodicAllowance", nil)
 	cdc.RegisterConcrete(&AllowedMsgAllowance{}, "cosmos-sdk/AllowedMsgAllowance", nil)
+	cdc.RegisterConcrete(&FieldPredicateAllowance{}, "cosmos-sdk/FieldPredicateAllowance", nil)
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry
@@ -27,6 +33,11 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgGrantAllowance{},
 		&MsgRevokeAllowance{},
+		&MsgCreateFeePool{},
+		&MsgDepositFeePool{},
+		&MsgWithdrawFeePool{},
+		&MsgGrantPoolAllowance{},
+		&MsgRevokePoolAllowance{},
 	)
 
 	registry.RegisterInterface(
@@ -35,6 +46,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&BasicAllowance{},
 		&PeriodicAllowance{},
 		&AllowedMsgAllowance{},
+		&FieldPredicateAllowance{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
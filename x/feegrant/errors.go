@@ -22,4 +22,10 @@ var (
 	ErrNoMessages = sdkerrors.Register(DefaultCodespace, 6, "allowed messages are empty")
 	// ErrMessageNotAllowed error if message is not allowed
 	ErrMessageNotAllowed = sdkerrors.Register(DefaultCodespace, 7, "message not allowed")
+	// ErrInvalidPredicate error if a field predicate is invalid
+	ErrInvalidPredicate = sdkerrors.Register(DefaultCodespace, 8, "invalid field predicate")
+	// ErrInvalidFeePool error if a fee pool is invalid
+	ErrInvalidFeePool = sdkerrors.Register(DefaultCodespace, 9, "invalid fee pool")
+	// ErrFeePoolNotFound error if a fee pool doesn't exist
+	ErrFeePoolNotFound = sdkerrors.Register(DefaultCodespace, 10, "fee pool not found")
 )
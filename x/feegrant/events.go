@@ -2,13 +2,20 @@ package feegrant
 
 // evidence module events
 const (
-	EventTypeUseFeeGrant    = "use_feegrant"
-	EventTypeRevokeFeeGrant = "revoke_feegrant"
-	EventTypeSetFeeGrant    = "set_feegrant"
-	EventTypeUpdateFeeGrant = "update_feegrant"
+	EventTypeUseFeeGrant     = "use_feegrant"
+	EventTypeRevokeFeeGrant  = "revoke_feegrant"
+	EventTypeSetFeeGrant     = "set_feegrant"
+	EventTypeUpdateFeeGrant  = "update_feegrant"
+	EventTypeCreateFeePool   = "create_fee_pool"
+	EventTypeDepositFeePool  = "deposit_fee_pool"
+	EventTypeWithdrawFeePool = "withdraw_fee_pool"
 
-	AttributeKeyGranter = "granter"
-	AttributeKeyGrantee = "grantee"
+	AttributeKeyGranter   = "granter"
+	AttributeKeyGrantee   = "grantee"
+	AttributeKeyPoolID    = "pool_id"
+	AttributeKeyAdmin     = "admin"
+	AttributeKeyDepositor = "depositor"
+	AttributeKeyRecipient = "recipient"
 
 	AttributeValueCategory = ModuleName
 )
@@ -10,6 +10,7 @@ type AccountKeeper interface {
 	GetModuleAddress(moduleName string) sdk.AccAddress
 	GetModuleAccount(ctx sdk.Context, moduleName string) auth.ModuleAccountI
 
+	NewAccount(ctx sdk.Context, acc auth.AccountI) auth.AccountI
 	NewAccountWithAddress(ctx sdk.Context, addr sdk.AccAddress) auth.AccountI
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) auth.AccountI
 	SetAccount(ctx sdk.Context, acc auth.AccountI)
@@ -19,4 +20,5 @@ type AccountKeeper interface {
 type BankKeeper interface {
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 }
@@ -0,0 +1,27 @@
+package feegrant
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// FeePoolAddress returns the account address of the fee pool with the given ID.
+func FeePoolAddress(poolID uint64) sdk.AccAddress {
+	return address.Module(ModuleName, FeePoolKey(poolID))
+}
+
+// ValidateBasic performs basic validation of the fee pool.
+func (p FeePool) ValidateBasic() error {
+	if p.Id == 0 {
+		return sdkerrors.Wrap(ErrInvalidFeePool, "pool id cannot be zero")
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid pool address: %s", err)
+	}
+
+	return nil
+}
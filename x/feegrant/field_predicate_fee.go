@@ -0,0 +1,228 @@
+package feegrant
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ FeeAllowanceI                 = (*FieldPredicateAllowance)(nil)
+	_ types.UnpackInterfacesMessage = (*FieldPredicateAllowance)(nil)
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *FieldPredicateAllowance) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// NewFieldPredicateAllowance creates new field predicate fee allowance.
+func NewFieldPredicateAllowance(allowance FeeAllowanceI, predicates []FieldPredicate, maxGas uint64, maxFee sdk.Coins) (*FieldPredicateAllowance, error) {
+	msg, ok := allowance.(proto.Message)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", msg)
+	}
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FieldPredicateAllowance{
+		Allowance:  any,
+		Predicates: predicates,
+		MaxGas:     maxGas,
+		MaxFee:     maxFee,
+	}, nil
+}
+
+// GetAllowance returns allowed fee allowance.
+func (a *FieldPredicateAllowance) GetAllowance() (FeeAllowanceI, error) {
+	allowance, ok := a.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// SetAllowance sets allowed fee allowance.
+func (a *FieldPredicateAllowance) SetAllowance(allowance FeeAllowanceI) error {
+	var err error
+	a.Allowance, err = types.NewAnyWithValue(allowance.(proto.Message))
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", allowance)
+	}
+
+	return nil
+}
+
+// Accept method checks the gas and fee caps of the transaction and that each
+// message matches at least one of the field predicates.
+func (a *FieldPredicateAllowance) Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	// The gas meter limit is the gas limit of the transaction, unless gas isn't
+	// metered, e.g. when simulating.
+	if gasLimit := ctx.GasMeter().Limit(); a.MaxGas > 0 && gasLimit != math.MaxUint64 && gasLimit > a.MaxGas {
+		return false, sdkerrors.Wrapf(ErrFeeLimitExceeded, "gas limit %d is more than max gas %d", gasLimit, a.MaxGas)
+	}
+	if !a.MaxFee.Empty() && !fee.IsAllLTE(a.MaxFee) {
+		return false, sdkerrors.Wrapf(ErrFeeLimitExceeded, "fee %s is more than max fee %s", fee, a.MaxFee)
+	}
+
+	for _, msg := range msgs {
+		if !a.msgAllowed(ctx, msg) {
+			return false, sdkerrors.Wrapf(ErrMessageNotAllowed, "message %s does not match any field predicate", sdk.MsgTypeURL(msg))
+		}
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return false, err
+	}
+
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if err == nil && !remove {
+		if err = a.SetAllowance(allowance); err != nil {
+			return false, err
+		}
+	}
+	return remove, err
+}
+
+func (a *FieldPredicateAllowance) msgAllowed(ctx sdk.Context, msg sdk.Msg) bool {
+	msgType := sdk.MsgTypeURL(msg)
+	for _, p := range a.Predicates {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check field predicate")
+		if p.MsgTypeUrl != "" && p.MsgTypeUrl != msgType {
+			continue
+		}
+		if p.matches(msg) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a *FieldPredicateAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return sdkerrors.Wrap(ErrNoAllowance, "allowance should not be empty")
+	}
+	if len(a.Predicates) == 0 {
+		return sdkerrors.Wrap(ErrInvalidPredicate, "predicates shouldn't be empty")
+	}
+	for _, p := range a.Predicates {
+		if err := p.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	if !a.MaxFee.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "max fee is invalid: %s", a.MaxFee)
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
+
+func (a *FieldPredicateAllowance) ExpiresAt() (*time.Time, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+	return allowance.ExpiresAt()
+}
+
+// ValidateBasic performs basic validation of the field predicate.
+func (p FieldPredicate) ValidateBasic() error {
+	if p.FieldPath == "" {
+		return sdkerrors.Wrap(ErrInvalidPredicate, "field path shouldn't be empty")
+	}
+	for _, name := range strings.Split(p.FieldPath, ".") {
+		if name == "" {
+			return sdkerrors.Wrapf(ErrInvalidPredicate, "invalid field path %s", p.FieldPath)
+		}
+	}
+
+	return nil
+}
+
+// matches returns true if the field of the message is equal to the value. All
+// the elements of repeated fields must be equal to the value.
+func (p FieldPredicate) matches(msg sdk.Msg) bool {
+	values, ok := fieldValues(reflect.ValueOf(msg), strings.Split(p.FieldPath, "."))
+	if !ok || len(values) == 0 {
+		return false
+	}
+	for _, v := range values {
+		if v != p.Value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// fieldValues returns the string representations of the values found at the
+// given proto field path. Any fields are not inspected.
+func fieldValues(v reflect.Value, path []string) ([]string, bool) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil, false
+		}
+		v = v.Elem()
+	}
+
+	isRepeated := (v.Kind() == reflect.Slice || v.Kind() == reflect.Array) && v.Type().Elem().Kind() != reflect.Uint8
+	if len(path) == 0 && !isRepeated {
+		if s, ok := v.Interface().(fmt.Stringer); ok {
+			return []string{s.String()}, true
+		}
+		return []string{fmt.Sprint(v.Interface())}, true
+	}
+
+	switch {
+	case isRepeated:
+		var values []string
+		for i := 0; i < v.Len(); i++ {
+			elemValues, ok := fieldValues(v.Index(i), path)
+			if !ok {
+				return nil, false
+			}
+			values = append(values, elemValues...)
+		}
+		return values, true
+
+	case v.Kind() == reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if protoFieldName(v.Type().Field(i)) == path[0] {
+				return fieldValues(v.Field(i), path[1:])
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// protoFieldName returns the proto name of a generated struct field.
+func protoFieldName(field reflect.StructField) string {
+	for _, part := range strings.Split(field.Tag.Get("protobuf"), ",") {
+		if strings.HasPrefix(part, "name=") {
+			return strings.TrimPrefix(part, "name=")
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,244 @@
+package feegrant_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ocproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+func TestFieldPredicateFeeValidAllow(t *testing.T) {
+	app := simapp.Setup(t, false)
+
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 43))
+	leftAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 512))
+
+	from := sdk.AccAddress("from________________")
+	to := sdk.AccAddress("to__________________")
+	other := sdk.AccAddress("other_______________")
+	send := banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	sendOther := banktypes.NewMsgSend(from, other, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	multiSend := banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(from, sdk.NewCoins(sdk.NewInt64Coin("stake", 20)))},
+		[]banktypes.Output{
+			banktypes.NewOutput(to, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))),
+			banktypes.NewOutput(other, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))),
+		},
+	)
+	sendType := sdk.MsgTypeURL(send)
+	multiSendType := sdk.MsgTypeURL(multiSend)
+
+	cases := map[string]struct {
+		allowance  *feegrant.BasicAllowance
+		predicates []feegrant.FieldPredicate
+		maxGas     uint64
+		maxFee     sdk.Coins
+		gasLimit   uint64
+		msgs       []sdk.Msg
+		fee        sdk.Coins
+		accept     bool
+		remove     bool
+		remains    sdk.Coins
+	}{
+		"field matches": {
+			allowance:  &feegrant.BasicAllowance{SpendLimit: atom},
+			predicates: []feegrant.FieldPredicate{{MsgTypeUrl: sendType, FieldPath: "to_address", Value: to.String()}},
+			msgs:       []sdk.Msg{send},
+			fee:        smallAtom,
+			accept:     true,
+			remains:    leftAtom,
+		},
+		"field doesn't match": {
+			allowance:  &feegrant.BasicAllowance{SpendLimit: atom},
+			predicates: []feegrant.FieldPredicate{{MsgTypeUrl: sendType, FieldPath: "to_address", Value: to.String()}},
+			msgs:       []sdk.Msg{sendOther},
+			fee:        smallAtom,
+			accept:     false,
+		},
+		"one of the msgs doesn't match": {
+			allowance:  &feegrant.BasicAllowance{SpendLimit: atom},
+			predicates: []feegrant.FieldPredicate{{MsgTypeUrl: sendType, FieldPath: "to_address", Value: to.String()}},
+			msgs:       []sdk.Msg{send, sendOther},
+			fee:        smallAtom,
+			accept:     false,
+		},
+		"msg type doesn't match": {
+			allowance:  &feegrant.BasicAllowance{SpendLimit: atom},
+			predicates: []feegrant.FieldPredicate{{MsgTypeUrl: "/cosmos.gov.v1.MsgVote", FieldPath: "to_address", Value: to.String()}},
+			msgs:       []sdk.Msg{send},
+			fee:        smallAtom,
+			accept:     false,
+		},
+		"any msg type": {
+			allowance:  &feegrant.BasicAllowance{SpendLimit: atom},
+			predicates: []feegrant.FieldPredicate{{FieldPath: "from_address", Value: from.String()}},
+			msgs:       []sdk.Msg{send, sendOther},
+			fee:        smallAtom,
+			accept:     true,
+			remains:    leftAtom,
+		},
+		"unknown field": {
+			allowance:  &feegrant.BasicAllowance{SpendLimit: atom},
+			predicates: []feegrant.FieldPredicate{{FieldPath: "recipient", Value: to.String()}},
+			msgs:       []sdk.Msg{send},
+			fee:        smallAtom,
+			accept:     false,
+		},
+		"one of several predicates matches": {
+			allowance: &feegrant.BasicAllowance{SpendLimit: atom},
+			predicates: []feegrant.FieldPredicate{
+				{MsgTypeUrl: sendType, FieldPath: "to_address", Value: other.String()},
+				{MsgTypeUrl: sendType, FieldPath: "to_address", Value: to.String()},
+			},
+			msgs:    []sdk.Msg{send},
+			fee:     smallAtom,
+			accept:  true,
+			remains: leftAtom,
+		},
+		"nested coin field": {
+			allowance:  &feegrant.BasicAllowance{SpendLimit: atom},
+			predicates: []feegrant.FieldPredicate{{MsgTypeUrl: sendType, FieldPath: "amount.denom", Value: "stake"}},
+			msgs:       []sdk.Msg{send},
+			fee:        smallAtom,
+			accept:     true,
+			remains:    leftAtom,
+		},
+		"repeated field, all elements must match": {
+			allowance:  &feegrant.BasicAllowance{SpendLimit: atom},
+			predicates: []feegrant.FieldPredicate{{MsgTypeUrl: multiSendType, FieldPath: "outputs.address", Value: to.String()}},
+			msgs:       []sdk.Msg{multiSend},
+			fee:        smallAtom,
+			accept:     false,
+		},
+		"repeated field, all elements match": {
+			allowance:  &feegrant.BasicAllowance{SpendLimit: atom},
+			predicates: []feegrant.FieldPredicate{{MsgTypeUrl: multiSendType, FieldPath: "inputs.address", Value: from.String()}},
+			msgs:       []sdk.Msg{multiSend},
+			fee:        smallAtom,
+			accept:     true,
+			remains:    leftAtom,
+		},
+		"gas within cap": {
+			allowance:  &feegrant.BasicAllowance{SpendLimit: atom},
+			predicates: []feegrant.FieldPredicate{{FieldPath: "to_address", Value: to.String()}},
+			maxGas:     200000,
+			gasLimit:   200000,
+			msgs:       []sdk.Msg{send},
+			fee:        smallAtom,
+			accept:     true,
+			remains:    leftAtom,
+		},
+		"gas more than cap": {
+			allowance:  &feegrant.BasicAllowance{SpendLimit: atom},
+			predicates: []feegrant.FieldPredicate{{FieldPath: "to_address", Value: to.String()}},
+			maxGas:     200000,
+			gasLimit:   200001,
+			msgs:       []sdk.Msg{send},
+			fee:        smallAtom,
+			accept:     false,
+		},
+		"fee more than cap": {
+			allowance:  &feegrant.BasicAllowance{SpendLimit: atom},
+			predicates: []feegrant.FieldPredicate{{FieldPath: "to_address", Value: to.String()}},
+			maxFee:     sdk.NewCoins(sdk.NewInt64Coin("atom", 10)),
+			msgs:       []sdk.Msg{send},
+			fee:        smallAtom,
+			accept:     false,
+		},
+		"all fee within cap": {
+			allowance:  &feegrant.BasicAllowance{SpendLimit: smallAtom},
+			predicates: []feegrant.FieldPredicate{{FieldPath: "to_address", Value: to.String()}},
+			maxFee:     smallAtom,
+			msgs:       []sdk.Msg{send},
+			fee:        smallAtom,
+			accept:     true,
+			remove:     true,
+		},
+	}
+
+	for name, stc := range cases {
+		tc := stc // to make scopelint happy
+		t.Run(name, func(t *testing.T) {
+			err := tc.allowance.ValidateBasic()
+			require.NoError(t, err)
+
+			ctx := app.BaseApp.NewContext(false, ocproto.Header{}).WithBlockTime(time.Now())
+			if tc.gasLimit > 0 {
+				ctx = ctx.WithGasMeter(sdk.NewGasMeter(tc.gasLimit))
+			}
+
+			allowance, err := feegrant.NewFieldPredicateAllowance(tc.allowance, tc.predicates, tc.maxGas, tc.maxFee)
+			require.NoError(t, err)
+			require.NoError(t, allowance.ValidateBasic())
+
+			// now try to deduct
+			removed, err := allowance.Accept(ctx, tc.fee, tc.msgs)
+			if !tc.accept {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tc.remove, removed)
+			if !removed {
+				// mimic save & load process
+				var granter, grantee sdk.AccAddress
+				newGrant, err := feegrant.NewGrant(granter, grantee, allowance)
+				require.NoError(t, err)
+
+				cdc := simapp.MakeTestEncodingConfig().Codec
+				bz, err := cdc.Marshal(&newGrant)
+				require.NoError(t, err)
+
+				var loadedGrant feegrant.Grant
+				err = cdc.Unmarshal(bz, &loadedGrant)
+				require.NoError(t, err)
+
+				newAllowance, err := loadedGrant.GetGrant()
+				require.NoError(t, err)
+				feeAllowance, err := newAllowance.(*feegrant.FieldPredicateAllowance).GetAllowance()
+				require.NoError(t, err)
+				assert.Equal(t, tc.remains, feeAllowance.(*feegrant.BasicAllowance).SpendLimit)
+			}
+		})
+	}
+}
+
+func TestFieldPredicateAllowanceValidateBasic(t *testing.T) {
+	basic := &feegrant.BasicAllowance{}
+	valid := []feegrant.FieldPredicate{{FieldPath: "to_address", Value: "cosmos1"}}
+
+	cases := map[string]struct {
+		predicates []feegrant.FieldPredicate
+		maxFee     sdk.Coins
+		valid      bool
+	}{
+		"valid":              {predicates: valid, valid: true},
+		"no predicates":      {valid: false},
+		"empty field path":   {predicates: []feegrant.FieldPredicate{{Value: "cosmos1"}}, valid: false},
+		"invalid field path": {predicates: []feegrant.FieldPredicate{{FieldPath: "amount..denom"}}, valid: false},
+		"invalid max fee":    {predicates: valid, maxFee: sdk.Coins{sdk.Coin{Denom: "1x", Amount: sdk.NewInt(1)}}, valid: false},
+	}
+
+	for name, stc := range cases {
+		tc := stc // to make scopelint happy
+		t.Run(name, func(t *testing.T) {
+			allowance, err := feegrant.NewFieldPredicateAllowance(basic, tc.predicates, 0, tc.maxFee)
+			require.NoError(t, err)
+			err = allowance.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package feegrant
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var _ types.UnpackInterfacesMessage = GenesisState{}
@@ -25,6 +26,22 @@ func ValidateGenesis(data GenesisState) error {
 			return err
 		}
 	}
+
+	ids := make(map[uint64]bool, len(data.FeePools))
+	addrs := make(map[string]bool, len(data.FeePools))
+	for _, p := range data.FeePools {
+		if err := p.ValidateBasic(); err != nil {
+			return err
+		}
+		if ids[p.Id] {
+			return sdkerrors.Wrapf(ErrInvalidFeePool, "duplicate fee pool id %d", p.Id)
+		}
+		if addrs[p.Address] {
+			return sdkerrors.Wrapf(ErrInvalidFeePool, "duplicate fee pool address %s", p.Address)
+		}
+		ids[p.Id] = true
+		addrs[p.Address] = true
+	}
 	return nil
 }
 
@@ -0,0 +1,215 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+// CreateFeePool creates a new fee pool administered by admin, along with the
+// pool account holding its funds.
+func (k Keeper) CreateFeePool(ctx sdk.Context, admin sdk.AccAddress, metadata string) (feegrant.FeePool, error) {
+	var pool feegrant.FeePool
+	// loop here in the rare case where the derived address of a pool collides
+	// with an existing account, e.g. one created by sending coins to it.
+	for {
+		poolID := k.nextFeePoolID(ctx)
+		poolAddr := feegrant.FeePoolAddress(poolID)
+		if k.authKeeper.GetAccount(ctx, poolAddr) != nil {
+			continue
+		}
+
+		acc := k.authKeeper.NewAccount(ctx, &authtypes.ModuleAccount{
+			BaseAccount: &authtypes.BaseAccount{
+				Address: poolAddr.String(),
+			},
+			Name: poolAddr.String(),
+		})
+		k.authKeeper.SetAccount(ctx, acc)
+
+		pool = feegrant.FeePool{
+			Id:       poolID,
+			Admin:    admin.String(),
+			Address:  poolAddr.String(),
+			Metadata: metadata,
+		}
+		break
+	}
+
+	if err := k.setFeePool(ctx, pool); err != nil {
+		return feegrant.FeePool{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			feegrant.EventTypeCreateFeePool,
+			sdk.NewAttribute(feegrant.AttributeKeyPoolID, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(feegrant.AttributeKeyAdmin, pool.Admin),
+			sdk.NewAttribute(feegrant.AttributeKeyGranter, pool.Address),
+		),
+	)
+
+	return pool, nil
+}
+
+// DepositFeePool moves coins from the depositor to the fee pool account.
+func (k Keeper) DepositFeePool(ctx sdk.Context, poolID uint64, depositor sdk.AccAddress, amount sdk.Coins) error {
+	pool, err := k.GetFeePool(ctx, poolID)
+	if err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, depositor, sdk.MustAccAddressFromBech32(pool.Address), amount); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			feegrant.EventTypeDepositFeePool,
+			sdk.NewAttribute(feegrant.AttributeKeyPoolID, strconv.FormatUint(poolID, 10)),
+			sdk.NewAttribute(feegrant.AttributeKeyDepositor, depositor.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// WithdrawFeePool moves coins from the fee pool account to the recipient. Only
+// the pool admin can withdraw.
+func (k Keeper) WithdrawFeePool(ctx sdk.Context, poolID uint64, admin, recipient sdk.AccAddress, amount sdk.Coins) error {
+	pool, err := k.getFeePoolWithAdmin(ctx, poolID, admin)
+	if err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, sdk.MustAccAddressFromBech32(pool.Address), recipient, amount); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			feegrant.EventTypeWithdrawFeePool,
+			sdk.NewAttribute(feegrant.AttributeKeyPoolID, strconv.FormatUint(poolID, 10)),
+			sdk.NewAttribute(feegrant.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// GrantPoolAllowance creates a new grant from the fee pool account to the
+// grantee. Only the pool admin can grant pool allowances.
+func (k Keeper) GrantPoolAllowance(ctx sdk.Context, poolID uint64, admin, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error {
+	pool, err := k.getFeePoolWithAdmin(ctx, poolID, admin)
+	if err != nil {
+		return err
+	}
+
+	return k.GrantAllowance(ctx, sdk.MustAccAddressFromBech32(pool.Address), grantee, feeAllowance)
+}
+
+// RevokePoolAllowance removes the grant from the fee pool account to the
+// grantee. Only the pool admin can revoke pool allowances.
+func (k Keeper) RevokePoolAllowance(ctx sdk.Context, poolID uint64, admin, grantee sdk.AccAddress) error {
+	pool, err := k.getFeePoolWithAdmin(ctx, poolID, admin)
+	if err != nil {
+		return err
+	}
+
+	return k.revokeAllowance(ctx, sdk.MustAccAddressFromBech32(pool.Address), grantee)
+}
+
+// GetFeePool returns the fee pool with the given ID.
+func (k Keeper) GetFeePool(ctx sdk.Context, poolID uint64) (feegrant.FeePool, error) {
+	bz := ctx.KVStore(k.storeKey).Get(feegrant.FeePoolKey(poolID))
+	if len(bz) == 0 {
+		return feegrant.FeePool{}, sdkerrors.Wrapf(feegrant.ErrFeePoolNotFound, "fee pool %d", poolID)
+	}
+
+	var pool feegrant.FeePool
+	if err := k.cdc.Unmarshal(bz, &pool); err != nil {
+		return feegrant.FeePool{}, err
+	}
+
+	return pool, nil
+}
+
+// GetFeePoolByAddress returns the fee pool with the given account address.
+func (k Keeper) GetFeePoolByAddress(ctx sdk.Context, addr sdk.AccAddress) (feegrant.FeePool, error) {
+	bz := ctx.KVStore(k.storeKey).Get(feegrant.FeePoolByAddressKey(addr))
+	if len(bz) == 0 {
+		return feegrant.FeePool{}, sdkerrors.Wrapf(feegrant.ErrFeePoolNotFound, "fee pool %s", addr)
+	}
+
+	return k.GetFeePool(ctx, sdk.BigEndianToUint64(bz))
+}
+
+// IterateFeePools iterates over all the fee pools in the store.
+// Callback to get all data, returns true to stop, false to keep reading
+func (k Keeper) IterateFeePools(ctx sdk.Context, cb func(pool feegrant.FeePool) bool) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, feegrant.FeePoolKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var pool feegrant.FeePool
+		if err := k.cdc.Unmarshal(iter.Value(), &pool); err != nil {
+			return err
+		}
+
+		if cb(pool) {
+			break
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) getFeePoolWithAdmin(ctx sdk.Context, poolID uint64, admin sdk.AccAddress) (feegrant.FeePool, error) {
+	pool, err := k.GetFeePool(ctx, poolID)
+	if err != nil {
+		return feegrant.FeePool{}, err
+	}
+	if pool.Admin != admin.String() {
+		return feegrant.FeePool{}, sdkerrors.ErrUnauthorized.Wrapf("%s is not the admin of fee pool %d", admin, poolID)
+	}
+
+	return pool, nil
+}
+
+// setFeePool stores the fee pool and its address index, and makes sure the
+// next fee pool ID is after it.
+func (k Keeper) setFeePool(ctx sdk.Context, pool feegrant.FeePool) error {
+	bz, err := k.cdc.Marshal(&pool)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(feegrant.FeePoolKey(pool.Id), bz)
+	store.Set(feegrant.FeePoolByAddressKey(sdk.MustAccAddressFromBech32(pool.Address)), sdk.Uint64ToBigEndian(pool.Id))
+	if pool.Id > k.lastFeePoolID(ctx) {
+		store.Set(feegrant.FeePoolSeqKey, sdk.Uint64ToBigEndian(pool.Id))
+	}
+
+	return nil
+}
+
+func (k Keeper) lastFeePoolID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(feegrant.FeePoolSeqKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) nextFeePoolID(ctx sdk.Context) uint64 {
+	poolID := k.lastFeePoolID(ctx) + 1
+	ctx.KVStore(k.storeKey).Set(feegrant.FeePoolSeqKey, sdk.Uint64ToBigEndian(poolID))
+	return poolID
+}
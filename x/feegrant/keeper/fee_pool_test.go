@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+func (suite *KeeperTestSuite) TestFeePool() {
+	admin, depositor, grantee, recipient := suite.addrs[0], suite.addrs[1], suite.addrs[2], suite.addrs[3]
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+
+	res, err := suite.msgSrvr.CreateFeePool(suite.ctx, feegrant.NewMsgCreateFeePool(admin, "pool"))
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.PoolId)
+	poolAddr := feegrant.FeePoolAddress(res.PoolId)
+	suite.Require().Equal(poolAddr.String(), res.Address)
+	suite.Require().NotNil(suite.app.AccountKeeper.GetAccount(suite.sdkCtx, poolAddr))
+
+	pool, err := suite.keeper.GetFeePoolByAddress(suite.sdkCtx, poolAddr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(feegrant.FeePool{Id: 1, Admin: admin.String(), Address: poolAddr.String(), Metadata: "pool"}, pool)
+
+	// a second pool gets the next ID and its own account
+	res2, err := suite.msgSrvr.CreateFeePool(suite.ctx, feegrant.NewMsgCreateFeePool(admin, ""))
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), res2.PoolId)
+	suite.Require().NotEqual(res.Address, res2.Address)
+
+	// anyone can deposit
+	_, err = suite.msgSrvr.DepositFeePool(suite.ctx, feegrant.NewMsgDepositFeePool(depositor, res.PoolId, deposit))
+	suite.Require().NoError(err)
+	suite.Require().Equal(deposit, suite.app.BankKeeper.GetAllBalances(suite.sdkCtx, poolAddr))
+
+	_, err = suite.msgSrvr.DepositFeePool(suite.ctx, feegrant.NewMsgDepositFeePool(depositor, 10, deposit))
+	suite.Require().ErrorIs(err, feegrant.ErrFeePoolNotFound)
+
+	// only the admin can withdraw
+	withdraw := sdk.NewCoins(sdk.NewInt64Coin("stake", 400))
+	_, err = suite.msgSrvr.WithdrawFeePool(suite.ctx, feegrant.NewMsgWithdrawFeePool(depositor, res.PoolId, recipient, withdraw))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	before := suite.app.BankKeeper.GetBalance(suite.sdkCtx, recipient, "stake")
+	_, err = suite.msgSrvr.WithdrawFeePool(suite.ctx, feegrant.NewMsgWithdrawFeePool(admin, res.PoolId, recipient, withdraw))
+	suite.Require().NoError(err)
+	suite.Require().Equal(before.Add(withdraw[0]), suite.app.BankKeeper.GetBalance(suite.sdkCtx, recipient, "stake"))
+	suite.Require().Equal(deposit.Sub(withdraw...), suite.app.BankKeeper.GetAllBalances(suite.sdkCtx, poolAddr))
+
+	// only the admin can grant pool allowances, which are granted by the pool account
+	allowance := &feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))}
+	msg, err := feegrant.NewMsgGrantPoolAllowance(allowance, depositor, res.PoolId, grantee)
+	suite.Require().NoError(err)
+	_, err = suite.msgSrvr.GrantPoolAllowance(suite.ctx, msg)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	msg, err = feegrant.NewMsgGrantPoolAllowance(allowance, admin, res.PoolId, grantee)
+	suite.Require().NoError(err)
+	_, err = suite.msgSrvr.GrantPoolAllowance(suite.ctx, msg)
+	suite.Require().NoError(err)
+	_, err = suite.msgSrvr.GrantPoolAllowance(suite.ctx, msg)
+	suite.Require().Error(err)
+
+	grant, err := suite.keeper.GetAllowance(suite.sdkCtx, poolAddr, grantee)
+	suite.Require().NoError(err)
+	suite.Require().Equal(allowance, grant)
+
+	send := banktypes.NewMsgSend(grantee, recipient, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	err = suite.keeper.UseGrantedFees(suite.sdkCtx, poolAddr, grantee, sdk.NewCoins(sdk.NewInt64Coin("stake", 40)), []sdk.Msg{send})
+	suite.Require().NoError(err)
+
+	// the allowance of another pool doesn't apply
+	_, err = suite.keeper.GetAllowance(suite.sdkCtx, feegrant.FeePoolAddress(res2.PoolId), grantee)
+	suite.Require().Error(err)
+
+	// only the admin can revoke pool allowances
+	revoke := feegrant.NewMsgRevokePoolAllowance(depositor, res.PoolId, grantee)
+	_, err = suite.msgSrvr.RevokePoolAllowance(suite.ctx, &revoke)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	revoke = feegrant.NewMsgRevokePoolAllowance(admin, res.PoolId, grantee)
+	_, err = suite.msgSrvr.RevokePoolAllowance(suite.ctx, &revoke)
+	suite.Require().NoError(err)
+	_, err = suite.keeper.GetAllowance(suite.sdkCtx, poolAddr, grantee)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestFeePoolQueries() {
+	for i := 0; i < 3; i++ {
+		_, err := suite.keeper.CreateFeePool(suite.sdkCtx, suite.addrs[0], "")
+		suite.Require().NoError(err)
+	}
+
+	_, err := suite.keeper.FeePool(suite.ctx, nil)
+	suite.Require().Error(err)
+
+	res, err := suite.keeper.FeePool(suite.ctx, &feegrant.QueryFeePoolRequest{PoolId: 2})
+	suite.Require().NoError(err)
+	suite.Require().Equal(feegrant.FeePoolAddress(2).String(), res.FeePool.Address)
+
+	_, err = suite.keeper.FeePool(suite.ctx, &feegrant.QueryFeePoolRequest{PoolId: 4})
+	suite.Require().ErrorIs(err, feegrant.ErrFeePoolNotFound)
+
+	poolsRes, err := suite.keeper.FeePools(suite.ctx, &feegrant.QueryFeePoolsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(poolsRes.FeePools, 3)
+	for i, pool := range poolsRes.FeePools {
+		suite.Require().Equal(uint64(i+1), pool.Id)
+	}
+}
+
+func (suite *GenesisTestSuite) TestImportExportFeePools() {
+	pool, err := suite.keeper.CreateFeePool(suite.ctx, granterAddr, "pool")
+	suite.Require().NoError(err)
+	allowance := &feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("foo", 100))}
+	err = suite.keeper.GrantPoolAllowance(suite.ctx, pool.Id, granterAddr, granteeAddr, allowance)
+	suite.Require().NoError(err)
+
+	genesis, err := suite.keeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]feegrant.FeePool{pool}, genesis.FeePools)
+	suite.Require().NoError(feegrant.ValidateGenesis(*genesis))
+
+	err = suite.keeper.InitGenesis(suite.ctx, genesis)
+	suite.Require().NoError(err)
+	newGenesis, err := suite.keeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(genesis, newGenesis)
+
+	// new pools are created after the imported ones
+	next, err := suite.keeper.CreateFeePool(suite.ctx, granterAddr, "")
+	suite.Require().NoError(err)
+	suite.Require().Equal(pool.Id+1, next.Id)
+}
@@ -125,3 +125,49 @@ func (q Keeper) AllowancesByGranter(c context.Context, req *feegrant.QueryAllowa
 
 	return &feegrant.QueryAllowancesByGranterResponse{Allowances: grants, Pagination: pageRes}, nil
 }
+
+// FeePool returns the fee pool with the given ID.
+func (q Keeper) FeePool(c context.Context, req *feegrant.QueryFeePoolRequest) (*feegrant.QueryFeePoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pool, err := q.GetFeePool(ctx, req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegrant.QueryFeePoolResponse{FeePool: &pool}, nil
+}
+
+// FeePools returns all the fee pools.
+func (q Keeper) FeePools(c context.Context, req *feegrant.QueryFeePoolsRequest) (*feegrant.QueryFeePoolsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var pools []*feegrant.FeePool
+
+	store := ctx.KVStore(q.storeKey)
+	poolsStore := prefix.NewStore(store, feegrant.FeePoolKeyPrefix)
+
+	pageRes, err := query.Paginate(poolsStore, req.Pagination, func(key []byte, value []byte) error {
+		var pool feegrant.FeePool
+
+		if err := q.cdc.Unmarshal(value, &pool); err != nil {
+			return err
+		}
+
+		pools = append(pools, &pool)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &feegrant.QueryFeePoolsResponse{FeePools: pools, Pagination: pageRes}, nil
+}
@@ -20,16 +20,18 @@ type Keeper struct {
 	cdc        codec.BinaryCodec
 	storeKey   storetypes.StoreKey
 	authKeeper feegrant.AccountKeeper
+	bankKeeper feegrant.BankKeeper
 }
 
 var _ ante.FeegrantKeeper = &Keeper{}
 
 // NewKeeper creates a fee grant Keeper
-func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, ak feegrant.AccountKeeper) Keeper {
+func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, ak feegrant.AccountKeeper, bk feegrant.BankKeeper) Keeper {
 	return Keeper{
 		cdc:        cdc,
 		storeKey:   storeKey,
 		authKeeper: ak,
+		bankKeeper: bk,
 	}
 }
 
@@ -271,6 +273,12 @@ func emitUseGrantEvent(ctx sdk.Context, granter, grantee string) {
 
 // InitGenesis will initialize the keeper from a *previously validated* GenesisState
 func (k Keeper) InitGenesis(ctx sdk.Context, data *feegrant.GenesisState) error {
+	for _, p := range data.FeePools {
+		if err := k.setFeePool(ctx, p); err != nil {
+			return err
+		}
+	}
+
 	for _, f := range data.Allowances {
 		granter, err := sdk.AccAddressFromBech32(f.Granter)
 		if err != nil {
@@ -302,9 +310,19 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (*feegrant.GenesisState, error) {
 		grants = append(grants, grant)
 		return false
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	var pools []feegrant.FeePool
+	err = k.IterateFeePools(ctx, func(pool feegrant.FeePool) bool {
+		pools = append(pools, pool)
+		return false
+	})
 
 	return &feegrant.GenesisState{
 		Allowances: grants,
+		FeePools:   pools,
 	}, err
 }
 
@@ -76,3 +76,118 @@ func (k msgServer) RevokeAllowance(goCtx context.Context, msg *feegrant.MsgRevok
 
 	return &feegrant.MsgRevokeAllowanceResponse{}, nil
 }
+
+// CreateFeePool creates a new fee pool administered by the admin.
+func (k msgServer) CreateFeePool(goCtx context.Context, msg *feegrant.MsgCreateFeePool) (*feegrant.MsgCreateFeePoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	admin, err := sdk.AccAddressFromBech32(msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := k.Keeper.CreateFeePool(ctx, admin, msg.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgCreateFeePoolResponse{PoolId: pool.Id, Address: pool.Address}, nil
+}
+
+// DepositFeePool deposits funds from the depositor into a fee pool.
+func (k msgServer) DepositFeePool(goCtx context.Context, msg *feegrant.MsgDepositFeePool) (*feegrant.MsgDepositFeePoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Keeper.DepositFeePool(ctx, msg.PoolId, depositor, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgDepositFeePoolResponse{}, nil
+}
+
+// WithdrawFeePool withdraws funds from a fee pool to the recipient.
+func (k msgServer) WithdrawFeePool(goCtx context.Context, msg *feegrant.MsgWithdrawFeePool) (*feegrant.MsgWithdrawFeePoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	admin, err := sdk.AccAddressFromBech32(msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Keeper.WithdrawFeePool(ctx, msg.PoolId, admin, recipient, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgWithdrawFeePoolResponse{}, nil
+}
+
+// GrantPoolAllowance grants an allowance from a fee pool's funds to be used by the grantee.
+func (k msgServer) GrantPoolAllowance(goCtx context.Context, msg *feegrant.MsgGrantPoolAllowance) (*feegrant.MsgGrantPoolAllowanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	admin, err := sdk.AccAddressFromBech32(msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := k.Keeper.GetFeePool(ctx, msg.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Checking for duplicate entry
+	if f, _ := k.Keeper.GetAllowance(ctx, sdk.MustAccAddressFromBech32(pool.Address), grantee); f != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "fee allowance already exists")
+	}
+
+	allowance, err := msg.GetFeeAllowanceI()
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Keeper.GrantPoolAllowance(ctx, msg.PoolId, admin, grantee, allowance)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgGrantPoolAllowanceResponse{}, nil
+}
+
+// RevokePoolAllowance revokes a fee allowance between a fee pool and grantee.
+func (k msgServer) RevokePoolAllowance(goCtx context.Context, msg *feegrant.MsgRevokePoolAllowance) (*feegrant.MsgRevokePoolAllowanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	admin, err := sdk.AccAddressFromBech32(msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Keeper.RevokePoolAllowance(ctx, msg.PoolId, admin, grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgRevokePoolAllowanceResponse{}, nil
+}
@@ -29,6 +29,18 @@ var (
 	// FeeAllowanceQueueKeyPrefix is the set of the kvstore for fee allowance keys data
 	// - 0x01<allowance_prefix_queue_key_bytes>: <empty value>
 	FeeAllowanceQueueKeyPrefix = []byte{0x01}
+
+	// FeePoolKeyPrefix is the set of the kvstore for fee pool data
+	// - 0x02<pool_id_bytes>: FeePool
+	FeePoolKeyPrefix = []byte{0x02}
+
+	// FeePoolSeqKey is the key of the last fee pool ID
+	// - 0x03: <pool_id_bytes>
+	FeePoolSeqKey = []byte{0x03}
+
+	// FeePoolByAddressKeyPrefix is the set of the kvstore for the fee pool ID by address index
+	// - 0x04<len(pool_address_bytes)><pool_address_bytes>: <pool_id_bytes>
+	FeePoolByAddressKeyPrefix = []byte{0x04}
 )
 
 // FeeAllowanceKey is the canonical key to store a grant from granter to grantee
@@ -93,3 +105,19 @@ func ParseAddressesFromFeeAllowanceQueueKey(key []byte) (granter, grantee sdk.Ac
 
 	return granter, grantee
 }
+
+// FeePoolKey is the key to store a fee pool.
+//
+// Key format:
+// - <0x02><pool_id_bytes>
+func FeePoolKey(poolID uint64) []byte {
+	return append(FeePoolKeyPrefix, sdk.Uint64ToBigEndian(poolID)...)
+}
+
+// FeePoolByAddressKey is the key to index a fee pool by its address.
+//
+// Key format:
+// - <0x04><len(pool_address_bytes)><pool_address_bytes>
+func FeePoolByAddressKey(addr sdk.AccAddress) []byte {
+	return append(FeePoolByAddressKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
+}
@@ -13,7 +13,15 @@ var (
 	_, _ sdk.Msg            = &MsgGrantAllowance{}, &MsgRevokeAllowance{}
 	_, _ legacytx.LegacyMsg = &MsgGrantAllowance{}, &MsgRevokeAllowance{} // For amino support.
 
+	_, _, _ sdk.Msg = &MsgCreateFeePool{}, &MsgDepositFeePool{}, &MsgWithdrawFeePool{}
+	_, _    sdk.Msg = &MsgGrantPoolAllowance{}, &MsgRevokePoolAllowance{}
+
+	// For amino support.
+	_, _, _ legacytx.LegacyMsg = &MsgCreateFeePool{}, &MsgDepositFeePool{}, &MsgWithdrawFeePool{}
+	_, _    legacytx.LegacyMsg = &MsgGrantPoolAllowance{}, &MsgRevokePoolAllowance{}
+
 	_ types.UnpackInterfacesMessage = &MsgGrantAllowance{}
+	_ types.UnpackInterfacesMessage = &MsgGrantPoolAllowance{}
 )
 
 // NewMsgGrantAllowance creates a new MsgGrantAllowance.
@@ -136,3 +144,250 @@ func (msg MsgRevokeAllowance) Route() string {
 func (msg MsgRevokeAllowance) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
 }
+
+// NewMsgCreateFeePool creates a new MsgCreateFeePool.
+//
+//nolint:interfacer
+func NewMsgCreateFeePool(admin sdk.AccAddress, metadata string) *MsgCreateFeePool {
+	return &MsgCreateFeePool{Admin: admin.String(), Metadata: metadata}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCreateFeePool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSigners gets the admin of the fee pool.
+func (msg MsgCreateFeePool) GetSigners() []sdk.AccAddress {
+	admin, _ := sdk.AccAddressFromBech32(msg.Admin)
+	return []sdk.AccAddress{admin}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgCreateFeePool) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgCreateFeePool) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgCreateFeePool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgDepositFeePool creates a new MsgDepositFeePool.
+//
+//nolint:interfacer
+func NewMsgDepositFeePool(depositor sdk.AccAddress, poolID uint64, amount sdk.Coins) *MsgDepositFeePool {
+	return &MsgDepositFeePool{Depositor: depositor.String(), PoolId: poolID, Amount: amount}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgDepositFeePool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid depositor address: %s", err)
+	}
+	if msg.PoolId == 0 {
+		return sdkerrors.Wrap(ErrInvalidFeePool, "pool id cannot be zero")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.Empty() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid amount: %s", msg.Amount)
+	}
+
+	return nil
+}
+
+// GetSigners gets the depositor.
+func (msg MsgDepositFeePool) GetSigners() []sdk.AccAddress {
+	depositor, _ := sdk.AccAddressFromBech32(msg.Depositor)
+	return []sdk.AccAddress{depositor}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgDepositFeePool) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgDepositFeePool) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgDepositFeePool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgWithdrawFeePool creates a new MsgWithdrawFeePool.
+//
+//nolint:interfacer
+func NewMsgWithdrawFeePool(admin sdk.AccAddress, poolID uint64, recipient sdk.AccAddress, amount sdk.Coins) *MsgWithdrawFeePool {
+	return &MsgWithdrawFeePool{Admin: admin.String(), PoolId: poolID, Recipient: recipient.String(), Amount: amount}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgWithdrawFeePool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+	if msg.PoolId == 0 {
+		return sdkerrors.Wrap(ErrInvalidFeePool, "pool id cannot be zero")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.Empty() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid amount: %s", msg.Amount)
+	}
+
+	return nil
+}
+
+// GetSigners gets the admin of the fee pool.
+func (msg MsgWithdrawFeePool) GetSigners() []sdk.AccAddress {
+	admin, _ := sdk.AccAddressFromBech32(msg.Admin)
+	return []sdk.AccAddress{admin}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgWithdrawFeePool) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgWithdrawFeePool) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgWithdrawFeePool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgGrantPoolAllowance creates a new MsgGrantPoolAllowance.
+//
+//nolint:interfacer
+func NewMsgGrantPoolAllowance(feeAllowance FeeAllowanceI, admin sdk.AccAddress, poolID uint64, grantee sdk.AccAddress) (*MsgGrantPoolAllowance, error) {
+	msg, ok := feeAllowance.(proto.Message)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", msg)
+	}
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgGrantPoolAllowance{
+		Admin:     admin.String(),
+		PoolId:    poolID,
+		Grantee:   grantee.String(),
+		Allowance: any,
+	}, nil
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgGrantPoolAllowance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Grantee); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", err)
+	}
+	if msg.PoolId == 0 {
+		return sdkerrors.Wrap(ErrInvalidFeePool, "pool id cannot be zero")
+	}
+	allowance, err := msg.GetFeeAllowanceI()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
+
+// GetSigners gets the admin of the fee pool.
+func (msg MsgGrantPoolAllowance) GetSigners() []sdk.AccAddress {
+	admin, _ := sdk.AccAddressFromBech32(msg.Admin)
+	return []sdk.AccAddress{admin}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgGrantPoolAllowance) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgGrantPoolAllowance) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgGrantPoolAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetFeeAllowanceI returns unpacked FeeAllowance
+func (msg MsgGrantPoolAllowance) GetFeeAllowanceI() (FeeAllowanceI, error) {
+	allowance, ok := msg.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgGrantPoolAllowance) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(msg.Allowance, &allowance)
+}
+
+// NewMsgRevokePoolAllowance returns a message to revoke a fee pool allowance
+// for a given grantee.
+//
+//nolint:interfacer
+func NewMsgRevokePoolAllowance(admin sdk.AccAddress, poolID uint64, grantee sdk.AccAddress) MsgRevokePoolAllowance {
+	return MsgRevokePoolAllowance{Admin: admin.String(), PoolId: poolID, Grantee: grantee.String()}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRevokePoolAllowance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Grantee); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", err)
+	}
+	if msg.PoolId == 0 {
+		return sdkerrors.Wrap(ErrInvalidFeePool, "pool id cannot be zero")
+	}
+
+	return nil
+}
+
+// GetSigners gets the admin of the fee pool.
+func (msg MsgRevokePoolAllowance) GetSigners() []sdk.AccAddress {
+	admin, _ := sdk.AccAddressFromBech32(msg.Admin)
+	return []sdk.AccAddress{admin}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgRevokePoolAllowance) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgRevokePoolAllowance) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgRevokePoolAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
@@ -134,6 +134,48 @@ func TestMsgRevokeAllowance(t *testing.T) {
 	}
 }
 
+func TestMsgFeePools(t *testing.T) {
+	addr, _ := sdk.AccAddressFromBech32("cosmos1aeuqja06474dfrj7uqsvukm6rael982kk89mqr")
+	addr2, _ := sdk.AccAddressFromBech32("cosmos1nph3cfzk6trsmfxkeu943nvach5qw4vwstnvkl")
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	basic := &feegrant.BasicAllowance{SpendLimit: atom}
+
+	grant, err := feegrant.NewMsgGrantPoolAllowance(basic, addr, 1, addr2)
+	require.NoError(t, err)
+	noPoolGrant, err := feegrant.NewMsgGrantPoolAllowance(basic, addr, 0, addr2)
+	require.NoError(t, err)
+	revoke := feegrant.NewMsgRevokePoolAllowance(addr, 1, addr2)
+	noGranteeRevoke := feegrant.NewMsgRevokePoolAllowance(addr, 1, sdk.AccAddress{})
+
+	cases := map[string]struct {
+		msg    sdk.Msg
+		signer sdk.AccAddress
+		valid  bool
+	}{
+		"create":                      {feegrant.NewMsgCreateFeePool(addr, "pool"), addr, true},
+		"create, no admin":            {feegrant.NewMsgCreateFeePool(sdk.AccAddress{}, ""), nil, false},
+		"deposit":                     {feegrant.NewMsgDepositFeePool(addr2, 1, atom), addr2, true},
+		"deposit, no pool":            {feegrant.NewMsgDepositFeePool(addr2, 0, atom), nil, false},
+		"deposit, no amount":          {feegrant.NewMsgDepositFeePool(addr2, 1, nil), nil, false},
+		"withdraw":                    {feegrant.NewMsgWithdrawFeePool(addr, 1, addr2, atom), addr, true},
+		"withdraw, no recipient":      {feegrant.NewMsgWithdrawFeePool(addr, 1, sdk.AccAddress{}, atom), nil, false},
+		"grant pool allowance":        {grant, addr, true},
+		"grant pool allowance, no id": {noPoolGrant, nil, false},
+		"revoke pool allowance":       {&revoke, addr, true},
+		"revoke, no grantee":          {&noGranteeRevoke, nil, false},
+	}
+
+	for name, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err, name)
+			require.Equal(t, []sdk.AccAddress{tc.signer}, tc.msg.GetSigners(), name)
+		} else {
+			require.Error(t, err, name)
+		}
+	}
+}
+
 func TestAminoJSON(t *testing.T) {
 	tx := legacytx.StdTx{}
 	var msg legacytx.LegacyMsg
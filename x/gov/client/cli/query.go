@@ -530,39 +530,22 @@ $ %s query gov params
 			}
 			queryClient := v1.NewQueryClient(clientCtx)
 
-			// Query store for all 3 params
-			ctx := cmd.Context()
-			votingRes, err := queryClient.Params(
-				ctx,
-				&v1.QueryParamsRequest{ParamsType: "voting"},
-			)
-			if err != nil {
-				return err
-			}
-
-			tallyRes, err := queryClient.Params(
-				ctx,
-				&v1.QueryParamsRequest{ParamsType: "tallying"},
-			)
-			if err != nil {
-				return err
-			}
-
-			depositRes, err := queryClient.Params(
-				ctx,
+			// Query store for all params
+			res, err := queryClient.Params(
+				cmd.Context(),
 				&v1.QueryParamsRequest{ParamsType: "deposit"},
 			)
 			if err != nil {
 				return err
 			}
 
-			params := v1.NewParams(
-				*votingRes.GetVotingParams(),
-				*tallyRes.GetTallyParams(),
-				*depositRes.GetDepositParams(),
-			)
+			// Also fill the deprecated voting and tally params for backwards compatibility.
+			votingParams := res.Params.GetVotingParams()
+			res.VotingParams = &votingParams
+			tallyParams := res.Params.GetTallyParams()
+			res.TallyParams = &tallyParams
 
-			return clientCtx.PrintObjectLegacy(params)
+			return clientCtx.PrintProto(res)
 		},
 	}
 
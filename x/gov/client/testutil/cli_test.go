@@ -17,11 +17,12 @@ func TestIntegrationTestSuite(t *testing.T) {
 	cfg.NumValidators = 1
 	suite.Run(t, NewIntegrationTestSuite(cfg))
 
-	dp := v1.NewDepositParams(sdk.NewCoins(sdk.NewCoin(cfg.BondDenom, v1.DefaultMinDepositTokens)), time.Duration(15)*time.Second)
-	vp := v1.NewVotingParams(time.Duration(5) * time.Second)
 	genesisState := v1.DefaultGenesisState()
-	genesisState.DepositParams = &dp
-	genesisState.VotingParams = &vp
+	maxDepPeriod := time.Duration(15) * time.Second
+	votingPeriod := time.Duration(5) * time.Second
+	genesisState.Params.MinDeposit = sdk.NewCoins(sdk.NewCoin(cfg.BondDenom, v1.DefaultMinDepositTokens))
+	genesisState.Params.MaxDepositPeriod = &maxDepPeriod
+	genesisState.Params.VotingPeriod = &votingPeriod
 	bz, err := cfg.Codec.MarshalJSON(genesisState)
 	require.NoError(t, err)
 	cfg.GenesisState["gov"] = bz
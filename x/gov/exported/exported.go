@@ -0,0 +1,13 @@
+package exported
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ParamSubspace defines an interface that implements the legacy x/params Subspace
+// type.
+//
+// NOTE: This is used solely for migration of x/params managed parameters.
+type ParamSubspace interface {
+	Get(ctx sdk.Context, key []byte, ptr interface{})
+}
@@ -158,24 +158,28 @@ func (q Keeper) Params(c context.Context, req *v1.QueryParamsRequest) (*v1.Query
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
+	params := q.GetParams(ctx)
+	response := &v1.QueryParamsResponse{Params: &params}
 
 	switch req.ParamsType {
 	case v1.ParamDeposit:
-		depositParmas := q.GetDepositParams(ctx)
-		return &v1.QueryParamsResponse{DepositParams: &depositParmas}, nil
+		depositParams := params.GetDepositParams()
+		response.DepositParams = &depositParams
 
 	case v1.ParamVoting:
-		votingParmas := q.GetVotingParams(ctx)
-		return &v1.QueryParamsResponse{VotingParams: &votingParmas}, nil
+		votingParams := params.GetVotingParams()
+		response.VotingParams = &votingParams
 
 	case v1.ParamTallying:
-		tallyParams := q.GetTallyParams(ctx)
-		return &v1.QueryParamsResponse{TallyParams: &tallyParams}, nil
+		tallyParams := params.GetTallyParams()
+		response.TallyParams = &tallyParams
 
 	default:
 		return nil, status.Errorf(codes.InvalidArgument,
 			"%s is not a valid parameter type", req.ParamsType)
 	}
+
+	return response, nil
 }
 
 // Deposit queries single deposit information based on proposalID, depositAddr.
@@ -843,3 +843,90 @@ func (suite *KeeperTestSuite) TestLegacyMsgDeposit() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgUpdateParams() {
+	authority := suite.app.GovKeeper.GetAuthority()
+	params := v1.DefaultParams()
+	testCases := []struct {
+		name      string
+		input     func() *v1.MsgUpdateParams
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "valid",
+			input: func() *v1.MsgUpdateParams {
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params,
+				}
+			},
+			expErr: false,
+		},
+		{
+			name: "invalid authority",
+			input: func() *v1.MsgUpdateParams {
+				return &v1.MsgUpdateParams{
+					Authority: suite.addrs[0].String(),
+					Params:    params,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "expected gov account as only signer for proposal message",
+		},
+		{
+			name: "invalid quorum",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.Quorum = "abc"
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "invalid quorum string",
+		},
+		{
+			name: "negative quorum",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.Quorum = "-0.1"
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "quorom cannot be negative",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			msg := tc.input()
+			exec := func(updateParams *v1.MsgUpdateParams) error {
+				if err := msg.ValidateBasic(); err != nil {
+					return err
+				}
+
+				if _, err := suite.msgSrvr.UpdateParams(suite.ctx, updateParams); err != nil {
+					return err
+				}
+				return nil
+			}
+
+			err := exec(msg)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(params, suite.app.GovKeeper.GetParams(suite.ctx))
+			}
+		})
+	}
+}
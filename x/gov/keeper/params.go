@@ -2,41 +2,64 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
-// GetDepositParams returns the current DepositParams from the global param store
+// GetParams returns the current x/gov module parameters.
+func (keeper Keeper) GetParams(ctx sdk.Context) (params v1.Params) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the x/gov module parameters.
+func (keeper Keeper) SetParams(ctx sdk.Context, params v1.Params) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&params)
+	store.Set(types.ParamsKey, bz)
+}
+
+// GetDepositParams returns the current DepositParams from the gov params
 func (keeper Keeper) GetDepositParams(ctx sdk.Context) v1.DepositParams {
-	var depositParams v1.DepositParams
-	keeper.paramSpace.Get(ctx, v1.ParamStoreKeyDepositParams, &depositParams)
-	return depositParams
+	return keeper.GetParams(ctx).GetDepositParams()
 }
 
-// GetVotingParams returns the current VotingParams from the global param store
+// GetVotingParams returns the current VotingParams from the gov params
 func (keeper Keeper) GetVotingParams(ctx sdk.Context) v1.VotingParams {
-	var votingParams v1.VotingParams
-	keeper.paramSpace.Get(ctx, v1.ParamStoreKeyVotingParams, &votingParams)
-	return votingParams
+	return keeper.GetParams(ctx).GetVotingParams()
 }
 
-// GetTallyParams returns the current TallyParam from the global param store
+// GetTallyParams returns the current TallyParams from the gov params
 func (keeper Keeper) GetTallyParams(ctx sdk.Context) v1.TallyParams {
-	var tallyParams v1.TallyParams
-	keeper.paramSpace.Get(ctx, v1.ParamStoreKeyTallyParams, &tallyParams)
-	return tallyParams
+	return keeper.GetParams(ctx).GetTallyParams()
 }
 
-// SetDepositParams sets DepositParams to the global param store
+// SetDepositParams sets the DepositParams portion of the gov params
 func (keeper Keeper) SetDepositParams(ctx sdk.Context, depositParams v1.DepositParams) {
-	keeper.paramSpace.Set(ctx, v1.ParamStoreKeyDepositParams, &depositParams)
+	params := keeper.GetParams(ctx)
+	params.MinDeposit = depositParams.MinDeposit
+	params.MaxDepositPeriod = depositParams.MaxDepositPeriod
+	keeper.SetParams(ctx, params)
 }
 
-// SetVotingParams sets VotingParams to the global param store
+// SetVotingParams sets the VotingParams portion of the gov params
 func (keeper Keeper) SetVotingParams(ctx sdk.Context, votingParams v1.VotingParams) {
-	keeper.paramSpace.Set(ctx, v1.ParamStoreKeyVotingParams, &votingParams)
+	params := keeper.GetParams(ctx)
+	params.VotingPeriod = votingParams.VotingPeriod
+	keeper.SetParams(ctx, params)
 }
 
-// SetTallyParams sets TallyParams to the global param store
+// SetTallyParams sets the TallyParams portion of the gov params
 func (keeper Keeper) SetTallyParams(ctx sdk.Context, tallyParams v1.TallyParams) {
-	keeper.paramSpace.Set(ctx, v1.ParamStoreKeyTallyParams, &tallyParams)
+	params := keeper.GetParams(ctx)
+	params.Quorum = tallyParams.Quorum
+	params.Threshold = tallyParams.Threshold
+	params.VetoThreshold = tallyParams.VetoThreshold
+	keeper.SetParams(ctx, params)
 }
@@ -0,0 +1,46 @@
+package v047
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/exported"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// migrateParams migrates the x/gov module state from the legacy x/params subspace
+// to the x/gov module store.
+func migrateParams(ctx sdk.Context, store sdk.KVStore, legacySubspace exported.ParamSubspace, cdc codec.BinaryCodec) error {
+	var (
+		depositParams v1.DepositParams
+		votingParams  v1.VotingParams
+		tallyParams   v1.TallyParams
+	)
+
+	legacySubspace.Get(ctx, v1.ParamStoreKeyDepositParams, &depositParams)
+	legacySubspace.Get(ctx, v1.ParamStoreKeyVotingParams, &votingParams)
+	legacySubspace.Get(ctx, v1.ParamStoreKeyTallyParams, &tallyParams)
+
+	params := v1.NewParamsFromLegacy(depositParams, votingParams, tallyParams)
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+	return nil
+}
+
+// MigrateStore performs in-place store migrations from v3 (v0.46) to v4 (v0.47). The
+// migration includes:
+//
+// - Migrate params from the x/params module to the x/gov module store.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, legacySubspace exported.ParamSubspace, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	return migrateParams(ctx, store, legacySubspace, cdc)
+}
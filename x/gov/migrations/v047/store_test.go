@@ -0,0 +1,40 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v047gov "github.com/cosmos/cosmos-sdk/x/gov/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	govKey := sdk.NewKVStoreKey(types.StoreKey)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(govKey, tKey)
+	legacySubspace := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, govKey, tKey, types.ModuleName).
+		WithKeyTable(v1.ParamKeyTable())
+
+	depositParams := v1.DefaultDepositParams()
+	votingParams := v1.DefaultVotingParams()
+	tallyParams := v1.DefaultTallyParams()
+	legacySubspace.Set(ctx, v1.ParamStoreKeyDepositParams, depositParams)
+	legacySubspace.Set(ctx, v1.ParamStoreKeyVotingParams, votingParams)
+	legacySubspace.Set(ctx, v1.ParamStoreKeyTallyParams, tallyParams)
+
+	// Run migrations.
+	require.NoError(t, v047gov.MigrateStore(ctx, govKey, legacySubspace, encCfg.Codec))
+
+	// Make sure the params are now in the module store.
+	var res v1.Params
+	bz := ctx.KVStore(govKey).Get(types.ParamsKey)
+	require.NoError(t, encCfg.Codec.Unmarshal(bz, &res))
+	require.Equal(t, v1.NewParamsFromLegacy(depositParams, votingParams, tallyParams), res)
+}
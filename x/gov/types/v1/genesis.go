@@ -8,12 +8,10 @@ import (
 )
 
 // NewGenesisState creates a new genesis state for the governance module
-func NewGenesisState(startingProposalID uint64, dp DepositParams, vp VotingParams, tp TallyParams) *GenesisState {
+func NewGenesisState(startingProposalID uint64, params Params) *GenesisState {
 	return &GenesisState{
 		StartingProposalId: startingProposalID,
-		DepositParams:      &dp,
-		VotingParams:       &vp,
-		TallyParams:        &tp,
+		Params:             &params,
 	}
 }
 
@@ -21,18 +19,32 @@ func NewGenesisState(startingProposalID uint64, dp DepositParams, vp VotingParam
 func DefaultGenesisState() *GenesisState {
 	return NewGenesisState(
 		DefaultStartingProposalID,
-		DefaultDepositParams(),
-		DefaultVotingParams(),
-		DefaultTallyParams(),
+		DefaultParams(),
 	)
 }
 
 // Empty returns true if a GenesisState is empty
 func (data GenesisState) Empty() bool {
 	return data.StartingProposalId == 0 ||
-		data.DepositParams == nil ||
-		data.VotingParams == nil ||
-		data.TallyParams == nil
+		(data.Params == nil && !data.hasLegacyParams())
+}
+
+// hasLegacyParams returns true if all of the deprecated deposit, voting and tally params are set.
+func (data GenesisState) hasLegacyParams() bool {
+	return data.DepositParams != nil && data.VotingParams != nil && data.TallyParams != nil
+}
+
+// GetParamsOrLegacy returns the genesis params. If they are not set, they are
+// built from the deprecated deposit, voting and tally params.
+func (data GenesisState) GetParamsOrLegacy() Params {
+	switch {
+	case data.Params != nil:
+		return *data.Params
+	case data.hasLegacyParams():
+		return NewParamsFromLegacy(*data.DepositParams, *data.VotingParams, *data.TallyParams)
+	default:
+		return Params{}
+	}
 }
 
 // ValidateGenesis checks if parameters are within valid ranges
@@ -41,6 +53,17 @@ func ValidateGenesis(data *GenesisState) error {
 		return errors.New("starting proposal id must be greater than 0")
 	}
 
+	if data.Params != nil {
+		if err := data.Params.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid params: %w", err)
+		}
+		return nil
+	}
+
+	if !data.hasLegacyParams() {
+		return errors.New("params must be provided")
+	}
+
 	if err := validateTallyParams(*data.TallyParams); err != nil {
 		return fmt.Errorf("invalid tally params: %w", err)
 	}
@@ -170,28 +170,76 @@ func validateVotingParams(i interface{}) error {
 	return nil
 }
 
-// Params returns all of the governance params
-type Params struct {
-	VotingParams  VotingParams  `json:"voting_params" yaml:"voting_params"`
-	TallyParams   TallyParams   `json:"tally_params" yaml:"tally_params"`
-	DepositParams DepositParams `json:"deposit_params" yaml:"deposit_params"`
-}
-
-func (gp Params) String() string {
-	return gp.VotingParams.String() + "\n" +
-		gp.TallyParams.String() + "\n" + gp.DepositParams.String()
+// NewParams creates a new Params instance with given values.
+func NewParams(
+	minDeposit sdk.Coins, maxDepositPeriod time.Duration, votingPeriod time.Duration,
+	quorum, threshold, vetoThreshold sdk.Dec,
+) Params {
+	return Params{
+		MinDeposit:       minDeposit,
+		MaxDepositPeriod: &maxDepositPeriod,
+		VotingPeriod:     &votingPeriod,
+		Quorum:           quorum.String(),
+		Threshold:        threshold.String(),
+		VetoThreshold:    vetoThreshold.String(),
+	}
 }
 
-// NewParams creates a new gov Params instance
-func NewParams(vp VotingParams, tp TallyParams, dp DepositParams) Params {
+// NewParamsFromLegacy creates a new Params instance from the separate deposit, voting and tally params.
+func NewParamsFromLegacy(dp DepositParams, vp VotingParams, tp TallyParams) Params {
 	return Params{
-		VotingParams:  vp,
-		DepositParams: dp,
-		TallyParams:   tp,
+		MinDeposit:       dp.MinDeposit,
+		MaxDepositPeriod: dp.MaxDepositPeriod,
+		VotingPeriod:     vp.VotingPeriod,
+		Quorum:           tp.Quorum,
+		Threshold:        tp.Threshold,
+		VetoThreshold:    tp.VetoThreshold,
 	}
 }
 
-// DefaultParams default governance params
+// DefaultParams returns the default governance params
 func DefaultParams() Params {
-	return NewParams(DefaultVotingParams(), DefaultTallyParams(), DefaultDepositParams())
+	return NewParams(
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
+		DefaultPeriod,
+		DefaultPeriod,
+		DefaultQuorum,
+		DefaultThreshold,
+		DefaultVetoThreshold,
+	)
+}
+
+// GetDepositParams returns the deposit related parameters.
+func (p Params) GetDepositParams() DepositParams {
+	return DepositParams{
+		MinDeposit:       p.MinDeposit,
+		MaxDepositPeriod: p.MaxDepositPeriod,
+	}
+}
+
+// GetVotingParams returns the voting related parameters.
+func (p Params) GetVotingParams() VotingParams {
+	return VotingParams{
+		VotingPeriod: p.VotingPeriod,
+	}
+}
+
+// GetTallyParams returns the tally related parameters.
+func (p Params) GetTallyParams() TallyParams {
+	return TallyParams{
+		Quorum:        p.Quorum,
+		Threshold:     p.Threshold,
+		VetoThreshold: p.VetoThreshold,
+	}
+}
+
+// ValidateBasic performs basic validation on governance parameters.
+func (p Params) ValidateBasic() error {
+	if err := validateDepositParams(p.GetDepositParams()); err != nil {
+		return err
+	}
+	if err := validateVotingParams(p.GetVotingParams()); err != nil {
+		return err
+	}
+	return validateTallyParams(p.GetTallyParams())
 }
@@ -34,6 +34,7 @@ func QueryCmd(name string) *cobra.Command {
 		QueryGroupsByMemberCmd(),
 		QueryTallyResultCmd(),
 		QueryGroupsCmd(),
+		QueryGroupVotingTreeCmd(),
 	)
 
 	return queryCmd
@@ -539,3 +540,38 @@ func QueryGroupsCmd() *cobra.Command {
 
 	return cmd
 }
+
+// QueryGroupVotingTreeCmd creates a CLI command for Query/GroupVotingTree.
+func QueryGroupVotingTreeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "group-voting-tree [id]",
+		Short: "Query for the effective voting tree of a group, including nested sub-groups",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := group.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GroupVotingTree(cmd.Context(), &group.QueryGroupVotingTreeRequest{
+				GroupId: groupID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
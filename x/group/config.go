@@ -9,6 +9,12 @@ type Config struct {
 	MaxExecutionPeriod time.Duration
 	// MaxMetadataLen defines the max length of the metadata bytes field for various entities within the group module. Defaults to 255 if not explicitly set.
 	MaxMetadataLen uint64
+	// MaxNestingDepth defines the max depth of sub-groups resolved when tallying votes and checking voters. Defaults to 3 if not explicitly set.
+	MaxNestingDepth uint64
+	// MaxTimelockedExecutionsPerBlock defines the max number of time-locked proposals executed by the EndBlocker in a block, the others being executed in the next blocks. Defaults to 100 if not explicitly set.
+	MaxTimelockedExecutionsPerBlock uint64
+	// MaxTimelockedExecutionGas defines the max gas consumed by the automatic execution of a time-locked proposal. Defaults to 10,000,000 if not explicitly set.
+	MaxTimelockedExecutionGas uint64
 }
 
 // DefaultConfig returns the default config for group.
@@ -16,5 +22,9 @@ func DefaultConfig() Config {
 	return Config{
 		MaxExecutionPeriod: 2 * time.Hour * 24 * 7, // Two weeks.
 		MaxMetadataLen:     255,
+		MaxNestingDepth:    3,
+
+		MaxTimelockedExecutionsPerBlock: 100,
+		MaxTimelockedExecutionGas:       10_000_000,
 	}
 }
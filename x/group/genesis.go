@@ -75,6 +75,18 @@ func (s GenesisState) Validate() error {
 			return sdkerrors.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("proposal with ProposalId %d doesn't exist", v.ProposalId))
 		}
 	}
+
+	for _, snapshot := range s.VotingPowerSnapshots {
+
+		if err := snapshot.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "VotingPowerSnapshot validation failed")
+		}
+
+		// check that proposal exists
+		if _, exists := proposals[snapshot.ProposalId]; !exists {
+			return sdkerrors.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("proposal with ProposalId %d doesn't exist", snapshot.ProposalId))
+		}
+	}
 	return nil
 }
 
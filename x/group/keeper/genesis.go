@@ -39,6 +39,10 @@ func (k Keeper) InitGenesis(ctx types.Context, cdc codec.JSONCodec, data json.Ra
 		panic(errors.Wrap(err, "votes"))
 	}
 
+	if err := k.votingPowerSnapshotTable.Import(ctx.KVStore(k.key), genesisState.VotingPowerSnapshots, 0); err != nil {
+		panic(errors.Wrap(err, "voting power snapshots"))
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -85,5 +89,12 @@ func (k Keeper) ExportGenesis(ctx types.Context, _ codec.JSONCodec) *group.Genes
 	}
 	genesisState.Votes = votes
 
+	var snapshots []*group.VotingPowerSnapshot
+	_, err = k.votingPowerSnapshotTable.Export(ctx.KVStore(k.key), &snapshots)
+	if err != nil {
+		panic(errors.Wrap(err, "voting power snapshots"))
+	}
+	genesisState.VotingPowerSnapshots = snapshots
+
 	return genesisState
 }
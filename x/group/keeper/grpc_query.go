@@ -369,3 +369,52 @@ func (k Keeper) Groups(goCtx context.Context, request *group.QueryGroupsRequest)
 		Pagination: pageRes,
 	}, nil
 }
+
+// GroupVotingTree returns the effective voting tree of a group, resolving the
+// members which are group policies into their sub-group members.
+func (k Keeper) GroupVotingTree(goCtx context.Context, request *group.QueryGroupVotingTreeRequest) (*group.QueryGroupVotingTreeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := k.getGroupInfo(ctx, request.GroupId); err != nil {
+		return nil, sdkerrors.Wrap(err, "group")
+	}
+
+	members, err := k.votingTree(ctx, request.GroupId, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryGroupVotingTreeResponse{Members: members}, nil
+}
+
+// votingTree returns the voting tree nodes of the members of a group, resolving
+// up to MaxNestingDepth levels of nested sub-groups.
+func (k Keeper) votingTree(ctx sdk.Context, groupID uint64, depth uint64) ([]*group.VotingTreeNode, error) {
+	members, err := k.groupMembers(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]*group.VotingTreeNode, 0, len(members))
+	for _, member := range members {
+		node := &group.VotingTreeNode{
+			Address: member.Member.Address,
+			Weight:  member.Member.Weight,
+		}
+
+		subID, isSubGroup, err := k.subGroupID(ctx, member.Member.Address)
+		if err != nil {
+			return nil, err
+		}
+		if isSubGroup {
+			node.GroupId = subID
+			if depth < k.config.MaxNestingDepth {
+				if node.Members, err = k.votingTree(ctx, subID, depth+1); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
@@ -38,17 +38,23 @@ const (
 	ProposalTableSeqPrefix           byte = 0x31
 	ProposalByGroupPolicyIndexPrefix byte = 0x32
 	ProposalsByVotingPeriodEndPrefix byte = 0x33
+	ProposalsByExecuteAfterPrefix    byte = 0x34
 
 	// Vote Table
 	VoteTablePrefix           byte = 0x40
 	VoteByProposalIndexPrefix byte = 0x41
 	VoteByVoterIndexPrefix    byte = 0x42
+
+	// Voting Power Snapshot Table
+	VotingPowerSnapshotTablePrefix byte = 0x50
 )
 
 type Keeper struct {
 	key storetypes.StoreKey
 
-	accKeeper group.AccountKeeper
+	accKeeper     group.AccountKeeper
+	bankKeeper    group.BankKeeper
+	stakingKeeper group.StakingKeeper
 
 	// Group Table
 	groupTable        orm.AutoUInt64Table
@@ -69,23 +75,31 @@ type Keeper struct {
 	proposalTable              orm.AutoUInt64Table
 	proposalByGroupPolicyIndex orm.Index
 	proposalsByVotingPeriodEnd orm.Index
+	proposalsByExecuteAfter    orm.Index
 
 	// Vote Table
 	voteTable           orm.PrimaryKeyTable
 	voteByProposalIndex orm.Index
 	voteByVoterIndex    orm.Index
 
+	// Voting Power Snapshot Table
+	votingPowerSnapshotTable orm.PrimaryKeyTable
+
 	router baseapp.IMsgServiceRouter
 
 	config group.Config
 }
 
 // NewKeeper creates a new group keeper.
-func NewKeeper(storeKey storetypes.StoreKey, cdc codec.Codec, router baseapp.IMsgServiceRouter, accKeeper group.AccountKeeper, config group.Config) Keeper {
+func NewKeeper(storeKey storetypes.StoreKey, cdc codec.Codec, router baseapp.IMsgServiceRouter, accKeeper group.AccountKeeper,
+	bankKeeper group.BankKeeper, stakingKeeper group.StakingKeeper, config group.Config,
+) Keeper {
 	k := Keeper{
-		key:       storeKey,
-		router:    router,
-		accKeeper: accKeeper,
+		key:           storeKey,
+		router:        router,
+		accKeeper:     accKeeper,
+		bankKeeper:    bankKeeper,
+		stakingKeeper: stakingKeeper,
 	}
 
 	groupTable, err := orm.NewAutoUInt64Table([2]byte{GroupTablePrefix}, GroupTableSeqPrefix, &group.GroupInfo{}, cdc)
@@ -177,6 +191,18 @@ func NewKeeper(storeKey storetypes.StoreKey, cdc codec.Codec, router baseapp.IMs
 	if err != nil {
 		panic(err.Error())
 	}
+	k.proposalsByExecuteAfter, err = orm.NewIndex(proposalTable, ProposalsByExecuteAfterPrefix, func(value interface{}) ([]interface{}, error) {
+		// Only accepted proposals waiting for their timelock to expire are
+		// queued for automatic execution.
+		p := value.(*group.Proposal)
+		if p.ExecuteAfter == nil || p.Status != group.PROPOSAL_STATUS_ACCEPTED || p.ExecutorResult != group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN {
+			return nil, nil
+		}
+		return []interface{}{sdk.FormatTimeBytes(*p.ExecuteAfter)}, nil
+	}, []byte{})
+	if err != nil {
+		panic(err.Error())
+	}
 	k.proposalTable = *proposalTable
 
 	// Vote Table
@@ -202,12 +228,28 @@ func NewKeeper(storeKey storetypes.StoreKey, cdc codec.Codec, router baseapp.IMs
 	}
 	k.voteTable = *voteTable
 
+	// Voting Power Snapshot Table
+	votingPowerSnapshotTable, err := orm.NewPrimaryKeyTable([2]byte{VotingPowerSnapshotTablePrefix}, &group.VotingPowerSnapshot{}, cdc)
+	if err != nil {
+		panic(err.Error())
+	}
+	k.votingPowerSnapshotTable = *votingPowerSnapshotTable
+
 	if config.MaxMetadataLen == 0 {
 		config.MaxMetadataLen = group.DefaultConfig().MaxMetadataLen
 	}
 	if config.MaxExecutionPeriod == 0 {
 		config.MaxExecutionPeriod = group.DefaultConfig().MaxExecutionPeriod
 	}
+	if config.MaxNestingDepth == 0 {
+		config.MaxNestingDepth = group.DefaultConfig().MaxNestingDepth
+	}
+	if config.MaxTimelockedExecutionsPerBlock == 0 {
+		config.MaxTimelockedExecutionsPerBlock = group.DefaultConfig().MaxTimelockedExecutionsPerBlock
+	}
+	if config.MaxTimelockedExecutionGas == 0 {
+		config.MaxTimelockedExecutionGas = group.DefaultConfig().MaxTimelockedExecutionGas
+	}
 	k.config = config
 
 	return k
@@ -225,14 +267,27 @@ func (k Keeper) GetGroupSequence(ctx sdk.Context) uint64 {
 
 // proposalsByVPEnd returns all proposals whose voting_period_end is after the `endTime` time argument.
 func (k Keeper) proposalsByVPEnd(ctx sdk.Context, endTime time.Time) (proposals []group.Proposal, err error) {
+	return k.proposalsByTimeIndex(ctx, k.proposalsByVotingPeriodEnd, endTime, 0)
+}
+
+// proposalsByExecAfter returns at most `limit` time-locked proposals whose
+// execute_after is before the `endTime` time argument.
+func (k Keeper) proposalsByExecAfter(ctx sdk.Context, endTime time.Time, limit uint64) (proposals []group.Proposal, err error) {
+	return k.proposalsByTimeIndex(ctx, k.proposalsByExecuteAfter, endTime, limit)
+}
+
+// proposalsByTimeIndex returns the proposals indexed by a time lower than the
+// `endTime` time argument in the given index, at most `limit` of them if it is
+// not zero.
+func (k Keeper) proposalsByTimeIndex(ctx sdk.Context, index orm.Index, endTime time.Time, limit uint64) (proposals []group.Proposal, err error) {
 	timeBytes := sdk.FormatTimeBytes(endTime)
-	it, err := k.proposalsByVotingPeriodEnd.PrefixScan(ctx.KVStore(k.key), nil, timeBytes)
+	it, err := index.PrefixScan(ctx.KVStore(k.key), nil, timeBytes)
 	if err != nil {
 		return proposals, err
 	}
 	defer it.Close()
 
-	for {
+	for limit == 0 || uint64(len(proposals)) < limit {
 		// Important: this following line cannot be outside of the for loop.
 		// It seems that when one unmarshals into the same `group.Proposal`
 		// reference, then gogoproto somehow "adds" the new bytes to the old
@@ -331,6 +386,150 @@ func (k Keeper) pruneVotes(ctx sdk.Context, proposalID uint64) error {
 	return nil
 }
 
+// pruneVotingPowerSnapshot prunes the voting power snapshots of a proposal
+// from state, if any.
+func (k Keeper) pruneVotingPowerSnapshot(ctx sdk.Context, proposalID uint64) error {
+	store := ctx.KVStore(k.key)
+	it, err := k.votingPowerSnapshotTable.PrefixScan(store, orm.EncodeSequence(proposalID), orm.EncodeSequence(proposalID+1))
+	if err != nil {
+		return err
+	}
+
+	var snapshots []group.VotingPowerSnapshot
+	_, err = orm.ReadAll(it, &snapshots)
+	if err != nil {
+		return err
+	}
+
+	for _, snapshot := range snapshots {
+		if err := k.votingPowerSnapshotTable.Delete(store, &snapshot); err != nil { //nolint:gosec // implicit memory aliasing in for loop
+			return err
+		}
+	}
+	return nil
+}
+
+// groupMembers returns all the members of a group.
+func (k Keeper) groupMembers(ctx sdk.Context, groupID uint64) ([]group.GroupMember, error) {
+	it, err := k.groupMemberByGroupIndex.Get(ctx.KVStore(k.key), groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var members []group.GroupMember
+	for {
+		var member group.GroupMember
+		_, err = it.LoadNext(&member)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return members, err
+		}
+		members = append(members, member)
+	}
+	return members, nil
+}
+
+// subGroupID returns the ID of the group behind a group member which is a
+// group policy, and false if the member is a plain account.
+func (k Keeper) subGroupID(ctx sdk.Context, address string) (uint64, bool, error) {
+	policyInfo, err := k.getGroupPolicyInfo(ctx, address)
+	switch {
+	case sdkerrors.ErrNotFound.Is(err):
+		return 0, false, nil
+	case err != nil:
+		return 0, false, err
+	}
+	return policyInfo.GroupId, true, nil
+}
+
+// assertNoGroupCycle checks that adding the given address as a member of a
+// group doesn't make this group one of its own sub-groups.
+func (k Keeper) assertNoGroupCycle(ctx sdk.Context, groupID uint64, address string) error {
+	subID, isSubGroup, err := k.subGroupID(ctx, address)
+	if err != nil {
+		return err
+	}
+	if !isSubGroup {
+		return nil
+	}
+
+	found, err := k.containsGroup(ctx, subID, groupID, make(map[uint64]bool))
+	if err != nil {
+		return err
+	}
+	if found {
+		return sdkerrors.Wrapf(errors.ErrInvalid, "member %s would create a cycle of nested groups", address)
+	}
+	return nil
+}
+
+// containsGroup returns true if targetID is the group with ID groupID or one
+// of its nested sub-groups.
+func (k Keeper) containsGroup(ctx sdk.Context, groupID, targetID uint64, visited map[uint64]bool) (bool, error) {
+	if groupID == targetID {
+		return true, nil
+	}
+	if visited[groupID] {
+		return false, nil
+	}
+	visited[groupID] = true
+
+	members, err := k.groupMembers(ctx, groupID)
+	if err != nil {
+		return false, err
+	}
+	for _, member := range members {
+		subID, isSubGroup, err := k.subGroupID(ctx, member.Member.Address)
+		if err != nil {
+			return false, err
+		}
+		if !isSubGroup {
+			continue
+		}
+		found, err := k.containsGroup(ctx, subID, targetID, visited)
+		if err != nil || found {
+			return found, err
+		}
+	}
+	return false, nil
+}
+
+// isNestedMember returns true if the address is a member of one of the
+// sub-groups of a group, resolving up to MaxNestingDepth levels of nesting.
+func (k Keeper) isNestedMember(ctx sdk.Context, groupID uint64, address string, depth uint64) (bool, error) {
+	if depth >= k.config.MaxNestingDepth {
+		return false, nil
+	}
+
+	members, err := k.groupMembers(ctx, groupID)
+	if err != nil {
+		return false, err
+	}
+	for _, member := range members {
+		subID, isSubGroup, err := k.subGroupID(ctx, member.Member.Address)
+		if err != nil {
+			return false, err
+		}
+		if !isSubGroup {
+			continue
+		}
+		if k.groupMemberTable.Has(ctx.KVStore(k.key), orm.PrimaryKey(&group.GroupMember{
+			GroupId: subID,
+			Member:  &group.Member{Address: address},
+		})) {
+			return true, nil
+		}
+		found, err := k.isNestedMember(ctx, subID, address, depth+1)
+		if err != nil || found {
+			return found, err
+		}
+	}
+	return false, nil
+}
+
 // votesByProposal returns all votes for a given proposal.
 func (k Keeper) votesByProposal(ctx sdk.Context, proposalID uint64) ([]group.Vote, error) {
 	it, err := k.voteByProposalIndex.Get(ctx.KVStore(k.key), proposalID)
@@ -410,6 +609,9 @@ func (k Keeper) TallyProposalsAtVPEnd(ctx sdk.Context) error {
 			if err := k.pruneVotes(ctx, proposalID); err != nil {
 				return err
 			}
+			if err := k.pruneVotingPowerSnapshot(ctx, proposalID); err != nil {
+				return err
+			}
 			// Emit event for proposal finalized with its result
 			if err := ctx.EventManager().EmitTypedEvent(
 				&group.EventProposalPruned{
@@ -432,3 +634,62 @@ func (k Keeper) TallyProposalsAtVPEnd(ctx sdk.Context) error {
 	}
 	return nil
 }
+
+// ExecTimelockedProposals executes the accepted proposals whose timelock has
+// expired, at most MaxTimelockedExecutionsPerBlock of them, the others being
+// executed in the next blocks. Each proposal is executed in a cached context
+// with a gas meter limited to MaxTimelockedExecutionGas, and is marked as
+// failed if its execution returns an error or panics, so that a single
+// proposal cannot halt the chain.
+func (k Keeper) ExecTimelockedProposals(ctx sdk.Context) error {
+	proposals, err := k.proposalsByExecAfter(ctx, ctx.BlockTime(), k.config.MaxTimelockedExecutionsPerBlock)
+	if err != nil {
+		return err
+	}
+
+	for i := range proposals {
+		proposal := proposals[i]
+		cacheCtx, writeCache := ctx.WithGasMeter(sdk.NewGasMeter(k.config.MaxTimelockedExecutionGas)).CacheContext()
+		execErr := k.execTimelockedProposal(cacheCtx, proposal)
+		if execErr == nil {
+			writeCache()
+			continue
+		}
+
+		k.Logger(ctx).Error("time-locked proposal execution failed", "cause", execErr, "proposalID", proposal.Id)
+		proposal.ExecutorResult = group.PROPOSAL_EXECUTOR_RESULT_FAILURE
+		if err := k.proposalTable.Update(ctx.KVStore(k.key), proposal.Id, &proposal); err != nil {
+			return sdkerrors.Wrap(err, "proposal update")
+		}
+
+		if err := ctx.EventManager().EmitTypedEvent(&group.EventExec{
+			ProposalId: proposal.Id,
+			Logs:       fmt.Sprintf("proposal execution failed on proposal %d, because of error %s", proposal.Id, execErr.Error()),
+			Result:     proposal.ExecutorResult,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// execTimelockedProposal executes a time-locked proposal and updates it,
+// recovering from the out of gas and other panics.
+func (k Keeper) execTimelockedProposal(ctx sdk.Context, proposal group.Proposal) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if oog, ok := r.(sdk.ErrorOutOfGas); ok {
+				err = sdkerrors.ErrOutOfGas.Wrapf("out of gas in location: %v; gasUsed: %d", oog.Descriptor, ctx.GasMeter().GasConsumed())
+				return
+			}
+			err = sdkerrors.ErrPanic.Wrap(fmt.Sprint(r))
+		}
+	}()
+
+	policyInfo, err := k.getGroupPolicyInfo(ctx, proposal.GroupPolicyAddress)
+	if err != nil {
+		return sdkerrors.Wrap(err, "group policy")
+	}
+
+	return k.doExecAndUpdate(ctx, &proposal, policyInfo)
+}
@@ -3059,6 +3059,211 @@ func (s *TestSuite) TestPruneProposals() {
 	s.Require().Contains(err.Error(), "load proposal: not found")
 }
 
+func (s *TestSuite) TestTimelockedProposal() {
+	addrs := s.addrs
+	timelock := time.Hour
+	msgSend := &banktypes.MsgSend{
+		FromAddress: s.groupPolicyAddr.String(),
+		ToAddress:   addrs[2].String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+
+	setupTimelockedProposal := func() (sdk.Context, uint64) {
+		sdkCtx, _ := s.sdkCtx.CacheContext()
+		ctx := sdk.WrapSDKContext(sdkCtx)
+
+		policy := &group.ThresholdDecisionPolicy{
+			Threshold: "2",
+			Windows: &group.DecisionPolicyWindows{
+				VotingPeriod: time.Second,
+				Timelock:     timelock,
+			},
+		}
+		policyReq := &group.MsgUpdateGroupPolicyDecisionPolicy{
+			Admin:              addrs[0].String(),
+			GroupPolicyAddress: s.groupPolicyAddr.String(),
+		}
+		s.Require().NoError(policyReq.SetDecisionPolicy(policy))
+		_, err := s.keeper.UpdateGroupPolicyDecisionPolicy(ctx, policyReq)
+		s.Require().NoError(err)
+
+		proposalID := submitProposalAndVote(ctx, s, []sdk.Msg{msgSend}, []string{addrs[1].String()}, group.VOTE_OPTION_YES)
+		_, err = s.keeper.Exec(ctx, &group.MsgExec{Executor: addrs[1].String(), ProposalId: proposalID})
+		s.Require().NoError(err)
+
+		res, err := s.keeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		s.Require().Equal(group.PROPOSAL_STATUS_ACCEPTED, res.Proposal.Status)
+		s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, res.Proposal.ExecutorResult)
+		s.Require().NotNil(res.Proposal.ExecuteAfter)
+		s.Require().Equal(sdkCtx.BlockTime().Add(timelock), *res.Proposal.ExecuteAfter)
+
+		return sdkCtx, proposalID
+	}
+
+	s.Run("exec is blocked during the timelock", func() {
+		sdkCtx, proposalID := setupTimelockedProposal()
+		ctx := sdk.WrapSDKContext(sdkCtx)
+
+		res, err := s.keeper.Exec(ctx, &group.MsgExec{Executor: addrs[1].String(), ProposalId: proposalID})
+		s.Require().NoError(err)
+		s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, res.Result)
+		s.Require().True(s.app.BankKeeper.GetBalance(sdkCtx, addrs[2], "test").IsZero())
+
+		sdkCtx = sdkCtx.WithBlockTime(sdkCtx.BlockTime().Add(timelock))
+		res, err = s.keeper.Exec(sdk.WrapSDKContext(sdkCtx), &group.MsgExec{Executor: addrs[1].String(), ProposalId: proposalID})
+		s.Require().NoError(err)
+		s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, res.Result)
+		s.Require().Equal(int64(100), s.app.BankKeeper.GetBalance(sdkCtx, addrs[2], "test").Amount.Int64())
+	})
+
+	s.Run("end blocker executes the proposal after the timelock", func() {
+		sdkCtx, proposalID := setupTimelockedProposal()
+
+		module.EndBlocker(sdkCtx, s.keeper)
+		_, err := s.keeper.Proposal(sdk.WrapSDKContext(sdkCtx), &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+
+		sdkCtx = sdkCtx.WithBlockTime(sdkCtx.BlockTime().Add(timelock + 1))
+		module.EndBlocker(sdkCtx, s.keeper)
+		_, err = s.keeper.Proposal(sdk.WrapSDKContext(sdkCtx), &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().ErrorContains(err, "load proposal: not found")
+		s.Require().Equal(int64(100), s.app.BankKeeper.GetBalance(sdkCtx, addrs[2], "test").Amount.Int64())
+		s.Require().True(eventTypeFound(sdkCtx.EventManager().ABCIEvents(), EventProposalPruned))
+	})
+
+	s.Run("end blocker marks a proposal running out of gas as failed", func() {
+		sdkCtx, proposalID := setupTimelockedProposal()
+		k := keeper.NewKeeper(s.app.GetKey(group.StoreKey), s.app.AppCodec(), s.app.MsgServiceRouter(), s.app.AccountKeeper, s.app.BankKeeper, s.app.StakingKeeper, group.Config{MaxTimelockedExecutionGas: 1})
+
+		sdkCtx = sdkCtx.WithBlockTime(sdkCtx.BlockTime().Add(timelock + 1))
+		s.Require().NotPanics(func() { module.EndBlocker(sdkCtx, k) })
+		res, err := s.keeper.Proposal(sdk.WrapSDKContext(sdkCtx), &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_FAILURE, res.Proposal.ExecutorResult)
+		s.Require().True(s.app.BankKeeper.GetBalance(sdkCtx, addrs[2], "test").IsZero())
+	})
+
+	s.Run("end blocker executes at most MaxTimelockedExecutionsPerBlock proposals", func() {
+		sdkCtx, proposalID1 := setupTimelockedProposal()
+		ctx := sdk.WrapSDKContext(sdkCtx)
+		proposalID2 := submitProposalAndVote(ctx, s, []sdk.Msg{msgSend}, []string{addrs[1].String()}, group.VOTE_OPTION_YES)
+		_, err := s.keeper.Exec(ctx, &group.MsgExec{Executor: addrs[1].String(), ProposalId: proposalID2})
+		s.Require().NoError(err)
+		k := keeper.NewKeeper(s.app.GetKey(group.StoreKey), s.app.AppCodec(), s.app.MsgServiceRouter(), s.app.AccountKeeper, s.app.BankKeeper, s.app.StakingKeeper, group.Config{MaxTimelockedExecutionsPerBlock: 1})
+
+		sdkCtx = sdkCtx.WithBlockTime(sdkCtx.BlockTime().Add(timelock + 1))
+		module.EndBlocker(sdkCtx, k)
+		_, err = s.keeper.Proposal(sdk.WrapSDKContext(sdkCtx), &group.QueryProposalRequest{ProposalId: proposalID1})
+		s.Require().ErrorContains(err, "load proposal: not found")
+		res, err := s.keeper.Proposal(sdk.WrapSDKContext(sdkCtx), &group.QueryProposalRequest{ProposalId: proposalID2})
+		s.Require().NoError(err)
+		s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, res.Proposal.ExecutorResult)
+
+		module.EndBlocker(sdkCtx, k)
+		_, err = s.keeper.Proposal(sdk.WrapSDKContext(sdkCtx), &group.QueryProposalRequest{ProposalId: proposalID2})
+		s.Require().ErrorContains(err, "load proposal: not found")
+		s.Require().Equal(int64(200), s.app.BankKeeper.GetBalance(sdkCtx, addrs[2], "test").Amount.Int64())
+	})
+
+	s.Run("cancel during the timelock", func() {
+		sdkCtx, proposalID := setupTimelockedProposal()
+		ctx := sdk.WrapSDKContext(sdkCtx)
+
+		_, err := s.keeper.CancelProposal(ctx, &group.MsgCancelProposal{Address: addrs[1].String(), ProposalId: proposalID})
+		s.Require().ErrorContains(err, "not the group policy admin")
+
+		_, err = s.keeper.CancelProposal(ctx, &group.MsgCancelProposal{Address: addrs[0].String(), ProposalId: proposalID})
+		s.Require().NoError(err)
+
+		res, err := s.keeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		s.Require().Equal(group.PROPOSAL_STATUS_CANCELLED, res.Proposal.Status)
+
+		sdkCtx = sdkCtx.WithBlockTime(sdkCtx.BlockTime().Add(timelock + 1))
+		module.EndBlocker(sdkCtx, s.keeper)
+		_, err = s.keeper.Exec(sdk.WrapSDKContext(sdkCtx), &group.MsgExec{Executor: addrs[1].String(), ProposalId: proposalID})
+		s.Require().ErrorContains(err, "not possible to exec with proposal status PROPOSAL_STATUS_CANCELLED")
+		s.Require().True(s.app.BankKeeper.GetBalance(sdkCtx, addrs[2], "test").IsZero())
+	})
+
+	s.Run("cannot cancel after the timelock", func() {
+		sdkCtx, proposalID := setupTimelockedProposal()
+		sdkCtx = sdkCtx.WithBlockTime(sdkCtx.BlockTime().Add(timelock))
+
+		_, err := s.keeper.CancelProposal(sdk.WrapSDKContext(sdkCtx), &group.MsgCancelProposal{Address: addrs[0].String(), ProposalId: proposalID})
+		s.Require().ErrorContains(err, "proposal is not time-locked")
+	})
+}
+
+func (s *TestSuite) TestNestedGroups() {
+	addrs := s.addrs
+	ctx := s.ctx
+
+	// Sub-group whose policy passes with both of its members' votes.
+	subPolicyAddr, subGroupID := s.createGroupAndGroupPolicy(addrs[0], []group.MemberRequest{
+		{Address: addrs[2].String(), Weight: "1"},
+		{Address: addrs[3].String(), Weight: "1"},
+	}, group.NewThresholdDecisionPolicy("2", time.Second, 0))
+
+	_, err := s.keeper.UpdateGroupMembers(ctx, &group.MsgUpdateGroupMembers{
+		Admin:         addrs[0].String(),
+		GroupId:       s.groupID,
+		MemberUpdates: []group.MemberRequest{{Address: subPolicyAddr, Weight: "2"}},
+	})
+	s.Require().NoError(err)
+
+	// The parent group's policy can be added neither to itself nor to its sub-group.
+	for _, groupID := range []uint64{s.groupID, subGroupID} {
+		_, err = s.keeper.UpdateGroupMembers(ctx, &group.MsgUpdateGroupMembers{
+			Admin:         addrs[0].String(),
+			GroupId:       groupID,
+			MemberUpdates: []group.MemberRequest{{Address: s.groupPolicyAddr.String(), Weight: "1"}},
+		})
+		s.Require().ErrorContains(err, "would create a cycle of nested groups")
+	}
+
+	treeRes, err := s.keeper.GroupVotingTree(ctx, &group.QueryGroupVotingTreeRequest{GroupId: s.groupID})
+	s.Require().NoError(err)
+	s.Require().Len(treeRes.Members, 3)
+	for _, node := range treeRes.Members {
+		if node.Address == subPolicyAddr {
+			s.Require().Equal(subGroupID, node.GroupId)
+			s.Require().Len(node.Members, 2)
+		} else {
+			s.Require().Zero(node.GroupId)
+			s.Require().Empty(node.Members)
+		}
+	}
+
+	proposalID := submitProposal(ctx, s, []sdk.Msg{}, []string{addrs[4].String()})
+
+	_, err = s.keeper.Vote(ctx, &group.MsgVote{ProposalId: proposalID, Voter: addrs[5].String(), Option: group.VOTE_OPTION_YES})
+	s.Require().ErrorContains(err, "voter address")
+
+	// A single sub-group member's vote doesn't pass the sub-group's policy.
+	_, err = s.keeper.Vote(ctx, &group.MsgVote{ProposalId: proposalID, Voter: addrs[2].String(), Option: group.VOTE_OPTION_YES})
+	s.Require().NoError(err)
+	tallyRes, err := s.keeper.TallyResult(ctx, &group.QueryTallyResultRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal("0", tallyRes.Tally.YesCount)
+
+	// Once the sub-group's policy passes, the sub-group votes yes with its full weight.
+	_, err = s.keeper.Vote(ctx, &group.MsgVote{ProposalId: proposalID, Voter: addrs[3].String(), Option: group.VOTE_OPTION_YES})
+	s.Require().NoError(err)
+	tallyRes, err = s.keeper.TallyResult(ctx, &group.QueryTallyResultRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal("2", tallyRes.Tally.YesCount)
+
+	// A direct vote of the sub-group's policy takes precedence.
+	_, err = s.keeper.Vote(ctx, &group.MsgVote{ProposalId: proposalID, Voter: subPolicyAddr, Option: group.VOTE_OPTION_NO})
+	s.Require().NoError(err)
+	tallyRes, err = s.keeper.TallyResult(ctx, &group.QueryTallyResultRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal("0", tallyRes.Tally.YesCount)
+	s.Require().Equal("2", tallyRes.Tally.NoCount)
+}
+
 func submitProposal(
 	ctx context.Context, s *TestSuite, msgs []sdk.Msg,
 	proposers []string,
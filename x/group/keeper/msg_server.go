@@ -172,6 +172,9 @@ func (k Keeper) UpdateGroupMembers(goCtx context.Context, req *group.MsgUpdateGr
 					return sdkerrors.Wrap(err, "add member")
 				}
 			} else { // else handle create.
+				if err := k.assertNoGroupCycle(ctx, req.GroupId, groupMember.Member.Address); err != nil {
+					return err
+				}
 				groupMember.Member.AddedAt = ctx.BlockTime()
 				if err := k.groupMemberTable.Create(ctx.KVStore(k.key), &groupMember); err != nil {
 					return sdkerrors.Wrap(err, "add member")
@@ -527,6 +530,12 @@ func (k Keeper) SubmitProposal(goCtx context.Context, req *group.MsgSubmitPropos
 		return nil, sdkerrors.Wrap(err, "create proposal")
 	}
 
+	// Snapshot the members' voting power for token weighted decision policies,
+	// including the ones of nested sub-groups.
+	if err := k.snapshotVotingPower(ctx, *m, g.Id, policy); err != nil {
+		return nil, sdkerrors.Wrap(err, "snapshot voting power")
+	}
+
 	err = ctx.EventManager().EmitTypedEvent(&group.EventSubmitProposal{ProposalId: id})
 	if err != nil {
 		return nil, err
@@ -633,9 +642,21 @@ func (k Keeper) Vote(goCtx context.Context, req *group.MsgVote) (*group.MsgVoteR
 	}
 
 	// Count and store votes.
+	// Members of nested sub-groups can vote too, their votes being resolved
+	// through the sub-group's decision policy at tally time.
 	voterAddr := req.Voter
 	voter := group.GroupMember{GroupId: electorate.Id, Member: &group.Member{Address: voterAddr}}
-	if err := k.groupMemberTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&voter), &voter); err != nil {
+	err = k.groupMemberTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&voter), &voter)
+	if sdkerrors.ErrNotFound.Is(err) {
+		isNestedMember, nestedErr := k.isNestedMember(ctx, electorate.Id, voterAddr, 0)
+		if nestedErr != nil {
+			return nil, nestedErr
+		}
+		if isNestedMember {
+			err = nil
+		}
+	}
+	if err != nil {
 		return nil, sdkerrors.Wrapf(err, "voter address: %s", voterAddr)
 	}
 	newVote := group.Vote{
@@ -685,7 +706,12 @@ func (k Keeper) doTallyAndUpdate(ctx sdk.Context, p *group.Proposal, electorate
 		return err
 	}
 
-	result, err := policy.Allow(tallyResult, electorate.TotalWeight)
+	totalPower, err := k.totalVotingPower(ctx, *p, electorate)
+	if err != nil {
+		return err
+	}
+
+	result, err := policy.Allow(tallyResult, totalPower)
 	if err != nil {
 		return sdkerrors.Wrap(err, "policy allow")
 	}
@@ -696,9 +722,18 @@ func (k Keeper) doTallyAndUpdate(ctx sdk.Context, p *group.Proposal, electorate
 		if err := k.pruneVotes(ctx, p.Id); err != nil {
 			return err
 		}
+		if err := k.pruneVotingPowerSnapshot(ctx, p.Id); err != nil {
+			return err
+		}
 		p.FinalTallyResult = tallyResult
 		if result.Allow {
 			p.Status = group.PROPOSAL_STATUS_ACCEPTED
+			// Accepted proposals of a time-locked policy can only be executed
+			// once the timelock has expired.
+			if timelock := policy.GetTimelock(); timelock > 0 {
+				executeAfter := ctx.BlockTime().Add(timelock)
+				p.ExecuteAfter = &executeAfter
+			}
 		} else {
 			p.Status = group.PROPOSAL_STATUS_REJECTED
 		}
@@ -741,29 +776,47 @@ func (k Keeper) Exec(goCtx context.Context, req *group.MsgExec) (*group.MsgExecR
 		}
 	}
 
+	if err := k.doExecAndUpdate(ctx, &proposal, policyInfo); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgExecResponse{
+		Result: proposal.ExecutorResult,
+	}, nil
+}
+
+// doExecAndUpdate executes the messages of an accepted proposal, unless it is
+// still time-locked, and then updates or prunes the proposal accordingly.
+func (k Keeper) doExecAndUpdate(ctx sdk.Context, proposal *group.Proposal, policyInfo group.GroupPolicyInfo) error {
+	id := proposal.Id
+
 	// Execute proposal payload.
 	var logs string
 	if proposal.Status == group.PROPOSAL_STATUS_ACCEPTED && proposal.ExecutorResult != group.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
-		// Caching context so that we don't update the store in case of failure.
-		cacheCtx, flush := ctx.CacheContext()
-
-		addr, err := sdk.AccAddressFromBech32(policyInfo.Address)
-		if err != nil {
-			return nil, err
-		}
-
-		decisionPolicy := policyInfo.DecisionPolicy.GetCachedValue().(group.DecisionPolicy)
-		if results, err := k.doExecuteMsgs(cacheCtx, k.router, proposal, addr, decisionPolicy); err != nil {
-			proposal.ExecutorResult = group.PROPOSAL_EXECUTOR_RESULT_FAILURE
-			logs = fmt.Sprintf("proposal execution failed on proposal %d, because of error %s", id, err.Error())
-			k.Logger(ctx).Info("proposal execution failed", "cause", err, "proposalID", id)
+		if proposal.ExecuteAfter != nil && ctx.BlockTime().Before(*proposal.ExecuteAfter) {
+			logs = fmt.Sprintf("proposal %d is time-locked until %s", id, proposal.ExecuteAfter)
 		} else {
-			proposal.ExecutorResult = group.PROPOSAL_EXECUTOR_RESULT_SUCCESS
-			flush()
+			// Caching context so that we don't update the store in case of failure.
+			cacheCtx, flush := ctx.CacheContext()
+
+			addr, err := sdk.AccAddressFromBech32(policyInfo.Address)
+			if err != nil {
+				return err
+			}
 
-			for _, res := range results {
-				// NOTE: The sdk msg handler creates a new EventManager, so events must be correctly propagated back to the current context
-				ctx.EventManager().EmitEvents(res.GetEvents())
+			decisionPolicy := policyInfo.DecisionPolicy.GetCachedValue().(group.DecisionPolicy)
+			if results, err := k.doExecuteMsgs(cacheCtx, k.router, *proposal, addr, decisionPolicy); err != nil {
+				proposal.ExecutorResult = group.PROPOSAL_EXECUTOR_RESULT_FAILURE
+				logs = fmt.Sprintf("proposal execution failed on proposal %d, because of error %s", id, err.Error())
+				k.Logger(ctx).Info("proposal execution failed", "cause", err, "proposalID", id)
+			} else {
+				proposal.ExecutorResult = group.PROPOSAL_EXECUTOR_RESULT_SUCCESS
+				flush()
+
+				for _, res := range results {
+					// NOTE: The sdk msg handler creates a new EventManager, so events must be correctly propagated back to the current context
+					ctx.EventManager().EmitEvents(res.GetEvents())
+				}
 			}
 		}
 	}
@@ -771,38 +824,71 @@ func (k Keeper) Exec(goCtx context.Context, req *group.MsgExec) (*group.MsgExecR
 	// Update proposal in proposalTable
 	// If proposal has successfully run, delete it from state.
 	if proposal.ExecutorResult == group.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
-		if err := k.pruneProposal(ctx, proposal.Id); err != nil {
-			return nil, err
+		if err := k.pruneProposal(ctx, id); err != nil {
+			return err
 		}
 
 		// Emit event for proposal finalized with its result
 		if err := ctx.EventManager().EmitTypedEvent(
 			&group.EventProposalPruned{
-				ProposalId:  proposal.Id,
+				ProposalId:  id,
 				Status:      proposal.Status,
 				TallyResult: &proposal.FinalTallyResult,
 			}); err != nil {
-			return nil, err
+			return err
 		}
 	} else {
 		store := ctx.KVStore(k.key)
-		if err := k.proposalTable.Update(store, id, &proposal); err != nil {
-			return nil, err
+		if err := k.proposalTable.Update(store, id, proposal); err != nil {
+			return err
 		}
 	}
 
-	err = ctx.EventManager().EmitTypedEvent(&group.EventExec{
+	return ctx.EventManager().EmitTypedEvent(&group.EventExec{
 		ProposalId: id,
 		Logs:       logs,
 		Result:     proposal.ExecutorResult,
 	})
+}
+
+// CancelProposal implements the MsgServer/CancelProposal method.
+func (k Keeper) CancelProposal(goCtx context.Context, req *group.MsgCancelProposal) (*group.MsgCancelProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	id := req.ProposalId
+
+	proposal, err := k.getProposal(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
-	return &group.MsgExecResponse{
-		Result: proposal.ExecutorResult,
-	}, nil
+	// Ensure the proposal is still time-locked.
+	if proposal.Status != group.PROPOSAL_STATUS_ACCEPTED || proposal.ExecutorResult == group.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
+		return nil, sdkerrors.Wrapf(errors.ErrInvalid, "cannot cancel a proposal with the status of %s", proposal.Status.String())
+	}
+	if proposal.ExecuteAfter == nil || !ctx.BlockTime().Before(*proposal.ExecuteAfter) {
+		return nil, sdkerrors.Wrap(errors.ErrInvalid, "proposal is not time-locked")
+	}
+
+	policyInfo, err := k.getGroupPolicyInfo(ctx, proposal.GroupPolicyAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "load group policy")
+	}
+
+	if req.Address != policyInfo.Admin {
+		return nil, sdkerrors.Wrapf(errors.ErrUnauthorized, "given address is not the group policy admin: %s", req.Address)
+	}
+
+	proposal.Status = group.PROPOSAL_STATUS_CANCELLED
+	if err := k.proposalTable.Update(ctx.KVStore(k.key), id, &proposal); err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&group.EventCancelProposal{ProposalId: id})
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.MsgCancelProposalResponse{}, nil
 }
 
 // LeaveGroup implements the MsgServer/LeaveGroup method.
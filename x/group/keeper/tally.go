@@ -20,44 +20,291 @@ func (k Keeper) Tally(ctx sdk.Context, p group.Proposal, groupID uint64) (group.
 		return p.FinalTallyResult, nil
 	}
 
-	it, err := k.voteByProposalIndex.Get(ctx.KVStore(k.key), p.Id)
+	votes, err := k.votesByProposal(ctx, p.Id)
 	if err != nil {
 		return group.TallyResult{}, err
 	}
-	defer it.Close()
+	votesByVoter := make(map[string]group.Vote, len(votes))
+	for _, vote := range votes {
+		votesByVoter[vote.Voter] = vote
+	}
 
+	// Proposals submitted under a token weighted decision policy use the
+	// voting power snapshotted at submission instead of the member weight.
+	snapshot, hasSnapshot, err := k.getVotingPowerSnapshot(ctx, p.Id, p.GroupPolicyAddress)
+	if err != nil {
+		return group.TallyResult{}, err
+	}
+	electorate := snapshot.Powers
+	if !hasSnapshot {
+		if electorate, err = k.memberWeights(ctx, groupID); err != nil {
+			return group.TallyResult{}, err
+		}
+	}
+
+	return k.tallyElectorate(ctx, p.Id, electorate, votesByVoter, 0)
+}
+
+// tallyElectorate tallies the votes of the voters of a group, with the given
+// voting power. Voters which are group policies and didn't vote themselves
+// vote with their full power according to their own decision policy applied
+// to the votes of their sub-group's members.
+func (k Keeper) tallyElectorate(
+	ctx sdk.Context, proposalID uint64, electorate []group.MemberVotingPower, votes map[string]group.Vote, depth uint64,
+) (group.TallyResult, error) {
 	tallyResult := group.DefaultTallyResult()
 
-	for {
-		var vote group.Vote
-		_, err = it.LoadNext(&vote)
-		if errors.ErrORMIteratorDone.Is(err) {
-			break
+	// Votes of voters who are not part of the electorate, e.g. members who
+	// left the group after voting, are simply skipped.
+	for _, voter := range electorate {
+		vote, ok := votes[voter.Address]
+		if !ok {
+			option, hasVoted, err := k.subGroupVote(ctx, proposalID, voter.Address, votes, depth+1)
+			if err != nil {
+				return group.TallyResult{}, err
+			}
+			if !hasVoted {
+				continue
+			}
+			vote = group.Vote{Voter: voter.Address, Option: option}
+		}
+
+		if err := tallyResult.Add(vote, voter.Power); err != nil {
+			return group.TallyResult{}, sdkerrors.Wrap(err, "add new vote")
 		}
+	}
+
+	return tallyResult, nil
+}
+
+// subGroupVote resolves the vote of a voter which is a group policy from the
+// votes of its sub-group's members: yes if they pass its decision policy, no
+// if they definitely don't, and no vote otherwise. The sub-group's members
+// vote with the power defined by the policy type: their voting power
+// snapshotted at the proposal submission for token weighted decision policies,
+// their member weight otherwise. Sub-groups deeper than MaxNestingDepth don't
+// vote.
+func (k Keeper) subGroupVote(
+	ctx sdk.Context, proposalID uint64, address string, votes map[string]group.Vote, depth uint64,
+) (group.VoteOption, bool, error) {
+	if depth > k.config.MaxNestingDepth {
+		return group.VOTE_OPTION_UNSPECIFIED, false, nil
+	}
+
+	policyInfo, err := k.getGroupPolicyInfo(ctx, address)
+	switch {
+	case sdkerrors.ErrNotFound.Is(err):
+		return group.VOTE_OPTION_UNSPECIFIED, false, nil
+	case err != nil:
+		return group.VOTE_OPTION_UNSPECIFIED, false, err
+	}
+	ctx.GasMeter().ConsumeGas(gasCostPerIteration, "tally sub-group")
+
+	policy, err := policyInfo.GetDecisionPolicy()
+	if err != nil {
+		return group.VOTE_OPTION_UNSPECIFIED, false, err
+	}
+
+	var (
+		electorate []group.MemberVotingPower
+		totalPower string
+	)
+	if _, ok := policy.(*group.TokenWeightedDecisionPolicy); ok {
+		// A sub-group without snapshot held no voting power at submission.
+		snapshot, hasSnapshot, err := k.getVotingPowerSnapshot(ctx, proposalID, address)
+		if err != nil || !hasSnapshot {
+			return group.VOTE_OPTION_UNSPECIFIED, false, err
+		}
+		electorate, totalPower = snapshot.Powers, snapshot.TotalPower
+	} else {
+		subGroup, err := k.getGroupInfo(ctx, policyInfo.GroupId)
 		if err != nil {
-			return group.TallyResult{}, err
+			return group.VOTE_OPTION_UNSPECIFIED, false, sdkerrors.Wrap(err, "sub-group")
+		}
+		if electorate, err = k.memberWeights(ctx, subGroup.Id); err != nil {
+			return group.VOTE_OPTION_UNSPECIFIED, false, err
 		}
+		totalPower = subGroup.TotalWeight
+	}
 
-		var member group.GroupMember
-		err := k.groupMemberTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&group.GroupMember{
-			GroupId: groupID,
-			Member:  &group.Member{Address: vote.Voter},
-		}), &member)
+	subTally, err := k.tallyElectorate(ctx, proposalID, electorate, votes, depth)
+	if err != nil {
+		return group.VOTE_OPTION_UNSPECIFIED, false, err
+	}
 
+	result, err := policy.Allow(subTally, totalPower)
+	if err != nil {
+		return group.VOTE_OPTION_UNSPECIFIED, false, sdkerrors.Wrap(err, "sub-group policy allow")
+	}
+
+	switch {
+	case result.Allow:
+		return group.VOTE_OPTION_YES, true, nil
+	case result.Final:
+		return group.VOTE_OPTION_NO, true, nil
+	default:
+		return group.VOTE_OPTION_UNSPECIFIED, false, nil
+	}
+}
+
+// memberWeights returns the members of a group with their weight as voting
+// power.
+func (k Keeper) memberWeights(ctx sdk.Context, groupID uint64) ([]group.MemberVotingPower, error) {
+	members, err := k.groupMembers(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	weights := make([]group.MemberVotingPower, len(members))
+	for i, member := range members {
+		weights[i] = group.MemberVotingPower{Address: member.Member.Address, Power: member.Member.Weight}
+	}
+	return weights, nil
+}
+
+// totalVotingPower returns the total voting power a proposal is tallied
+// against: the snapshotted power for proposals submitted under a token
+// weighted decision policy, the group's total weight otherwise.
+func (k Keeper) totalVotingPower(ctx sdk.Context, p group.Proposal, electorate group.GroupInfo) (string, error) {
+	snapshot, hasSnapshot, err := k.getVotingPowerSnapshot(ctx, p.Id, p.GroupPolicyAddress)
+	if err != nil {
+		return "", err
+	}
+	if !hasSnapshot {
+		return electorate.TotalWeight, nil
+	}
+	return snapshot.TotalPower, nil
+}
+
+// getVotingPowerSnapshot returns the voting power snapshot of the group of a
+// group policy for a proposal, and false if there is none.
+func (k Keeper) getVotingPowerSnapshot(ctx sdk.Context, proposalID uint64, policyAddress string) (group.VotingPowerSnapshot, bool, error) {
+	var snapshot group.VotingPowerSnapshot
+	key := orm.PrimaryKey(&group.VotingPowerSnapshot{ProposalId: proposalID, GroupPolicyAddress: policyAddress})
+	err := k.votingPowerSnapshotTable.GetOne(ctx.KVStore(k.key), key, &snapshot)
+	switch {
+	case sdkerrors.ErrNotFound.Is(err):
+		return group.VotingPowerSnapshot{}, false, nil
+	case err != nil:
+		return group.VotingPowerSnapshot{}, false, err
+	}
+	return snapshot, true, nil
+}
+
+// snapshotVotingPower stores the voting power of all the members of the group
+// of the group policy of a proposal submitted under a token weighted decision
+// policy, and of the nested sub-groups with a token weighted decision policy.
+// Members without any voting power are left out of the snapshots.
+func (k Keeper) snapshotVotingPower(ctx sdk.Context, p group.Proposal, groupID uint64, policy group.DecisionPolicy) error {
+	if tokenPolicy, ok := policy.(*group.TokenWeightedDecisionPolicy); ok {
+		snapshot, err := k.computeVotingPower(ctx, p.Id, p.GroupPolicyAddress, groupID, tokenPolicy)
+		if err != nil {
+			return err
+		}
+		// Prevent proposal that can not succeed.
+		if snapshot.Powers == nil {
+			return sdkerrors.Wrapf(errors.ErrInvalid, "group members don't hold any %s voting power", tokenPolicy.Denom)
+		}
+		if err := k.votingPowerSnapshotTable.Create(ctx.KVStore(k.key), &snapshot); err != nil {
+			return err
+		}
+	}
+
+	return k.snapshotSubGroupsVotingPower(ctx, p.Id, groupID, 1)
+}
+
+// snapshotSubGroupsVotingPower stores the voting power snapshots of the
+// sub-groups of a group with a token weighted decision policy, resolving up to
+// MaxNestingDepth levels of nesting. Sub-groups without any voting power are
+// left out.
+func (k Keeper) snapshotSubGroupsVotingPower(ctx sdk.Context, proposalID, groupID uint64, depth uint64) error {
+	if depth > k.config.MaxNestingDepth {
+		return nil
+	}
+
+	members, err := k.groupMembers(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	for _, member := range members {
+		policyInfo, err := k.getGroupPolicyInfo(ctx, member.Member.Address)
 		switch {
 		case sdkerrors.ErrNotFound.Is(err):
-			// If the member left the group after voting, then we simply skip the
-			// vote.
 			continue
 		case err != nil:
-			// For any other errors, we stop and return the error.
-			return group.TallyResult{}, err
+			return err
 		}
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "snapshot sub-group voting power")
 
-		if err := tallyResult.Add(vote, member.Member.Weight); err != nil {
-			return group.TallyResult{}, sdkerrors.Wrap(err, "add new vote")
+		policy, err := policyInfo.GetDecisionPolicy()
+		if err != nil {
+			return err
+		}
+		tokenPolicy, ok := policy.(*group.TokenWeightedDecisionPolicy)
+		key := orm.PrimaryKey(&group.VotingPowerSnapshot{ProposalId: proposalID, GroupPolicyAddress: policyInfo.Address})
+		if ok && !k.votingPowerSnapshotTable.Has(ctx.KVStore(k.key), key) {
+			snapshot, err := k.computeVotingPower(ctx, proposalID, policyInfo.Address, policyInfo.GroupId, tokenPolicy)
+			if err != nil {
+				return err
+			}
+			if snapshot.Powers != nil {
+				if err := k.votingPowerSnapshotTable.Create(ctx.KVStore(k.key), &snapshot); err != nil {
+					return err
+				}
+			}
+		}
+
+		if err := k.snapshotSubGroupsVotingPower(ctx, proposalID, policyInfo.GroupId, depth+1); err != nil {
+			return err
 		}
 	}
+	return nil
+}
 
-	return tallyResult, nil
+// computeVotingPower returns the voting power snapshot of the members of the
+// group of a group policy with a token weighted decision policy.
+func (k Keeper) computeVotingPower(
+	ctx sdk.Context, proposalID uint64, policyAddress string, groupID uint64, policy *group.TokenWeightedDecisionPolicy,
+) (group.VotingPowerSnapshot, error) {
+	if policy.Source == group.TOKEN_WEIGHT_SOURCE_STAKED {
+		if bondDenom := k.stakingKeeper.BondDenom(ctx); policy.Denom != bondDenom {
+			return group.VotingPowerSnapshot{}, sdkerrors.Wrapf(errors.ErrInvalid, "staked token weight source requires the bond denom %s, got %s", bondDenom, policy.Denom)
+		}
+	}
+
+	members, err := k.groupMembers(ctx, groupID)
+	if err != nil {
+		return group.VotingPowerSnapshot{}, err
+	}
+
+	snapshot := group.VotingPowerSnapshot{ProposalId: proposalID, GroupPolicyAddress: policyAddress}
+	totalPower := sdk.ZeroInt()
+	for _, member := range members {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "snapshot voting power")
+		addr, err := sdk.AccAddressFromBech32(member.Member.Address)
+		if err != nil {
+			return group.VotingPowerSnapshot{}, err
+		}
+
+		var power sdk.Int
+		switch policy.Source {
+		case group.TOKEN_WEIGHT_SOURCE_BALANCE:
+			power = k.bankKeeper.GetBalance(ctx, addr, policy.Denom).Amount
+		case group.TOKEN_WEIGHT_SOURCE_STAKED:
+			power = k.stakingKeeper.GetDelegatorBonded(ctx, addr)
+		default:
+			return group.VotingPowerSnapshot{}, sdkerrors.Wrapf(errors.ErrInvalid, "token weight source %s", policy.Source)
+		}
+		if !power.IsPositive() {
+			continue
+		}
+
+		snapshot.Powers = append(snapshot.Powers, group.MemberVotingPower{
+			Address: member.Member.Address,
+			Power:   power.String(),
+		})
+		totalPower = totalPower.Add(power)
+	}
+	snapshot.TotalPower = totalPower.String()
+
+	return snapshot, nil
 }
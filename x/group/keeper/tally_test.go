@@ -5,6 +5,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/group"
 )
@@ -88,3 +89,180 @@ func (s *TestSuite) TestTally() {
 		})
 	}
 }
+
+func (s *TestSuite) TestTokenWeightedTally() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := sdk.WrapSDKContext(sdkCtx)
+	addrs := s.addrs
+	whale, minnow, poor := addrs[2], addrs[3], addrs[5]
+
+	s.Require().NoError(testutil.FundAccount(s.app.BankKeeper, sdkCtx, whale, sdk.NewCoins(sdk.NewInt64Coin("gov", 60))))
+	s.Require().NoError(testutil.FundAccount(s.app.BankKeeper, sdkCtx, minnow, sdk.NewCoins(sdk.NewInt64Coin("gov", 40))))
+
+	members := []group.MemberRequest{
+		{Address: whale.String(), Weight: "1"},
+		{Address: minnow.String(), Weight: "1"},
+		{Address: poor.String(), Weight: "1"},
+	}
+	groupRes, err := s.keeper.CreateGroup(ctx, &group.MsgCreateGroup{
+		Admin:   addrs[0].String(),
+		Members: members,
+	})
+	s.Require().NoError(err)
+
+	createPolicy := func(policy group.DecisionPolicy) string {
+		policyReq := &group.MsgCreateGroupPolicy{
+			Admin:   addrs[0].String(),
+			GroupId: groupRes.GroupId,
+		}
+		s.Require().NoError(policyReq.SetDecisionPolicy(policy))
+		policyRes, err := s.keeper.CreateGroupPolicy(ctx, policyReq)
+		s.Require().NoError(err)
+		return policyRes.Address
+	}
+	submit := func(policyAddr string) (uint64, error) {
+		res, err := s.keeper.SubmitProposal(ctx, &group.MsgSubmitProposal{
+			GroupPolicyAddress: policyAddr,
+			Proposers:          []string{minnow.String()},
+		})
+		if err != nil {
+			return 0, err
+		}
+		return res.ProposalId, nil
+	}
+
+	s.Run("no voting power", func() {
+		policyAddr := createPolicy(group.NewTokenWeightedDecisionPolicy("nobody", group.TOKEN_WEIGHT_SOURCE_BALANCE, "0.5", time.Second, 0))
+		_, err := submit(policyAddr)
+		s.Require().ErrorContains(err, "group members don't hold any nobody voting power")
+	})
+
+	s.Run("staked source requires bond denom", func() {
+		policyAddr := createPolicy(group.NewTokenWeightedDecisionPolicy("gov", group.TOKEN_WEIGHT_SOURCE_STAKED, "0.5", time.Second, 0))
+		_, err := submit(policyAddr)
+		s.Require().ErrorContains(err, "staked token weight source requires the bond denom")
+	})
+
+	s.Run("votes are weighted by the snapshotted balances", func() {
+		policyAddr := createPolicy(group.NewTokenWeightedDecisionPolicy("gov", group.TOKEN_WEIGHT_SOURCE_BALANCE, "0.5", time.Second, 0))
+		proposalID, err := submit(policyAddr)
+		s.Require().NoError(err)
+
+		// Moving tokens after submission doesn't change the voting power.
+		s.Require().NoError(s.app.BankKeeper.SendCoins(sdkCtx, minnow, whale, sdk.NewCoins(sdk.NewInt64Coin("gov", 40))))
+
+		for _, voter := range []sdk.AccAddress{minnow, poor} {
+			_, err = s.keeper.Vote(ctx, &group.MsgVote{
+				ProposalId: proposalID,
+				Voter:      voter.String(),
+				Option:     group.VOTE_OPTION_YES,
+			})
+			s.Require().NoError(err)
+		}
+
+		res, err := s.keeper.TallyResult(ctx, &group.QueryTallyResultRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		s.Require().Equal(group.TallyResult{
+			YesCount:        "40",
+			NoCount:         "0",
+			NoWithVetoCount: "0",
+			AbstainCount:    "0",
+		}, res.Tally)
+
+		_, err = s.keeper.Vote(ctx, &group.MsgVote{
+			ProposalId: proposalID,
+			Voter:      whale.String(),
+			Option:     group.VOTE_OPTION_NO,
+			Exec:       group.Exec_EXEC_TRY,
+		})
+		s.Require().NoError(err)
+
+		proposal, err := s.keeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		s.Require().Equal(group.PROPOSAL_STATUS_REJECTED, proposal.Proposal.Status)
+		s.Require().Equal("60", proposal.Proposal.FinalTallyResult.NoCount)
+
+		// The snapshot is pruned together with the votes.
+		s.Require().Empty(s.keeper.ExportGenesis(sdkCtx, s.app.AppCodec()).VotingPowerSnapshots)
+	})
+}
+
+func (s *TestSuite) TestNestedTokenWeightedTally() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := sdk.WrapSDKContext(sdkCtx)
+	addrs := s.addrs
+	whale, minnow, other := addrs[2], addrs[3], addrs[4]
+
+	s.Require().NoError(testutil.FundAccount(s.app.BankKeeper, sdkCtx, whale, sdk.NewCoins(sdk.NewInt64Coin("gov", 60))))
+	s.Require().NoError(testutil.FundAccount(s.app.BankKeeper, sdkCtx, minnow, sdk.NewCoins(sdk.NewInt64Coin("gov", 40))))
+
+	createGroupWithPolicy := func(members []group.MemberRequest, policy group.DecisionPolicy) string {
+		req := &group.MsgCreateGroupWithPolicy{Admin: addrs[0].String(), Members: members}
+		s.Require().NoError(req.SetDecisionPolicy(policy))
+		res, err := s.keeper.CreateGroupWithPolicy(ctx, req)
+		s.Require().NoError(err)
+		return res.GroupPolicyAddress
+	}
+	submit := func(policyAddr string) uint64 {
+		res, err := s.keeper.SubmitProposal(ctx, &group.MsgSubmitProposal{
+			GroupPolicyAddress: policyAddr,
+			Proposers:          []string{other.String()},
+		})
+		s.Require().NoError(err)
+		return res.ProposalId
+	}
+	vote := func(proposalID uint64, voter sdk.AccAddress) {
+		_, err := s.keeper.Vote(ctx, &group.MsgVote{ProposalId: proposalID, Voter: voter.String(), Option: group.VOTE_OPTION_YES})
+		s.Require().NoError(err)
+	}
+	yesCount := func(proposalID uint64) string {
+		res, err := s.keeper.TallyResult(ctx, &group.QueryTallyResultRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		return res.Tally.YesCount
+	}
+
+	// Sub-group whose members have the same weight but different balances.
+	subMembers := []group.MemberRequest{{Address: whale.String(), Weight: "1"}, {Address: minnow.String(), Weight: "1"}}
+
+	s.Run("token weighted sub-group policy", func() {
+		subPolicyAddr := createGroupWithPolicy(subMembers,
+			group.NewTokenWeightedDecisionPolicy("gov", group.TOKEN_WEIGHT_SOURCE_BALANCE, "0.5", time.Second, 0))
+		policyAddr := createGroupWithPolicy([]group.MemberRequest{
+			{Address: subPolicyAddr, Weight: "2"},
+			{Address: other.String(), Weight: "1"},
+		}, group.NewThresholdDecisionPolicy("3", time.Second, 0))
+		proposalID := submit(policyAddr)
+
+		// Moving tokens after submission doesn't change the sub-group's voting power.
+		s.Require().NoError(s.app.BankKeeper.SendCoins(sdkCtx, whale, minnow, sdk.NewCoins(sdk.NewInt64Coin("gov", 60))))
+
+		// The minnow's snapshotted balance doesn't pass the sub-group's policy,
+		// even though it holds half the sub-group's member weight.
+		vote(proposalID, minnow)
+		s.Require().Equal("0", yesCount(proposalID))
+
+		vote(proposalID, whale)
+		s.Require().Equal("2", yesCount(proposalID))
+	})
+
+	s.Run("snapshot proposal with nested voters", func() {
+		subPolicyAddr := createGroupWithPolicy(subMembers, group.NewThresholdDecisionPolicy("2", time.Second, 0))
+		subPolicy := sdk.MustAccAddressFromBech32(subPolicyAddr)
+		s.Require().NoError(testutil.FundAccount(s.app.BankKeeper, sdkCtx, subPolicy, sdk.NewCoins(sdk.NewInt64Coin("gov", 30))))
+		s.Require().NoError(testutil.FundAccount(s.app.BankKeeper, sdkCtx, other, sdk.NewCoins(sdk.NewInt64Coin("gov", 10))))
+
+		policyAddr := createGroupWithPolicy([]group.MemberRequest{
+			{Address: subPolicyAddr, Weight: "1"},
+			{Address: other.String(), Weight: "1"},
+		}, group.NewTokenWeightedDecisionPolicy("gov", group.TOKEN_WEIGHT_SOURCE_BALANCE, "0.5", time.Second, 0))
+		proposalID := submit(policyAddr)
+
+		vote(proposalID, whale)
+		s.Require().Equal("0", yesCount(proposalID))
+
+		// Once the sub-group's policy passes, the sub-group votes yes with its
+		// snapshotted balance.
+		vote(proposalID, minnow)
+		s.Require().Equal("30", yesCount(proposalID))
+	})
+}
@@ -10,6 +10,10 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 		panic(err)
 	}
 
+	if err := k.ExecTimelockedProposals(ctx); err != nil {
+		panic(err)
+	}
+
 	if err := k.PruneProposals(ctx); err != nil {
 		panic(err)
 	}
@@ -689,6 +689,40 @@ func (m MsgWithdrawProposal) ValidateBasic() error {
 	return nil
 }
 
+var _ sdk.Msg = &MsgCancelProposal{}
+
+// Route Implements Msg.
+func (m MsgCancelProposal) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type Implements Msg.
+func (m MsgCancelProposal) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes Implements Msg.
+func (m MsgCancelProposal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgCancelProposal.
+func (m MsgCancelProposal) GetSigners() []sdk.AccAddress {
+	admin := sdk.MustAccAddressFromBech32(m.Address)
+
+	return []sdk.AccAddress{admin}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgCancelProposal) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Address)
+	if err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+
+	if m.ProposalId == 0 {
+		return sdkerrors.Wrap(errors.ErrEmpty, "proposal id")
+	}
+
+	return nil
+}
+
 var _ sdk.Msg = &MsgVote{}
 
 // Route Implements Msg.
@@ -1070,6 +1070,55 @@ func TestMsgWithdrawProposal(t *testing.T) {
 	}
 }
 
+func TestMsgCancelProposal(t *testing.T) {
+	testCases := []struct {
+		name   string
+		msg    *group.MsgCancelProposal
+		expErr bool
+		errMsg string
+	}{
+		{
+			"invalid address",
+			&group.MsgCancelProposal{
+				Address: "address",
+			},
+			true,
+			"decoding bech32 failed",
+		},
+		{
+			"proposal id is required",
+			&group.MsgCancelProposal{
+				Address: member1.String(),
+			},
+			true,
+			"proposal id: value is empty",
+		},
+		{
+			"valid msg",
+			&group.MsgCancelProposal{
+				Address:    member1.String(),
+				ProposalId: 1,
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := tc.msg
+			err := msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, msg.Type(), sdk.MsgTypeURL(&group.MsgCancelProposal{}))
+			}
+		})
+	}
+}
+
 func TestMsgExec(t *testing.T) {
 	testCases := []struct {
 		name   string
@@ -1485,6 +1485,98 @@ func (m *QueryGroupsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryGroupVotingTreeRequest is the Query/GroupVotingTree request type.
+type QueryGroupVotingTreeRequest struct {
+	// group_id is the unique ID of the group.
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+}
+
+func (m *QueryGroupVotingTreeRequest) Reset()         { *m = QueryGroupVotingTreeRequest{} }
+func (m *QueryGroupVotingTreeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupVotingTreeRequest) ProtoMessage()    {}
+func (*QueryGroupVotingTreeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{28}
+}
+func (m *QueryGroupVotingTreeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGroupVotingTreeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGroupVotingTreeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGroupVotingTreeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupVotingTreeRequest.Merge(m, src)
+}
+func (m *QueryGroupVotingTreeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGroupVotingTreeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupVotingTreeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGroupVotingTreeRequest proto.InternalMessageInfo
+
+func (m *QueryGroupVotingTreeRequest) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+// QueryGroupVotingTreeResponse is the Query/GroupVotingTree response type.
+type QueryGroupVotingTreeResponse struct {
+	// members are the nodes of the group's voting tree.
+	Members []*VotingTreeNode `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (m *QueryGroupVotingTreeResponse) Reset()         { *m = QueryGroupVotingTreeResponse{} }
+func (m *QueryGroupVotingTreeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupVotingTreeResponse) ProtoMessage()    {}
+func (*QueryGroupVotingTreeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{29}
+}
+func (m *QueryGroupVotingTreeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGroupVotingTreeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGroupVotingTreeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGroupVotingTreeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupVotingTreeResponse.Merge(m, src)
+}
+func (m *QueryGroupVotingTreeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGroupVotingTreeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupVotingTreeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGroupVotingTreeResponse proto.InternalMessageInfo
+
+func (m *QueryGroupVotingTreeResponse) GetMembers() []*VotingTreeNode {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryGroupInfoRequest)(nil), "cosmos.group.v1.QueryGroupInfoRequest")
 	proto.RegisterType((*QueryGroupInfoResponse)(nil), "cosmos.group.v1.QueryGroupInfoResponse")
@@ -1514,93 +1606,99 @@ func init() {
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.group.v1.QueryTallyResultResponse")
 	proto.RegisterType((*QueryGroupsRequest)(nil), "cosmos.group.v1.QueryGroupsRequest")
 	proto.RegisterType((*QueryGroupsResponse)(nil), "cosmos.group.v1.QueryGroupsResponse")
+	proto.RegisterType((*QueryGroupVotingTreeRequest)(nil), "cosmos.group.v1.QueryGroupVotingTreeRequest")
+	proto.RegisterType((*QueryGroupVotingTreeResponse)(nil), "cosmos.group.v1.QueryGroupVotingTreeResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/query.proto", fileDescriptor_0fcf9f1d74302290) }
 
 var fileDescriptor_0fcf9f1d74302290 = []byte{
-	// 1292 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0x4b, 0x6f, 0x1b, 0x55,
-	0x14, 0xc7, 0x73, 0x4b, 0x9e, 0x27, 0x6d, 0x23, 0x6e, 0xd3, 0x36, 0x99, 0x46, 0x4e, 0x98, 0x96,
-	0xbc, 0x33, 0x13, 0x3b, 0x69, 0x5a, 0xf1, 0x54, 0x2d, 0x41, 0xc8, 0xa2, 0x28, 0x35, 0x11, 0x0b,
-	0x84, 0x14, 0x8d, 0xe3, 0x89, 0x19, 0x61, 0xcf, 0xb8, 0x9e, 0x49, 0x84, 0x15, 0x79, 0x83, 0x04,
-	0x0b, 0xc4, 0x02, 0x5a, 0x84, 0x4a, 0xc4, 0xa2, 0x0b, 0x24, 0xf8, 0x00, 0x20, 0x24, 0x76, 0xdd,
-	0x75, 0x59, 0xc1, 0x86, 0x15, 0x42, 0x09, 0xdf, 0x82, 0x0d, 0x9a, 0x7b, 0xcf, 0xb5, 0xe7, 0xed,
-	0x89, 0xb0, 0xc0, 0xab, 0x76, 0x66, 0xce, 0xb9, 0xe7, 0x37, 0xff, 0x73, 0xe6, 0xfa, 0x7f, 0x03,
-	0xd7, 0xf6, 0x2c, 0xbb, 0x6a, 0xd9, 0x6a, 0xb9, 0x6e, 0x1d, 0xd4, 0xd4, 0xc3, 0xac, 0x7a, 0xff,
-	0x40, 0xaf, 0x37, 0x94, 0x5a, 0xdd, 0x72, 0x2c, 0x3a, 0xc6, 0x1f, 0x2a, 0xec, 0xa1, 0x72, 0x98,
-	0x95, 0xc6, 0xcb, 0x56, 0xd9, 0x62, 0xcf, 0x54, 0xf7, 0x7f, 0x3c, 0x4c, 0x9a, 0x2a, 0x5b, 0x56,
-	0xb9, 0xa2, 0xab, 0x5a, 0xcd, 0x50, 0x35, 0xd3, 0xb4, 0x1c, 0xcd, 0x31, 0x2c, 0xd3, 0xc6, 0xa7,
-	0xa1, 0x0a, 0x4e, 0xa3, 0xa6, 0x8b, 0x87, 0x8b, 0xf8, 0xb0, 0xa8, 0xd9, 0x3a, 0x2f, 0xad, 0x1e,
-	0x66, 0x8b, 0xba, 0xa3, 0x65, 0xd5, 0x9a, 0x56, 0x36, 0x4c, 0xb6, 0x12, 0xc6, 0x4e, 0xf2, 0xd8,
-	0x5d, 0x5e, 0x1f, 0xd1, 0xd8, 0x85, 0x9c, 0x83, 0xcb, 0xf7, 0xdc, 0xe4, 0x4d, 0xb7, 0xc6, 0x96,
-	0xb9, 0x6f, 0x15, 0xf4, 0xfb, 0x07, 0xba, 0xed, 0xd0, 0x49, 0x18, 0x66, 0x75, 0x77, 0x8d, 0xd2,
-	0x04, 0x99, 0x21, 0xf3, 0xfd, 0x85, 0x21, 0x76, 0xbd, 0x55, 0x92, 0xdf, 0x82, 0x2b, 0xc1, 0x1c,
-	0xbb, 0x66, 0x99, 0xb6, 0x4e, 0x15, 0xe8, 0x37, 0xcc, 0x7d, 0x8b, 0x25, 0x8c, 0xe6, 0x24, 0x25,
-	0xa0, 0x82, 0xd2, 0xce, 0x60, 0x71, 0xf2, 0x3d, 0xb8, 0xd6, 0x5e, 0x69, 0xdb, 0xaa, 0x18, 0x7b,
-	0x0d, 0x2f, 0x43, 0x0e, 0x86, 0xb4, 0x52, 0xa9, 0xae, 0xdb, 0x36, 0x5b, 0x71, 0x24, 0x3f, 0xf1,
-	0xeb, 0x8f, 0x2b, 0xe3, 0xb8, 0xe8, 0x1d, 0xfe, 0xe4, 0x1d, 0xa7, 0x6e, 0x98, 0xe5, 0x82, 0x08,
-	0x94, 0x77, 0x60, 0x2a, 0x7a, 0x49, 0x44, 0x5c, 0xf7, 0x21, 0xce, 0x44, 0x23, 0x7a, 0xf2, 0x38,
-	0x68, 0x13, 0x26, 0xda, 0xab, 0xde, 0xd5, 0xab, 0x45, 0xbd, 0x6e, 0x77, 0x56, 0x8a, 0xbe, 0x09,
-	0xd0, 0x6e, 0xc6, 0xc4, 0x39, 0x56, 0x72, 0x56, 0x94, 0x74, 0x3b, 0xa7, 0xf0, 0xa1, 0xc1, 0xce,
-	0x29, 0xdb, 0x5a, 0x59, 0xc7, 0x65, 0x0b, 0x9e, 0x4c, 0xf9, 0x5b, 0x02, 0x93, 0x11, 0xf5, 0xf1,
-	0x95, 0x36, 0x60, 0xa8, 0xca, 0x6f, 0x4d, 0x90, 0x99, 0xe7, 0xe6, 0x47, 0x73, 0x53, 0xd1, 0x6f,
-	0xc5, 0xf3, 0x0a, 0x22, 0x98, 0x6e, 0x46, 0xd0, 0xcd, 0x75, 0xa4, 0xe3, 0x45, 0x7d, 0x78, 0x0f,
-	0x7d, 0x78, 0x76, 0xbe, 0x71, 0xa7, 0x54, 0x35, 0x4c, 0xa1, 0x8f, 0x02, 0x03, 0x9a, 0x7b, 0xdd,
-	0xb1, 0x87, 0x3c, 0xac, 0x6b, 0xa2, 0x7d, 0x43, 0x40, 0x8a, 0xa2, 0x42, 0xd5, 0x72, 0x30, 0xc8,
-	0xe4, 0x11, 0xa2, 0x25, 0x4d, 0x2b, 0x46, 0x76, 0x4f, 0xb1, 0x4f, 0x08, 0xcc, 0x04, 0xc6, 0xd4,
-	0xd0, 0xed, 0x3c, 0xbf, 0xfc, 0x0f, 0x07, 0xeb, 0x27, 0x02, 0x2f, 0x24, 0x70, 0xa0, 0x54, 0x9b,
-	0x70, 0x91, 0x83, 0xd4, 0x30, 0x00, 0x25, 0xeb, 0xfc, 0xf5, 0x5c, 0x28, 0x7b, 0xd7, 0xed, 0x9e,
-	0x7e, 0xc7, 0x31, 0xfa, 0xf5, 0xc4, 0xe0, 0xc5, 0x89, 0xea, 0x9f, 0xbf, 0xde, 0x13, 0xf5, 0x16,
-	0x8c, 0x33, 0xec, 0xed, 0xba, 0x55, 0xb3, 0x6c, 0xad, 0x22, 0x74, 0x9c, 0x86, 0xd1, 0x1a, 0xde,
-	0x6a, 0x8f, 0x22, 0x88, 0x5b, 0x5b, 0x25, 0xf9, 0x6d, 0xfc, 0x11, 0x69, 0x27, 0xe2, 0x3b, 0xde,
-	0x84, 0x61, 0x11, 0x86, 0x1b, 0xee, 0x64, 0xe8, 0xed, 0x5a, 0x49, 0xad, 0x50, 0xf9, 0x31, 0x01,
-	0xd9, 0xb7, 0xa0, 0x98, 0x48, 0x2e, 0xc2, 0xbf, 0xf8, 0x79, 0xe8, 0x5a, 0x8f, 0xbf, 0x27, 0x70,
-	0x3d, 0x11, 0x11, 0x15, 0xb8, 0x05, 0x23, 0xe2, 0xb5, 0x44, 0x83, 0x13, 0x24, 0x68, 0xc7, 0x76,
-	0xaf, 0xab, 0x75, 0x98, 0x66, 0xa0, 0xef, 0x5a, 0x8e, 0x9e, 0x6f, 0xe1, 0xba, 0x57, 0xf5, 0xb4,
-	0x0d, 0x76, 0xbf, 0xa4, 0x43, 0x37, 0x81, 0x71, 0x24, 0x7e, 0x49, 0x2c, 0x4c, 0xbe, 0x8b, 0x5f,
-	0x67, 0x64, 0x4d, 0x54, 0x66, 0x01, 0xfa, 0xdd, 0x60, 0x9c, 0x8b, 0xcb, 0x21, 0x51, 0xdc, 0xe8,
-	0x02, 0x0b, 0x91, 0x3f, 0x25, 0xe8, 0x13, 0xdc, 0x7b, 0x76, 0xfe, 0xcc, 0x03, 0xda, 0xb5, 0xae,
-	0x7f, 0x45, 0xd0, 0x5d, 0x84, 0x40, 0xf0, 0xa5, 0x96, 0xb8, 0x50, 0xa2, 0xd5, 0x31, 0x6f, 0xc5,
-	0x63, 0xba, 0xd7, 0xe2, 0x07, 0x04, 0xed, 0x09, 0x62, 0xf9, 0x9a, 0xdb, 0xea, 0x1d, 0x49, 0xd5,
-	0xbb, 0xae, 0x69, 0xf5, 0xa5, 0x30, 0x05, 0x7e, 0xa8, 0xff, 0x55, 0xa8, 0x47, 0x41, 0x4b, 0x80,
-	0x96, 0xa8, 0x07, 0x36, 0x94, 0x63, 0xe2, 0xf5, 0xc2, 0x1e, 0xb4, 0x5e, 0xb0, 0x2b, 0x2f, 0xc1,
-	0x55, 0xc6, 0xb6, 0xa3, 0x55, 0x2a, 0xee, 0xde, 0x76, 0x50, 0x71, 0x52, 0xff, 0x38, 0xec, 0xe0,
-	0x6c, 0xfa, 0x72, 0xf1, 0xa5, 0x6e, 0xc3, 0x80, 0xe3, 0xde, 0xc6, 0x4d, 0x20, 0xec, 0x5b, 0x3d,
-	0x49, 0xf9, 0xfe, 0xa7, 0x7f, 0x4c, 0xf7, 0x15, 0x78, 0x82, 0xfc, 0x3e, 0x50, 0x8f, 0x5a, 0x02,
-	0xa6, 0x5b, 0xcd, 0x78, 0x40, 0xe0, 0x92, 0x6f, 0xf9, 0x1e, 0x68, 0x42, 0xee, 0xef, 0xe7, 0x61,
-	0x80, 0x41, 0xd1, 0xcf, 0x09, 0x8c, 0xb4, 0x0a, 0xd1, 0xd9, 0x10, 0x44, 0xe4, 0x89, 0x4e, 0x9a,
-	0xeb, 0x18, 0xc7, 0x8b, 0xca, 0xca, 0xc7, 0xbf, 0xfd, 0xf5, 0xf0, 0xdc, 0x3c, 0x9d, 0x55, 0x83,
-	0x07, 0x50, 0xb4, 0xa3, 0xe6, 0xbe, 0xa5, 0x1e, 0x09, 0x6b, 0xda, 0xa4, 0xdf, 0x11, 0x18, 0x0b,
-	0x78, 0x14, 0xba, 0x9c, 0x50, 0x2c, 0x74, 0xd0, 0x93, 0x56, 0x52, 0x46, 0x23, 0xe0, 0x3a, 0x03,
-	0x54, 0xe8, 0x72, 0x0c, 0x20, 0x73, 0x54, 0x0d, 0xe4, 0xc4, 0x0f, 0xb5, 0x49, 0x1f, 0x11, 0x38,
-	0xef, 0x3d, 0x3f, 0xd1, 0x85, 0x84, 0xaa, 0xfe, 0x33, 0x9e, 0xb4, 0x98, 0x26, 0x14, 0xe9, 0xb2,
-	0x8c, 0x6e, 0x89, 0x2e, 0xc4, 0xd0, 0xe1, 0xf1, 0xcb, 0xab, 0xe0, 0x31, 0x81, 0x0b, 0xbe, 0x53,
-	0x0a, 0x4d, 0x2a, 0x18, 0xf0, 0xb9, 0xd2, 0x52, 0xaa, 0x58, 0xa4, 0x5b, 0x65, 0x74, 0x8b, 0x74,
-	0x3e, 0x9a, 0xce, 0xde, 0x2d, 0x36, 0x76, 0x99, 0x1d, 0x76, 0x95, 0xab, 0x1a, 0x66, 0x93, 0xfe,
-	0x42, 0x60, 0x3c, 0xea, 0x78, 0x40, 0xb3, 0x9d, 0xba, 0x16, 0x3a, 0xd2, 0x48, 0xb9, 0xb3, 0xa4,
-	0x20, 0xf1, 0xcb, 0x8c, 0xf8, 0x26, 0x5d, 0x4b, 0xea, 0xb6, 0xa1, 0x33, 0x72, 0xfe, 0xc8, 0xa3,
-	0xec, 0xcf, 0x61, 0x78, 0x2e, 0x70, 0x3a, 0x78, 0x9f, 0xce, 0xb9, 0xb3, 0xa4, 0x20, 0xfc, 0x6d,
-	0x06, 0x9f, 0xa3, 0xab, 0x29, 0xe0, 0xfd, 0xb2, 0x7f, 0x46, 0x60, 0x58, 0xf8, 0x0b, 0xfa, 0x62,
-	0x74, 0xe9, 0x80, 0x11, 0x92, 0x66, 0x3b, 0x85, 0x21, 0x95, 0xca, 0xa8, 0x16, 0xe8, 0x5c, 0x88,
-	0x4a, 0x6c, 0xdc, 0xea, 0x91, 0x67, 0x57, 0x6f, 0xd2, 0x27, 0x04, 0xae, 0x44, 0x3b, 0x5d, 0xba,
-	0x96, 0x5c, 0x33, 0xd2, 0xba, 0x4b, 0xeb, 0x67, 0x4b, 0x42, 0xec, 0x57, 0x18, 0xf6, 0x06, 0x5d,
-	0x8f, 0xc5, 0x6e, 0x0f, 0x01, 0x6e, 0x02, 0x9e, 0xef, 0xff, 0x09, 0x81, 0x4b, 0x11, 0x86, 0x94,
-	0xae, 0x46, 0xb3, 0xc4, 0xfb, 0x65, 0x29, 0x7b, 0x86, 0x0c, 0x44, 0x7f, 0x83, 0xa1, 0xbf, 0x4e,
-	0x5f, 0x0d, 0xa1, 0xbb, 0x16, 0xc7, 0xa5, 0x6e, 0xe9, 0xcd, 0x6c, 0x98, 0x5f, 0x7f, 0xf5, 0x88,
-	0xdd, 0x6c, 0xd2, 0x1f, 0x08, 0x8c, 0x05, 0xbc, 0x67, 0xdc, 0x56, 0x1b, 0xed, 0x95, 0xe3, 0xb6,
-	0xda, 0x18, 0x43, 0x9b, 0x30, 0xbf, 0xcc, 0x9a, 0x79, 0xc1, 0x03, 0x23, 0xf3, 0x35, 0x81, 0xf3,
-	0x5e, 0xeb, 0x17, 0xb7, 0xdd, 0x46, 0x78, 0xd6, 0xb8, 0xed, 0x36, 0xca, 0x49, 0x26, 0xcc, 0x72,
-	0x8b, 0x10, 0x15, 0x45, 0x0d, 0x1f, 0x13, 0xb8, 0xe8, 0x37, 0x59, 0xb4, 0xc3, 0x0e, 0xea, 0x73,
-	0x89, 0xd2, 0x72, 0xba, 0x60, 0xc4, 0x5b, 0x63, 0x78, 0x2b, 0x74, 0x29, 0x61, 0xbf, 0xe5, 0xbf,
-	0x08, 0x9e, 0x51, 0x3d, 0x26, 0x30, 0xea, 0xb1, 0x3e, 0x74, 0x3e, 0xba, 0x64, 0xd8, 0x8e, 0x49,
-	0x0b, 0x29, 0x22, 0x91, 0x6c, 0x83, 0x91, 0xad, 0x52, 0x25, 0xfe, 0x6b, 0x0a, 0x4c, 0x21, 0xb3,
-	0x5e, 0xd4, 0x81, 0x41, 0xfe, 0xae, 0xf4, 0x7a, 0x92, 0x12, 0x82, 0xe8, 0x46, 0x72, 0x10, 0xc2,
-	0x4c, 0x33, 0x98, 0x49, 0x7a, 0x35, 0x46, 0xa6, 0xfc, 0x6b, 0x4f, 0x4f, 0x32, 0xe4, 0xd9, 0x49,
-	0x86, 0xfc, 0x79, 0x92, 0x21, 0x5f, 0x9c, 0x66, 0xfa, 0x9e, 0x9d, 0x66, 0xfa, 0x7e, 0x3f, 0xcd,
-	0xf4, 0xbd, 0x77, 0xa3, 0x6c, 0x38, 0x1f, 0x1c, 0x14, 0x95, 0x3d, 0xab, 0x2a, 0x92, 0xf9, 0x3f,
-	0x2b, 0x76, 0xe9, 0x43, 0xf5, 0x23, 0xbe, 0x40, 0x71, 0x90, 0xfd, 0xbd, 0x7b, 0xed, 0x9f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x1c, 0xce, 0x79, 0xa7, 0xb7, 0x17, 0x00, 0x00,
+	// 1354 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x99, 0x4b, 0x6f, 0xdc, 0x54,
+	0x14, 0xc7, 0x73, 0x4b, 0xfa, 0xc8, 0x49, 0xdb, 0x48, 0x37, 0x49, 0x3b, 0x71, 0xa2, 0x49, 0x70,
+	0x4b, 0xde, 0x63, 0x67, 0x26, 0x69, 0x1a, 0xde, 0xea, 0x48, 0x10, 0xb2, 0x68, 0x95, 0x0e, 0x51,
+	0x25, 0x10, 0x52, 0x34, 0x93, 0x71, 0x8c, 0xc5, 0x8c, 0x3d, 0xb5, 0x9d, 0x88, 0x51, 0x34, 0x1b,
+	0x24, 0x58, 0x20, 0x16, 0xd0, 0x22, 0x54, 0x22, 0x16, 0x5d, 0x54, 0xc0, 0x07, 0x00, 0x21, 0xb1,
+	0xeb, 0xae, 0xcb, 0x0a, 0x36, 0xac, 0x10, 0x4a, 0xf8, 0x20, 0xc8, 0xf7, 0x1e, 0xcf, 0xf8, 0x3d,
+	0x8e, 0x18, 0x41, 0x56, 0x19, 0xdb, 0xe7, 0xdc, 0xfb, 0xbb, 0xff, 0x73, 0x7c, 0xfd, 0xbf, 0x0a,
+	0x8c, 0xef, 0x18, 0x56, 0xdd, 0xb0, 0x64, 0xd5, 0x34, 0xf6, 0x1a, 0xf2, 0x7e, 0x5e, 0xbe, 0xbf,
+	0xa7, 0x98, 0x4d, 0xa9, 0x61, 0x1a, 0xb6, 0x41, 0x87, 0xf8, 0x43, 0x89, 0x3d, 0x94, 0xf6, 0xf3,
+	0xc2, 0x88, 0x6a, 0xa8, 0x06, 0x7b, 0x26, 0x3b, 0xbf, 0x78, 0x98, 0x30, 0xa1, 0x1a, 0x86, 0x5a,
+	0x53, 0xe4, 0x72, 0x43, 0x93, 0xcb, 0xba, 0x6e, 0xd8, 0x65, 0x5b, 0x33, 0x74, 0x0b, 0x9f, 0x86,
+	0x66, 0xb0, 0x9b, 0x0d, 0xc5, 0x7d, 0x38, 0x8f, 0x0f, 0x2b, 0x65, 0x4b, 0xe1, 0x53, 0xcb, 0xfb,
+	0xf9, 0x8a, 0x62, 0x97, 0xf3, 0x72, 0xa3, 0xac, 0x6a, 0x3a, 0x1b, 0x09, 0x63, 0xc7, 0x78, 0xec,
+	0x36, 0x9f, 0x1f, 0xd1, 0xd8, 0x85, 0x58, 0x80, 0xd1, 0xbb, 0x4e, 0xf2, 0xba, 0x33, 0xc7, 0x86,
+	0xbe, 0x6b, 0x94, 0x94, 0xfb, 0x7b, 0x8a, 0x65, 0xd3, 0x31, 0xb8, 0xc0, 0xe6, 0xdd, 0xd6, 0xaa,
+	0x19, 0x32, 0x45, 0x66, 0xfb, 0x4b, 0xe7, 0xd9, 0xf5, 0x46, 0x55, 0x7c, 0x07, 0xae, 0x04, 0x73,
+	0xac, 0x86, 0xa1, 0x5b, 0x0a, 0x95, 0xa0, 0x5f, 0xd3, 0x77, 0x0d, 0x96, 0x30, 0x58, 0x10, 0xa4,
+	0x80, 0x0a, 0x52, 0x27, 0x83, 0xc5, 0x89, 0x77, 0x61, 0xbc, 0x33, 0xd2, 0xa6, 0x51, 0xd3, 0x76,
+	0x9a, 0x5e, 0x86, 0x02, 0x9c, 0x2f, 0x57, 0xab, 0xa6, 0x62, 0x59, 0x6c, 0xc4, 0x81, 0x62, 0xe6,
+	0xb7, 0x9f, 0x72, 0x23, 0x38, 0xe8, 0x2d, 0xfe, 0xe4, 0x5d, 0xdb, 0xd4, 0x74, 0xb5, 0xe4, 0x06,
+	0x8a, 0x5b, 0x30, 0x11, 0x3d, 0x24, 0x22, 0xae, 0xf8, 0x10, 0xa7, 0xa2, 0x11, 0x3d, 0x79, 0x1c,
+	0xb4, 0x05, 0x99, 0xce, 0xa8, 0xb7, 0x95, 0x7a, 0x45, 0x31, 0xad, 0xee, 0x4a, 0xd1, 0xb7, 0x01,
+	0x3a, 0xc5, 0xc8, 0x9c, 0x61, 0x53, 0x4e, 0xbb, 0x53, 0x3a, 0x95, 0x93, 0x78, 0xd3, 0x60, 0xe5,
+	0xa4, 0xcd, 0xb2, 0xaa, 0xe0, 0xb0, 0x25, 0x4f, 0xa6, 0xf8, 0x1d, 0x81, 0xb1, 0x88, 0xf9, 0x71,
+	0x49, 0xab, 0x70, 0xbe, 0xce, 0x6f, 0x65, 0xc8, 0xd4, 0x0b, 0xb3, 0x83, 0x85, 0x89, 0xe8, 0x55,
+	0xf1, 0xbc, 0x92, 0x1b, 0x4c, 0xd7, 0x23, 0xe8, 0x66, 0xba, 0xd2, 0xf1, 0x49, 0x7d, 0x78, 0x0f,
+	0x7d, 0x78, 0x56, 0xb1, 0x79, 0xab, 0x5a, 0xd7, 0x74, 0x57, 0x1f, 0x09, 0xce, 0x96, 0x9d, 0xeb,
+	0xae, 0x35, 0xe4, 0x61, 0x3d, 0x13, 0xed, 0x5b, 0x02, 0x42, 0x14, 0x15, 0xaa, 0x56, 0x80, 0x73,
+	0x4c, 0x1e, 0x57, 0xb4, 0xa4, 0x6e, 0xc5, 0xc8, 0xde, 0x29, 0xf6, 0x29, 0x81, 0xa9, 0x40, 0x9b,
+	0x6a, 0x8a, 0x55, 0xe4, 0x97, 0xff, 0x61, 0x63, 0xfd, 0x4c, 0xe0, 0xc5, 0x04, 0x0e, 0x94, 0x6a,
+	0x1d, 0x2e, 0x73, 0x90, 0x06, 0x06, 0xa0, 0x64, 0xdd, 0xdf, 0x9e, 0x4b, 0xaa, 0x77, 0xdc, 0xde,
+	0xe9, 0x77, 0x18, 0xa3, 0xdf, 0xa9, 0x68, 0xbc, 0x38, 0x51, 0xfd, 0xfd, 0x77, 0xfa, 0x44, 0xbd,
+	0x09, 0x23, 0x0c, 0x7b, 0xd3, 0x34, 0x1a, 0x86, 0x55, 0xae, 0xb9, 0x3a, 0x4e, 0xc2, 0x60, 0x03,
+	0x6f, 0x75, 0x5a, 0x11, 0xdc, 0x5b, 0x1b, 0x55, 0xf1, 0x0e, 0x8c, 0x06, 0x12, 0x71, 0x8d, 0x37,
+	0xe0, 0x82, 0x1b, 0x86, 0x1b, 0xee, 0x58, 0x68, 0x75, 0xed, 0xa4, 0x76, 0xa8, 0xf8, 0x98, 0x80,
+	0xe8, 0x1b, 0xd0, 0xed, 0x48, 0x2e, 0xc2, 0xbf, 0xf8, 0x3c, 0xf4, 0xac, 0xc6, 0x3f, 0x10, 0xb8,
+	0x96, 0x88, 0x88, 0x0a, 0xdc, 0x84, 0x01, 0x77, 0x59, 0x6e, 0x81, 0x13, 0x24, 0xe8, 0xc4, 0xf6,
+	0xae, 0xaa, 0x26, 0x4c, 0x32, 0xd0, 0x7b, 0x86, 0xad, 0x14, 0xdb, 0xb8, 0xce, 0x95, 0x99, 0xb6,
+	0xc0, 0xce, 0x9b, 0xb4, 0xef, 0x24, 0x64, 0xce, 0x74, 0xd1, 0x99, 0x87, 0x89, 0xb7, 0xf1, 0xed,
+	0x8c, 0x9c, 0x13, 0x95, 0x99, 0x83, 0x7e, 0x27, 0x18, 0xfb, 0x62, 0x34, 0x24, 0x8a, 0x13, 0x5d,
+	0x62, 0x21, 0xe2, 0x67, 0x04, 0x7d, 0x82, 0x73, 0xcf, 0x2a, 0x9e, 0xb8, 0x41, 0x7b, 0x56, 0xf5,
+	0xaf, 0x09, 0x4c, 0x44, 0x83, 0xe0, 0xa2, 0x16, 0xb8, 0x50, 0x6e, 0xa9, 0x63, 0x56, 0xc5, 0x63,
+	0x7a, 0x57, 0xe2, 0x07, 0x04, 0xed, 0x09, 0x62, 0xf9, 0x8a, 0xdb, 0xae, 0x1d, 0x49, 0x55, 0xbb,
+	0x9e, 0x69, 0xf5, 0x95, 0x6b, 0x0a, 0xfc, 0x50, 0xff, 0xab, 0x50, 0x8f, 0x82, 0x96, 0x00, 0x2d,
+	0xd1, 0x29, 0xd8, 0x50, 0x0e, 0x09, 0x8c, 0x47, 0xa2, 0x9d, 0x06, 0xbb, 0xf2, 0x0a, 0x5c, 0x65,
+	0x6c, 0x5b, 0xe5, 0x5a, 0xcd, 0xd9, 0xdb, 0xf6, 0x6a, 0x76, 0xea, 0x8f, 0xc3, 0x16, 0x64, 0xc2,
+	0xb9, 0xb8, 0xa8, 0x35, 0x38, 0x6b, 0x3b, 0xb7, 0x71, 0x13, 0x08, 0xfb, 0x56, 0x4f, 0x52, 0xb1,
+	0xff, 0xd9, 0x9f, 0x93, 0x7d, 0x25, 0x9e, 0x20, 0x7e, 0x00, 0xd4, 0xa3, 0x96, 0x0b, 0xd3, 0xab,
+	0x62, 0x3c, 0x20, 0x30, 0xec, 0x1b, 0xfe, 0x34, 0x14, 0x61, 0xcd, 0xdb, 0x20, 0xf7, 0x0c, 0x5b,
+	0xd3, 0xd5, 0x2d, 0x53, 0x51, 0x52, 0x1c, 0xd8, 0xde, 0x83, 0x89, 0xe8, 0x4c, 0x5c, 0xd6, 0xcb,
+	0xc1, 0x03, 0xc4, 0x64, 0xd4, 0xeb, 0x88, 0x59, 0x77, 0x8c, 0xaa, 0xd2, 0x3e, 0x43, 0x14, 0x9e,
+	0x0c, 0xc3, 0x59, 0x36, 0x36, 0xfd, 0x82, 0xc0, 0x40, 0x7b, 0xf5, 0x74, 0x3a, 0x34, 0x42, 0xe4,
+	0x31, 0x53, 0x98, 0xe9, 0x1a, 0xc7, 0x19, 0x45, 0xe9, 0x93, 0xdf, 0xff, 0x7e, 0x78, 0x66, 0x96,
+	0x4e, 0xcb, 0xc1, 0x53, 0x31, 0xae, 0x5a, 0xdf, 0x35, 0xe4, 0x03, 0x57, 0x81, 0x16, 0x7d, 0x42,
+	0x60, 0x28, 0x60, 0x9c, 0xe8, 0x62, 0xc2, 0x64, 0xa1, 0xd3, 0xa7, 0x90, 0x4b, 0x19, 0x8d, 0x80,
+	0x2b, 0x0c, 0x50, 0xa2, 0x8b, 0x31, 0x80, 0xcc, 0xe6, 0x35, 0x91, 0x13, 0x77, 0x8f, 0x16, 0x7d,
+	0x44, 0xe0, 0xa2, 0xf7, 0x50, 0x47, 0xe7, 0x12, 0x66, 0xf5, 0x1f, 0x3c, 0x85, 0xf9, 0x34, 0xa1,
+	0x48, 0x97, 0x67, 0x74, 0x0b, 0x74, 0x2e, 0x86, 0x0e, 0xeb, 0xe9, 0x55, 0xf0, 0x90, 0xc0, 0x25,
+	0xdf, 0xd1, 0x89, 0x26, 0x4d, 0x18, 0x30, 0xdf, 0xc2, 0x42, 0xaa, 0x58, 0xa4, 0x5b, 0x62, 0x74,
+	0xf3, 0x74, 0x36, 0x9a, 0xce, 0xda, 0xae, 0x34, 0xb7, 0x99, 0x47, 0x77, 0x94, 0xab, 0x6b, 0x7a,
+	0x8b, 0xfe, 0x4a, 0x60, 0x24, 0xea, 0xcc, 0x42, 0xf3, 0xdd, 0xaa, 0x16, 0x3a, 0x67, 0x09, 0x85,
+	0x93, 0xa4, 0x20, 0xf1, 0xab, 0x8c, 0xf8, 0x06, 0x5d, 0x4e, 0xaa, 0xb6, 0xa6, 0x30, 0x72, 0xfe,
+	0xc8, 0xa3, 0xec, 0x2f, 0x61, 0x78, 0x2e, 0x70, 0x3a, 0x78, 0x9f, 0xce, 0x85, 0x93, 0xa4, 0x20,
+	0xfc, 0x1a, 0x83, 0x2f, 0xd0, 0xa5, 0x14, 0xf0, 0x7e, 0xd9, 0x3f, 0x27, 0x70, 0xc1, 0x35, 0x3d,
+	0xf4, 0xa5, 0xe8, 0xa9, 0x03, 0xee, 0x4c, 0x98, 0xee, 0x16, 0x86, 0x54, 0x32, 0xa3, 0x9a, 0xa3,
+	0x33, 0x21, 0x2a, 0xf7, 0x6b, 0x22, 0x1f, 0x78, 0x3e, 0x35, 0x2d, 0xfa, 0x94, 0xc0, 0x95, 0x68,
+	0xfb, 0x4d, 0x97, 0x93, 0xe7, 0x8c, 0x3c, 0x4f, 0x08, 0x2b, 0x27, 0x4b, 0x42, 0xec, 0xd7, 0x18,
+	0xf6, 0x2a, 0x5d, 0x89, 0xc5, 0xee, 0x34, 0x01, 0x6e, 0x02, 0x9e, 0xf7, 0xff, 0x29, 0x81, 0xe1,
+	0x08, 0x97, 0x4c, 0x97, 0xa2, 0x59, 0xe2, 0x4d, 0xbc, 0x90, 0x3f, 0x41, 0x06, 0xa2, 0xbf, 0xc5,
+	0xd0, 0xdf, 0xa4, 0xaf, 0x87, 0xd0, 0x1d, 0xdf, 0xe5, 0x50, 0xb7, 0xf5, 0x76, 0x6e, 0x98, 0x7e,
+	0xfd, 0xe5, 0x03, 0x76, 0xb3, 0x45, 0x7f, 0x24, 0x30, 0x14, 0x30, 0xc4, 0x71, 0x5b, 0x6d, 0xb4,
+	0x81, 0x17, 0x72, 0x29, 0xa3, 0xbb, 0xf6, 0xaf, 0x43, 0x64, 0x79, 0xc1, 0x03, 0x2d, 0xf3, 0x0d,
+	0x81, 0x8b, 0x5e, 0x3f, 0x1a, 0xb7, 0xdd, 0x46, 0x18, 0xe9, 0xb8, 0xed, 0x36, 0xca, 0xde, 0x26,
+	0xf4, 0x72, 0x9b, 0x10, 0x15, 0x45, 0x0d, 0x1f, 0x13, 0xb8, 0xec, 0x77, 0x7e, 0xb4, 0xcb, 0x0e,
+	0xea, 0xb3, 0xae, 0xc2, 0x62, 0xba, 0x60, 0xc4, 0x5b, 0x66, 0x78, 0x39, 0xba, 0x90, 0xb0, 0xdf,
+	0xf2, 0x2f, 0x82, 0xa7, 0x55, 0x0f, 0x09, 0x0c, 0x7a, 0xfc, 0x18, 0x9d, 0x8d, 0x9e, 0x32, 0xec,
+	0x11, 0x85, 0xb9, 0x14, 0x91, 0x48, 0xb6, 0xca, 0xc8, 0x96, 0xa8, 0x14, 0xff, 0x36, 0x05, 0xba,
+	0x90, 0xf9, 0x41, 0x6a, 0xc3, 0x39, 0xbe, 0x56, 0x7a, 0x2d, 0x49, 0x09, 0x97, 0xe8, 0x7a, 0x72,
+	0x10, 0xc2, 0x4c, 0x32, 0x98, 0x31, 0x7a, 0x35, 0x46, 0x26, 0xfa, 0xbd, 0x6b, 0x32, 0x3a, 0xf6,
+	0x28, 0xd1, 0x64, 0x84, 0x5c, 0x9b, 0x90, 0x4b, 0x19, 0xdd, 0x55, 0x1e, 0xf6, 0xc3, 0xfb, 0xfd,
+	0x76, 0x3a, 0x4d, 0xd3, 0xd5, 0x6d, 0xdb, 0x54, 0x94, 0xe2, 0x1b, 0xcf, 0x8e, 0xb2, 0xe4, 0xf9,
+	0x51, 0x96, 0xfc, 0x75, 0x94, 0x25, 0x5f, 0x1e, 0x67, 0xfb, 0x9e, 0x1f, 0x67, 0xfb, 0xfe, 0x38,
+	0xce, 0xf6, 0xbd, 0x7f, 0x5d, 0xd5, 0xec, 0x0f, 0xf7, 0x2a, 0xd2, 0x8e, 0x51, 0x77, 0xc7, 0xe4,
+	0x7f, 0x72, 0x56, 0xf5, 0x23, 0xf9, 0x63, 0x3e, 0x6e, 0xe5, 0x1c, 0xfb, 0x6f, 0xc1, 0xf2, 0x3f,
+	0x03, 0x00, 0x8e, 0x01, 0x37, 0xf2, 0xf5, 0x18, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1649,6 +1747,9 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(ctx context.Context, in *QueryGroupsRequest, opts ...grpc.CallOption) (*QueryGroupsResponse, error)
+	// GroupVotingTree queries the effective voting tree of a group, resolving
+	// the members that are group policies into their sub-group members.
+	GroupVotingTree(ctx context.Context, in *QueryGroupVotingTreeRequest, opts ...grpc.CallOption) (*QueryGroupVotingTreeResponse, error)
 }
 
 type queryClient struct {
@@ -1785,6 +1886,15 @@ func (c *queryClient) Groups(ctx context.Context, in *QueryGroupsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) GroupVotingTree(ctx context.Context, in *QueryGroupVotingTreeRequest, opts ...grpc.CallOption) (*QueryGroupVotingTreeResponse, error) {
+	out := new(QueryGroupVotingTreeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/GroupVotingTree", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// GroupInfo queries group info based on group id.
@@ -1821,6 +1931,9 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(context.Context, *QueryGroupsRequest) (*QueryGroupsResponse, error)
+	// GroupVotingTree queries the effective voting tree of a group, resolving
+	// the members that are group policies into their sub-group members.
+	GroupVotingTree(context.Context, *QueryGroupVotingTreeRequest) (*QueryGroupVotingTreeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1869,6 +1982,9 @@ func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTall
 func (*UnimplementedQueryServer) Groups(ctx context.Context, req *QueryGroupsRequest) (*QueryGroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Groups not implemented")
 }
+func (*UnimplementedQueryServer) GroupVotingTree(ctx context.Context, req *QueryGroupVotingTreeRequest) (*QueryGroupVotingTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupVotingTree not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2126,6 +2242,24 @@ func _Query_Groups_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GroupVotingTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupVotingTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GroupVotingTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Query/GroupVotingTree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GroupVotingTree(ctx, req.(*QueryGroupVotingTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.group.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2186,6 +2320,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Groups",
 			Handler:    _Query_Groups_Handler,
 		},
+		{
+			MethodName: "GroupVotingTree",
+			Handler:    _Query_GroupVotingTree_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/query.proto",
@@ -3319,6 +3457,71 @@ func (m *QueryGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryGroupVotingTreeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupVotingTreeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupVotingTreeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupVotingTreeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupVotingTreeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupVotingTreeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3775,6 +3978,33 @@ func (m *QueryGroupsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGroupVotingTreeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GroupId))
+	}
+	return n
+}
+
+func (m *QueryGroupVotingTreeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6669,6 +6899,159 @@ func (m *QueryGroupsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGroupVotingTreeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupVotingTreeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupVotingTreeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupVotingTreeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupVotingTreeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupVotingTreeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, &VotingTreeNode{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
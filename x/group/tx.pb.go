@@ -1460,6 +1460,98 @@ func (m *MsgLeaveGroupResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgLeaveGroupResponse proto.InternalMessageInfo
 
+// MsgCancelProposal is the Msg/CancelProposal request type.
+type MsgCancelProposal struct {
+	// proposal is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// address is the admin of the group policy.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *MsgCancelProposal) Reset()         { *m = MsgCancelProposal{} }
+func (m *MsgCancelProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelProposal) ProtoMessage()    {}
+func (*MsgCancelProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{28}
+}
+func (m *MsgCancelProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelProposal.Merge(m, src)
+}
+func (m *MsgCancelProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelProposal proto.InternalMessageInfo
+
+func (m *MsgCancelProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgCancelProposal) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// MsgCancelProposalResponse is the Msg/CancelProposal response type.
+type MsgCancelProposalResponse struct {
+}
+
+func (m *MsgCancelProposalResponse) Reset()         { *m = MsgCancelProposalResponse{} }
+func (m *MsgCancelProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelProposalResponse) ProtoMessage()    {}
+func (*MsgCancelProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{29}
+}
+func (m *MsgCancelProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelProposalResponse.Merge(m, src)
+}
+func (m *MsgCancelProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelProposalResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cosmos.group.v1.Exec", Exec_name, Exec_value)
 	proto.RegisterType((*MsgCreateGroup)(nil), "cosmos.group.v1.MsgCreateGroup")
@@ -1490,92 +1582,96 @@ func init() {
 	proto.RegisterType((*MsgExecResponse)(nil), "cosmos.group.v1.MsgExecResponse")
 	proto.RegisterType((*MsgLeaveGroup)(nil), "cosmos.group.v1.MsgLeaveGroup")
 	proto.RegisterType((*MsgLeaveGroupResponse)(nil), "cosmos.group.v1.MsgLeaveGroupResponse")
+	proto.RegisterType((*MsgCancelProposal)(nil), "cosmos.group.v1.MsgCancelProposal")
+	proto.RegisterType((*MsgCancelProposalResponse)(nil), "cosmos.group.v1.MsgCancelProposalResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/tx.proto", fileDescriptor_6b8d3d629f136420) }
 
 var fileDescriptor_6b8d3d629f136420 = []byte{
-	// 1276 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x41, 0x73, 0xdb, 0x44,
-	0x14, 0xb6, 0x6c, 0x37, 0x71, 0x9e, 0x1b, 0x27, 0x51, 0x9c, 0xd6, 0x51, 0x5a, 0xdb, 0x88, 0x34,
-	0x49, 0x3d, 0x8d, 0x4c, 0x1c, 0x7a, 0x09, 0x4c, 0x99, 0x24, 0x35, 0x4c, 0x00, 0x43, 0x46, 0x69,
-	0x29, 0x70, 0x31, 0x8a, 0xb5, 0x55, 0x35, 0xd8, 0x96, 0xd1, 0xca, 0x89, 0x73, 0x84, 0x13, 0xd0,
-	0x0b, 0x33, 0xfd, 0x03, 0xcc, 0x70, 0xe1, 0xc8, 0xa1, 0x37, 0x6e, 0x70, 0xe9, 0x70, 0xea, 0x70,
-	0x62, 0x38, 0x30, 0x4c, 0x72, 0xe0, 0xca, 0x4f, 0x60, 0xb4, 0x2b, 0xad, 0x2d, 0x5b, 0x8e, 0x14,
-	0xe3, 0xc2, 0xa9, 0xb5, 0xde, 0xf7, 0xde, 0xfb, 0xbe, 0xb7, 0x6f, 0xf7, 0xed, 0x06, 0x32, 0x35,
-	0x03, 0x37, 0x0c, 0x5c, 0xd4, 0x4c, 0xa3, 0xdd, 0x2a, 0x1e, 0x6d, 0x14, 0xad, 0x8e, 0xd4, 0x32,
-	0x0d, 0xcb, 0xe0, 0x67, 0xa8, 0x45, 0x22, 0x16, 0xe9, 0x68, 0x43, 0x48, 0x6b, 0x86, 0x66, 0x10,
-	0x5b, 0xd1, 0xfe, 0x1f, 0x85, 0x09, 0x8b, 0x14, 0x56, 0xa5, 0x06, 0xc7, 0xc7, 0x31, 0x69, 0x86,
-	0xa1, 0xd5, 0x51, 0x91, 0xfc, 0x3a, 0x6c, 0x3f, 0x2c, 0x2a, 0xcd, 0x13, 0xc7, 0xb4, 0x34, 0x90,
-	0xf6, 0xa4, 0x85, 0x5c, 0xbf, 0xab, 0x8e, 0xb1, 0x81, 0x35, 0xdb, 0xd4, 0xc0, 0x1a, 0x35, 0x88,
-	0xdf, 0x73, 0x90, 0xaa, 0x60, 0x6d, 0xd7, 0x44, 0x8a, 0x85, 0xde, 0xb2, 0x5d, 0x79, 0x09, 0x2e,
-	0x29, 0x6a, 0x43, 0x6f, 0x66, 0xb8, 0x3c, 0xb7, 0x36, 0xb5, 0x93, 0xf9, 0xf5, 0xe9, 0x7a, 0xda,
-	0x21, 0xb1, 0xad, 0xaa, 0x26, 0xc2, 0xf8, 0xc0, 0x32, 0xf5, 0xa6, 0x26, 0x53, 0x18, 0x7f, 0x07,
-	0x26, 0x1b, 0xa8, 0x71, 0x88, 0x4c, 0x9c, 0x89, 0xe6, 0x63, 0x6b, 0xc9, 0x52, 0x56, 0xea, 0xd3,
-	0x29, 0x55, 0x88, 0x5d, 0x46, 0x9f, 0xb5, 0x11, 0xb6, 0x76, 0xe2, 0xcf, 0xfe, 0xc8, 0x45, 0x64,
-	0xd7, 0x89, 0x17, 0x20, 0xd1, 0x40, 0x96, 0xa2, 0x2a, 0x96, 0x92, 0x89, 0xd9, 0x29, 0x65, 0xf6,
-	0x7b, 0x0b, 0xbe, 0xf8, 0xeb, 0x87, 0x02, 0xcd, 0x23, 0x6e, 0xc2, 0x15, 0x2f, 0x53, 0x19, 0xe1,
-	0x96, 0xd1, 0xc4, 0x88, 0x5f, 0x84, 0x04, 0x49, 0x55, 0xd5, 0x55, 0x42, 0x3a, 0x2e, 0x4f, 0x92,
-	0xdf, 0x7b, 0xaa, 0xf8, 0x23, 0x07, 0x0b, 0x15, 0xac, 0xdd, 0x6f, 0xa9, 0xae, 0x57, 0xc5, 0x49,
-	0x7b, 0x51, 0x99, 0xbd, 0x49, 0xa2, 0x9e, 0x24, 0xfc, 0x3b, 0x90, 0xa2, 0x62, 0xaa, 0x6d, 0x92,
-	0x07, 0x67, 0x62, 0x17, 0x28, 0xc4, 0x34, 0xf5, 0xa5, 0x14, 0xb1, 0x47, 0x72, 0x0e, 0xae, 0xfb,
-	0x92, 0x77, 0x95, 0x8b, 0xdf, 0x71, 0x30, 0xef, 0x45, 0x6c, 0x13, 0xb2, 0x63, 0x14, 0x77, 0x1b,
-	0xa6, 0x9a, 0xe8, 0xb8, 0x4a, 0xc3, 0xc5, 0x02, 0xc2, 0x25, 0x9a, 0xe8, 0x98, 0x30, 0xf0, 0xc8,
-	0xb8, 0x0e, 0x4b, 0x3e, 0x24, 0x99, 0x88, 0xc7, 0x1c, 0x59, 0x59, 0x8f, 0x4c, 0xba, 0xfe, 0xe3,
-	0xd4, 0x11, 0xb6, 0xcd, 0xf2, 0x90, 0xf5, 0x27, 0xc3, 0xf8, 0xfe, 0xcd, 0x41, 0xda, 0xdb, 0x89,
-	0xfb, 0x46, 0x5d, 0xaf, 0x9d, 0xfc, 0x47, 0x6c, 0x79, 0x05, 0x66, 0x54, 0x54, 0xd3, 0xb1, 0x6e,
-	0x34, 0xab, 0x2d, 0x92, 0x39, 0x13, 0xcf, 0x73, 0x6b, 0xc9, 0x52, 0x5a, 0xa2, 0xc7, 0x83, 0xe4,
-	0x1e, 0x0f, 0xd2, 0x76, 0xf3, 0x64, 0x47, 0xfc, 0xe5, 0xe9, 0x7a, 0xb6, 0xbf, 0x11, 0xef, 0x3a,
-	0x01, 0x28, 0x73, 0x39, 0xa5, 0x7a, 0x7e, 0x6f, 0xa5, 0xbe, 0xfc, 0x36, 0x17, 0xe9, 0x29, 0x8a,
-	0x0c, 0xd7, 0xfc, 0x14, 0xb3, 0x1d, 0x58, 0x82, 0x49, 0x85, 0x2a, 0x0c, 0xd4, 0xee, 0x02, 0xc5,
-	0xdf, 0x39, 0x58, 0xf4, 0x56, 0x9a, 0x06, 0x1d, 0xad, 0x83, 0xdf, 0x86, 0x34, 0xad, 0x25, 0xad,
-	0x48, 0xd5, 0xa5, 0x13, 0x0d, 0x70, 0xe7, 0xb5, 0xde, 0xcc, 0xc4, 0x32, 0x8e, 0x96, 0x7f, 0x1c,
-	0x83, 0x8c, 0xb7, 0x62, 0x0f, 0x74, 0xeb, 0xd1, 0x88, 0x7d, 0xf2, 0x6f, 0x4f, 0xd8, 0x1b, 0x90,
-	0xa2, 0xb5, 0xe9, 0x6b, 0xa9, 0x69, 0xcd, 0xb3, 0xd9, 0x4a, 0xb0, 0xe0, 0x29, 0x21, 0x43, 0xc7,
-	0x09, 0x7a, 0xbe, 0xa7, 0x52, 0xcc, 0x67, 0xa3, 0xcf, 0x47, 0xc1, 0x4e, 0xd9, 0x2e, 0xe5, 0xb9,
-	0xb5, 0x84, 0xb7, 0xba, 0x98, 0xae, 0xac, 0x4f, 0xfb, 0x4e, 0xbc, 0xe0, 0xf6, 0xfd, 0x8a, 0x83,
-	0xfc, 0xb0, 0xd5, 0x08, 0x31, 0x45, 0xc6, 0xd9, 0x5c, 0xe2, 0xcb, 0xf0, 0xd2, 0xd0, 0xae, 0x67,
-	0x47, 0xcc, 0x93, 0x28, 0x88, 0x7e, 0x28, 0xaf, 0xee, 0xff, 0x75, 0x93, 0xf8, 0x2c, 0x63, 0xec,
-	0x05, 0x2f, 0xe3, 0x2d, 0x28, 0x04, 0x17, 0x85, 0xd5, 0xf0, 0x27, 0x8e, 0x1c, 0x5a, 0x03, 0xf0,
-	0x91, 0x87, 0xcb, 0x38, 0xab, 0x17, 0x76, 0x1a, 0xad, 0xc0, 0xf2, 0x79, 0x1a, 0x98, 0xd8, 0xaf,
-	0xa3, 0x30, 0x57, 0xc1, 0xda, 0x41, 0xfb, 0xb0, 0xa1, 0x5b, 0xfb, 0xa6, 0xd1, 0x32, 0xb0, 0x52,
-	0x1f, 0xca, 0x98, 0x1b, 0x81, 0xf1, 0x35, 0x98, 0x6a, 0x91, 0xb8, 0xee, 0x31, 0x34, 0x25, 0x77,
-	0x3f, 0x9c, 0x3b, 0xaf, 0x5e, 0xb1, 0x6d, 0x18, 0x2b, 0x1a, 0xc2, 0x99, 0x38, 0x39, 0xbf, 0x7c,
-	0x5b, 0x44, 0x66, 0x28, 0xfe, 0x26, 0xc4, 0x51, 0x07, 0xd5, 0xc8, 0x21, 0x92, 0x2a, 0x2d, 0x0c,
-	0x9c, 0x76, 0xe5, 0x0e, 0xaa, 0xc9, 0x04, 0xb2, 0xc5, 0xbb, 0x3d, 0xd2, 0x25, 0x23, 0xbe, 0x4e,
-	0x06, 0x8b, 0xb7, 0x16, 0x6c, 0x9b, 0xe7, 0x20, 0xd9, 0x72, 0xbe, 0x75, 0x77, 0x3a, 0xb8, 0x9f,
-	0xf6, 0x54, 0xb1, 0x43, 0xae, 0x54, 0xf6, 0x01, 0xa1, 0x9a, 0xca, 0x31, 0xab, 0x65, 0x90, 0x5f,
-	0xef, 0x0c, 0x8c, 0x86, 0x9c, 0x81, 0x5b, 0x97, 0x6d, 0xe6, 0x6c, 0x22, 0xd2, 0x7b, 0x52, 0x7f,
-	0x66, 0xb6, 0xc6, 0xa7, 0x1c, 0x4c, 0x56, 0xb0, 0xf6, 0x81, 0x61, 0x05, 0xab, 0xb0, 0x9b, 0xfb,
-	0xc8, 0xb0, 0x90, 0x19, 0xc8, 0x85, 0xc2, 0xf8, 0x4d, 0x98, 0x30, 0x5a, 0x96, 0x6e, 0xd0, 0x81,
-	0x97, 0x2a, 0x2d, 0x0d, 0x14, 0xdd, 0xce, 0xfb, 0x3e, 0x81, 0xc8, 0x0e, 0xd4, 0xb3, 0xea, 0xf1,
-	0xbe, 0x55, 0xbf, 0xc0, 0x1a, 0xd2, 0x86, 0x27, 0x3c, 0xc4, 0x39, 0x98, 0x71, 0x34, 0x32, 0xdd,
-	0x0d, 0x22, 0xdb, 0xc6, 0x07, 0xcb, 0x7e, 0x15, 0x12, 0x76, 0xc8, 0xb6, 0x65, 0x04, 0x2b, 0x67,
-	0xc8, 0xad, 0xa4, 0x4d, 0x60, 0x02, 0xeb, 0x5a, 0x13, 0x99, 0xa2, 0x4c, 0x18, 0x10, 0x7a, 0x6e,
-	0xcf, 0xbc, 0x01, 0x13, 0x26, 0xc2, 0xed, 0xba, 0x45, 0x62, 0xa6, 0x4a, 0xab, 0x03, 0x6a, 0xdc,
-	0xc5, 0x2a, 0x3b, 0x21, 0x65, 0x02, 0x97, 0x1d, 0x37, 0xb1, 0x0e, 0xd3, 0x15, 0xac, 0xbd, 0x8b,
-	0x94, 0x23, 0xe7, 0x91, 0x35, 0xc2, 0x85, 0xe9, 0x9c, 0xeb, 0x62, 0x5f, 0x1f, 0x5d, 0x25, 0x6f,
-	0x9e, 0x6e, 0x36, 0x57, 0x47, 0xa1, 0x00, 0x71, 0x52, 0xc6, 0x34, 0xcc, 0x96, 0x3f, 0x2c, 0xef,
-	0x56, 0xef, 0xbf, 0x77, 0xb0, 0x5f, 0xde, 0xdd, 0x7b, 0x73, 0xaf, 0x7c, 0x77, 0x36, 0xc2, 0x5f,
-	0x86, 0x04, 0xf9, 0x7a, 0x4f, 0xfe, 0x68, 0x96, 0x2b, 0xfd, 0x9c, 0x84, 0x58, 0x05, 0x6b, 0xfc,
-	0x03, 0x48, 0xf6, 0xbe, 0x0e, 0x73, 0x83, 0x57, 0x0f, 0xcf, 0x60, 0x15, 0x56, 0x03, 0x00, 0xac,
-	0xa8, 0x75, 0xe0, 0x7d, 0x9e, 0x65, 0x2b, 0x7e, 0xee, 0x83, 0x38, 0x41, 0x0a, 0x87, 0x63, 0xd9,
-	0x1e, 0xc2, 0xec, 0xc0, 0x2b, 0x69, 0x39, 0x20, 0x06, 0x41, 0x09, 0xb7, 0xc2, 0xa0, 0x58, 0x1e,
-	0x03, 0xe6, 0xfd, 0x1e, 0x32, 0xab, 0x81, 0x74, 0x29, 0x50, 0x28, 0x86, 0x04, 0xb2, 0x84, 0x3a,
-	0xcc, 0x0d, 0xbe, 0x44, 0x6e, 0x04, 0x2c, 0x02, 0x85, 0x09, 0xeb, 0xa1, 0x60, 0x2c, 0x55, 0x1b,
-	0x16, 0xfc, 0x2f, 0xb4, 0x37, 0x03, 0xe2, 0x74, 0xa1, 0xc2, 0x46, 0x68, 0x28, 0x4b, 0xdb, 0x81,
-	0x2b, 0x43, 0x1e, 0x09, 0x85, 0x80, 0x62, 0xf5, 0x60, 0x85, 0x52, 0x78, 0x2c, 0xcb, 0xfc, 0x84,
-	0x83, 0x5c, 0xd0, 0x1d, 0x6c, 0x33, 0x54, 0x5c, 0xaf, 0x93, 0xf0, 0xda, 0x08, 0x4e, 0x8c, 0xd5,
-	0xe7, 0x1c, 0x2c, 0x0e, 0xbf, 0xd5, 0xac, 0x87, 0x0a, 0xcd, 0xfa, 0xed, 0xf6, 0x85, 0xe0, 0x8c,
-	0xc3, 0x27, 0x90, 0xea, 0xbb, 0x6b, 0x88, 0x7e, 0x81, 0xbc, 0x18, 0xa1, 0x10, 0x8c, 0xe9, 0xdd,
-	0xb0, 0x03, 0x33, 0xd8, 0x77, 0xc3, 0xf6, 0xa3, 0xfc, 0x37, 0xec, 0xb0, 0xa9, 0xca, 0xef, 0x40,
-	0x9c, 0x4c, 0xd4, 0x8c, 0x9f, 0x97, 0x6d, 0x11, 0xf2, 0xc3, 0x2c, 0xbd, 0x31, 0xc8, 0xb9, 0xea,
-	0x1b, 0xc3, 0xb6, 0xf8, 0xc7, 0xf0, 0xcc, 0x98, 0x7b, 0x00, 0x3d, 0xf3, 0x21, 0xeb, 0x87, 0xef,
-	0xda, 0x85, 0x95, 0xf3, 0xed, 0x6e, 0xd4, 0x9d, 0x3b, 0xcf, 0x4e, 0xb3, 0xdc, 0xf3, 0xd3, 0x2c,
-	0xf7, 0xe7, 0x69, 0x96, 0xfb, 0xe6, 0x2c, 0x1b, 0x79, 0x7e, 0x96, 0x8d, 0xfc, 0x76, 0x96, 0x8d,
-	0x7c, 0xbc, 0xac, 0xe9, 0xd6, 0xa3, 0xf6, 0xa1, 0x54, 0x33, 0x1a, 0xce, 0xdf, 0x18, 0x9d, 0x7f,
-	0xd6, 0xb1, 0xfa, 0x69, 0xb1, 0x43, 0xff, 0x8e, 0x78, 0x38, 0x41, 0x6e, 0x68, 0x9b, 0xff, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0xee, 0x01, 0x59, 0xf1, 0xd5, 0x14, 0x00, 0x00,
+	// 1302 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcf, 0x53, 0xe3, 0xe4,
+	0x1b, 0x6f, 0xda, 0x2e, 0x94, 0x07, 0x28, 0x10, 0x0a, 0xdb, 0x06, 0xb6, 0xed, 0x37, 0x5f, 0x16,
+	0xd8, 0x0e, 0xb4, 0x52, 0xdc, 0x0b, 0x3a, 0xeb, 0x00, 0x5b, 0x1d, 0xd4, 0x2a, 0x13, 0x76, 0x5d,
+	0xf5, 0x52, 0x43, 0xf3, 0x6e, 0x36, 0x63, 0xdb, 0xd4, 0xbe, 0x29, 0x94, 0xa3, 0x9e, 0xd4, 0xbd,
+	0x38, 0xb3, 0xff, 0x80, 0x33, 0x8e, 0x33, 0x1e, 0x3d, 0xec, 0xcd, 0x9b, 0xa7, 0x1d, 0x4f, 0x3b,
+	0x9e, 0x1c, 0x0f, 0x8e, 0x03, 0x07, 0xaf, 0xfe, 0x09, 0x4e, 0xde, 0x37, 0x79, 0x49, 0x9a, 0x94,
+	0x84, 0xca, 0xae, 0x27, 0x48, 0x9e, 0xcf, 0xf3, 0x7c, 0x3e, 0xcf, 0x8f, 0xbc, 0x3f, 0x0a, 0xe9,
+	0xba, 0x8e, 0x9b, 0x3a, 0x2e, 0xa9, 0x1d, 0xbd, 0xdb, 0x2e, 0x1d, 0x6d, 0x94, 0x8c, 0x5e, 0xb1,
+	0xdd, 0xd1, 0x0d, 0x9d, 0x9f, 0xa2, 0x96, 0x22, 0xb1, 0x14, 0x8f, 0x36, 0x84, 0x94, 0xaa, 0xab,
+	0x3a, 0xb1, 0x95, 0xcc, 0xff, 0x28, 0x4c, 0xc8, 0x50, 0x58, 0x8d, 0x1a, 0x2c, 0x1f, 0xcb, 0xa4,
+	0xea, 0xba, 0xda, 0x40, 0x25, 0xf2, 0x74, 0xd8, 0x7d, 0x58, 0x92, 0x5b, 0x27, 0x96, 0x69, 0xc1,
+	0x43, 0x7b, 0xd2, 0x46, 0xb6, 0xdf, 0x75, 0xcb, 0xd8, 0xc4, 0xaa, 0x69, 0x6a, 0x62, 0x95, 0x1a,
+	0xc4, 0x1f, 0x38, 0x48, 0x56, 0xb1, 0xba, 0xdb, 0x41, 0xb2, 0x81, 0xde, 0x32, 0x5d, 0xf9, 0x22,
+	0x5c, 0x93, 0x95, 0xa6, 0xd6, 0x4a, 0x73, 0x79, 0x6e, 0x75, 0x6c, 0x27, 0xfd, 0xeb, 0xd3, 0xf5,
+	0x94, 0x25, 0x62, 0x5b, 0x51, 0x3a, 0x08, 0xe3, 0x03, 0xa3, 0xa3, 0xb5, 0x54, 0x89, 0xc2, 0xf8,
+	0x3b, 0x30, 0xda, 0x44, 0xcd, 0x43, 0xd4, 0xc1, 0xe9, 0x68, 0x3e, 0xb6, 0x3a, 0x5e, 0xce, 0x16,
+	0xfb, 0xf2, 0x2c, 0x56, 0x89, 0x5d, 0x42, 0x9f, 0x75, 0x11, 0x36, 0x76, 0xe2, 0xcf, 0xfe, 0xc8,
+	0x45, 0x24, 0xdb, 0x89, 0x17, 0x20, 0xd1, 0x44, 0x86, 0xac, 0xc8, 0x86, 0x9c, 0x8e, 0x99, 0x94,
+	0x12, 0x7b, 0xde, 0x82, 0x2f, 0xfe, 0xfa, 0xb1, 0x40, 0x79, 0xc4, 0x4d, 0x98, 0x77, 0x2b, 0x95,
+	0x10, 0x6e, 0xeb, 0x2d, 0x8c, 0xf8, 0x0c, 0x24, 0x08, 0x55, 0x4d, 0x53, 0x88, 0xe8, 0xb8, 0x34,
+	0x4a, 0x9e, 0xf7, 0x14, 0xf1, 0x27, 0x0e, 0xe6, 0xaa, 0x58, 0xbd, 0xdf, 0x56, 0x6c, 0xaf, 0xaa,
+	0x45, 0x7b, 0xd9, 0x34, 0x9d, 0x24, 0x51, 0x17, 0x09, 0xff, 0x0e, 0x24, 0x69, 0x32, 0xb5, 0x2e,
+	0xe1, 0xc1, 0xe9, 0xd8, 0x25, 0x0a, 0x31, 0x49, 0x7d, 0xa9, 0x44, 0xec, 0x4a, 0x39, 0x07, 0x37,
+	0x7c, 0xc5, 0xdb, 0x99, 0x8b, 0xdf, 0x71, 0x30, 0xeb, 0x46, 0x6c, 0x13, 0xb1, 0x57, 0x98, 0xdc,
+	0x6d, 0x18, 0x6b, 0xa1, 0xe3, 0x1a, 0x0d, 0x17, 0x0b, 0x08, 0x97, 0x68, 0xa1, 0x63, 0xa2, 0xc0,
+	0x95, 0xc6, 0x0d, 0x58, 0xf0, 0x11, 0xc9, 0x92, 0x78, 0xcc, 0xc1, 0xbc, 0xdb, 0x5e, 0xb5, 0xfa,
+	0x7f, 0x95, 0x79, 0x84, 0x1d, 0xb3, 0x3c, 0x64, 0xfd, 0xc5, 0x30, 0xbd, 0x7f, 0x73, 0x90, 0x72,
+	0x4f, 0xe2, 0xbe, 0xde, 0xd0, 0xea, 0x27, 0x2f, 0x49, 0x2d, 0x2f, 0xc3, 0x94, 0x82, 0xea, 0x1a,
+	0xd6, 0xf4, 0x56, 0xad, 0x4d, 0x98, 0xd3, 0xf1, 0x3c, 0xb7, 0x3a, 0x5e, 0x4e, 0x15, 0xe9, 0xf2,
+	0x50, 0xb4, 0x97, 0x87, 0xe2, 0x76, 0xeb, 0x64, 0x47, 0xfc, 0xe5, 0xe9, 0x7a, 0xb6, 0x7f, 0x10,
+	0xef, 0x5a, 0x01, 0xa8, 0x72, 0x29, 0xa9, 0xb8, 0x9e, 0xb7, 0x92, 0x5f, 0x7e, 0x9b, 0x8b, 0x38,
+	0x8a, 0x22, 0xc1, 0xa2, 0x5f, 0xc6, 0xec, 0x0b, 0x2c, 0xc3, 0xa8, 0x4c, 0x33, 0x0c, 0xcc, 0xdd,
+	0x06, 0x8a, 0xbf, 0x73, 0x90, 0x71, 0x57, 0x9a, 0x06, 0x1d, 0x6e, 0x82, 0xdf, 0x86, 0x14, 0xad,
+	0x25, 0xad, 0x48, 0xcd, 0x96, 0x13, 0x0d, 0x70, 0xe7, 0x55, 0x27, 0x33, 0xb1, 0x5c, 0xc5, 0xc8,
+	0x3f, 0x8e, 0x41, 0xda, 0x5d, 0xb1, 0x07, 0x9a, 0xf1, 0x68, 0xc8, 0x39, 0xf9, 0xb7, 0x2b, 0xec,
+	0x4d, 0x48, 0xd2, 0xda, 0xf4, 0x8d, 0xd4, 0xa4, 0xea, 0xfa, 0xd8, 0xca, 0x30, 0xe7, 0x2a, 0x21,
+	0x43, 0xc7, 0x09, 0x7a, 0xd6, 0x51, 0x29, 0xe6, 0xb3, 0xd1, 0xe7, 0x23, 0x63, 0xab, 0x6c, 0xd7,
+	0xf2, 0xdc, 0x6a, 0xc2, 0x5d, 0x5d, 0x4c, 0x3b, 0xeb, 0x33, 0xbe, 0x23, 0x2f, 0x78, 0x7c, 0xbf,
+	0xe2, 0x20, 0x3f, 0xa8, 0x1b, 0x21, 0x76, 0x91, 0xab, 0x1c, 0x2e, 0xf1, 0xff, 0xf0, 0xbf, 0x81,
+	0x53, 0xcf, 0x96, 0x98, 0x27, 0x51, 0x10, 0xfd, 0x50, 0xee, 0xbc, 0xff, 0xd3, 0x8f, 0xc4, 0xa7,
+	0x8d, 0xb1, 0x17, 0xdc, 0xc6, 0x35, 0x28, 0x04, 0x17, 0x85, 0xd5, 0xf0, 0x67, 0x0e, 0x16, 0xfd,
+	0xe0, 0x43, 0x6f, 0x2e, 0x57, 0x59, 0xbd, 0xb0, 0xbb, 0xd1, 0x32, 0x2c, 0x5d, 0x94, 0x03, 0x4b,
+	0xf6, 0xeb, 0x28, 0xcc, 0x54, 0xb1, 0x7a, 0xd0, 0x3d, 0x6c, 0x6a, 0xc6, 0x7e, 0x47, 0x6f, 0xeb,
+	0x58, 0x6e, 0x0c, 0x54, 0xcc, 0x0d, 0xa1, 0x78, 0x11, 0xc6, 0xda, 0x24, 0xae, 0xbd, 0x0c, 0x8d,
+	0x49, 0xe7, 0x2f, 0x2e, 0xdc, 0xaf, 0x5e, 0x31, 0x6d, 0x18, 0xcb, 0x2a, 0xc2, 0xe9, 0x78, 0x3e,
+	0x36, 0x68, 0x44, 0x24, 0x86, 0xe2, 0x6f, 0x41, 0x1c, 0xf5, 0x50, 0x9d, 0x2c, 0x22, 0xc9, 0xf2,
+	0x9c, 0x67, 0xb5, 0xab, 0xf4, 0x50, 0x5d, 0x22, 0x90, 0x2d, 0xde, 0x9e, 0x91, 0x73, 0x31, 0xe2,
+	0xeb, 0x90, 0xf1, 0xd4, 0x82, 0x7d, 0xe6, 0x39, 0x18, 0x6f, 0x5b, 0xef, 0xce, 0xbf, 0x74, 0xb0,
+	0x5f, 0xed, 0x29, 0x62, 0x8f, 0x1c, 0xa9, 0xcc, 0x05, 0x42, 0xe9, 0xc8, 0xc7, 0xac, 0x96, 0x41,
+	0x7e, 0xce, 0x3d, 0x30, 0x1a, 0x72, 0x0f, 0xdc, 0x9a, 0x30, 0x95, 0xdb, 0x4f, 0xd6, 0x39, 0xa9,
+	0x9f, 0x99, 0xf5, 0xf8, 0x94, 0x83, 0xd1, 0x2a, 0x56, 0x3f, 0xd0, 0x8d, 0xe0, 0x2c, 0xcc, 0xe1,
+	0x3e, 0xd2, 0x0d, 0xd4, 0x09, 0xd4, 0x42, 0x61, 0xfc, 0x26, 0x8c, 0xe8, 0x6d, 0x43, 0xd3, 0xe9,
+	0x86, 0x97, 0x2c, 0x2f, 0x78, 0x8a, 0x6e, 0xf2, 0xbe, 0x4f, 0x20, 0x92, 0x05, 0x75, 0x75, 0x3d,
+	0xde, 0xd7, 0xf5, 0x4b, 0xf4, 0x90, 0x0e, 0x3c, 0xd1, 0x21, 0xce, 0xc0, 0x94, 0x95, 0x23, 0xcb,
+	0xbb, 0x49, 0xd2, 0x36, 0xf1, 0xc1, 0x69, 0xbf, 0x0a, 0x09, 0x33, 0x64, 0xd7, 0xd0, 0x83, 0x33,
+	0x67, 0xc8, 0xad, 0x71, 0x53, 0xc0, 0x08, 0xd6, 0xd4, 0x16, 0xea, 0x88, 0x12, 0x4c, 0x59, 0x74,
+	0x6c, 0x66, 0xde, 0x80, 0x91, 0x0e, 0xc2, 0xdd, 0x86, 0x41, 0x62, 0x26, 0xcb, 0x2b, 0x9e, 0x6c,
+	0xec, 0x66, 0x55, 0xac, 0x90, 0x12, 0x81, 0x4b, 0x96, 0x9b, 0xd8, 0x80, 0xc9, 0x2a, 0x56, 0xdf,
+	0x45, 0xf2, 0x91, 0x75, 0xc9, 0x1a, 0xe2, 0xc0, 0x74, 0xc1, 0x71, 0xb1, 0x6f, 0x8e, 0xae, 0xc3,
+	0x9c, 0x8b, 0x8d, 0x55, 0xf2, 0x88, 0x2c, 0x12, 0xbb, 0x72, 0xab, 0x8e, 0x1a, 0x2f, 0x73, 0xb0,
+	0x17, 0x20, 0xe3, 0xe1, 0xb5, 0x45, 0x15, 0x0a, 0x10, 0x27, 0xbd, 0x4d, 0xc1, 0x74, 0xe5, 0xc3,
+	0xca, 0x6e, 0xed, 0xfe, 0x7b, 0x07, 0xfb, 0x95, 0xdd, 0xbd, 0x37, 0xf7, 0x2a, 0x77, 0xa7, 0x23,
+	0xfc, 0x04, 0x24, 0xc8, 0xdb, 0x7b, 0xd2, 0x47, 0xd3, 0x5c, 0xf9, 0xfb, 0x09, 0x88, 0x55, 0xb1,
+	0xca, 0x3f, 0x80, 0x71, 0xe7, 0x95, 0x35, 0xe7, 0x3d, 0x0f, 0xb9, 0x76, 0x7b, 0x61, 0x25, 0x00,
+	0xc0, 0x3a, 0xdd, 0x00, 0xde, 0xe7, 0xae, 0xb8, 0xec, 0xe7, 0xee, 0xc5, 0x09, 0xc5, 0x70, 0x38,
+	0xc6, 0xf6, 0x10, 0xa6, 0x3d, 0x57, 0xb7, 0xa5, 0x80, 0x18, 0x04, 0x25, 0xac, 0x85, 0x41, 0x31,
+	0x1e, 0x1d, 0x66, 0xfd, 0x6e, 0x57, 0x2b, 0x81, 0x72, 0x29, 0x50, 0x28, 0x85, 0x04, 0x32, 0x42,
+	0x0d, 0x66, 0xbc, 0xd7, 0xa3, 0x9b, 0x01, 0x4d, 0xa0, 0x30, 0x61, 0x3d, 0x14, 0x8c, 0x51, 0x75,
+	0x61, 0xce, 0xff, 0x94, 0x7d, 0x2b, 0x20, 0xce, 0x39, 0x54, 0xd8, 0x08, 0x0d, 0x65, 0xb4, 0x3d,
+	0x98, 0x1f, 0x70, 0x73, 0x29, 0x04, 0x14, 0xcb, 0x81, 0x15, 0xca, 0xe1, 0xb1, 0x8c, 0xf9, 0x09,
+	0x07, 0xb9, 0xa0, 0x83, 0xe1, 0x66, 0xa8, 0xb8, 0x6e, 0x27, 0xe1, 0xb5, 0x21, 0x9c, 0x98, 0xaa,
+	0xcf, 0x39, 0xc8, 0x0c, 0x3e, 0x6a, 0xad, 0x87, 0x0a, 0xcd, 0xe6, 0xed, 0xf6, 0xa5, 0xe0, 0x4c,
+	0xc3, 0x27, 0x90, 0xec, 0x3b, 0x00, 0x89, 0x7e, 0x81, 0xdc, 0x18, 0xa1, 0x10, 0x8c, 0x71, 0x7e,
+	0xb0, 0x9e, 0x83, 0x81, 0xef, 0x07, 0xdb, 0x8f, 0x12, 0xd6, 0xc2, 0xa0, 0x18, 0xcf, 0x0e, 0xc4,
+	0xc9, 0x36, 0x9f, 0xf6, 0xf3, 0x32, 0x2d, 0x42, 0x7e, 0x90, 0xc5, 0x19, 0x83, 0xac, 0xab, 0xbe,
+	0x31, 0x4c, 0x8b, 0x90, 0x1f, 0x64, 0x61, 0x31, 0xee, 0x01, 0x38, 0x36, 0xad, 0xac, 0x1f, 0xfe,
+	0xdc, 0x2e, 0x2c, 0x5f, 0x6c, 0x77, 0xf6, 0xa9, 0x6f, 0x0f, 0xf2, 0xed, 0x93, 0x1b, 0x23, 0x14,
+	0x82, 0x31, 0x36, 0xc3, 0xce, 0x9d, 0x67, 0xa7, 0x59, 0xee, 0xf9, 0x69, 0x96, 0xfb, 0xf3, 0x34,
+	0xcb, 0x7d, 0x73, 0x96, 0x8d, 0x3c, 0x3f, 0xcb, 0x46, 0x7e, 0x3b, 0xcb, 0x46, 0x3e, 0x5e, 0x52,
+	0x35, 0xe3, 0x51, 0xf7, 0xb0, 0x58, 0xd7, 0x9b, 0xd6, 0x4f, 0xab, 0xd6, 0x9f, 0x75, 0xac, 0x7c,
+	0x5a, 0xea, 0xd1, 0x9f, 0x4f, 0x0f, 0x47, 0xc8, 0xc1, 0x74, 0xf3, 0x9f, 0x01, 0x00, 0xf2, 0x6c,
+	0xa7, 0x8d, 0xcc, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1618,6 +1714,9 @@ type MsgClient interface {
 	Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error)
+	// CancelProposal allows the group policy admin to cancel an accepted
+	// proposal during its execution timelock.
+	CancelProposal(ctx context.Context, in *MsgCancelProposal, opts ...grpc.CallOption) (*MsgCancelProposalResponse, error)
 }
 
 type msgClient struct {
@@ -1754,6 +1853,15 @@ func (c *msgClient) LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) CancelProposal(ctx context.Context, in *MsgCancelProposal, opts ...grpc.CallOption) (*MsgCancelProposalResponse, error) {
+	out := new(MsgCancelProposalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/CancelProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateGroup creates a new group with an admin account address, a list of members and some optional metadata.
@@ -1784,6 +1892,9 @@ type MsgServer interface {
 	Exec(context.Context, *MsgExec) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error)
+	// CancelProposal allows the group policy admin to cancel an accepted
+	// proposal during its execution timelock.
+	CancelProposal(context.Context, *MsgCancelProposal) (*MsgCancelProposalResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1832,6 +1943,9 @@ func (*UnimplementedMsgServer) Exec(ctx context.Context, req *MsgExec) (*MsgExec
 func (*UnimplementedMsgServer) LeaveGroup(ctx context.Context, req *MsgLeaveGroup) (*MsgLeaveGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
 }
+func (*UnimplementedMsgServer) CancelProposal(ctx context.Context, req *MsgCancelProposal) (*MsgCancelProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelProposal not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -2089,6 +2203,24 @@ func _Msg_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/CancelProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelProposal(ctx, req.(*MsgCancelProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.group.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -2149,6 +2281,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LeaveGroup",
 			Handler:    _Msg_LeaveGroup_Handler,
 		},
+		{
+			MethodName: "CancelProposal",
+			Handler:    _Msg_CancelProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/tx.proto",
@@ -3187,6 +3323,64 @@ func (m *MsgLeaveGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCancelProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -3656,6 +3850,31 @@ func (m *MsgLeaveGroupResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCancelProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6571,6 +6790,157 @@ func (m *MsgLeaveGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCancelProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -35,6 +35,10 @@ type DecisionPolicy interface {
 	// where we can execution a proposal. It can be set to 0 or to a value
 	// lesser than VotingPeriod to allow TRY_EXEC.
 	GetMinExecutionPeriod() time.Duration
+	// GetTimelock returns the mandatory delay between a proposal being accepted
+	// and its execution. It can be set to 0 to allow executing accepted
+	// proposals right away.
+	GetTimelock() time.Duration
 	// Allow defines policy-specific logic to allow a proposal to pass or not,
 	// based on its tally result, the group's total power and the time since
 	// the proposal was submitted.
@@ -49,7 +53,7 @@ var _ DecisionPolicy = &ThresholdDecisionPolicy{}
 
 // NewThresholdDecisionPolicy creates a threshold DecisionPolicy
 func NewThresholdDecisionPolicy(threshold string, votingPeriod time.Duration, minExecutionPeriod time.Duration) DecisionPolicy {
-	return &ThresholdDecisionPolicy{threshold, &DecisionPolicyWindows{votingPeriod, minExecutionPeriod}}
+	return &ThresholdDecisionPolicy{threshold, &DecisionPolicyWindows{VotingPeriod: votingPeriod, MinExecutionPeriod: minExecutionPeriod}}
 }
 
 func (p ThresholdDecisionPolicy) GetVotingPeriod() time.Duration {
@@ -60,6 +64,10 @@ func (p ThresholdDecisionPolicy) GetMinExecutionPeriod() time.Duration {
 	return p.Windows.MinExecutionPeriod
 }
 
+func (p ThresholdDecisionPolicy) GetTimelock() time.Duration {
+	return p.Windows.Timelock
+}
+
 func (p ThresholdDecisionPolicy) ValidateBasic() error {
 	if _, err := math.NewPositiveDecFromString(p.Threshold); err != nil {
 		return sdkerrors.Wrap(err, "threshold")
@@ -69,6 +77,10 @@ func (p ThresholdDecisionPolicy) ValidateBasic() error {
 		return sdkerrors.Wrap(errors.ErrInvalid, "voting period cannot be zero")
 	}
 
+	if p.Windows.Timelock < 0 {
+		return sdkerrors.Wrap(errors.ErrInvalid, "timelock cannot be negative")
+	}
+
 	return nil
 }
 
@@ -143,6 +155,9 @@ func (p *ThresholdDecisionPolicy) Validate(g GroupInfo, config Config) error {
 	if p.Windows.MinExecutionPeriod > p.Windows.VotingPeriod+config.MaxExecutionPeriod {
 		return sdkerrors.Wrap(errors.ErrInvalid, "min_execution_period should be smaller than voting_period + max_execution_period")
 	}
+	if p.Windows.Timelock >= config.MaxExecutionPeriod {
+		return sdkerrors.Wrap(errors.ErrInvalid, "timelock should be smaller than max_execution_period")
+	}
 	return nil
 }
 
@@ -151,7 +166,7 @@ var _ DecisionPolicy = &PercentageDecisionPolicy{}
 
 // NewPercentageDecisionPolicy creates a new percentage DecisionPolicy
 func NewPercentageDecisionPolicy(percentage string, votingPeriod time.Duration, executionPeriod time.Duration) DecisionPolicy {
-	return &PercentageDecisionPolicy{percentage, &DecisionPolicyWindows{votingPeriod, executionPeriod}}
+	return &PercentageDecisionPolicy{percentage, &DecisionPolicyWindows{VotingPeriod: votingPeriod, MinExecutionPeriod: executionPeriod}}
 }
 
 func (p PercentageDecisionPolicy) GetVotingPeriod() time.Duration {
@@ -162,6 +177,10 @@ func (p PercentageDecisionPolicy) GetMinExecutionPeriod() time.Duration {
 	return p.Windows.MinExecutionPeriod
 }
 
+func (p PercentageDecisionPolicy) GetTimelock() time.Duration {
+	return p.Windows.Timelock
+}
+
 func (p PercentageDecisionPolicy) ValidateBasic() error {
 	percentage, err := math.NewPositiveDecFromString(p.Percentage)
 	if err != nil {
@@ -175,6 +194,10 @@ func (p PercentageDecisionPolicy) ValidateBasic() error {
 		return sdkerrors.Wrap(errors.ErrInvalid, "voting period cannot be 0")
 	}
 
+	if p.Windows.Timelock < 0 {
+		return sdkerrors.Wrap(errors.ErrInvalid, "timelock cannot be negative")
+	}
+
 	return nil
 }
 
@@ -182,6 +205,9 @@ func (p *PercentageDecisionPolicy) Validate(g GroupInfo, config Config) error {
 	if p.Windows.MinExecutionPeriod > p.Windows.VotingPeriod+config.MaxExecutionPeriod {
 		return sdkerrors.Wrap(errors.ErrInvalid, "min_execution_period should be smaller than voting_period + max_execution_period")
 	}
+	if p.Windows.Timelock >= config.MaxExecutionPeriod {
+		return sdkerrors.Wrap(errors.ErrInvalid, "timelock should be smaller than max_execution_period")
+	}
 	return nil
 }
 
@@ -231,6 +257,76 @@ func (p PercentageDecisionPolicy) Allow(tally TallyResult, totalPower string) (D
 	return DecisionPolicyResult{Allow: false, Final: false}, nil
 }
 
+// Implements DecisionPolicy Interface
+var _ DecisionPolicy = &TokenWeightedDecisionPolicy{}
+
+// NewTokenWeightedDecisionPolicy creates a new token weighted DecisionPolicy
+func NewTokenWeightedDecisionPolicy(denom string, source TokenWeightSource, percentage string, votingPeriod time.Duration, executionPeriod time.Duration) DecisionPolicy {
+	return &TokenWeightedDecisionPolicy{denom, source, percentage, &DecisionPolicyWindows{VotingPeriod: votingPeriod, MinExecutionPeriod: executionPeriod}}
+}
+
+func (p TokenWeightedDecisionPolicy) GetVotingPeriod() time.Duration {
+	return p.Windows.VotingPeriod
+}
+
+func (p TokenWeightedDecisionPolicy) GetMinExecutionPeriod() time.Duration {
+	return p.Windows.MinExecutionPeriod
+}
+
+func (p TokenWeightedDecisionPolicy) GetTimelock() time.Duration {
+	return p.Windows.Timelock
+}
+
+func (p TokenWeightedDecisionPolicy) ValidateBasic() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return sdkerrors.Wrap(err, "denom")
+	}
+
+	if p.Source != TOKEN_WEIGHT_SOURCE_BALANCE && p.Source != TOKEN_WEIGHT_SOURCE_STAKED {
+		return sdkerrors.Wrapf(errors.ErrInvalid, "token weight source %s", p.Source)
+	}
+
+	percentage, err := math.NewPositiveDecFromString(p.Percentage)
+	if err != nil {
+		return sdkerrors.Wrap(err, "percentage threshold")
+	}
+	if percentage.Cmp(math.NewDecFromInt64(1)) == 1 {
+		return sdkerrors.Wrap(errors.ErrInvalid, "percentage must be > 0 and <= 1")
+	}
+
+	if p.Windows == nil || p.Windows.VotingPeriod == 0 {
+		return sdkerrors.Wrap(errors.ErrInvalid, "voting period cannot be 0")
+	}
+
+	if p.Windows.Timelock < 0 {
+		return sdkerrors.Wrap(errors.ErrInvalid, "timelock cannot be negative")
+	}
+
+	return nil
+}
+
+// Validate validates the policy against the group. The group's member weights
+// are not used by this policy, voting power is instead snapshotted from the
+// members' tokens when a proposal is submitted.
+func (p *TokenWeightedDecisionPolicy) Validate(g GroupInfo, config Config) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	if p.Windows.MinExecutionPeriod > p.Windows.VotingPeriod+config.MaxExecutionPeriod {
+		return sdkerrors.Wrap(errors.ErrInvalid, "min_execution_period should be smaller than voting_period + max_execution_period")
+	}
+	if p.Windows.Timelock >= config.MaxExecutionPeriod {
+		return sdkerrors.Wrap(errors.ErrInvalid, "timelock should be smaller than max_execution_period")
+	}
+	return nil
+}
+
+// Allow allows a proposal to pass when the tally of yes votes equals or exceeds the percentage threshold before the timeout.
+// The totalPower is expected to be the total voting power snapshotted when the proposal was submitted.
+func (p TokenWeightedDecisionPolicy) Allow(tally TallyResult, totalPower string) (DecisionPolicyResult, error) {
+	return PercentageDecisionPolicy{Percentage: p.Percentage, Windows: p.Windows}.Allow(tally, totalPower)
+}
+
 var _ orm.Validateable = GroupPolicyInfo{}
 
 // NewGroupPolicyInfo creates a new GroupPolicyInfo instance
@@ -426,6 +522,54 @@ func (v Vote) ValidateBasic() error {
 	return nil
 }
 
+func (s VotingPowerSnapshot) PrimaryKeyFields() []interface{} {
+	addr := sdk.MustAccAddressFromBech32(s.GroupPolicyAddress)
+
+	return []interface{}{s.ProposalId, addr.Bytes()}
+}
+
+var _ orm.Validateable = VotingPowerSnapshot{}
+
+func (s VotingPowerSnapshot) ValidateBasic() error {
+	if s.ProposalId == 0 {
+		return sdkerrors.Wrap(errors.ErrEmpty, "voting power snapshot ProposalId")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(s.GroupPolicyAddress); err != nil {
+		return sdkerrors.Wrap(err, "voting power snapshot group policy")
+	}
+
+	totalPower, err := math.NewPositiveDecFromString(s.TotalPower)
+	if err != nil {
+		return sdkerrors.Wrap(err, "voting power snapshot total power")
+	}
+
+	sum := math.NewDecFromInt64(0)
+	index := make(map[string]struct{}, len(s.Powers))
+	for _, p := range s.Powers {
+		if _, err := sdk.AccAddressFromBech32(p.Address); err != nil {
+			return sdkerrors.Wrap(err, "voting power snapshot member")
+		}
+		if _, exists := index[p.Address]; exists {
+			return sdkerrors.Wrapf(errors.ErrDuplicate, "voting power snapshot member %s", p.Address)
+		}
+		index[p.Address] = struct{}{}
+
+		power, err := math.NewPositiveDecFromString(p.Power)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "voting power snapshot member %s power", p.Address)
+		}
+		if sum, err = sum.Add(power); err != nil {
+			return err
+		}
+	}
+
+	if sum.Cmp(totalPower) != 0 {
+		return sdkerrors.Wrapf(errors.ErrInvalid, "voting power snapshot total power %s doesn't match the sum of members' power %s", s.TotalPower, sum)
+	}
+	return nil
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (q QueryGroupPoliciesByGroupResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return unpackGroupPolicies(unpacker, q.GroupPolicies)
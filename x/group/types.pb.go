@@ -30,6 +30,41 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TokenWeightSource enumerates the sources of a member's voting power for the
+// TokenWeightedDecisionPolicy.
+type TokenWeightSource int32
+
+const (
+	// TOKEN_WEIGHT_SOURCE_UNSPECIFIED defines an unspecified source which will
+	// return an error.
+	TOKEN_WEIGHT_SOURCE_UNSPECIFIED TokenWeightSource = 0
+	// TOKEN_WEIGHT_SOURCE_BALANCE uses the member's bank balance of the policy
+	// denom.
+	TOKEN_WEIGHT_SOURCE_BALANCE TokenWeightSource = 1
+	// TOKEN_WEIGHT_SOURCE_STAKED uses the member's total bonded stake.
+	TOKEN_WEIGHT_SOURCE_STAKED TokenWeightSource = 2
+)
+
+var TokenWeightSource_name = map[int32]string{
+	0: "TOKEN_WEIGHT_SOURCE_UNSPECIFIED",
+	1: "TOKEN_WEIGHT_SOURCE_BALANCE",
+	2: "TOKEN_WEIGHT_SOURCE_STAKED",
+}
+
+var TokenWeightSource_value = map[string]int32{
+	"TOKEN_WEIGHT_SOURCE_UNSPECIFIED": 0,
+	"TOKEN_WEIGHT_SOURCE_BALANCE":     1,
+	"TOKEN_WEIGHT_SOURCE_STAKED":      2,
+}
+
+func (x TokenWeightSource) String() string {
+	return proto.EnumName(TokenWeightSource_name, int32(x))
+}
+
+func (TokenWeightSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{0}
+}
+
 // VoteOption enumerates the valid vote options for a given proposal.
 type VoteOption int32
 
@@ -68,7 +103,7 @@ func (x VoteOption) String() string {
 }
 
 func (VoteOption) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{0}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{1}
 }
 
 // ProposalStatus defines proposal statuses.
@@ -91,6 +126,9 @@ const (
 	// A proposal can be withdrawn before the voting start time by the owner.
 	// When this happens the final status is Withdrawn.
 	PROPOSAL_STATUS_WITHDRAWN ProposalStatus = 5
+	// Final status of a proposal when it is cancelled by the group policy admin
+	// after being accepted, during its execution timelock.
+	PROPOSAL_STATUS_CANCELLED ProposalStatus = 6
 )
 
 var ProposalStatus_name = map[int32]string{
@@ -100,6 +138,7 @@ var ProposalStatus_name = map[int32]string{
 	3: "PROPOSAL_STATUS_REJECTED",
 	4: "PROPOSAL_STATUS_ABORTED",
 	5: "PROPOSAL_STATUS_WITHDRAWN",
+	6: "PROPOSAL_STATUS_CANCELLED",
 }
 
 var ProposalStatus_value = map[string]int32{
@@ -109,6 +148,7 @@ var ProposalStatus_value = map[string]int32{
 	"PROPOSAL_STATUS_REJECTED":    3,
 	"PROPOSAL_STATUS_ABORTED":     4,
 	"PROPOSAL_STATUS_WITHDRAWN":   5,
+	"PROPOSAL_STATUS_CANCELLED":   6,
 }
 
 func (x ProposalStatus) String() string {
@@ -116,7 +156,7 @@ func (x ProposalStatus) String() string {
 }
 
 func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{1}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{2}
 }
 
 // ProposalExecutorResult defines types of proposal executor results.
@@ -152,7 +192,7 @@ func (x ProposalExecutorResult) String() string {
 }
 
 func (ProposalExecutorResult) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{2}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{3}
 }
 
 // Member represents a group member with an account address,
@@ -297,10 +337,10 @@ func (m *MemberRequest) GetMetadata() string {
 
 // ThresholdDecisionPolicy is a decision policy where a proposal passes when it
 // satisfies the two following conditions:
-// 1. The sum of all `YES` voters' weights is greater or equal than the defined
-//    `threshold`.
-// 2. The voting and execution periods of the proposal respect the parameters
-//    given by `windows`.
+//  1. The sum of all `YES` voters' weights is greater or equal than the defined
+//     `threshold`.
+//  2. The voting and execution periods of the proposal respect the parameters
+//     given by `windows`.
 type ThresholdDecisionPolicy struct {
 	// threshold is the minimum weighted sum of `YES` votes that must be met or
 	// exceeded for a proposal to succeed.
@@ -358,10 +398,10 @@ func (m *ThresholdDecisionPolicy) GetWindows() *DecisionPolicyWindows {
 
 // PercentageDecisionPolicy is a decision policy where a proposal passes when
 // it satisfies the two following conditions:
-// 1. The percentage of all `YES` voters' weights out of the total group weight
-//    is greater or equal than the given `percentage`.
-// 2. The voting and execution periods of the proposal respect the parameters
-//    given by `windows`.
+//  1. The percentage of all `YES` voters' weights out of the total group weight
+//     is greater or equal than the given `percentage`.
+//  2. The voting and execution periods of the proposal respect the parameters
+//     given by `windows`.
 type PercentageDecisionPolicy struct {
 	// percentage is the minimum percentage the weighted sum of `YES` votes must
 	// meet for a proposal to succeed.
@@ -417,6 +457,90 @@ func (m *PercentageDecisionPolicy) GetWindows() *DecisionPolicyWindows {
 	return nil
 }
 
+// TokenWeightedDecisionPolicy is a decision policy where each member's voting
+// power is not its static group weight, but the amount of tokens it holds at
+// the time a proposal is submitted. A proposal passes when it satisfies the two
+// following conditions:
+//  1. The percentage of all `YES` voters' power out of the total power
+//     snapshotted at proposal submission is greater or equal than the given
+//     `percentage`.
+//  2. The voting and execution periods of the proposal respect the parameters
+//     given by `windows`.
+type TokenWeightedDecisionPolicy struct {
+	// denom is the denomination of the tokens used to compute voting power.
+	// When the source is TOKEN_WEIGHT_SOURCE_STAKED, it must be the staking
+	// bond denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// source defines where a member's token amount is read from.
+	Source TokenWeightSource `protobuf:"varint,2,opt,name=source,proto3,enum=cosmos.group.v1.TokenWeightSource" json:"source,omitempty"`
+	// percentage is the minimum percentage the `YES` voting power must meet for
+	// a proposal to succeed.
+	Percentage string `protobuf:"bytes,3,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	// windows defines the different windows for voting and execution.
+	Windows *DecisionPolicyWindows `protobuf:"bytes,4,opt,name=windows,proto3" json:"windows,omitempty"`
+}
+
+func (m *TokenWeightedDecisionPolicy) Reset()         { *m = TokenWeightedDecisionPolicy{} }
+func (m *TokenWeightedDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*TokenWeightedDecisionPolicy) ProtoMessage()    {}
+func (*TokenWeightedDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{4}
+}
+func (m *TokenWeightedDecisionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenWeightedDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenWeightedDecisionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenWeightedDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenWeightedDecisionPolicy.Merge(m, src)
+}
+func (m *TokenWeightedDecisionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenWeightedDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenWeightedDecisionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenWeightedDecisionPolicy proto.InternalMessageInfo
+
+func (m *TokenWeightedDecisionPolicy) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TokenWeightedDecisionPolicy) GetSource() TokenWeightSource {
+	if m != nil {
+		return m.Source
+	}
+	return TOKEN_WEIGHT_SOURCE_UNSPECIFIED
+}
+
+func (m *TokenWeightedDecisionPolicy) GetPercentage() string {
+	if m != nil {
+		return m.Percentage
+	}
+	return ""
+}
+
+func (m *TokenWeightedDecisionPolicy) GetWindows() *DecisionPolicyWindows {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
 // DecisionPolicyWindows defines the different windows for voting and execution.
 type DecisionPolicyWindows struct {
 	// voting_period is the duration from submission of a proposal to the end of voting period
@@ -434,13 +558,23 @@ type DecisionPolicyWindows struct {
 	// is empty, meaning that all proposals created with this decision policy
 	// won't be able to be executed.
 	MinExecutionPeriod time.Duration `protobuf:"bytes,2,opt,name=min_execution_period,json=minExecutionPeriod,proto3,stdduration" json:"min_execution_period"`
+	// timelock is the mandatory delay between a proposal being accepted and its
+	// execution. During the timelock, the group policy admin can cancel the
+	// proposal, and once it is over, the proposal is executed automatically at
+	// the end of the block. If not set, timelock will default to 0, meaning that
+	// accepted proposals can be executed right away with MsgExec.
+	//
+	// The timelock must not be greater than the app-specific
+	// `max_execution_period`, or else accepted proposals would be pruned before
+	// they can be executed.
+	Timelock time.Duration `protobuf:"bytes,3,opt,name=timelock,proto3,stdduration" json:"timelock"`
 }
 
 func (m *DecisionPolicyWindows) Reset()         { *m = DecisionPolicyWindows{} }
 func (m *DecisionPolicyWindows) String() string { return proto.CompactTextString(m) }
 func (*DecisionPolicyWindows) ProtoMessage()    {}
 func (*DecisionPolicyWindows) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{4}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{5}
 }
 func (m *DecisionPolicyWindows) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -483,6 +617,13 @@ func (m *DecisionPolicyWindows) GetMinExecutionPeriod() time.Duration {
 	return 0
 }
 
+func (m *DecisionPolicyWindows) GetTimelock() time.Duration {
+	if m != nil {
+		return m.Timelock
+	}
+	return 0
+}
+
 // GroupInfo represents the high-level on-chain information for a group.
 type GroupInfo struct {
 	// id is the unique ID of the group.
@@ -506,7 +647,7 @@ func (m *GroupInfo) Reset()         { *m = GroupInfo{} }
 func (m *GroupInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupInfo) ProtoMessage()    {}
 func (*GroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{5}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{6}
 }
 func (m *GroupInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -589,7 +730,7 @@ func (m *GroupMember) Reset()         { *m = GroupMember{} }
 func (m *GroupMember) String() string { return proto.CompactTextString(m) }
 func (*GroupMember) ProtoMessage()    {}
 func (*GroupMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{6}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{7}
 }
 func (m *GroupMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -632,6 +773,81 @@ func (m *GroupMember) GetMember() *Member {
 	return nil
 }
 
+// VotingTreeNode is a member of a group's voting tree. Members which are group
+// policies are resolved into the members of their sub-group.
+type VotingTreeNode struct {
+	// address is the member's account address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// weight is the member's voting weight in its parent group.
+	Weight string `protobuf:"bytes,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	// group_id is the unique ID of the sub-group when the member is a group
+	// policy, and 0 otherwise.
+	GroupId uint64 `protobuf:"varint,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// members are the members of the sub-group, if any.
+	Members []*VotingTreeNode `protobuf:"bytes,4,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (m *VotingTreeNode) Reset()         { *m = VotingTreeNode{} }
+func (m *VotingTreeNode) String() string { return proto.CompactTextString(m) }
+func (*VotingTreeNode) ProtoMessage()    {}
+func (*VotingTreeNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{8}
+}
+func (m *VotingTreeNode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VotingTreeNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VotingTreeNode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VotingTreeNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotingTreeNode.Merge(m, src)
+}
+func (m *VotingTreeNode) XXX_Size() int {
+	return m.Size()
+}
+func (m *VotingTreeNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotingTreeNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VotingTreeNode proto.InternalMessageInfo
+
+func (m *VotingTreeNode) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *VotingTreeNode) GetWeight() string {
+	if m != nil {
+		return m.Weight
+	}
+	return ""
+}
+
+func (m *VotingTreeNode) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+func (m *VotingTreeNode) GetMembers() []*VotingTreeNode {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
 // GroupPolicyInfo represents the high-level on-chain information for a group policy.
 type GroupPolicyInfo struct {
 	// address is the account address of group policy.
@@ -655,7 +871,7 @@ func (m *GroupPolicyInfo) Reset()         { *m = GroupPolicyInfo{} }
 func (m *GroupPolicyInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupPolicyInfo) ProtoMessage()    {}
 func (*GroupPolicyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{7}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
 }
 func (m *GroupPolicyInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -724,13 +940,17 @@ type Proposal struct {
 	ExecutorResult ProposalExecutorResult `protobuf:"varint,11,opt,name=executor_result,json=executorResult,proto3,enum=cosmos.group.v1.ProposalExecutorResult" json:"executor_result,omitempty"`
 	// messages is a list of `sdk.Msg`s that will be executed if the proposal passes.
 	Messages []*types.Any `protobuf:"bytes,12,rep,name=messages,proto3" json:"messages,omitempty"`
+	// execute_after is the timestamp after which an accepted proposal whose
+	// decision policy has a timelock can be executed. It is only set once the
+	// proposal is accepted.
+	ExecuteAfter *time.Time `protobuf:"bytes,13,opt,name=execute_after,json=executeAfter,proto3,stdtime" json:"execute_after,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{8}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -775,7 +995,7 @@ func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{11}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -822,7 +1042,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{12}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -886,7 +1106,140 @@ func (m *Vote) GetSubmitTime() time.Time {
 	return time.Time{}
 }
 
+// VotingPowerSnapshot is the voting power of every member of a group captured
+// when a proposal was submitted, for the group of a TokenWeightedDecisionPolicy:
+// either the group policy of the proposal or a group policy of a nested
+// sub-group.
+type VotingPowerSnapshot struct {
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// total_power is the sum of all the members' voting power.
+	TotalPower string `protobuf:"bytes,2,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+	// powers is the list of members with a non-zero voting power.
+	Powers []MemberVotingPower `protobuf:"bytes,3,rep,name=powers,proto3" json:"powers"`
+	// group_policy_address is the account address of the group policy whose
+	// group members' voting power is snapshotted.
+	GroupPolicyAddress string `protobuf:"bytes,4,opt,name=group_policy_address,json=groupPolicyAddress,proto3" json:"group_policy_address,omitempty"`
+}
+
+func (m *VotingPowerSnapshot) Reset()         { *m = VotingPowerSnapshot{} }
+func (m *VotingPowerSnapshot) String() string { return proto.CompactTextString(m) }
+func (*VotingPowerSnapshot) ProtoMessage()    {}
+func (*VotingPowerSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{13}
+}
+func (m *VotingPowerSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VotingPowerSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VotingPowerSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VotingPowerSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotingPowerSnapshot.Merge(m, src)
+}
+func (m *VotingPowerSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *VotingPowerSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotingPowerSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VotingPowerSnapshot proto.InternalMessageInfo
+
+func (m *VotingPowerSnapshot) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *VotingPowerSnapshot) GetTotalPower() string {
+	if m != nil {
+		return m.TotalPower
+	}
+	return ""
+}
+
+func (m *VotingPowerSnapshot) GetPowers() []MemberVotingPower {
+	if m != nil {
+		return m.Powers
+	}
+	return nil
+}
+
+func (m *VotingPowerSnapshot) GetGroupPolicyAddress() string {
+	if m != nil {
+		return m.GroupPolicyAddress
+	}
+	return ""
+}
+
+// MemberVotingPower is the voting power of a single group member.
+type MemberVotingPower struct {
+	// address is the member's account address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// power is the member's voting power.
+	Power string `protobuf:"bytes,2,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *MemberVotingPower) Reset()         { *m = MemberVotingPower{} }
+func (m *MemberVotingPower) String() string { return proto.CompactTextString(m) }
+func (*MemberVotingPower) ProtoMessage()    {}
+func (*MemberVotingPower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{14}
+}
+func (m *MemberVotingPower) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MemberVotingPower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MemberVotingPower.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MemberVotingPower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemberVotingPower.Merge(m, src)
+}
+func (m *MemberVotingPower) XXX_Size() int {
+	return m.Size()
+}
+func (m *MemberVotingPower) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemberVotingPower.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MemberVotingPower proto.InternalMessageInfo
+
+func (m *MemberVotingPower) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MemberVotingPower) GetPower() string {
+	if m != nil {
+		return m.Power
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.group.v1.TokenWeightSource", TokenWeightSource_name, TokenWeightSource_value)
 	proto.RegisterEnum("cosmos.group.v1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.group.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterEnum("cosmos.group.v1.ProposalExecutorResult", ProposalExecutorResult_name, ProposalExecutorResult_value)
@@ -894,101 +1247,121 @@ func init() {
 	proto.RegisterType((*MemberRequest)(nil), "cosmos.group.v1.MemberRequest")
 	proto.RegisterType((*ThresholdDecisionPolicy)(nil), "cosmos.group.v1.ThresholdDecisionPolicy")
 	proto.RegisterType((*PercentageDecisionPolicy)(nil), "cosmos.group.v1.PercentageDecisionPolicy")
+	proto.RegisterType((*TokenWeightedDecisionPolicy)(nil), "cosmos.group.v1.TokenWeightedDecisionPolicy")
 	proto.RegisterType((*DecisionPolicyWindows)(nil), "cosmos.group.v1.DecisionPolicyWindows")
 	proto.RegisterType((*GroupInfo)(nil), "cosmos.group.v1.GroupInfo")
 	proto.RegisterType((*GroupMember)(nil), "cosmos.group.v1.GroupMember")
+	proto.RegisterType((*VotingTreeNode)(nil), "cosmos.group.v1.VotingTreeNode")
 	proto.RegisterType((*GroupPolicyInfo)(nil), "cosmos.group.v1.GroupPolicyInfo")
 	proto.RegisterType((*Proposal)(nil), "cosmos.group.v1.Proposal")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.group.v1.TallyResult")
 	proto.RegisterType((*Vote)(nil), "cosmos.group.v1.Vote")
+	proto.RegisterType((*VotingPowerSnapshot)(nil), "cosmos.group.v1.VotingPowerSnapshot")
+	proto.RegisterType((*MemberVotingPower)(nil), "cosmos.group.v1.MemberVotingPower")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/types.proto", fileDescriptor_f5bddd15d7a54a9d) }
 
 var fileDescriptor_f5bddd15d7a54a9d = []byte{
-	// 1299 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xf6, 0xda, 0x8e, 0x3f, 0x5e, 0xa7, 0xb6, 0x99, 0x86, 0x66, 0x93, 0x14, 0x3b, 0x98, 0x0a,
-	0xa2, 0xa2, 0xd8, 0x6d, 0x2a, 0x81, 0xd4, 0x03, 0x60, 0x3b, 0x5b, 0xea, 0xaa, 0xb5, 0xad, 0xdd,
-	0x75, 0x42, 0xb9, 0xac, 0x36, 0xde, 0xe9, 0x66, 0x85, 0xbd, 0x63, 0x76, 0xc7, 0x49, 0xfd, 0x0f,
-	0x7a, 0x41, 0xf4, 0xc8, 0x05, 0xa9, 0x12, 0xbf, 0x00, 0xa9, 0x07, 0xc4, 0x85, 0x6b, 0xd5, 0x03,
-	0xaa, 0x38, 0x71, 0x02, 0xd4, 0x5e, 0xe0, 0xc4, 0x95, 0x23, 0xda, 0x99, 0xd9, 0xc4, 0x1f, 0x89,
-	0x69, 0x2a, 0xe0, 0x94, 0xcc, 0x3c, 0xcf, 0xfb, 0xce, 0xf3, 0x7e, 0xae, 0x0c, 0x6b, 0x5d, 0xe2,
-	0xf7, 0x89, 0x5f, 0xb1, 0x3d, 0x32, 0x1c, 0x54, 0x0e, 0xae, 0x56, 0xe8, 0x68, 0x80, 0xfd, 0xf2,
-	0xc0, 0x23, 0x94, 0xa0, 0x1c, 0x07, 0xcb, 0x0c, 0x2c, 0x1f, 0x5c, 0x5d, 0x5d, 0xb2, 0x89, 0x4d,
-	0x18, 0x56, 0x09, 0xfe, 0xe3, 0xb4, 0xd5, 0x82, 0x4d, 0x88, 0xdd, 0xc3, 0x15, 0x76, 0xda, 0x1b,
-	0xde, 0xab, 0x58, 0x43, 0xcf, 0xa4, 0x0e, 0x71, 0x05, 0x5e, 0x9c, 0xc6, 0xa9, 0xd3, 0xc7, 0x3e,
-	0x35, 0xfb, 0x03, 0x41, 0x58, 0xe1, 0xef, 0x18, 0xdc, 0xb3, 0x78, 0x54, 0x40, 0xd3, 0xb6, 0xa6,
-	0x3b, 0xe2, 0x50, 0xe9, 0x5b, 0x09, 0x12, 0x77, 0x70, 0x7f, 0x0f, 0x7b, 0x68, 0x0b, 0x92, 0xa6,
-	0x65, 0x79, 0xd8, 0xf7, 0x65, 0x69, 0x5d, 0xda, 0x48, 0xd7, 0xe4, 0x9f, 0x1e, 0x6f, 0x2e, 0x09,
-	0x47, 0x55, 0x8e, 0x68, 0xd4, 0x73, 0x5c, 0x5b, 0x0d, 0x89, 0xe8, 0x02, 0x24, 0x0e, 0xb1, 0x63,
-	0xef, 0x53, 0x39, 0x1a, 0x98, 0xa8, 0xe2, 0x84, 0x56, 0x21, 0xd5, 0xc7, 0xd4, 0xb4, 0x4c, 0x6a,
-	0xca, 0x31, 0x86, 0x1c, 0x9d, 0xd1, 0x87, 0x90, 0x32, 0x2d, 0x0b, 0x5b, 0x86, 0x49, 0xe5, 0xf8,
-	0xba, 0xb4, 0x91, 0xd9, 0x5a, 0x2d, 0x73, 0x81, 0xe5, 0x50, 0x60, 0x59, 0x0f, 0x83, 0xab, 0xa5,
-	0x9e, 0xfc, 0x52, 0x8c, 0x3c, 0xfc, 0xb5, 0x28, 0xb1, 0x47, 0xb1, 0x55, 0xa5, 0xa5, 0x43, 0x38,
-	0xc7, 0x25, 0xab, 0xf8, 0xf3, 0x21, 0xf6, 0xe9, 0xff, 0xa5, 0xbc, 0xf4, 0x85, 0x04, 0xcb, 0xfa,
-	0xbe, 0x87, 0xfd, 0x7d, 0xd2, 0xb3, 0xb6, 0x71, 0xd7, 0xf1, 0x1d, 0xe2, 0xb6, 0x49, 0xcf, 0xe9,
-	0x8e, 0xd0, 0x45, 0x48, 0xd3, 0x10, 0xe2, 0x2a, 0xd4, 0xe3, 0x0b, 0xf4, 0x11, 0x24, 0x0f, 0x1d,
-	0xd7, 0x22, 0x87, 0x3e, 0x7b, 0x2e, 0xb3, 0xf5, 0x76, 0x79, 0xaa, 0x2d, 0xca, 0x93, 0xfe, 0x76,
-	0x39, 0x5b, 0x0d, 0xcd, 0xae, 0xa3, 0xa7, 0x8f, 0x37, 0xb3, 0x93, 0x9c, 0xd2, 0x43, 0x09, 0xe4,
-	0x36, 0xf6, 0xba, 0xd8, 0xa5, 0xa6, 0x8d, 0xa7, 0x04, 0x15, 0x00, 0x06, 0x47, 0x98, 0x50, 0x34,
-	0x76, 0xf3, 0x1f, 0x49, 0xfa, 0x4e, 0x82, 0xd7, 0x4f, 0x34, 0x43, 0x37, 0xe1, 0xdc, 0x01, 0xa1,
-	0x8e, 0x6b, 0x1b, 0x03, 0xec, 0x39, 0x84, 0x27, 0x29, 0xb3, 0xb5, 0x32, 0x53, 0xfb, 0x6d, 0xd1,
-	0xf8, 0xbc, 0xf4, 0x5f, 0x05, 0xa5, 0x5f, 0xe4, 0x96, 0x6d, 0x66, 0x88, 0x3a, 0xb0, 0xd4, 0x77,
-	0x5c, 0x03, 0xdf, 0xc7, 0xdd, 0x61, 0x40, 0x0c, 0x1d, 0x46, 0x5f, 0xde, 0x21, 0xea, 0x3b, 0xae,
-	0x12, 0xda, 0x73, 0xb7, 0xa5, 0x3f, 0x24, 0x48, 0x7f, 0x1c, 0x84, 0xde, 0x70, 0xef, 0x11, 0x94,
-	0x85, 0xa8, 0xc3, 0x35, 0xc6, 0xd5, 0xa8, 0x63, 0xa1, 0x32, 0x2c, 0x98, 0x56, 0xdf, 0x71, 0x79,
-	0xbb, 0xcc, 0xe9, 0x30, 0x4e, 0x9b, 0x3b, 0x01, 0x32, 0x24, 0x0f, 0xb0, 0x17, 0xa4, 0x88, 0x0d,
-	0x40, 0x5c, 0x0d, 0x8f, 0xe8, 0x4d, 0x58, 0xa4, 0x84, 0x9a, 0x3d, 0x43, 0xf4, 0xe6, 0x02, 0xb3,
-	0xcc, 0xb0, 0xbb, 0x5d, 0xde, 0xa0, 0x75, 0x80, 0xae, 0x87, 0x4d, 0xca, 0x07, 0x28, 0x71, 0x86,
-	0x01, 0x4a, 0x0b, 0xbb, 0x2a, 0x2d, 0xdd, 0x85, 0x0c, 0x0b, 0x55, 0x8c, 0xfe, 0x0a, 0xa4, 0x58,
-	0xd1, 0x8d, 0xa3, 0x90, 0x93, 0xec, 0xdc, 0xb0, 0x50, 0x05, 0x12, 0x7d, 0x46, 0x12, 0xe9, 0x5d,
-	0x9e, 0xe9, 0x12, 0x31, 0x8b, 0x82, 0x56, 0xfa, 0x2b, 0x0a, 0x39, 0xe6, 0x9b, 0x97, 0x9f, 0x25,
-	0xf3, 0x55, 0x06, 0x74, 0x5c, 0x53, 0x74, 0x52, 0xd3, 0x51, 0x2d, 0x62, 0x67, 0xaf, 0x45, 0xfc,
-	0xf4, 0x5a, 0x2c, 0x4c, 0xd6, 0xc2, 0x84, 0x9c, 0x25, 0x3a, 0xd9, 0x18, 0xb0, 0x58, 0x44, 0xb6,
-	0x97, 0x66, 0xb2, 0x5d, 0x75, 0x47, 0xb5, 0xd2, 0xd3, 0xc7, 0x9b, 0x85, 0xf9, 0x13, 0xa4, 0x66,
-	0xad, 0xc9, 0x19, 0x9d, 0xac, 0x65, 0xf2, 0x95, 0x6a, 0x79, 0x3d, 0xf5, 0xe0, 0x51, 0x31, 0xf2,
-	0xfb, 0xa3, 0xa2, 0x54, 0xfa, 0x61, 0x01, 0x52, 0x6d, 0x8f, 0x0c, 0x88, 0x6f, 0xf6, 0x66, 0x1a,
-	0xf8, 0x16, 0x2c, 0xf1, 0x7c, 0xf2, 0x58, 0x8c, 0xb0, 0x20, 0xff, 0xd4, 0xcf, 0xc8, 0x3e, 0x2e,
-	0xa6, 0x40, 0xe6, 0x36, 0xf7, 0x7b, 0x90, 0x1e, 0x30, 0x0d, 0xd8, 0xf3, 0xe5, 0xf8, 0x7a, 0x6c,
-	0xae, 0xf3, 0x63, 0x2a, 0x52, 0x20, 0xe3, 0x0f, 0xf7, 0xfa, 0x0e, 0x35, 0x82, 0x2f, 0x1b, 0x2b,
-	0xc6, 0xcb, 0x26, 0x03, 0xb8, 0x61, 0x00, 0xa1, 0xb7, 0xe0, 0x1c, 0x0f, 0x33, 0xac, 0x6a, 0x82,
-	0x65, 0x60, 0x91, 0x5d, 0xee, 0x88, 0xd2, 0x5e, 0x99, 0xca, 0x45, 0xc8, 0x4d, 0x32, 0xee, 0x78,
-	0xc4, 0xa1, 0xc5, 0xfb, 0x90, 0xf0, 0xa9, 0x49, 0x87, 0xbe, 0x9c, 0x5a, 0x97, 0x36, 0xb2, 0x5b,
-	0xc5, 0x99, 0x31, 0x08, 0x13, 0xaf, 0x31, 0x9a, 0x2a, 0xe8, 0xa8, 0x0d, 0xe8, 0x9e, 0xe3, 0x9a,
-	0x3d, 0x83, 0x9a, 0xbd, 0xde, 0xc8, 0xf0, 0xb0, 0x3f, 0xec, 0x51, 0x39, 0xcd, 0xa2, 0xbb, 0x38,
-	0xe3, 0x44, 0x0f, 0x48, 0x2a, 0xe3, 0xd4, 0xe2, 0x41, 0x7c, 0x6a, 0x9e, 0x59, 0x8f, 0xdd, 0xa3,
-	0x36, 0xbc, 0x36, 0xb1, 0x48, 0x0d, 0xec, 0x5a, 0x32, 0x9c, 0x21, 0x5d, 0xb9, 0xf1, 0x6d, 0xaa,
-	0xb8, 0x16, 0x6a, 0x43, 0x8e, 0x2f, 0x53, 0xe2, 0x85, 0x02, 0x33, 0x2c, 0xca, 0x77, 0x4e, 0x8d,
-	0x52, 0x11, 0x7c, 0xae, 0x49, 0xcd, 0xe2, 0x89, 0x33, 0xba, 0x12, 0x34, 0x88, 0xef, 0x9b, 0x36,
-	0xf6, 0xe5, 0xc5, 0xf5, 0xd8, 0x69, 0x43, 0xa3, 0x1e, 0xb1, 0xae, 0xc7, 0x83, 0x2e, 0x2e, 0x7d,
-	0x2d, 0x41, 0x66, 0x3c, 0xd6, 0x35, 0x48, 0x8f, 0xb0, 0x6f, 0x74, 0xc9, 0xd0, 0xa5, 0xe2, 0x1b,
-	0x96, 0x1a, 0x61, 0xbf, 0x1e, 0x9c, 0x83, 0x52, 0x9b, 0x7b, 0x3e, 0x35, 0x1d, 0x57, 0x10, 0xf8,
-	0x97, 0x7c, 0x51, 0x5c, 0x72, 0xd2, 0x0a, 0xa4, 0x5c, 0x22, 0x70, 0xde, 0xaa, 0x49, 0x97, 0x70,
-	0xe8, 0x5d, 0x40, 0x2e, 0x31, 0x0e, 0x1d, 0xba, 0x6f, 0x1c, 0x60, 0x1a, 0x92, 0xf8, 0x82, 0xc8,
-	0xb9, 0x64, 0xd7, 0xa1, 0xfb, 0x3b, 0x98, 0x72, 0xb2, 0xd0, 0xf7, 0xa7, 0x04, 0xf1, 0x1d, 0x42,
-	0x31, 0x2a, 0x42, 0x66, 0x20, 0x52, 0x71, 0xbc, 0x34, 0x21, 0xbc, 0xe2, 0x3b, 0xea, 0x80, 0x50,
-	0xb1, 0x36, 0xe7, 0xee, 0x28, 0x46, 0x43, 0xd7, 0x20, 0x41, 0x06, 0xc1, 0xd7, 0x88, 0xa9, 0xcc,
-	0x6e, 0xad, 0xcd, 0xa4, 0x3e, 0x78, 0xb7, 0xc5, 0x28, 0xaa, 0xa0, 0xce, 0x5d, 0x6c, 0xff, 0xce,
-	0x3c, 0x5d, 0xfe, 0x52, 0x02, 0x38, 0x7e, 0x19, 0xad, 0xc1, 0xf2, 0x4e, 0x4b, 0x57, 0x8c, 0x56,
-	0x5b, 0x6f, 0xb4, 0x9a, 0x46, 0xa7, 0xa9, 0xb5, 0x95, 0x7a, 0xe3, 0x46, 0x43, 0xd9, 0xce, 0x47,
-	0xd0, 0x79, 0xc8, 0x8d, 0x83, 0x77, 0x15, 0x2d, 0x2f, 0xa1, 0x65, 0x38, 0x3f, 0x7e, 0x59, 0xad,
-	0x69, 0x7a, 0xb5, 0xd1, 0xcc, 0x47, 0x11, 0x82, 0xec, 0x38, 0xd0, 0x6c, 0xe5, 0x63, 0xe8, 0x22,
-	0xc8, 0x93, 0x77, 0xc6, 0x6e, 0x43, 0xbf, 0x69, 0xec, 0x28, 0x7a, 0x2b, 0x1f, 0x5f, 0x8d, 0x3f,
-	0xf8, 0xa6, 0x10, 0xb9, 0xfc, 0xa3, 0x04, 0xd9, 0xc9, 0x61, 0x43, 0x45, 0x58, 0x6b, 0xab, 0xad,
-	0x76, 0x4b, 0xab, 0xde, 0x36, 0x34, 0xbd, 0xaa, 0x77, 0xb4, 0x29, 0x65, 0x6f, 0xc0, 0xca, 0x34,
-	0x41, 0xeb, 0xd4, 0xee, 0x34, 0x74, 0x5d, 0xd9, 0xce, 0x4b, 0xc1, 0xb3, 0xd3, 0x70, 0xb5, 0x5e,
-	0x57, 0xda, 0x01, 0x1a, 0x3d, 0x09, 0x55, 0x95, 0x5b, 0x4a, 0x3d, 0x40, 0x63, 0x41, 0x46, 0x66,
-	0x6c, 0x6b, 0x2d, 0x35, 0x00, 0xe3, 0x27, 0xbd, 0x1b, 0x04, 0xb4, 0xad, 0x56, 0x77, 0x9b, 0xf9,
-	0x05, 0x11, 0xd0, 0xf7, 0x12, 0x5c, 0x38, 0x79, 0xae, 0xd0, 0x06, 0x5c, 0x3a, 0xb2, 0x57, 0x3e,
-	0x51, 0xea, 0x1d, 0xbd, 0xa5, 0x1a, 0xaa, 0xa2, 0x75, 0x6e, 0xeb, 0x53, 0x11, 0x5e, 0x82, 0xf5,
-	0x53, 0x99, 0xcd, 0x96, 0x6e, 0xa8, 0x9d, 0x66, 0x5e, 0x9a, 0xcb, 0xd2, 0x3a, 0xf5, 0xba, 0xa2,
-	0x69, 0xf9, 0xe8, 0x5c, 0xd6, 0x8d, 0x6a, 0xe3, 0x76, 0x47, 0x55, 0xf2, 0x31, 0x2e, 0xbe, 0xf6,
-	0xc1, 0x93, 0xe7, 0x05, 0xe9, 0xd9, 0xf3, 0x82, 0xf4, 0xdb, 0xf3, 0x82, 0xf4, 0xf0, 0x45, 0x21,
-	0xf2, 0xec, 0x45, 0x21, 0xf2, 0xf3, 0x8b, 0x42, 0xe4, 0xd3, 0x4b, 0xb6, 0x43, 0xf7, 0x87, 0x7b,
-	0xe5, 0x2e, 0xe9, 0x8b, 0x9f, 0x23, 0xe2, 0xcf, 0xa6, 0x6f, 0x7d, 0x56, 0xb9, 0xcf, 0x7f, 0x2d,
-	0xed, 0x25, 0x58, 0x27, 0x5e, 0xfb, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xea, 0x13, 0x0f, 0x14, 0x44,
-	0x0d, 0x00, 0x00,
+	// 1567 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcf, 0x6f, 0xdb, 0x46,
+	0x16, 0x36, 0x25, 0x59, 0x92, 0x9f, 0x6c, 0x59, 0x99, 0x78, 0x63, 0xda, 0xce, 0x4a, 0x5e, 0x25,
+	0xd8, 0x35, 0xb2, 0xb0, 0x94, 0x38, 0xc0, 0x2e, 0xd6, 0x87, 0x4d, 0x24, 0x99, 0x49, 0x94, 0x38,
+	0x92, 0x40, 0x52, 0x76, 0x53, 0xa0, 0x20, 0x68, 0x71, 0x2c, 0x13, 0x91, 0x38, 0x2a, 0x39, 0xb2,
+	0xe3, 0x53, 0x6f, 0x45, 0x2e, 0x45, 0x73, 0xec, 0xa5, 0x40, 0x80, 0xfe, 0x05, 0x2d, 0x72, 0xea,
+	0x5f, 0x10, 0xe4, 0x14, 0xf4, 0xd4, 0x53, 0x5b, 0x24, 0x28, 0xd0, 0x1e, 0x8a, 0x5e, 0xdb, 0x5b,
+	0xc1, 0x99, 0xa1, 0xad, 0x5f, 0x96, 0xe3, 0x20, 0xed, 0xc9, 0x9e, 0x79, 0xdf, 0x9b, 0xf9, 0xde,
+	0x9b, 0xef, 0xbd, 0x47, 0x08, 0x96, 0x1a, 0xc4, 0x6b, 0x13, 0x2f, 0xdf, 0x74, 0x49, 0xb7, 0x93,
+	0xdf, 0xbf, 0x96, 0xa7, 0x87, 0x1d, 0xec, 0xe5, 0x3a, 0x2e, 0xa1, 0x04, 0xcd, 0x72, 0x63, 0x8e,
+	0x19, 0x73, 0xfb, 0xd7, 0x16, 0xe7, 0x9a, 0xa4, 0x49, 0x98, 0x2d, 0xef, 0xff, 0xc7, 0x61, 0x8b,
+	0xe9, 0x26, 0x21, 0xcd, 0x16, 0xce, 0xb3, 0xd5, 0x4e, 0x77, 0x37, 0x6f, 0x75, 0x5d, 0x93, 0xda,
+	0xc4, 0x11, 0xf6, 0xcc, 0xa0, 0x9d, 0xda, 0x6d, 0xec, 0x51, 0xb3, 0xdd, 0x11, 0x80, 0x05, 0x7e,
+	0x8f, 0xc1, 0x4f, 0x16, 0x97, 0x0a, 0xd3, 0xa0, 0xaf, 0xe9, 0x1c, 0x72, 0x53, 0xf6, 0x4b, 0x09,
+	0xa2, 0xf7, 0x71, 0x7b, 0x07, 0xbb, 0x68, 0x0d, 0x62, 0xa6, 0x65, 0xb9, 0xd8, 0xf3, 0x64, 0x69,
+	0x59, 0x5a, 0x99, 0x2a, 0xca, 0xdf, 0x3c, 0x5b, 0x9d, 0x13, 0x07, 0x15, 0xb8, 0x45, 0xa3, 0xae,
+	0xed, 0x34, 0xd5, 0x00, 0x88, 0x2e, 0x40, 0xf4, 0x00, 0xdb, 0xcd, 0x3d, 0x2a, 0x87, 0x7c, 0x17,
+	0x55, 0xac, 0xd0, 0x22, 0xc4, 0xdb, 0x98, 0x9a, 0x96, 0x49, 0x4d, 0x39, 0xcc, 0x2c, 0x47, 0x6b,
+	0x74, 0x03, 0xe2, 0xa6, 0x65, 0x61, 0xcb, 0x30, 0xa9, 0x1c, 0x59, 0x96, 0x56, 0x12, 0x6b, 0x8b,
+	0x39, 0x4e, 0x30, 0x17, 0x10, 0xcc, 0xe9, 0x41, 0x70, 0xc5, 0xf8, 0xf3, 0xef, 0x32, 0x13, 0x4f,
+	0xbe, 0xcf, 0x48, 0xec, 0x52, 0x6c, 0x15, 0x68, 0xf6, 0x00, 0x66, 0x38, 0x65, 0x15, 0x7f, 0xd8,
+	0xc5, 0x1e, 0xfd, 0xab, 0x98, 0x67, 0x3f, 0x91, 0x60, 0x5e, 0xdf, 0x73, 0xb1, 0xb7, 0x47, 0x5a,
+	0xd6, 0x06, 0x6e, 0xd8, 0x9e, 0x4d, 0x9c, 0x1a, 0x69, 0xd9, 0x8d, 0x43, 0x74, 0x11, 0xa6, 0x68,
+	0x60, 0xe2, 0x2c, 0xd4, 0xe3, 0x0d, 0x74, 0x13, 0x62, 0x07, 0xb6, 0x63, 0x91, 0x03, 0x8f, 0x5d,
+	0x97, 0x58, 0xfb, 0x67, 0x6e, 0x40, 0x16, 0xb9, 0xfe, 0xf3, 0xb6, 0x39, 0x5a, 0x0d, 0xdc, 0xd6,
+	0xd1, 0x8b, 0x67, 0xab, 0xc9, 0x7e, 0x4c, 0xf6, 0x89, 0x04, 0x72, 0x0d, 0xbb, 0x0d, 0xec, 0x50,
+	0xb3, 0x89, 0x07, 0x08, 0xa5, 0x01, 0x3a, 0x47, 0x36, 0xc1, 0xa8, 0x67, 0xe7, 0x4f, 0xa2, 0xf4,
+	0xa3, 0x04, 0x4b, 0x3a, 0x79, 0x88, 0x9d, 0x6d, 0x96, 0x4e, 0x3c, 0x98, 0xa6, 0x39, 0x98, 0xb4,
+	0xb0, 0x43, 0xda, 0x82, 0x10, 0x5f, 0xa0, 0x75, 0x88, 0x7a, 0xa4, 0xeb, 0x36, 0x30, 0xa3, 0x92,
+	0x5c, 0xcb, 0x0e, 0x51, 0xe9, 0x39, 0x53, 0x63, 0x48, 0x55, 0x78, 0x0c, 0xc4, 0x19, 0x1e, 0x17,
+	0x67, 0xe4, 0xdd, 0xc5, 0xf9, 0xbb, 0x04, 0x7f, 0x1b, 0xe9, 0x86, 0xee, 0xc0, 0xcc, 0x3e, 0xa1,
+	0xb6, 0xd3, 0x34, 0x3a, 0xd8, 0xb5, 0x09, 0x17, 0x43, 0x62, 0x6d, 0x61, 0x48, 0xe3, 0x1b, 0xa2,
+	0xc0, 0xb9, 0xc4, 0x3f, 0xf3, 0x25, 0x3e, 0xcd, 0x3d, 0x6b, 0xcc, 0x11, 0xd5, 0x61, 0xae, 0x6d,
+	0x3b, 0x06, 0x7e, 0x84, 0x1b, 0x5d, 0x1f, 0x18, 0x1c, 0x18, 0x7a, 0xf3, 0x03, 0x51, 0xdb, 0x76,
+	0x94, 0xc0, 0x5f, 0x1c, 0x7b, 0x03, 0xe2, 0x7e, 0xef, 0x68, 0x91, 0xc6, 0x43, 0x39, 0xfc, 0xe6,
+	0x47, 0x1d, 0x39, 0x65, 0x7f, 0x96, 0x60, 0xea, 0xb6, 0x9f, 0xbb, 0xb2, 0xb3, 0x4b, 0x50, 0x12,
+	0x42, 0x36, 0x0f, 0x32, 0xa2, 0x86, 0x6c, 0x0b, 0xe5, 0x60, 0xd2, 0xb4, 0xda, 0xb6, 0x23, 0x87,
+	0x4e, 0x29, 0x45, 0x0e, 0x1b, 0xdb, 0x2a, 0x64, 0x88, 0xed, 0x63, 0xd7, 0xcf, 0x31, 0x7b, 0xbb,
+	0x88, 0x1a, 0x2c, 0xd1, 0x3f, 0x60, 0x9a, 0x12, 0x6a, 0xb6, 0x0c, 0x51, 0xc4, 0x93, 0xcc, 0x33,
+	0xc1, 0xf6, 0xb8, 0x4c, 0x50, 0x09, 0xa0, 0xe1, 0x62, 0x93, 0xf2, 0x4e, 0x13, 0x3d, 0x43, 0xa7,
+	0x99, 0x12, 0x7e, 0x05, 0x9a, 0x7d, 0x00, 0x09, 0x16, 0xaa, 0xe8, 0x91, 0x0b, 0x10, 0x67, 0xaa,
+	0x31, 0x8e, 0x42, 0x8e, 0xb1, 0x75, 0xd9, 0x42, 0x79, 0x88, 0xb6, 0x19, 0x48, 0xbc, 0xcf, 0xfc,
+	0x90, 0xcc, 0x44, 0xd3, 0x12, 0xb0, 0xec, 0x57, 0x12, 0x24, 0xb7, 0xd8, 0x7b, 0xeb, 0x2e, 0xc6,
+	0x15, 0x62, 0xe1, 0x77, 0xda, 0xc8, 0x7a, 0xa9, 0x86, 0xfb, 0xa9, 0xfe, 0x0f, 0x62, 0x9c, 0x83,
+	0x5f, 0x12, 0xe1, 0x95, 0xc4, 0x5a, 0x66, 0x88, 0x6b, 0x3f, 0x31, 0x35, 0xc0, 0x67, 0x7f, 0x0b,
+	0xc1, 0x2c, 0x4b, 0x08, 0x17, 0x3d, 0x53, 0xc0, 0xdb, 0xb0, 0xee, 0x65, 0x17, 0xea, 0x67, 0x77,
+	0x24, 0xa0, 0xf0, 0xd9, 0x05, 0x14, 0x39, 0x59, 0x40, 0x93, 0xfd, 0x02, 0x32, 0x61, 0xd6, 0x12,
+	0xf5, 0x6b, 0x74, 0x58, 0x2c, 0x42, 0x22, 0x73, 0x43, 0x12, 0x29, 0x38, 0x87, 0xc5, 0xec, 0x8b,
+	0x67, 0xab, 0xe9, 0xf1, 0x7d, 0x43, 0x4d, 0x5a, 0x7d, 0xeb, 0x01, 0x01, 0xc6, 0xde, 0x4a, 0x80,
+	0xeb, 0xf1, 0xc7, 0x4f, 0x33, 0x13, 0x3f, 0x3d, 0xcd, 0x48, 0xd9, 0x8f, 0xa3, 0x10, 0xaf, 0xb9,
+	0xa4, 0x43, 0x3c, 0xb3, 0x35, 0x54, 0x75, 0x77, 0x61, 0x8e, 0xe7, 0x93, 0xc7, 0x62, 0x04, 0x0f,
+	0x72, 0x5a, 0x11, 0xa2, 0xe6, 0xf1, 0x63, 0x0a, 0xcb, 0xd8, 0x8a, 0xfc, 0x0f, 0x4c, 0x75, 0x18,
+	0x87, 0x40, 0x3c, 0xe3, 0x0e, 0x3f, 0x86, 0x22, 0x05, 0x12, 0x5e, 0x77, 0xa7, 0x6d, 0x53, 0xc3,
+	0x6f, 0x23, 0xf2, 0xe4, 0x19, 0x92, 0x01, 0xdc, 0xd1, 0x37, 0xa1, 0x4b, 0x30, 0xc3, 0xc3, 0x0c,
+	0x5e, 0x35, 0xca, 0x32, 0x30, 0xcd, 0x36, 0xb7, 0xc4, 0xd3, 0x5e, 0x1d, 0xc8, 0x45, 0x80, 0x8d,
+	0x31, 0x6c, 0x6f, 0xc4, 0x81, 0xc7, 0x7f, 0x21, 0xea, 0x51, 0x93, 0x76, 0x3d, 0x39, 0xce, 0xe6,
+	0xcf, 0x70, 0x3d, 0x04, 0x89, 0xd7, 0x18, 0x4c, 0x15, 0x70, 0x54, 0x03, 0xb4, 0x6b, 0x3b, 0x66,
+	0xcb, 0xa0, 0x66, 0xab, 0x75, 0x68, 0xb8, 0xd8, 0xeb, 0xb6, 0xa8, 0x3c, 0xc5, 0xa2, 0xbb, 0x38,
+	0x3c, 0xc4, 0x7c, 0x90, 0xca, 0x30, 0xc5, 0x88, 0x1f, 0x9f, 0x9a, 0x62, 0xde, 0x3d, 0xfb, 0xa8,
+	0x06, 0xe7, 0xfa, 0xc6, 0x87, 0x81, 0x1d, 0x4b, 0x86, 0x33, 0xa4, 0x6b, 0xb6, 0x77, 0x86, 0x28,
+	0x8e, 0x85, 0x6a, 0x30, 0xcb, 0x47, 0x08, 0x71, 0x03, 0x82, 0x09, 0x16, 0xe5, 0xbf, 0x4e, 0x8c,
+	0x52, 0x11, 0x78, 0xce, 0x49, 0x4d, 0xe2, 0xbe, 0x35, 0xba, 0xea, 0x0b, 0xc4, 0xf3, 0xcc, 0x26,
+	0xf6, 0xe4, 0xe9, 0xe5, 0xf0, 0x49, 0x45, 0xa3, 0x1e, 0xa1, 0x90, 0x02, 0x33, 0xfc, 0x0c, 0x6c,
+	0x98, 0xbb, 0x14, 0xbb, 0xf2, 0xcc, 0xa9, 0x11, 0x45, 0x58, 0x34, 0xd3, 0xc2, 0xad, 0xe0, 0x7b,
+	0xad, 0x47, 0xfc, 0x62, 0xc8, 0x7e, 0x2e, 0x41, 0xa2, 0x37, 0x65, 0x4b, 0x30, 0x75, 0x88, 0x3d,
+	0xa3, 0x41, 0xba, 0x0e, 0x15, 0xdf, 0x15, 0xf1, 0x43, 0xec, 0x95, 0xfc, 0xb5, 0xaf, 0x18, 0x73,
+	0xc7, 0xa3, 0xa6, 0xed, 0x08, 0x00, 0xef, 0x92, 0xd3, 0x62, 0x93, 0x83, 0x16, 0x20, 0xee, 0x10,
+	0x61, 0xe7, 0x8a, 0x8f, 0x39, 0x84, 0x9b, 0xfe, 0x0d, 0xc8, 0x21, 0xc6, 0x81, 0x4d, 0xf7, 0x8c,
+	0x7d, 0x4c, 0x03, 0x10, 0xef, 0x33, 0xb3, 0x0e, 0xd9, 0xb6, 0xe9, 0xde, 0x16, 0xa6, 0x1c, 0x2c,
+	0xf8, 0xfd, 0x2a, 0x41, 0x64, 0x8b, 0x50, 0x8c, 0x32, 0x90, 0xe8, 0x88, 0x8c, 0x1e, 0x0f, 0x0c,
+	0x08, 0xb6, 0x78, 0xab, 0xdb, 0x27, 0x54, 0x8c, 0x8c, 0xb1, 0xad, 0x8e, 0xc1, 0xd0, 0x75, 0x88,
+	0x92, 0x8e, 0x3f, 0x97, 0x19, 0xcb, 0xe4, 0xda, 0xd2, 0xa8, 0xbe, 0x8d, 0xab, 0x0c, 0xa2, 0x0a,
+	0xe8, 0xd8, 0xfe, 0xf8, 0x6e, 0xca, 0xd2, 0xff, 0xea, 0x3b, 0xcf, 0x27, 0x46, 0x8d, 0x1c, 0x60,
+	0x57, 0x73, 0xcc, 0x8e, 0xb7, 0x47, 0xe8, 0xe9, 0x09, 0xc8, 0x00, 0x1f, 0xd9, 0x46, 0x87, 0x1c,
+	0x04, 0x69, 0x50, 0x81, 0x6d, 0xb1, 0x93, 0xd0, 0x4d, 0x88, 0x32, 0x93, 0x27, 0x87, 0x99, 0xd0,
+	0xb2, 0x27, 0x4c, 0xd5, 0x9e, 0xdb, 0x45, 0x69, 0x09, 0xbf, 0x13, 0x3b, 0x63, 0xe4, 0xec, 0x9d,
+	0x31, 0xfb, 0x01, 0x9c, 0x1b, 0xba, 0xee, 0xad, 0xc6, 0xdf, 0x1c, 0x4c, 0xf6, 0x46, 0xcc, 0x17,
+	0x57, 0x3e, 0x82, 0x73, 0x43, 0xdf, 0xb9, 0xe8, 0x12, 0x64, 0xf4, 0xea, 0x3d, 0xa5, 0x62, 0x6c,
+	0x2b, 0xe5, 0xdb, 0x77, 0x74, 0x43, 0xab, 0xd6, 0xd5, 0x92, 0x62, 0xd4, 0x2b, 0x5a, 0x4d, 0x29,
+	0x95, 0x6f, 0x95, 0x95, 0x8d, 0xd4, 0x04, 0xca, 0xc0, 0xd2, 0x28, 0x50, 0xb1, 0xb0, 0x59, 0xa8,
+	0x94, 0x94, 0x94, 0x84, 0xd2, 0xb0, 0x38, 0x0a, 0xa0, 0xe9, 0x85, 0x7b, 0xca, 0x46, 0x2a, 0xb4,
+	0x18, 0x79, 0xfc, 0x45, 0x7a, 0xe2, 0xca, 0xa7, 0x12, 0xc0, 0xb1, 0x82, 0xd0, 0x12, 0xcc, 0x6f,
+	0x55, 0x75, 0xc5, 0xa8, 0xd6, 0xf4, 0x72, 0xb5, 0x32, 0x70, 0xe5, 0x79, 0x98, 0xed, 0x35, 0x3e,
+	0x50, 0xb4, 0x94, 0x84, 0xe6, 0xe1, 0x7c, 0xef, 0x66, 0xa1, 0xa8, 0xe9, 0x85, 0x72, 0x25, 0x15,
+	0x42, 0x08, 0x92, 0xbd, 0x86, 0x4a, 0x35, 0x15, 0x46, 0x17, 0x41, 0xee, 0xdf, 0x33, 0xb6, 0xcb,
+	0xfa, 0x1d, 0x63, 0x4b, 0xd1, 0xab, 0xa9, 0x88, 0x60, 0xf4, 0x8b, 0x04, 0xc9, 0xfe, 0xde, 0xeb,
+	0xc7, 0x5a, 0x53, 0xab, 0xb5, 0xaa, 0x56, 0xd8, 0xf4, 0xf9, 0xeb, 0x75, 0x6d, 0x80, 0xd9, 0xdf,
+	0x61, 0x61, 0x10, 0xa0, 0xd5, 0x8b, 0xf7, 0xcb, 0xba, 0xae, 0x6c, 0xa4, 0x24, 0xff, 0xda, 0x41,
+	0x73, 0xa1, 0x54, 0x52, 0x6a, 0xbe, 0x35, 0x34, 0xca, 0xaa, 0x2a, 0x77, 0x95, 0x92, 0x6f, 0x0d,
+	0xfb, 0x19, 0x19, 0xf2, 0x2d, 0x56, 0x55, 0xdf, 0x18, 0x19, 0x75, 0xaf, 0x1f, 0xd0, 0x86, 0x5a,
+	0xd8, 0xae, 0xa4, 0x26, 0x47, 0x99, 0x4b, 0xfe, 0xeb, 0x6c, 0x6e, 0x2a, 0x1b, 0xa9, 0xa8, 0x88,
+	0xf7, 0x6b, 0x09, 0x2e, 0x8c, 0xee, 0xc2, 0x68, 0x05, 0x2e, 0x1f, 0xf9, 0x2b, 0xef, 0x29, 0xa5,
+	0xba, 0x5e, 0x55, 0x0d, 0x55, 0xd1, 0xea, 0x9b, 0xfa, 0x40, 0x02, 0x2e, 0xc3, 0xf2, 0x89, 0xc8,
+	0x4a, 0x55, 0x37, 0xd4, 0x7a, 0x25, 0x25, 0x8d, 0x45, 0x69, 0xf5, 0x52, 0x49, 0xd1, 0xb4, 0x54,
+	0x68, 0x2c, 0xea, 0x56, 0xa1, 0xbc, 0x59, 0x57, 0x95, 0x54, 0x98, 0x93, 0x2f, 0xfe, 0xff, 0xf9,
+	0xab, 0xb4, 0xf4, 0xf2, 0x55, 0x5a, 0xfa, 0xe1, 0x55, 0x5a, 0x7a, 0xf2, 0x3a, 0x3d, 0xf1, 0xf2,
+	0x75, 0x7a, 0xe2, 0xdb, 0xd7, 0xe9, 0x89, 0xf7, 0x2f, 0x37, 0x6d, 0xba, 0xd7, 0xdd, 0xc9, 0x35,
+	0x48, 0x5b, 0xfc, 0x34, 0x21, 0xfe, 0xac, 0x7a, 0xd6, 0xc3, 0xfc, 0x23, 0xfe, 0xcb, 0xc9, 0x4e,
+	0x94, 0x35, 0x9c, 0xeb, 0x7f, 0x0c, 0x00, 0xab, 0xf1, 0xc9, 0xfa, 0x50, 0x11, 0x00, 0x00,
 }
 
 func (this *GroupPolicyInfo) Equal(that interface{}) bool {
@@ -1213,7 +1586,7 @@ func (m *PercentageDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *DecisionPolicyWindows) Marshal() (dAtA []byte, err error) {
+func (m *TokenWeightedDecisionPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1223,36 +1596,51 @@ func (m *DecisionPolicyWindows) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DecisionPolicyWindows) MarshalTo(dAtA []byte) (int, error) {
+func (m *TokenWeightedDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DecisionPolicyWindows) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TokenWeightedDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinExecutionPeriod):])
-	if err4 != nil {
-		return 0, err4
+	if m.Windows != nil {
+		{
+			size, err := m.Windows.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	i -= n4
-	i = encodeVarintTypes(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x12
-	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
-	if err5 != nil {
-		return 0, err5
+	if len(m.Percentage) > 0 {
+		i -= len(m.Percentage)
+		copy(dAtA[i:], m.Percentage)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Percentage)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Source != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Source))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i -= n5
-	i = encodeVarintTypes(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *GroupInfo) Marshal() (dAtA []byte, err error) {
+func (m *DecisionPolicyWindows) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1262,29 +1650,76 @@ func (m *GroupInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GroupInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *DecisionPolicyWindows) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GroupInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DecisionPolicyWindows) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Timelock, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Timelock):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintTypes(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinExecutionPeriod):])
 	if err6 != nil {
 		return 0, err6
 	}
 	i -= n6
 	i = encodeVarintTypes(dAtA, i, uint64(n6))
 	i--
-	dAtA[i] = 0x32
-	if len(m.TotalWeight) > 0 {
-		i -= len(m.TotalWeight)
-		copy(dAtA[i:], m.TotalWeight)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.TotalWeight)))
-		i--
+	dAtA[i] = 0x12
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintTypes(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintTypes(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x32
+	if len(m.TotalWeight) > 0 {
+		i -= len(m.TotalWeight)
+		copy(dAtA[i:], m.TotalWeight)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TotalWeight)))
+		i--
 		dAtA[i] = 0x2a
 	}
 	if m.Version != 0 {
@@ -1354,6 +1789,62 @@ func (m *GroupMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VotingTreeNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VotingTreeNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotingTreeNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Weight) > 0 {
+		i -= len(m.Weight)
+		copy(dAtA[i:], m.Weight)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Weight)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GroupPolicyInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1374,12 +1865,12 @@ func (m *GroupPolicyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
-	if err8 != nil {
-		return 0, err8
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err10 != nil {
+		return 0, err10
 	}
-	i -= n8
-	i = encodeVarintTypes(dAtA, i, uint64(n8))
+	i -= n10
+	i = encodeVarintTypes(dAtA, i, uint64(n10))
 	i--
 	dAtA[i] = 0x3a
 	if m.DecisionPolicy != nil {
@@ -1448,6 +1939,16 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExecuteAfter != nil {
+		n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExecuteAfter, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExecuteAfter):])
+		if err12 != nil {
+			return 0, err12
+		}
+		i -= n12
+		i = encodeVarintTypes(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0x6a
+	}
 	if len(m.Messages) > 0 {
 		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1467,12 +1968,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x58
 	}
-	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingPeriodEnd):])
-	if err10 != nil {
-		return 0, err10
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingPeriodEnd):])
+	if err13 != nil {
+		return 0, err13
 	}
-	i -= n10
-	i = encodeVarintTypes(dAtA, i, uint64(n10))
+	i -= n13
+	i = encodeVarintTypes(dAtA, i, uint64(n13))
 	i--
 	dAtA[i] = 0x52
 	{
@@ -1500,12 +2001,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
-	if err12 != nil {
-		return 0, err12
+	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
+	if err15 != nil {
+		return 0, err15
 	}
-	i -= n12
-	i = encodeVarintTypes(dAtA, i, uint64(n12))
+	i -= n15
+	i = encodeVarintTypes(dAtA, i, uint64(n15))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Proposers) > 0 {
@@ -1610,12 +2111,12 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
-	if err13 != nil {
-		return 0, err13
+	n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
+	if err16 != nil {
+		return 0, err16
 	}
-	i -= n13
-	i = encodeVarintTypes(dAtA, i, uint64(n13))
+	i -= n16
+	i = encodeVarintTypes(dAtA, i, uint64(n16))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Metadata) > 0 {
@@ -1645,6 +2146,99 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VotingPowerSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VotingPowerSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotingPowerSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GroupPolicyAddress) > 0 {
+		i -= len(m.GroupPolicyAddress)
+		copy(dAtA[i:], m.GroupPolicyAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupPolicyAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Powers) > 0 {
+		for iNdEx := len(m.Powers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Powers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TotalPower) > 0 {
+		i -= len(m.TotalPower)
+		copy(dAtA[i:], m.TotalPower)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TotalPower)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MemberVotingPower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemberVotingPower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MemberVotingPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Power) > 0 {
+		i -= len(m.Power)
+		copy(dAtA[i:], m.Power)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Power)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -1734,6 +2328,30 @@ func (m *PercentageDecisionPolicy) Size() (n int) {
 	return n
 }
 
+func (m *TokenWeightedDecisionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Source != 0 {
+		n += 1 + sovTypes(uint64(m.Source))
+	}
+	l = len(m.Percentage)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Windows != nil {
+		l = m.Windows.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *DecisionPolicyWindows) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1744,6 +2362,8 @@ func (m *DecisionPolicyWindows) Size() (n int) {
 	n += 1 + l + sovTypes(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinExecutionPeriod)
 	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Timelock)
+	n += 1 + l + sovTypes(uint64(l))
 	return n
 }
 
@@ -1792,6 +2412,32 @@ func (m *GroupMember) Size() (n int) {
 	return n
 }
 
+func (m *VotingTreeNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Weight)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovTypes(uint64(m.GroupId))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *GroupPolicyInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1872,6 +2518,10 @@ func (m *Proposal) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
+	if m.ExecuteAfter != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExecuteAfter)
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -1925,27 +2575,70 @@ func (m *Vote) Size() (n int) {
 	return n
 }
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Member) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
+func (m *VotingPowerSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTypes(uint64(m.ProposalId))
+	}
+	l = len(m.TotalPower)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Powers) > 0 {
+		for _, e := range m.Powers {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.GroupPolicyAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *MemberVotingPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Power)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Member) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
@@ -2492,6 +3185,175 @@ func (m *PercentageDecisionPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TokenWeightedDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenWeightedDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenWeightedDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			m.Source = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Source |= TokenWeightSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Percentage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Windows == nil {
+				m.Windows = &DecisionPolicyWindows{}
+			}
+			if err := m.Windows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2587,6 +3449,39 @@ func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timelock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Timelock, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2930,7 +3825,7 @@ func (m *GroupMember) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GroupPolicyInfo) Unmarshal(dAtA []byte) error {
+func (m *VotingTreeNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2953,10 +3848,10 @@ func (m *GroupPolicyInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GroupPolicyInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: VotingTreeNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupPolicyInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VotingTreeNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2992,9 +3887,176 @@ func (m *GroupPolicyInfo) Unmarshal(dAtA []byte) error {
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
-			}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, &VotingTreeNode{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupPolicyInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupPolicyInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupPolicyInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
 			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
@@ -3536,6 +4598,42 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecuteAfter == nil {
+				m.ExecuteAfter = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExecuteAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3920,6 +5018,287 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VotingPowerSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VotingPowerSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VotingPowerSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Powers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Powers = append(m.Powers, MemberVotingPower{})
+			if err := m.Powers[len(m.Powers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemberVotingPower) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemberVotingPower: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemberVotingPower: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Power = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
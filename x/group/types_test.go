@@ -30,6 +30,17 @@ func TestThresholdDecisionPolicyValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"timelock too big",
+			group.ThresholdDecisionPolicy{
+				Threshold: "5",
+				Windows: &group.DecisionPolicyWindows{
+					VotingPeriod: time.Second,
+					Timelock:     config.MaxExecutionPeriod,
+				},
+			},
+			true,
+		},
 		{
 			"all good",
 			group.ThresholdDecisionPolicy{
@@ -41,6 +52,17 @@ func TestThresholdDecisionPolicyValidate(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"all good with timelock",
+			group.ThresholdDecisionPolicy{
+				Threshold: "5",
+				Windows: &group.DecisionPolicyWindows{
+					VotingPeriod: time.Hour,
+					Timelock:     time.Hour * 24,
+				},
+			},
+			false,
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -97,6 +119,83 @@ func TestPercentageDecisionPolicyValidate(t *testing.T) {
 	}
 }
 
+func TestTokenWeightedDecisionPolicyValidate(t *testing.T) {
+	g := group.GroupInfo{}
+	config := group.DefaultConfig()
+	windows := &group.DecisionPolicyWindows{
+		VotingPeriod:       time.Hour,
+		MinExecutionPeriod: time.Hour * 24,
+	}
+	testCases := []struct {
+		name   string
+		policy group.TokenWeightedDecisionPolicy
+		expErr bool
+	}{
+		{
+			"invalid denom",
+			group.TokenWeightedDecisionPolicy{
+				Denom:      "1",
+				Source:     group.TOKEN_WEIGHT_SOURCE_BALANCE,
+				Percentage: "0.5",
+				Windows:    windows,
+			},
+			true,
+		},
+		{
+			"unspecified source",
+			group.TokenWeightedDecisionPolicy{
+				Denom:      "stake",
+				Percentage: "0.5",
+				Windows:    windows,
+			},
+			true,
+		},
+		{
+			"percentage too big",
+			group.TokenWeightedDecisionPolicy{
+				Denom:      "stake",
+				Source:     group.TOKEN_WEIGHT_SOURCE_STAKED,
+				Percentage: "1.5",
+				Windows:    windows,
+			},
+			true,
+		},
+		{
+			"min exec period too big",
+			group.TokenWeightedDecisionPolicy{
+				Denom:      "stake",
+				Source:     group.TOKEN_WEIGHT_SOURCE_BALANCE,
+				Percentage: "0.5",
+				Windows: &group.DecisionPolicyWindows{
+					VotingPeriod:       time.Second,
+					MinExecutionPeriod: time.Hour * 24 * 30,
+				},
+			},
+			true,
+		},
+		{
+			"all good",
+			group.TokenWeightedDecisionPolicy{
+				Denom:      "stake",
+				Source:     group.TOKEN_WEIGHT_SOURCE_BALANCE,
+				Percentage: "0.5",
+				Windows:    windows,
+			},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.Validate(g, config)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestPercentageDecisionPolicyAllow(t *testing.T) {
 	testCases := []struct {
 		name           string
@@ -17,15 +17,31 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper, ic types.InflationCalculatio
 	minter := k.GetMinter(ctx)
 	params := k.GetParams(ctx)
 
-	// recalculate inflation rate
+	// recalculate the provisions as defined by the emission schedule
 	totalStakingSupply := k.StakingTokenSupply(ctx)
 	bondedRatio := k.BondedRatio(ctx)
-	minter.Inflation = ic(ctx, minter, params, bondedRatio)
-	minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalStakingSupply)
+
+	var mintedCoin sdk.Coin
+	switch params.EmissionSchedule {
+	case types.EmissionScheduleHalving:
+		mintedCoin = sdk.NewCoin(params.MintDenom, params.HalvingBlockProvision(ctx.BlockHeight()))
+		minter.AnnualProvisions = sdk.NewDecFromInt(mintedCoin.Amount).MulInt64(int64(params.BlocksPerYear))
+		minter.Inflation = types.ImpliedInflation(minter.AnnualProvisions, totalStakingSupply)
+
+	case types.EmissionSchedulePiecewise:
+		minter.AnnualProvisions = params.PiecewiseAnnualProvisions(ctx.BlockTime())
+		minter.Inflation = types.ImpliedInflation(minter.AnnualProvisions, totalStakingSupply)
+		mintedCoin = minter.BlockProvision(params)
+
+	default:
+		minter.Inflation = ic(ctx, minter, params, bondedRatio)
+		minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalStakingSupply)
+		mintedCoin = minter.BlockProvision(params)
+	}
 	k.SetMinter(ctx, minter)
 
-	// mint coins, update supply
-	mintedCoin := minter.BlockProvision(params)
+	// mint coins, update supply, up to the max supply
+	mintedCoin = k.CapToMaxSupply(ctx, params, mintedCoin)
 	mintedCoins := sdk.NewCoins(mintedCoin)
 
 	err := k.MintCoins(ctx, mintedCoins)
@@ -33,8 +49,8 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper, ic types.InflationCalculatio
 		panic(err)
 	}
 
-	// send the minted coins to the fee collector account
-	err = k.AddCollectedFees(ctx, mintedCoins)
+	// send the minted coins to the mint destinations
+	err = k.DistributeMintedCoins(ctx, params, mintedCoins)
 	if err != nil {
 		panic(err)
 	}
@@ -67,17 +67,23 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"mint_denom":"stake","inflation_rate_change":"0.130000000000000000","inflation_max":"1.000000000000000000","inflation_min":"1.000000000000000000","goal_bonded":"0.670000000000000000","blocks_per_year":"6311520"}`,
+			`{"mint_denom":"stake","inflation_rate_change":"0.130000000000000000","inflation_max":"1.000000000000000000","inflation_min":"1.000000000000000000","goal_bonded":"0.670000000000000000","blocks_per_year":"6311520","emission_schedule":"EMISSION_SCHEDULE_BONDED_RATIO","initial_block_provision":"0","halving_interval":"0","provision_points":[],"max_supply":"0","destinations":[]}`,
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
 			`blocks_per_year: "6311520"
+destinations: []
+emission_schedule: EMISSION_SCHEDULE_BONDED_RATIO
 goal_bonded: "0.670000000000000000"
+halving_interval: "0"
 inflation_max: "1.000000000000000000"
 inflation_min: "1.000000000000000000"
 inflation_rate_change: "0.130000000000000000"
-mint_denom: stake`,
+initial_block_provision: "0"
+max_supply: "0"
+mint_denom: stake
+provision_points: []`,
 		},
 	}
 
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+// CapToMaxSupply returns the coin to mint capped so that the supply of the mint
+// denom does not exceed the max supply param, if any.
+func (k Keeper) CapToMaxSupply(ctx sdk.Context, params types.Params, coin sdk.Coin) sdk.Coin {
+	if !params.MaxSupply.IsPositive() {
+		return coin
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, coin.Denom).Amount
+	if supply.GTE(params.MaxSupply) {
+		return sdk.NewCoin(coin.Denom, sdk.ZeroInt())
+	}
+	if mintable := params.MaxSupply.Sub(supply); coin.Amount.GT(mintable) {
+		return sdk.NewCoin(coin.Denom, mintable)
+	}
+	return coin
+}
+
+// DistributeMintedCoins sends the minted coins held by the mint module account
+// to the mint destinations according to their shares, the last destination
+// receiving the truncation remainder. All the coins go to the fee collector if
+// there are no destinations.
+func (k Keeper) DistributeMintedCoins(ctx sdk.Context, params types.Params, coins sdk.Coins) error {
+	if len(params.Destinations) == 0 {
+		return k.AddCollectedFees(ctx, coins)
+	}
+
+	remaining := coins
+	for i, destination := range params.Destinations {
+		amount := remaining
+		if i < len(params.Destinations)-1 {
+			amount, _ = sdk.NewDecCoinsFromCoins(coins...).MulDecTruncate(destination.Share).TruncateDecimal()
+			remaining = remaining.Sub(amount...)
+		}
+		if amount.IsZero() {
+			continue
+		}
+
+		var err error
+		if destination.Recipient == types.CommunityPoolRecipient {
+			err = k.distrKeeper.FundCommunityPool(ctx, amount, k.accountKeeper.GetModuleAddress(types.ModuleName))
+		} else {
+			err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, destination.Recipient, amount)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateDestinations returns an error if a mint destination is neither the
+// community pool nor a module account.
+func (k Keeper) ValidateDestinations(params types.Params) error {
+	for _, destination := range params.Destinations {
+		if destination.Recipient == types.CommunityPoolRecipient {
+			continue
+		}
+		if k.accountKeeper.GetModuleAddress(destination.Recipient) == nil {
+			return fmt.Errorf("mint destination recipient %s is not a module account", destination.Recipient)
+		}
+	}
+
+	return nil
+}
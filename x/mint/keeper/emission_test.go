@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func TestCapToMaxSupply(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	params := app.MintKeeper.GetParams(ctx)
+	supply := app.BankKeeper.GetSupply(ctx, params.MintDenom).Amount
+	coin := sdk.NewCoin(params.MintDenom, sdk.NewInt(100))
+
+	// no cap
+	require.Equal(t, coin, app.MintKeeper.CapToMaxSupply(ctx, params, coin))
+
+	// cap above the supply after minting
+	params.MaxSupply = supply.AddRaw(1000)
+	require.Equal(t, coin, app.MintKeeper.CapToMaxSupply(ctx, params, coin))
+
+	// cap reached while minting
+	params.MaxSupply = supply.AddRaw(40)
+	require.Equal(t, sdk.NewInt64Coin(params.MintDenom, 40), app.MintKeeper.CapToMaxSupply(ctx, params, coin))
+
+	// cap already reached
+	params.MaxSupply = supply
+	require.True(t, app.MintKeeper.CapToMaxSupply(ctx, params, coin).IsZero())
+}
+
+func TestDistributeMintedCoins(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	params := app.MintKeeper.GetParams(ctx)
+	params.Destinations = []types.MintDestination{
+		types.NewMintDestination(authtypes.FeeCollectorName, sdk.NewDecWithPrec(3, 1)),
+		types.NewMintDestination(types.CommunityPoolRecipient, sdk.NewDecWithPrec(7, 1)),
+	}
+	require.NoError(t, app.MintKeeper.ValidateDestinations(params))
+
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	feesBefore := app.BankKeeper.GetBalance(ctx, feeCollector, params.MintDenom).Amount
+	poolBefore := app.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(params.MintDenom)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 1001))
+	require.NoError(t, app.MintKeeper.MintCoins(ctx, coins))
+	require.NoError(t, app.MintKeeper.DistributeMintedCoins(ctx, params, coins))
+
+	// the fee collector share is truncated, the community pool gets the remainder
+	require.Equal(t, feesBefore.AddRaw(300), app.BankKeeper.GetBalance(ctx, feeCollector, params.MintDenom).Amount)
+	require.Equal(t, poolBefore.Add(sdk.NewDec(701)), app.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(params.MintDenom))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, app.AccountKeeper.GetModuleAddress(types.ModuleName)).IsZero())
+
+	params.Destinations = []types.MintDestination{types.NewMintDestination("not_a_module", sdk.OneDec())}
+	require.Error(t, app.MintKeeper.ValidateDestinations(params))
+}
+
+func TestBeginBlockerHalving(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 150})
+
+	params := app.MintKeeper.GetParams(ctx)
+	params.EmissionSchedule = types.EmissionScheduleHalving
+	params.InitialBlockProvision = sdk.NewInt(1000)
+	params.HalvingInterval = 100
+	params.Destinations = []types.MintDestination{
+		types.NewMintDestination(distrtypes.ModuleName, sdk.OneDec()),
+	}
+	app.MintKeeper.SetParams(ctx, params)
+
+	supplyBefore := app.BankKeeper.GetSupply(ctx, params.MintDenom).Amount
+	distrAddr := app.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)
+	distrBefore := app.BankKeeper.GetBalance(ctx, distrAddr, params.MintDenom).Amount
+
+	mint.BeginBlocker(ctx, app.MintKeeper, types.DefaultInflationCalculationFn)
+
+	require.Equal(t, supplyBefore.AddRaw(500), app.BankKeeper.GetSupply(ctx, params.MintDenom).Amount)
+	require.Equal(t, distrBefore.AddRaw(500), app.BankKeeper.GetBalance(ctx, distrAddr, params.MintDenom).Amount)
+	require.Equal(t, sdk.NewDec(500).MulInt64(int64(params.BlocksPerYear)), app.MintKeeper.GetMinter(ctx).AnnualProvisions)
+
+	// the max supply caps the minted coins
+	params.MaxSupply = supplyBefore.AddRaw(600)
+	app.MintKeeper.SetParams(ctx, params)
+	mint.BeginBlocker(ctx, app.MintKeeper, types.DefaultInflationCalculationFn)
+	require.Equal(t, params.MaxSupply, app.BankKeeper.GetSupply(ctx, params.MintDenom).Amount)
+}
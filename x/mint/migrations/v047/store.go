@@ -0,0 +1,39 @@
+package v047
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/exported"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2. The
+// migration includes:
+//
+// - Migrate params from the x/params module to the x/mint module store.
+// - Set the emission schedule params to their defaults, i.e. the bonded ratio
+// emission schedule without max supply.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, legacySubspace exported.Subspace, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var currParams types.Params
+	legacySubspace.GetParamSet(ctx, &currParams)
+
+	// the emission schedule params are not part of the legacy params
+	currParams.EmissionSchedule = types.EmissionScheduleBondedRatio
+	currParams.InitialBlockProvision = sdk.ZeroInt()
+	currParams.MaxSupply = sdk.ZeroInt()
+
+	if err := currParams.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&currParams)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+	return nil
+}
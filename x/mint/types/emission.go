@@ -0,0 +1,144 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CommunityPoolRecipient is the recipient of a mint destination funding the
+// community pool.
+const CommunityPoolRecipient = "community_pool"
+
+// NewProvisionPoint returns a new ProvisionPoint.
+func NewProvisionPoint(t time.Time, annualProvisions sdk.Dec) ProvisionPoint {
+	return ProvisionPoint{
+		Time:             t,
+		AnnualProvisions: annualProvisions,
+	}
+}
+
+// NewMintDestination returns a new MintDestination.
+func NewMintDestination(recipient string, share sdk.Dec) MintDestination {
+	return MintDestination{
+		Recipient: recipient,
+		Share:     share,
+	}
+}
+
+// HalvingBlockProvision returns the amount minted at the given height by the
+// halving emission schedule, i.e. the initial block provision halved once every
+// halving interval.
+func (p Params) HalvingBlockProvision(height int64) math.Int {
+	if height <= 0 || p.HalvingInterval == 0 {
+		return p.InitialBlockProvision
+	}
+
+	provision := p.InitialBlockProvision.BigInt()
+	halvings := uint64(height) / p.HalvingInterval
+	if halvings >= uint64(provision.BitLen()) {
+		return sdk.ZeroInt()
+	}
+	return sdk.NewIntFromBigInt(provision.Rsh(provision, uint(halvings)))
+}
+
+// PiecewiseAnnualProvisions returns the annual provisions at the given time of
+// the piecewise emission schedule, linearly interpolated between the provision
+// points. The annual provisions of the first and last points apply before and
+// after them.
+func (p Params) PiecewiseAnnualProvisions(blockTime time.Time) sdk.Dec {
+	points := p.ProvisionPoints
+	if len(points) == 0 {
+		return sdk.ZeroDec()
+	}
+	if !blockTime.After(points[0].Time) {
+		return points[0].AnnualProvisions
+	}
+
+	for i := 1; i < len(points); i++ {
+		start, end := points[i-1], points[i]
+		if blockTime.After(end.Time) {
+			continue
+		}
+
+		elapsed := sdk.NewDec(int64(blockTime.Sub(start.Time)))
+		duration := sdk.NewDec(int64(end.Time.Sub(start.Time)))
+		return start.AnnualProvisions.Add(
+			end.AnnualProvisions.Sub(start.AnnualProvisions).Mul(elapsed).Quo(duration),
+		)
+	}
+
+	return points[len(points)-1].AnnualProvisions
+}
+
+// ImpliedInflation returns the inflation rate implied by the given annual
+// provisions and total supply.
+func ImpliedInflation(annualProvisions sdk.Dec, totalSupply math.Int) sdk.Dec {
+	if !totalSupply.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	return annualProvisions.QuoInt(totalSupply)
+}
+
+// validateEmissionSchedule validates the params of the emission schedule in use.
+func (p Params) validateEmissionSchedule() error {
+	if p.InitialBlockProvision.IsNil() || p.InitialBlockProvision.IsNegative() {
+		return fmt.Errorf("initial block provision cannot be negative: %s", p.InitialBlockProvision)
+	}
+
+	for i, point := range p.ProvisionPoints {
+		if point.AnnualProvisions.IsNil() || point.AnnualProvisions.IsNegative() {
+			return fmt.Errorf("provision point annual provisions cannot be negative: %s", point.AnnualProvisions)
+		}
+		if i > 0 && !point.Time.After(p.ProvisionPoints[i-1].Time) {
+			return fmt.Errorf("provision points must be sorted by strictly increasing time")
+		}
+	}
+
+	switch p.EmissionSchedule {
+	case EmissionScheduleBondedRatio:
+	case EmissionScheduleHalving:
+		if p.HalvingInterval == 0 {
+			return fmt.Errorf("halving interval must be positive for the halving emission schedule")
+		}
+	case EmissionSchedulePiecewise:
+		if len(p.ProvisionPoints) == 0 {
+			return fmt.Errorf("provision points cannot be empty for the piecewise emission schedule")
+		}
+	default:
+		return fmt.Errorf("invalid emission schedule: %s", p.EmissionSchedule)
+	}
+
+	return nil
+}
+
+func validateDestinations(destinations []MintDestination) error {
+	if len(destinations) == 0 {
+		return nil
+	}
+
+	total := sdk.ZeroDec()
+	seen := make(map[string]bool, len(destinations))
+	for _, destination := range destinations {
+		if destination.Recipient == "" {
+			return fmt.Errorf("mint destination recipient cannot be blank")
+		}
+		if seen[destination.Recipient] {
+			return fmt.Errorf("duplicate mint destination recipient: %s", destination.Recipient)
+		}
+		seen[destination.Recipient] = true
+
+		if destination.Share.IsNil() || !destination.Share.IsPositive() {
+			return fmt.Errorf("mint destination share must be positive: %s", destination.Share)
+		}
+		total = total.Add(destination.Share)
+	}
+
+	if !total.Equal(sdk.OneDec()) {
+		return fmt.Errorf("mint destination shares must sum to one: %s", total)
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestHalvingBlockProvision(t *testing.T) {
+	params := DefaultParams()
+	params.EmissionSchedule = EmissionScheduleHalving
+	params.InitialBlockProvision = sdk.NewInt(1000)
+	params.HalvingInterval = 100
+
+	tests := []struct {
+		height int64
+		exp    int64
+	}{
+		{0, 1000},
+		{1, 1000},
+		{99, 1000},
+		{100, 500},
+		{250, 250},
+		{999, 1},
+		{1000, 0},
+		{1 << 40, 0},
+	}
+	for _, tc := range tests {
+		require.Equal(t, sdk.NewInt(tc.exp), params.HalvingBlockProvision(tc.height), "height %d", tc.height)
+	}
+
+	// the initial block provision is not modified
+	require.Equal(t, sdk.NewInt(1000), params.InitialBlockProvision)
+}
+
+func TestPiecewiseAnnualProvisions(t *testing.T) {
+	start := time.Unix(1000, 0)
+	params := DefaultParams()
+	params.EmissionSchedule = EmissionSchedulePiecewise
+	params.ProvisionPoints = []ProvisionPoint{
+		NewProvisionPoint(start, sdk.NewDec(1000)),
+		NewProvisionPoint(start.Add(100*time.Second), sdk.NewDec(2000)),
+		NewProvisionPoint(start.Add(300*time.Second), sdk.NewDec(0)),
+	}
+
+	tests := []struct {
+		time time.Time
+		exp  sdk.Dec
+	}{
+		{start.Add(-time.Hour), sdk.NewDec(1000)},
+		{start, sdk.NewDec(1000)},
+		{start.Add(25 * time.Second), sdk.NewDec(1250)},
+		{start.Add(100 * time.Second), sdk.NewDec(2000)},
+		{start.Add(200 * time.Second), sdk.NewDec(1000)},
+		{start.Add(time.Hour), sdk.ZeroDec()},
+	}
+	for _, tc := range tests {
+		require.Equal(t, tc.exp, params.PiecewiseAnnualProvisions(tc.time), "time %s", tc.time)
+	}
+}
+
+func TestValidateEmissionParams(t *testing.T) {
+	start := time.Unix(1000, 0)
+	oneThird := sdk.OneDec().QuoInt64(3)
+
+	tests := []struct {
+		name     string
+		malleate func(*Params)
+		expErr   bool
+	}{
+		{"default", func(*Params) {}, false},
+		{"halving", func(p *Params) {
+			p.EmissionSchedule = EmissionScheduleHalving
+			p.InitialBlockProvision = sdk.NewInt(1000)
+			p.HalvingInterval = 100
+		}, false},
+		{"halving without interval", func(p *Params) { p.EmissionSchedule = EmissionScheduleHalving }, true},
+		{"negative initial block provision", func(p *Params) { p.InitialBlockProvision = sdk.NewInt(-1) }, true},
+		{"piecewise", func(p *Params) {
+			p.EmissionSchedule = EmissionSchedulePiecewise
+			p.ProvisionPoints = []ProvisionPoint{NewProvisionPoint(start, sdk.NewDec(1000))}
+		}, false},
+		{"piecewise without points", func(p *Params) { p.EmissionSchedule = EmissionSchedulePiecewise }, true},
+		{"unsorted points", func(p *Params) {
+			p.ProvisionPoints = []ProvisionPoint{
+				NewProvisionPoint(start, sdk.NewDec(1000)),
+				NewProvisionPoint(start, sdk.NewDec(2000)),
+			}
+		}, true},
+		{"negative annual provisions", func(p *Params) {
+			p.ProvisionPoints = []ProvisionPoint{NewProvisionPoint(start, sdk.NewDec(-1))}
+		}, true},
+		{"unknown schedule", func(p *Params) { p.EmissionSchedule = 42 }, true},
+		{"negative max supply", func(p *Params) { p.MaxSupply = sdk.NewInt(-1) }, true},
+		{"destinations", func(p *Params) {
+			p.Destinations = []MintDestination{
+				NewMintDestination("fee_collector", oneThird),
+				NewMintDestination(CommunityPoolRecipient, sdk.OneDec().Sub(oneThird)),
+			}
+		}, false},
+		{"destination shares below one", func(p *Params) {
+			p.Destinations = []MintDestination{NewMintDestination("fee_collector", oneThird)}
+		}, true},
+		{"duplicate destination", func(p *Params) {
+			p.Destinations = []MintDestination{
+				NewMintDestination("fee_collector", sdk.NewDecWithPrec(5, 1)),
+				NewMintDestination("fee_collector", sdk.NewDecWithPrec(5, 1)),
+			}
+		}, true},
+		{"blank destination", func(p *Params) {
+			p.Destinations = []MintDestination{NewMintDestination("", sdk.OneDec())}
+		}, true},
+		{"zero share", func(p *Params) {
+			p.Destinations = []MintDestination{
+				NewMintDestination("fee_collector", sdk.OneDec()),
+				NewMintDestination(CommunityPoolRecipient, sdk.ZeroDec()),
+			}
+		}, true},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			tc.malleate(&params)
+			require.Equal(t, tc.expErr, params.Validate() != nil)
+		})
+	}
+}
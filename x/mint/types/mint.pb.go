@@ -9,15 +9,19 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,6 +29,39 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// EmissionSchedule defines how the minted coins are computed.
+type EmissionSchedule int32
+
+const (
+	// EMISSION_SCHEDULE_BONDED_RATIO computes the inflation from the bonded ratio.
+	EmissionScheduleBondedRatio EmissionSchedule = 0
+	// EMISSION_SCHEDULE_HALVING halves the block provision every halving interval.
+	EmissionScheduleHalving EmissionSchedule = 1
+	// EMISSION_SCHEDULE_PIECEWISE interpolates the annual provisions between the
+	// provision points.
+	EmissionSchedulePiecewise EmissionSchedule = 2
+)
+
+var EmissionSchedule_name = map[int32]string{
+	0: "EMISSION_SCHEDULE_BONDED_RATIO",
+	1: "EMISSION_SCHEDULE_HALVING",
+	2: "EMISSION_SCHEDULE_PIECEWISE",
+}
+
+var EmissionSchedule_value = map[string]int32{
+	"EMISSION_SCHEDULE_BONDED_RATIO": 0,
+	"EMISSION_SCHEDULE_HALVING":      1,
+	"EMISSION_SCHEDULE_PIECEWISE":    2,
+}
+
+func (x EmissionSchedule) String() string {
+	return proto.EnumName(EmissionSchedule_name, int32(x))
+}
+
+func (EmissionSchedule) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{0}
+}
+
 // Minter represents the minting state.
 type Minter struct {
 	// current annual inflation rate
@@ -80,6 +117,23 @@ type Params struct {
 	GoalBonded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"goal_bonded"`
 	// expected blocks per year
 	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
+	// emission_schedule defines how the minted coins are computed.
+	EmissionSchedule EmissionSchedule `protobuf:"varint,7,opt,name=emission_schedule,json=emissionSchedule,proto3,enum=cosmos.mint.v1beta1.EmissionSchedule" json:"emission_schedule,omitempty"`
+	// initial_block_provision is the amount minted per block before the first
+	// halving of the halving emission schedule.
+	InitialBlockProvision github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=initial_block_provision,json=initialBlockProvision,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"initial_block_provision"`
+	// halving_interval is the number of blocks between two halvings of the block
+	// provision of the halving emission schedule.
+	HalvingInterval uint64 `protobuf:"varint,9,opt,name=halving_interval,json=halvingInterval,proto3" json:"halving_interval,omitempty"`
+	// provision_points are the annual provisions of the piecewise emission
+	// schedule at given times, linearly interpolated in between.
+	ProvisionPoints []ProvisionPoint `protobuf:"bytes,10,rep,name=provision_points,json=provisionPoints,proto3" json:"provision_points"`
+	// max_supply is the supply of the mint denom above which no coins are minted,
+	// zero meaning no limit.
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply"`
+	// destinations split the minted coins between module accounts and the
+	// community pool. All minted coins go to the fee collector if empty.
+	Destinations []MintDestination `protobuf:"bytes,12,rep,name=destinations,proto3" json:"destinations"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -128,40 +182,191 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+func (m *Params) GetEmissionSchedule() EmissionSchedule {
+	if m != nil {
+		return m.EmissionSchedule
+	}
+	return EmissionScheduleBondedRatio
+}
+
+func (m *Params) GetHalvingInterval() uint64 {
+	if m != nil {
+		return m.HalvingInterval
+	}
+	return 0
+}
+
+func (m *Params) GetProvisionPoints() []ProvisionPoint {
+	if m != nil {
+		return m.ProvisionPoints
+	}
+	return nil
+}
+
+func (m *Params) GetDestinations() []MintDestination {
+	if m != nil {
+		return m.Destinations
+	}
+	return nil
+}
+
+// ProvisionPoint defines the annual provisions of the piecewise emission
+// schedule at a given time.
+type ProvisionPoint struct {
+	Time             time.Time                              `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	AnnualProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"annual_provisions"`
+}
+
+func (m *ProvisionPoint) Reset()         { *m = ProvisionPoint{} }
+func (m *ProvisionPoint) String() string { return proto.CompactTextString(m) }
+func (*ProvisionPoint) ProtoMessage()    {}
+func (*ProvisionPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{2}
+}
+func (m *ProvisionPoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProvisionPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProvisionPoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProvisionPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProvisionPoint.Merge(m, src)
+}
+func (m *ProvisionPoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProvisionPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProvisionPoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProvisionPoint proto.InternalMessageInfo
+
+func (m *ProvisionPoint) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// MintDestination defines the share of the minted coins sent to a recipient.
+type MintDestination struct {
+	// recipient is the name of the module account receiving the share, or
+	// "community_pool" to fund the community pool.
+	Recipient string                                 `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Share     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=share,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"share"`
+}
+
+func (m *MintDestination) Reset()         { *m = MintDestination{} }
+func (m *MintDestination) String() string { return proto.CompactTextString(m) }
+func (*MintDestination) ProtoMessage()    {}
+func (*MintDestination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{3}
+}
+func (m *MintDestination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintDestination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintDestination.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintDestination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintDestination.Merge(m, src)
+}
+func (m *MintDestination) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintDestination) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintDestination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintDestination proto.InternalMessageInfo
+
+func (m *MintDestination) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.mint.v1beta1.EmissionSchedule", EmissionSchedule_name, EmissionSchedule_value)
 	proto.RegisterType((*Minter)(nil), "cosmos.mint.v1beta1.Minter")
 	proto.RegisterType((*Params)(nil), "cosmos.mint.v1beta1.Params")
+	proto.RegisterType((*ProvisionPoint)(nil), "cosmos.mint.v1beta1.ProvisionPoint")
+	proto.RegisterType((*MintDestination)(nil), "cosmos.mint.v1beta1.MintDestination")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
 
 var fileDescriptor_2df116d183c1e223 = []byte{
-	// 393 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xc1, 0xaa, 0xda, 0x40,
-	0x18, 0x85, 0x93, 0x6a, 0x03, 0x4e, 0x2b, 0x6d, 0xc7, 0x16, 0x52, 0xa1, 0x51, 0x5c, 0x88, 0x5d,
-	0x98, 0x20, 0xdd, 0x95, 0xae, 0xd4, 0xad, 0x20, 0xd9, 0x55, 0x28, 0x61, 0x92, 0x4c, 0xe3, 0x60,
-	0x32, 0x13, 0x66, 0x46, 0xd1, 0xb7, 0xe8, 0xb2, 0xcb, 0x3e, 0x44, 0x1f, 0xc2, 0x5d, 0xa5, 0xab,
-	0xd2, 0x85, 0x5c, 0x74, 0x7b, 0x1f, 0xe2, 0x92, 0x99, 0x10, 0xe1, 0x2e, 0xee, 0x2a, 0xab, 0x64,
-	0xce, 0x99, 0xff, 0x3b, 0x27, 0x81, 0x1f, 0x38, 0x11, 0x13, 0x19, 0x13, 0x5e, 0x46, 0xa8, 0xf4,
-	0x76, 0x93, 0x10, 0x4b, 0x34, 0x51, 0x07, 0x37, 0xe7, 0x4c, 0x32, 0xd8, 0xd1, 0xbe, 0xab, 0xa4,
-	0xd2, 0xef, 0xbe, 0x4d, 0x58, 0xc2, 0x94, 0xef, 0x15, 0x6f, 0xfa, 0x6a, 0xf7, 0xbd, 0xbe, 0x1a,
-	0x68, 0xa3, 0x9c, 0x53, 0x87, 0xc1, 0x1f, 0x13, 0x58, 0x0b, 0x42, 0x25, 0xe6, 0x70, 0x05, 0x5a,
-	0x84, 0x7e, 0x4f, 0x91, 0x24, 0x8c, 0xda, 0x66, 0xdf, 0x1c, 0xb5, 0xa6, 0x5f, 0x8e, 0xe7, 0x9e,
-	0xf1, 0xff, 0xdc, 0x1b, 0x26, 0x44, 0xae, 0xb7, 0xa1, 0x1b, 0xb1, 0xac, 0x1c, 0x2f, 0x1f, 0x63,
-	0x11, 0x6f, 0x3c, 0x79, 0xc8, 0xb1, 0x70, 0xe7, 0x38, 0xfa, 0xfb, 0x7b, 0x0c, 0x4a, 0xfa, 0x1c,
-	0x47, 0xfe, 0x0d, 0x07, 0x09, 0x78, 0x83, 0x28, 0xdd, 0xa2, 0xb4, 0xe8, 0xb0, 0x23, 0x82, 0x30,
-	0x2a, 0xec, 0x67, 0x35, 0x64, 0xbc, 0xd6, 0xd8, 0x65, 0x45, 0x1d, 0xdc, 0x37, 0x80, 0xb5, 0x44,
-	0x1c, 0x65, 0x02, 0x7e, 0x00, 0xa0, 0xf8, 0x3b, 0x41, 0x8c, 0x29, 0xcb, 0xf4, 0x27, 0xf9, 0xad,
-	0x42, 0x99, 0x17, 0x02, 0xcc, 0xc1, 0xbb, 0xaa, 0x61, 0xc0, 0x91, 0xc4, 0x41, 0xb4, 0x46, 0x34,
-	0xc1, 0xb5, 0x14, 0xeb, 0x54, 0x68, 0x1f, 0x49, 0x3c, 0x53, 0x60, 0x88, 0x40, 0xfb, 0x96, 0x98,
-	0xa1, 0xbd, 0xdd, 0xa8, 0x21, 0xe9, 0x65, 0x85, 0x5c, 0xa0, 0xfd, 0xa3, 0x08, 0x42, 0xed, 0x66,
-	0xbd, 0x11, 0x84, 0xc2, 0x6f, 0xe0, 0x45, 0xc2, 0x50, 0x1a, 0x84, 0x8c, 0xc6, 0x38, 0xb6, 0x9f,
-	0xd7, 0x10, 0x00, 0x0a, 0xe0, 0x54, 0xf1, 0xe0, 0x10, 0xbc, 0x0a, 0x53, 0x16, 0x6d, 0x44, 0x90,
-	0x63, 0x1e, 0x1c, 0x30, 0xe2, 0xb6, 0xd5, 0x37, 0x47, 0x4d, 0xbf, 0xad, 0xe5, 0x25, 0xe6, 0x5f,
-	0x31, 0xe2, 0x9f, 0x9b, 0x3f, 0x7f, 0xf5, 0x8c, 0xe9, 0xec, 0x78, 0x71, 0xcc, 0xd3, 0xc5, 0x31,
-	0xef, 0x2e, 0x8e, 0xf9, 0xe3, 0xea, 0x18, 0xa7, 0xab, 0x63, 0xfc, 0xbb, 0x3a, 0xc6, 0xea, 0xe3,
-	0x93, 0x4d, 0xf6, 0x7a, 0xb1, 0x54, 0xa1, 0xd0, 0x52, 0xcb, 0xf0, 0xe9, 0x21, 0x00, 0x00, 0xff,
-	0xff, 0x83, 0xde, 0x9f, 0x75, 0x74, 0x03, 0x00, 0x00,
+	// 791 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0x77, 0xb3, 0xd9, 0xcd, 0xa4, 0xbb, 0xf1, 0xce, 0xb2, 0x5a, 0x37, 0xa5, 0x4e, 0x54,
+	0xa0, 0x4a, 0x91, 0xea, 0xa8, 0xe5, 0x82, 0x2a, 0x84, 0xd4, 0x24, 0x16, 0xb5, 0xd4, 0xa4, 0x91,
+	0x53, 0x40, 0x14, 0x21, 0x6b, 0xe2, 0x4c, 0x9d, 0x51, 0xed, 0x19, 0xcb, 0x33, 0x09, 0xe9, 0x99,
+	0x0b, 0xea, 0xa9, 0x47, 0x2e, 0x95, 0x90, 0xf8, 0x0b, 0xf0, 0x1f, 0x7a, 0xa3, 0xe2, 0x04, 0x1c,
+	0x0a, 0x6a, 0xf9, 0x21, 0xc8, 0x63, 0xd7, 0xa5, 0x69, 0x85, 0x84, 0x14, 0x71, 0x4a, 0xfc, 0xbd,
+	0xf7, 0xbe, 0xef, 0x7d, 0xce, 0x7b, 0x2f, 0x40, 0x77, 0x19, 0x0f, 0x18, 0x6f, 0x04, 0x84, 0x8a,
+	0xc6, 0x64, 0x63, 0x80, 0x05, 0xda, 0x90, 0x0f, 0x46, 0x18, 0x31, 0xc1, 0xe0, 0xab, 0x24, 0x6e,
+	0x48, 0x28, 0x8d, 0x57, 0xde, 0xf2, 0x98, 0xc7, 0x64, 0xbc, 0x11, 0x7f, 0x4b, 0x52, 0x2b, 0x8b,
+	0x49, 0xaa, 0x93, 0x04, 0xd2, 0xba, 0x24, 0x54, 0xf5, 0x18, 0xf3, 0x7c, 0xdc, 0x90, 0x4f, 0x83,
+	0xf1, 0x61, 0x43, 0x90, 0x00, 0x73, 0x81, 0x82, 0x30, 0x49, 0x58, 0xf9, 0x59, 0x01, 0x85, 0x0e,
+	0xa1, 0x02, 0x47, 0xf0, 0x00, 0x14, 0x09, 0x3d, 0xf4, 0x91, 0x20, 0x8c, 0x6a, 0x4a, 0x4d, 0xa9,
+	0x17, 0x9b, 0x1f, 0x9d, 0x5f, 0x56, 0x73, 0xbf, 0x5f, 0x56, 0x57, 0x3d, 0x22, 0x46, 0xe3, 0x81,
+	0xe1, 0xb2, 0x20, 0xe5, 0x4f, 0x3f, 0xd6, 0xf9, 0xf0, 0xa8, 0x21, 0x8e, 0x43, 0xcc, 0x8d, 0x36,
+	0x76, 0x7f, 0xf9, 0x71, 0x1d, 0xa4, 0xf2, 0x6d, 0xec, 0xda, 0xb7, 0x74, 0x90, 0x80, 0x97, 0x88,
+	0xd2, 0x31, 0xf2, 0xe3, 0x26, 0x27, 0x84, 0x13, 0x46, 0xb9, 0xf6, 0x68, 0x0e, 0x1a, 0x6a, 0x42,
+	0xdb, 0xcb, 0x58, 0x57, 0xfe, 0x7a, 0x0a, 0x0a, 0x3d, 0x14, 0xa1, 0x80, 0xc3, 0x65, 0x00, 0xe2,
+	0xd7, 0xe7, 0x0c, 0x31, 0x65, 0x41, 0x62, 0xc9, 0x2e, 0xc6, 0x48, 0x3b, 0x06, 0x60, 0x08, 0x5e,
+	0x67, 0x1d, 0x3a, 0x11, 0x12, 0xd8, 0x71, 0x47, 0x88, 0x7a, 0x78, 0x2e, 0x8d, 0xbd, 0xca, 0xa8,
+	0x6d, 0x24, 0x70, 0x4b, 0x12, 0x43, 0x04, 0x9e, 0xdf, 0x2a, 0x06, 0x68, 0xaa, 0x3d, 0x9e, 0x83,
+	0xd2, 0x42, 0x46, 0xd9, 0x41, 0xd3, 0x19, 0x09, 0x42, 0xb5, 0xfc, 0x7c, 0x25, 0x08, 0x85, 0x5f,
+	0x81, 0x92, 0xc7, 0x90, 0xef, 0x0c, 0x18, 0x1d, 0xe2, 0xa1, 0xf6, 0x64, 0x0e, 0x02, 0x20, 0x26,
+	0x6c, 0x4a, 0x3e, 0xb8, 0x0a, 0xca, 0x03, 0x9f, 0xb9, 0x47, 0xdc, 0x09, 0x71, 0xe4, 0x1c, 0x63,
+	0x14, 0x69, 0x85, 0x9a, 0x52, 0xcf, 0xdb, 0xcf, 0x13, 0xb8, 0x87, 0xa3, 0x2f, 0x30, 0x8a, 0xa0,
+	0x0d, 0x5e, 0xe2, 0x80, 0xf0, 0xf8, 0x57, 0x77, 0xb8, 0x3b, 0xc2, 0xc3, 0xb1, 0x8f, 0xb5, 0xa7,
+	0x35, 0xa5, 0xfe, 0x62, 0xf3, 0x3d, 0xe3, 0x81, 0xed, 0x31, 0xcc, 0x34, 0xbb, 0x9f, 0x26, 0xdb,
+	0x2a, 0x9e, 0x41, 0xa0, 0x00, 0x6f, 0x08, 0x25, 0x82, 0xc4, 0xee, 0x62, 0xb1, 0xdb, 0x71, 0xd5,
+	0x9e, 0xfd, 0x67, 0x9b, 0x16, 0x15, 0xff, 0xb0, 0x69, 0x51, 0x61, 0xbf, 0x4e, 0xc9, 0x9b, 0x31,
+	0x77, 0x36, 0xb3, 0x70, 0x0d, 0xa8, 0x23, 0xe4, 0x4f, 0x08, 0xf5, 0x1c, 0xb9, 0x8a, 0x13, 0xe4,
+	0x6b, 0x45, 0x69, 0xb9, 0x9c, 0xe2, 0x56, 0x0a, 0xc3, 0x7d, 0xa0, 0x66, 0x2d, 0x39, 0x21, 0x23,
+	0x54, 0x70, 0x0d, 0xd4, 0x1e, 0xd7, 0x4b, 0x9b, 0xef, 0x3c, 0xe8, 0x39, 0x13, 0xe9, 0xc5, 0xb9,
+	0xcd, 0x7c, 0xdc, 0xbe, 0x5d, 0x0e, 0xef, 0xa0, 0x1c, 0x7e, 0x09, 0x40, 0x80, 0xa6, 0x0e, 0x1f,
+	0x87, 0xa1, 0x7f, 0xac, 0x95, 0xe6, 0xe0, 0xb4, 0x18, 0xa0, 0x69, 0x5f, 0xd2, 0xc1, 0x2e, 0x58,
+	0x18, 0x62, 0x2e, 0x08, 0x95, 0x03, 0xc4, 0xb5, 0x05, 0xd9, 0xee, 0xbb, 0x0f, 0xb6, 0xdb, 0x91,
+	0xcb, 0x99, 0x25, 0xa7, 0xfd, 0xde, 0xa9, 0xdf, 0xca, 0x7f, 0xf7, 0x7d, 0x35, 0xb7, 0xf2, 0x93,
+	0x02, 0x5e, 0xdc, 0x35, 0x07, 0x3f, 0x04, 0xf9, 0xf8, 0xbc, 0xc9, 0x45, 0x2f, 0x6d, 0x56, 0x8c,
+	0xe4, 0xf6, 0x19, 0x37, 0xb7, 0xcf, 0xd8, 0xbf, 0xb9, 0x7d, 0xcd, 0x67, 0x31, 0xed, 0xe9, 0x1f,
+	0x55, 0xc5, 0x96, 0x15, 0xff, 0xe7, 0x79, 0xfa, 0x46, 0x01, 0xe5, 0x19, 0x97, 0xf0, 0x6d, 0x50,
+	0x8c, 0xb0, 0x4b, 0x42, 0x82, 0xa9, 0xb8, 0x39, 0x53, 0x19, 0x00, 0x6d, 0xf0, 0x84, 0x8f, 0x50,
+	0x34, 0x9f, 0xb3, 0x94, 0x50, 0xbd, 0xff, 0x9b, 0x02, 0xd4, 0xd9, 0x75, 0x80, 0x2d, 0xa0, 0x9b,
+	0x1d, 0xab, 0xdf, 0xb7, 0xf6, 0xba, 0x4e, 0xbf, 0xb5, 0x63, 0xb6, 0x3f, 0xdd, 0x35, 0x9d, 0xe6,
+	0x5e, 0xb7, 0x6d, 0xb6, 0x1d, 0x7b, 0x7b, 0xdf, 0xda, 0x53, 0x73, 0x95, 0xea, 0xc9, 0x59, 0x6d,
+	0x69, 0xb6, 0x32, 0x59, 0x5c, 0x3b, 0x36, 0x03, 0xb7, 0xc0, 0xe2, 0x7d, 0x92, 0x9d, 0xed, 0xdd,
+	0xcf, 0xac, 0xee, 0x27, 0xaa, 0x52, 0x59, 0x3a, 0x39, 0xab, 0xbd, 0x99, 0xad, 0xdf, 0x49, 0x86,
+	0x1c, 0x7e, 0x0c, 0x96, 0xee, 0xd7, 0xf6, 0x2c, 0xb3, 0x65, 0x7e, 0x6e, 0xf5, 0x4d, 0xf5, 0x51,
+	0x65, 0xf9, 0xe4, 0xac, 0xb6, 0x38, 0x5b, 0xdd, 0x23, 0xd8, 0xc5, 0x5f, 0x13, 0x8e, 0x2b, 0xf9,
+	0x6f, 0x7f, 0xd0, 0x73, 0xcd, 0xd6, 0xf9, 0x95, 0xae, 0x5c, 0x5c, 0xe9, 0xca, 0x9f, 0x57, 0xba,
+	0x72, 0x7a, 0xad, 0xe7, 0x2e, 0xae, 0xf5, 0xdc, 0xaf, 0xd7, 0x7a, 0xee, 0x60, 0xed, 0x5f, 0x5f,
+	0xd9, 0x34, 0xf9, 0x2f, 0x96, 0x6f, 0x6e, 0x50, 0x90, 0x53, 0xf3, 0xc1, 0xdf, 0x03, 0x00, 0x1f,
+	0xe4, 0x67, 0x6d, 0xa7, 0x07, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -227,6 +432,64 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Destinations) > 0 {
+		for iNdEx := len(m.Destinations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Destinations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.ProvisionPoints) > 0 {
+		for iNdEx := len(m.ProvisionPoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProvisionPoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.HalvingInterval != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.HalvingInterval))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.InitialBlockProvision.Size()
+		i -= size
+		if _, err := m.InitialBlockProvision.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.EmissionSchedule != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.EmissionSchedule))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.BlocksPerYear != 0 {
 		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
 		i--
@@ -282,6 +545,87 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProvisionPoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProvisionPoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProvisionPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintMint(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MintDestination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintDestination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintDestination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Share.Size()
+		i -= size
+		if _, err := m.Share.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMint(v)
 	base := offset
@@ -327,6 +671,56 @@ func (m *Params) Size() (n int) {
 	if m.BlocksPerYear != 0 {
 		n += 1 + sovMint(uint64(m.BlocksPerYear))
 	}
+	if m.EmissionSchedule != 0 {
+		n += 1 + sovMint(uint64(m.EmissionSchedule))
+	}
+	l = m.InitialBlockProvision.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.HalvingInterval != 0 {
+		n += 1 + sovMint(uint64(m.HalvingInterval))
+	}
+	if len(m.ProvisionPoints) > 0 {
+		for _, e := range m.ProvisionPoints {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.Destinations) > 0 {
+		for _, e := range m.Destinations {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProvisionPoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovMint(uint64(l))
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *MintDestination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Share.Size()
+	n += 1 + l + sovMint(uint64(l))
 	return n
 }
 
@@ -670,6 +1064,413 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionSchedule", wireType)
+			}
+			m.EmissionSchedule = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EmissionSchedule |= EmissionSchedule(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBlockProvision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialBlockProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalvingInterval", wireType)
+			}
+			m.HalvingInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HalvingInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvisionPoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProvisionPoints = append(m.ProvisionPoints, ProvisionPoint{})
+			if err := m.ProvisionPoints[len(m.ProvisionPoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destinations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destinations = append(m.Destinations, MintDestination{})
+			if err := m.Destinations[len(m.Destinations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProvisionPoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProvisionPoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProvisionPoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintDestination) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintDestination: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -30,24 +30,30 @@ func NewParams(
 	mintDenom string, inflationRateChange, inflationMax, inflationMin, goalBonded sdk.Dec, blocksPerYear uint64,
 ) Params {
 	return Params{
-		MintDenom:           mintDenom,
-		InflationRateChange: inflationRateChange,
-		InflationMax:        inflationMax,
-		InflationMin:        inflationMin,
-		GoalBonded:          goalBonded,
-		BlocksPerYear:       blocksPerYear,
+		MintDenom:             mintDenom,
+		InflationRateChange:   inflationRateChange,
+		InflationMax:          inflationMax,
+		InflationMin:          inflationMin,
+		GoalBonded:            goalBonded,
+		BlocksPerYear:         blocksPerYear,
+		EmissionSchedule:      EmissionScheduleBondedRatio,
+		InitialBlockProvision: sdk.ZeroInt(),
+		MaxSupply:             sdk.ZeroInt(),
 	}
 }
 
 // default minting module parameters
 func DefaultParams() Params {
 	return Params{
-		MintDenom:           sdk.DefaultBondDenom,
-		InflationRateChange: sdk.NewDecWithPrec(13, 2),
-		InflationMax:        sdk.NewDecWithPrec(20, 2),
-		InflationMin:        sdk.NewDecWithPrec(7, 2),
-		GoalBonded:          sdk.NewDecWithPrec(67, 2),
-		BlocksPerYear:       uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
+		MintDenom:             sdk.DefaultBondDenom,
+		InflationRateChange:   sdk.NewDecWithPrec(13, 2),
+		InflationMax:          sdk.NewDecWithPrec(20, 2),
+		InflationMin:          sdk.NewDecWithPrec(7, 2),
+		GoalBonded:            sdk.NewDecWithPrec(67, 2),
+		BlocksPerYear:         uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
+		EmissionSchedule:      EmissionScheduleBondedRatio,
+		InitialBlockProvision: sdk.ZeroInt(),
+		MaxSupply:             sdk.ZeroInt(),
 	}
 }
 
@@ -77,6 +83,15 @@ func (p Params) Validate() error {
 			p.InflationMax, p.InflationMin,
 		)
 	}
+	if err := p.validateEmissionSchedule(); err != nil {
+		return err
+	}
+	if p.MaxSupply.IsNil() || p.MaxSupply.IsNegative() {
+		return fmt.Errorf("max supply cannot be negative: %s", p.MaxSupply)
+	}
+	if err := validateDestinations(p.Destinations); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -88,6 +103,10 @@ func (p Params) String() string {
 }
 
 // Implements params.ParamSet
+//
+// NOTE: The emission schedule, max supply and destinations params were
+// introduced after the migration of the params to the x/mint module store and
+// are not part of the legacy param set.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyMintDenom, &p.MintDenom, validateMintDenom),
@@ -0,0 +1,51 @@
+package nft
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateBasic performs a stateless validation of the approval
+func (a Approval) ValidateBasic() error {
+	if err := ValidateClassID(a.ClassId); err != nil {
+		return err
+	}
+	if err := ValidateNFTID(a.Id); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(a.Spender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid spender address (%s)", a.Spender)
+	}
+	return nil
+}
+
+// IsExpired returns true if the approval expired at the given time
+func (a Approval) IsExpired(blockTime time.Time) bool {
+	return a.Expiration != nil && !blockTime.Before(*a.Expiration)
+}
+
+// ValidateBasic performs a stateless validation of the operator approval
+func (a OperatorApproval) ValidateBasic() error {
+	owner, err := sdk.AccAddressFromBech32(a.Owner)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", a.Owner)
+	}
+	operator, err := sdk.AccAddressFromBech32(a.Operator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid operator address (%s)", a.Operator)
+	}
+	if owner.Equals(operator) {
+		return sdkerrors.Wrap(ErrInvalidApproval, "owner and operator cannot be the same")
+	}
+	if a.ClassId != "" {
+		return ValidateClassID(a.ClassId)
+	}
+	return nil
+}
+
+// IsExpired returns true if the operator approval expired at the given time
+func (a OperatorApproval) IsExpired(blockTime time.Time) bool {
+	return a.Expiration != nil && !blockTime.Before(*a.Expiration)
+}
@@ -39,6 +39,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryOwner(),
 		GetCmdQueryBalance(),
 		GetCmdQuerySupply(),
+		GetCmdQueryRoyaltyPolicy(),
+		GetCmdQueryApproval(),
+		GetCmdQueryOperatorApprovals(),
 	)
 	return nftQueryCmd
 }
@@ -274,3 +277,88 @@ func GetCmdQuerySupply() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryRoyaltyPolicy implements the query royalty policy command.
+func GetCmdQueryRoyaltyPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "royalty-policy [class-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "query the royalty policy of an NFT class",
+		Example: fmt.Sprintf(`$ %s query %s royalty-policy <class-id>`, version.AppName, nft.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := nft.NewQueryClient(clientCtx)
+			res, err := queryClient.RoyaltyPolicy(cmd.Context(), &nft.QueryRoyaltyPolicyRequest{
+				ClassId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryApproval implements the query approval command.
+func GetCmdQueryApproval() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "approval [class-id] [nft-id]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "query the approved spender of an NFT",
+		Example: fmt.Sprintf(`$ %s query %s approval <class-id> <nft-id>`, version.AppName, nft.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := nft.NewQueryClient(clientCtx)
+			res, err := queryClient.Approval(cmd.Context(), &nft.QueryApprovalRequest{
+				ClassId: args[0],
+				Id:      args[1],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryOperatorApprovals implements the query operator approvals command.
+func GetCmdQueryOperatorApprovals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "operator-approvals [owner]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "query the operators approved by an owner",
+		Example: fmt.Sprintf(`$ %s query %s operator-approvals <owner>`, version.AppName, nft.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := nft.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.OperatorApprovals(cmd.Context(), &nft.QueryOperatorApprovalsRequest{
+				Owner:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "operator approvals")
+	return cmd
+}
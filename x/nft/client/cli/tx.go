@@ -2,17 +2,34 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
+// Flags for the nft module
+const (
+	FlagName            = "name"
+	FlagSymbol          = "symbol"
+	FlagDescription     = "description"
+	FlagURI             = "uri"
+	FlagURIHash         = "uri-hash"
+	FlagOpenMint        = "open-mint"
+	FlagBurnable        = "burnable"
+	FlagNonTransferable = "non-transferable"
+	FlagReceiver        = "receiver"
+	FlagExpiration      = "expiration"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	nftTxCmd := &cobra.Command{
@@ -26,6 +43,15 @@ func GetTxCmd() *cobra.Command {
 
 	nftTxCmd.AddCommand(
 		NewCmdSend(),
+		NewCmdCreateClass(),
+		NewCmdMint(),
+		NewCmdBurn(),
+		NewCmdUpdateNFT(),
+		NewCmdSetRoyaltyPolicy(),
+		NewCmdSendWithPayment(),
+		NewCmdApprove(),
+		NewCmdSetApprovalForAll(),
+		NewCmdRevoke(),
 	)
 
 	return nftTxCmd
@@ -58,3 +84,373 @@ func NewCmdSend() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func NewCmdCreateClass() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-class [class-id] --from [issuer]",
+		Args:  cobra.ExactArgs(1),
+		Short: "create a nft class issued by the sender",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s create-class <class-id> --name <name> --symbol <symbol> --uri <uri> --burnable --from <issuer> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			class := &nft.Class{Id: args[0]}
+			if class.Name, err = cmd.Flags().GetString(FlagName); err != nil {
+				return err
+			}
+			if class.Symbol, err = cmd.Flags().GetString(FlagSymbol); err != nil {
+				return err
+			}
+			if class.Description, err = cmd.Flags().GetString(FlagDescription); err != nil {
+				return err
+			}
+			if class.Uri, err = cmd.Flags().GetString(FlagURI); err != nil {
+				return err
+			}
+			if class.UriHash, err = cmd.Flags().GetString(FlagURIHash); err != nil {
+				return err
+			}
+			if class.OpenMint, err = cmd.Flags().GetBool(FlagOpenMint); err != nil {
+				return err
+			}
+			if class.Burnable, err = cmd.Flags().GetBool(FlagBurnable); err != nil {
+				return err
+			}
+			if class.NonTransferable, err = cmd.Flags().GetBool(FlagNonTransferable); err != nil {
+				return err
+			}
+
+			msg := nft.MsgCreateClass{
+				Issuer: clientCtx.GetFromAddress().String(),
+				Class:  class,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagName, "", "The human-readable name of the class")
+	cmd.Flags().String(FlagSymbol, "", "The abbreviated name of the class")
+	cmd.Flags().String(FlagDescription, "", "The description of the class")
+	cmd.Flags().String(FlagURI, "", "The uri of the class metadata stored off chain")
+	cmd.Flags().String(FlagURIHash, "", "The hash of the document pointed by uri")
+	cmd.Flags().Bool(FlagOpenMint, false, "Allow anyone to mint nfts of the class")
+	cmd.Flags().Bool(FlagBurnable, false, "Allow owners to burn their nfts of the class")
+	cmd.Flags().Bool(FlagNonTransferable, false, "Forbid sending the nfts of the class")
+	return cmd
+}
+
+func NewCmdMint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint [class-id] [nft-id] --from [minter]",
+		Args:  cobra.ExactArgs(2),
+		Short: "mint a nft",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s mint <class-id> <nft-id> --uri <uri> --receiver <receiver> --from <minter> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			token, err := nftFromFlags(cmd, args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			receiver, err := cmd.Flags().GetString(FlagReceiver)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgMint{
+				Minter:   clientCtx.GetFromAddress().String(),
+				Nft:      token,
+				Receiver: receiver,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagURI, "", "The uri of the nft metadata stored off chain")
+	cmd.Flags().String(FlagURIHash, "", "The hash of the document pointed by uri")
+	cmd.Flags().String(FlagReceiver, "", "The owner of the minted nft, defaults to the minter")
+	return cmd
+}
+
+func NewCmdBurn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [class-id] [nft-id] --from [owner]",
+		Args:  cobra.ExactArgs(2),
+		Short: "burn a nft",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s burn <class-id> <nft-id> --from <owner> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgBurn{
+				ClassId: args[0],
+				Id:      args[1],
+				Owner:   clientCtx.GetFromAddress().String(),
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdUpdateNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update [class-id] [nft-id] --from [issuer]",
+		Args:  cobra.ExactArgs(2),
+		Short: "update the metadata of a nft",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s update <class-id> <nft-id> --uri <uri> --uri-hash <uri-hash> --from <issuer> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			token, err := nftFromFlags(cmd, args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgUpdateNFT{
+				Issuer: clientCtx.GetFromAddress().String(),
+				Nft:    token,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagURI, "", "The uri of the nft metadata stored off chain")
+	cmd.Flags().String(FlagURIHash, "", "The hash of the document pointed by uri")
+	return cmd
+}
+
+func NewCmdSetRoyaltyPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-royalty-policy [class-id] [recipient] [basis-points] [denom] --from [issuer]",
+		Args:  cobra.ExactArgs(4),
+		Short: "set the royalty policy of a nft class, zero basis points remove it",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s set-royalty-policy <class-id> <recipient> 250 stake --from <issuer> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			basisPoints, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgSetRoyaltyPolicy{
+				Issuer:      clientCtx.GetFromAddress().String(),
+				ClassId:     args[0],
+				Recipient:   args[1],
+				BasisPoints: uint32(basisPoints),
+				Denom:       args[3],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdSendWithPayment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-with-payment [class-id] [nft-id] [receiver] [payment] --from [sender]",
+		Args:  cobra.ExactArgs(4),
+		Short: "transfer ownership of nft in exchange for a payment from the receiver",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			The transaction must be signed by both the sender and the receiver, e.g. using --generate-only and multi-signing.
+
+			$ %s tx %s send-with-payment <class-id> <nft-id> <receiver> 1000stake --from <sender> --chain-id <chain-id> --generate-only`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			payment, err := sdk.ParseCoinNormalized(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgSendWithPayment{
+				ClassId:  args[0],
+				Id:       args[1],
+				Sender:   clientCtx.GetFromAddress().String(),
+				Receiver: args[2],
+				Payment:  payment,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdApprove() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve [class-id] [nft-id] [spender] --from [owner]",
+		Args:  cobra.ExactArgs(3),
+		Short: "approve a spender to transfer a nft, replacing its previous approval",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s approve <class-id> <nft-id> <spender> --expiration 2030-01-01T00:00:00Z --from <owner> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			expiration, err := expirationFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgApprove{
+				Owner:      clientCtx.GetFromAddress().String(),
+				ClassId:    args[0],
+				Id:         args[1],
+				Spender:    args[2],
+				Expiration: expiration,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 timestamp after which the approval expires")
+	return cmd
+}
+
+func NewCmdSetApprovalForAll() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-approval-for-all [operator] [approved] --from [owner]",
+		Args:  cobra.ExactArgs(2),
+		Short: "approve or disapprove an operator to transfer all your nfts, or all your nfts of a class",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s set-approval-for-all <operator> true --class-id <class-id> --from <owner> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			approved, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			classID, err := cmd.Flags().GetString(FlagClassID)
+			if err != nil {
+				return err
+			}
+
+			expiration, err := expirationFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgSetApprovalForAll{
+				Owner:      clientCtx.GetFromAddress().String(),
+				Operator:   args[0],
+				ClassId:    classID,
+				Approved:   approved,
+				Expiration: expiration,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagClassID, "", "Restrict the approval to the nfts of a class")
+	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 timestamp after which the approval expires")
+	return cmd
+}
+
+func NewCmdRevoke() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [class-id] [nft-id] --from [owner]",
+		Args:  cobra.ExactArgs(2),
+		Short: "revoke the approval of a nft",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s revoke <class-id> <nft-id> --from <owner> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgRevoke{
+				Owner:   clientCtx.GetFromAddress().String(),
+				ClassId: args[0],
+				Id:      args[1],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func expirationFromFlags(cmd *cobra.Command) (*time.Time, error) {
+	exp, err := cmd.Flags().GetString(FlagExpiration)
+	if err != nil || exp == "" {
+		return nil, err
+	}
+
+	expiration, err := time.Parse(time.RFC3339, exp)
+	if err != nil {
+		return nil, err
+	}
+	return &expiration, nil
+}
+
+func nftFromFlags(cmd *cobra.Command, classID, nftID string) (*nft.NFT, error) {
+	uri, err := cmd.Flags().GetString(FlagURI)
+	if err != nil {
+		return nil, err
+	}
+
+	uriHash, err := cmd.Flags().GetString(FlagURIHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return &nft.NFT{
+		ClassId: classID,
+		Id:      nftID,
+		Uri:     uri,
+		UriHash: uriHash,
+	}, nil
+}
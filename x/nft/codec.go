@@ -9,6 +9,15 @@ import (
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSend{},
+		&MsgCreateClass{},
+		&MsgMint{},
+		&MsgBurn{},
+		&MsgUpdateNFT{},
+		&MsgSetRoyaltyPolicy{},
+		&MsgSendWithPayment{},
+		&MsgApprove{},
+		&MsgSetApprovalForAll{},
+		&MsgRevoke{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -10,6 +10,26 @@ func ValidateGenesis(data GenesisState) error {
 		if err := ValidateClassID(class.Id); err != nil {
 			return err
 		}
+		if class.Issuer != "" {
+			if _, err := sdk.AccAddressFromBech32(class.Issuer); err != nil {
+				return err
+			}
+		}
+	}
+	for _, policy := range data.RoyaltyPolicies {
+		if err := policy.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	for _, approval := range data.Approvals {
+		if err := approval.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	for _, approval := range data.OperatorApprovals {
+		if err := approval.ValidateBasic(); err != nil {
+			return err
+		}
 	}
 	for _, entry := range data.Entries {
 		for _, nft := range entry.Nfts {
@@ -27,6 +27,12 @@ type GenesisState struct {
 	// class defines the class of the nft type.
 	Classes []*Class `protobuf:"bytes,1,rep,name=classes,proto3" json:"classes,omitempty"`
 	Entries []*Entry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	// royalty_policies defines the royalty policies of the classes.
+	RoyaltyPolicies []*RoyaltyPolicy `protobuf:"bytes,3,rep,name=royalty_policies,json=royaltyPolicies,proto3" json:"royalty_policies,omitempty"`
+	// approvals defines the approvals of spenders to transfer NFTs.
+	Approvals []*Approval `protobuf:"bytes,4,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	// operator_approvals defines the approvals of operators to transfer the NFTs of owners.
+	OperatorApprovals []*OperatorApproval `protobuf:"bytes,5,rep,name=operator_approvals,json=operatorApprovals,proto3" json:"operator_approvals,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,6 +82,27 @@ func (m *GenesisState) GetEntries() []*Entry {
 	return nil
 }
 
+func (m *GenesisState) GetRoyaltyPolicies() []*RoyaltyPolicy {
+	if m != nil {
+		return m.RoyaltyPolicies
+	}
+	return nil
+}
+
+func (m *GenesisState) GetApprovals() []*Approval {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOperatorApprovals() []*OperatorApproval {
+	if m != nil {
+		return m.OperatorApprovals
+	}
+	return nil
+}
+
 // Entry Defines all nft owned by a person
 type Entry struct {
 	// owner is the owner address of the following nft
@@ -139,22 +166,28 @@ func init() {
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/genesis.proto", fileDescriptor_0095f7548e354a72) }
 
 var fileDescriptor_0095f7548e354a72 = []byte{
-	// 240 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0xcf, 0x4b, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f,
-	0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0xa8,
-	0xd0, 0xcb, 0x4b, 0x2b, 0xd1, 0x83, 0xaa, 0x90, 0x92, 0xc1, 0xa2, 0x0b, 0x24, 0x0f, 0xd6, 0xa1,
-	0x54, 0xc1, 0xc5, 0xe3, 0x0e, 0x31, 0x22, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x98, 0x8b, 0x3d,
-	0x39, 0x27, 0xb1, 0xb8, 0x38, 0xb5, 0x58, 0x82, 0x51, 0x81, 0x59, 0x83, 0xdb, 0x48, 0x52, 0x0f,
-	0xd3, 0x4c, 0x3d, 0x67, 0x90, 0x92, 0x20, 0x98, 0x4a, 0x90, 0xa6, 0xd4, 0xbc, 0x92, 0xa2, 0xcc,
-	0xd4, 0x62, 0x09, 0x26, 0xdc, 0x9a, 0x5c, 0xf3, 0x4a, 0x8a, 0x2a, 0x83, 0x60, 0x2a, 0x95, 0xbc,
-	0xb8, 0x58, 0xc1, 0x22, 0x42, 0x22, 0x5c, 0xac, 0xf9, 0xe5, 0x79, 0xa9, 0x45, 0x12, 0x8c, 0x0a,
-	0x8c, 0x1a, 0x9c, 0x41, 0x10, 0x8e, 0x90, 0x36, 0x17, 0x4b, 0x5e, 0x5a, 0x09, 0xcc, 0x40, 0x71,
-	0x6c, 0x06, 0xfa, 0xb9, 0x85, 0x04, 0x81, 0x15, 0x39, 0xd9, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1,
-	0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70,
-	0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x52, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae,
-	0x3e, 0x34, 0x20, 0x20, 0x94, 0x6e, 0x71, 0x4a, 0xb6, 0x7e, 0x05, 0x28, 0x24, 0x92, 0xd8, 0xc0,
-	0x41, 0x61, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xee, 0x44, 0x0c, 0xb7, 0x60, 0x01, 0x00, 0x00,
+	// 328 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x4d, 0x4b, 0xc3, 0x30,
+	0x18, 0x80, 0xd7, 0x7d, 0x28, 0x8b, 0x82, 0x1a, 0x04, 0xab, 0x8c, 0x32, 0x87, 0x87, 0x81, 0x98,
+	0x32, 0x77, 0x13, 0x2f, 0x2a, 0x2a, 0x88, 0xa8, 0x64, 0x9e, 0xbc, 0x8c, 0xac, 0x66, 0xb3, 0xd8,
+	0x25, 0x25, 0x79, 0x9d, 0xf6, 0x5f, 0xf8, 0xb3, 0x3c, 0xee, 0xa6, 0x47, 0xd9, 0xfe, 0x88, 0x24,
+	0xed, 0x9c, 0x68, 0x3d, 0x95, 0xb7, 0x7d, 0x9e, 0xa7, 0x24, 0x2f, 0xaa, 0x07, 0x52, 0x0f, 0xa5,
+	0xf6, 0x45, 0x1f, 0xfc, 0x51, 0xab, 0xc7, 0x81, 0xb5, 0xfc, 0x01, 0x17, 0x5c, 0x87, 0x9a, 0xc4,
+	0x4a, 0x82, 0xc4, 0x38, 0x25, 0x88, 0xe8, 0x03, 0xc9, 0x88, 0xad, 0x5a, 0x8e, 0x65, 0xbe, 0x5b,
+	0xa3, 0xf1, 0x5e, 0x44, 0xcb, 0xe7, 0x69, 0xa3, 0x03, 0x0c, 0x38, 0x6e, 0xa3, 0xc5, 0x20, 0x62,
+	0x5a, 0x73, 0xed, 0x3a, 0xf5, 0x52, 0x73, 0x69, 0x7f, 0x93, 0xfc, 0x8d, 0x92, 0x13, 0x83, 0xd0,
+	0x19, 0x69, 0x24, 0x2e, 0x40, 0x85, 0x5c, 0xbb, 0xc5, 0xff, 0xa5, 0x53, 0x01, 0x2a, 0xa1, 0x33,
+	0x12, 0x5f, 0xa2, 0x55, 0x25, 0x13, 0x16, 0x41, 0xd2, 0x8d, 0x65, 0x14, 0x06, 0xc6, 0x2e, 0x59,
+	0x7b, 0x3b, 0xcf, 0xa6, 0x29, 0x7b, 0x63, 0xd0, 0x84, 0xae, 0xa8, 0x1f, 0xa3, 0xa9, 0x1d, 0xa0,
+	0x2a, 0x8b, 0x63, 0x25, 0x47, 0x2c, 0xd2, 0x6e, 0xd9, 0x66, 0x6a, 0x79, 0x99, 0xa3, 0x0c, 0xa2,
+	0x73, 0x1c, 0x77, 0x10, 0x96, 0x31, 0x57, 0x0c, 0xa4, 0xea, 0xce, 0x23, 0x15, 0x1b, 0xd9, 0xc9,
+	0x8b, 0x5c, 0x67, 0xf4, 0x77, 0x6c, 0x4d, 0xfe, 0x7a, 0xa3, 0x1b, 0x17, 0xa8, 0x62, 0x0f, 0x8c,
+	0xd7, 0x51, 0x45, 0x3e, 0x0b, 0xae, 0x5c, 0xa7, 0xee, 0x34, 0xab, 0x34, 0x1d, 0xf0, 0x2e, 0x2a,
+	0x8b, 0x3e, 0xcc, 0xee, 0x6b, 0x23, 0xef, 0x2f, 0x57, 0x67, 0xb7, 0xd4, 0x42, 0xc7, 0x87, 0x6f,
+	0x13, 0xcf, 0x19, 0x4f, 0x3c, 0xe7, 0x73, 0xe2, 0x39, 0xaf, 0x53, 0xaf, 0x30, 0x9e, 0x7a, 0x85,
+	0x8f, 0xa9, 0x57, 0xb8, 0x6b, 0x0c, 0x42, 0x78, 0x78, 0xea, 0x91, 0x40, 0x0e, 0xfd, 0x6c, 0xd1,
+	0xe9, 0x63, 0x4f, 0xdf, 0x3f, 0xfa, 0x2f, 0x66, 0xd3, 0xbd, 0x05, 0xbb, 0xea, 0xf6, 0xd7, 0x00,
+	0xf5, 0xc1, 0xcc, 0x15, 0x40, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -177,6 +210,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OperatorApprovals) > 0 {
+		for iNdEx := len(m.OperatorApprovals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OperatorApprovals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Approvals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RoyaltyPolicies) > 0 {
+		for iNdEx := len(m.RoyaltyPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoyaltyPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Entries) > 0 {
 		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -281,6 +356,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RoyaltyPolicies) > 0 {
+		for _, e := range m.RoyaltyPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Approvals) > 0 {
+		for _, e := range m.Approvals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OperatorApprovals) > 0 {
+		for _, e := range m.OperatorApprovals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -406,6 +499,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoyaltyPolicies = append(m.RoyaltyPolicies, &RoyaltyPolicy{})
+			if err := m.RoyaltyPolicies[len(m.RoyaltyPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvals = append(m.Approvals, &Approval{})
+			if err := m.Approvals[len(m.Approvals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorApprovals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorApprovals = append(m.OperatorApprovals, &OperatorApproval{})
+			if err := m.OperatorApprovals[len(m.OperatorApprovals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
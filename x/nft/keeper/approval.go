@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+// Approve defines a method for approving a spender to transfer a nft on behalf of its owner,
+// replacing the previous approval of the nft, if any.
+// Note: When the upper module uses this method, it needs to authenticate owner
+func (k Keeper) Approve(ctx sdk.Context, owner sdk.AccAddress, approval nft.Approval) error {
+	if !k.HasNFT(ctx, approval.ClassId, approval.Id) {
+		return sdkerrors.Wrap(nft.ErrNFTNotExists, approval.Id)
+	}
+
+	if !k.GetOwner(ctx, approval.ClassId, approval.Id).Equals(owner) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", owner, approval.Id)
+	}
+
+	if err := approval.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if approval.Spender == owner.String() {
+		return sdkerrors.Wrap(nft.ErrInvalidApproval, "owner and spender cannot be the same")
+	}
+
+	bz, err := k.cdc.Marshal(&approval)
+	if err != nil {
+		return sdkerrors.Wrap(err, "Marshal nft.Approval failed")
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(approvalStoreKey(approval.ClassId, approval.Id), bz)
+	return nil
+}
+
+// Revoke defines a method for revoking the approval of a nft
+// Note: When the upper module uses this method, it needs to authenticate owner
+func (k Keeper) Revoke(ctx sdk.Context, owner sdk.AccAddress, classID, nftID string) error {
+	if !k.HasNFT(ctx, classID, nftID) {
+		return sdkerrors.Wrap(nft.ErrNFTNotExists, nftID)
+	}
+
+	if !k.GetOwner(ctx, classID, nftID).Equals(owner) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", owner, nftID)
+	}
+
+	k.deleteApproval(ctx, classID, nftID)
+	return nil
+}
+
+// GetApproval returns the approval of the specified nft, expired approvals are ignored
+func (k Keeper) GetApproval(ctx sdk.Context, classID, nftID string) (nft.Approval, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(approvalStoreKey(classID, nftID))
+
+	var approval nft.Approval
+	if len(bz) == 0 {
+		return approval, false
+	}
+	k.cdc.MustUnmarshal(bz, &approval)
+	if approval.IsExpired(ctx.BlockTime()) {
+		return nft.Approval{}, false
+	}
+	return approval, true
+}
+
+// GetApprovals returns the approvals of all nfts, including the expired ones
+func (k Keeper) GetApprovals(ctx sdk.Context) (approvals []*nft.Approval) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, ApprovalKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var approval nft.Approval
+		k.cdc.MustUnmarshal(iterator.Value(), &approval)
+		approvals = append(approvals, &approval)
+	}
+	return
+}
+
+// SetApprovalForAll defines a method for approving an operator to transfer all the nfts of an owner,
+// or all its nfts of a class.
+// Note: When the upper module uses this method, it needs to authenticate owner
+func (k Keeper) SetApprovalForAll(ctx sdk.Context, approval nft.OperatorApproval) error {
+	if err := approval.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if approval.ClassId != "" && !k.HasClass(ctx, approval.ClassId) {
+		return sdkerrors.Wrap(nft.ErrClassNotExists, approval.ClassId)
+	}
+
+	bz, err := k.cdc.Marshal(&approval)
+	if err != nil {
+		return sdkerrors.Wrap(err, "Marshal nft.OperatorApproval failed")
+	}
+	owner := sdk.MustAccAddressFromBech32(approval.Owner)
+	operator := sdk.MustAccAddressFromBech32(approval.Operator)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(operatorApprovalStoreKey(owner, operator, approval.ClassId), bz)
+	return nil
+}
+
+// DeleteApprovalForAll defines a method for removing the approval of an operator
+func (k Keeper) DeleteApprovalForAll(ctx sdk.Context, owner, operator sdk.AccAddress, classID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(operatorApprovalStoreKey(owner, operator, classID))
+}
+
+// GetOperatorApproval returns the approval of an operator by an owner for a class, or for all the classes
+// if classID is empty. Expired approvals are ignored
+func (k Keeper) GetOperatorApproval(ctx sdk.Context, owner, operator sdk.AccAddress, classID string) (nft.OperatorApproval, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(operatorApprovalStoreKey(owner, operator, classID))
+
+	var approval nft.OperatorApproval
+	if len(bz) == 0 {
+		return approval, false
+	}
+	k.cdc.MustUnmarshal(bz, &approval)
+	if approval.IsExpired(ctx.BlockTime()) {
+		return nft.OperatorApproval{}, false
+	}
+	return approval, true
+}
+
+// GetOperatorApprovals returns the operator approvals of all owners, including the expired ones
+func (k Keeper) GetOperatorApprovals(ctx sdk.Context) (approvals []*nft.OperatorApproval) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, OperatorApprovalKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var approval nft.OperatorApproval
+		k.cdc.MustUnmarshal(iterator.Value(), &approval)
+		approvals = append(approvals, &approval)
+	}
+	return
+}
+
+// IsApprovedForAll returns true if the operator is approved to transfer the nfts of the class of the owner
+func (k Keeper) IsApprovedForAll(ctx sdk.Context, owner, operator sdk.AccAddress, classID string) bool {
+	if _, has := k.GetOperatorApproval(ctx, owner, operator, ""); has {
+		return true
+	}
+	_, has := k.GetOperatorApproval(ctx, owner, operator, classID)
+	return has
+}
+
+// IsApprovedOrOwner returns true if the spender is the owner of the nft, is approved to transfer it
+// or is an operator of its owner
+func (k Keeper) IsApprovedOrOwner(ctx sdk.Context, spender sdk.AccAddress, classID, nftID string) bool {
+	owner := k.GetOwner(ctx, classID, nftID)
+	if owner.Empty() {
+		return false
+	}
+	if owner.Equals(spender) {
+		return true
+	}
+	if approval, has := k.GetApproval(ctx, classID, nftID); has && approval.Spender == spender.String() {
+		return true
+	}
+	return k.IsApprovedForAll(ctx, owner, spender, classID)
+}
+
+// TransferFrom defines a method for sending a nft on behalf of its owner. The sender must be the owner,
+// the approved spender of the nft or an operator of the owner.
+func (k Keeper) TransferFrom(ctx sdk.Context,
+	sender sdk.AccAddress,
+	classID string,
+	nftID string,
+	receiver sdk.AccAddress,
+) error {
+	if !k.IsApprovedOrOwner(ctx, sender, classID, nftID) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s nor approved to transfer it", sender, nftID)
+	}
+	return k.Transfer(ctx, classID, nftID, receiver)
+}
+
+func (k Keeper) deleteApproval(ctx sdk.Context, classID, nftID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(approvalStoreKey(classID, nftID))
+}
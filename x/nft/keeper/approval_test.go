@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/cosmos/cosmos-sdk/x/nft/keeper"
+)
+
+func (s *TestSuite) TestApprovals() {
+	msgSrvr := keeper.NewMsgServerImpl(s.app.NFTKeeper)
+	ctx := s.ctx.WithBlockTime(time.Now())
+	goCtx := sdk.WrapSDKContext(ctx)
+	owner, spender, receiver := s.addrs[0], s.addrs[1], s.addrs[2]
+	k := s.app.NFTKeeper
+
+	s.Require().NoError(k.SaveClass(ctx, nft.Class{Id: testClassID}))
+	for _, id := range []string{testID, "kitty2"} {
+		s.Require().NoError(k.Mint(ctx, nft.NFT{ClassId: testClassID, Id: id}, owner))
+	}
+
+	send := &nft.MsgSend{ClassId: testClassID, Id: testID, Sender: spender.String(), Receiver: receiver.String()}
+	_, err := msgSrvr.Send(goCtx, send)
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// only the owner can approve, and not in the past
+	approve := &nft.MsgApprove{Owner: spender.String(), ClassId: testClassID, Id: testID, Spender: spender.String()}
+	_, err = msgSrvr.Approve(goCtx, approve)
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	past := ctx.BlockTime().Add(-time.Hour)
+	approve = &nft.MsgApprove{Owner: owner.String(), ClassId: testClassID, Id: testID, Spender: spender.String(), Expiration: &past}
+	_, err = msgSrvr.Approve(goCtx, approve)
+	s.Require().ErrorIs(err, nft.ErrInvalidApproval)
+
+	expiration := ctx.BlockTime().Add(time.Hour)
+	approve.Expiration = &expiration
+	_, err = msgSrvr.Approve(goCtx, approve)
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.Approval(goCtx, &nft.QueryApprovalRequest{ClassId: testClassID, Id: testID})
+	s.Require().NoError(err)
+	s.Require().Equal(spender.String(), res.Approval.Spender)
+	s.Require().True(expiration.Equal(*res.Approval.Expiration))
+
+	// the approval is exported and imported
+	genesis := k.ExportGenesis(ctx)
+	s.Require().Len(genesis.Approvals, 1)
+	s.Require().NoError(nft.ValidateGenesis(*genesis))
+
+	// expired approvals are ignored
+	s.Require().False(k.IsApprovedOrOwner(ctx.WithBlockTime(expiration), spender, testClassID, testID))
+
+	// the approval is cleared on transfer
+	_, err = msgSrvr.Send(goCtx, send)
+	s.Require().NoError(err)
+	s.Require().Equal(receiver, k.GetOwner(ctx, testClassID, testID))
+	res, err = s.queryClient.Approval(goCtx, &nft.QueryApprovalRequest{ClassId: testClassID, Id: testID})
+	s.Require().NoError(err)
+	s.Require().Nil(res.Approval)
+
+	// revoked approvals no longer allow transfers
+	_, err = msgSrvr.Approve(goCtx, &nft.MsgApprove{Owner: owner.String(), ClassId: testClassID, Id: "kitty2", Spender: spender.String()})
+	s.Require().NoError(err)
+	_, err = msgSrvr.Revoke(goCtx, &nft.MsgRevoke{Owner: spender.String(), ClassId: testClassID, Id: "kitty2"})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = msgSrvr.Revoke(goCtx, &nft.MsgRevoke{Owner: owner.String(), ClassId: testClassID, Id: "kitty2"})
+	s.Require().NoError(err)
+	s.Require().False(k.IsApprovedOrOwner(ctx, spender, testClassID, "kitty2"))
+}
+
+func (s *TestSuite) TestOperatorApprovals() {
+	msgSrvr := keeper.NewMsgServerImpl(s.app.NFTKeeper)
+	ctx := s.ctx.WithBlockTime(time.Now())
+	goCtx := sdk.WrapSDKContext(ctx)
+	owner, operator, receiver := s.addrs[0], s.addrs[1], s.addrs[2]
+	k := s.app.NFTKeeper
+
+	for _, classID := range []string{testClassID, "puppy"} {
+		s.Require().NoError(k.SaveClass(ctx, nft.Class{Id: classID}))
+		s.Require().NoError(k.Mint(ctx, nft.NFT{ClassId: classID, Id: testID}, owner))
+	}
+
+	// approvals for a class only apply to the nfts of the class
+	_, err := msgSrvr.SetApprovalForAll(goCtx, &nft.MsgSetApprovalForAll{
+		Owner:    owner.String(),
+		Operator: operator.String(),
+		ClassId:  testClassID,
+		Approved: true,
+	})
+	s.Require().NoError(err)
+	s.Require().True(k.IsApprovedOrOwner(ctx, operator, testClassID, testID))
+	s.Require().False(k.IsApprovedOrOwner(ctx, operator, "puppy", testID))
+
+	_, err = msgSrvr.SetApprovalForAll(goCtx, &nft.MsgSetApprovalForAll{
+		Owner:    owner.String(),
+		Operator: operator.String(),
+		ClassId:  "unknown",
+		Approved: true,
+	})
+	s.Require().ErrorIs(err, nft.ErrClassNotExists)
+
+	// approvals for all the classes
+	expiration := ctx.BlockTime().Add(time.Hour)
+	_, err = msgSrvr.SetApprovalForAll(goCtx, &nft.MsgSetApprovalForAll{
+		Owner:      owner.String(),
+		Operator:   operator.String(),
+		Approved:   true,
+		Expiration: &expiration,
+	})
+	s.Require().NoError(err)
+	s.Require().True(k.IsApprovedOrOwner(ctx, operator, "puppy", testID))
+	s.Require().False(k.IsApprovedOrOwner(ctx.WithBlockTime(expiration), operator, "puppy", testID))
+
+	res, err := s.queryClient.OperatorApprovals(goCtx, &nft.QueryOperatorApprovalsRequest{Owner: owner.String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.OperatorApprovals, 2)
+	res, err = k.OperatorApprovals(sdk.WrapSDKContext(ctx.WithBlockTime(expiration)), &nft.QueryOperatorApprovalsRequest{Owner: owner.String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.OperatorApprovals, 1)
+
+	genesis := k.ExportGenesis(ctx)
+	s.Require().Len(genesis.OperatorApprovals, 2)
+	s.Require().NoError(nft.ValidateGenesis(*genesis))
+
+	// operators can sell on behalf of the owner, who gets the payment
+	ownerBalance := s.app.BankKeeper.GetBalance(ctx, owner, sdk.DefaultBondDenom)
+	_, err = msgSrvr.SendWithPayment(goCtx, &nft.MsgSendWithPayment{
+		ClassId:  "puppy",
+		Id:       testID,
+		Sender:   operator.String(),
+		Receiver: receiver.String(),
+		Payment:  sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(receiver, k.GetOwner(ctx, "puppy", testID))
+	s.Require().Equal(ownerBalance.AddAmount(sdk.NewInt(100)), s.app.BankKeeper.GetBalance(ctx, owner, sdk.DefaultBondDenom))
+
+	// the approvals of the previous owner don't apply to the new one
+	s.Require().False(k.IsApprovedOrOwner(ctx, operator, "puppy", testID))
+
+	_, err = msgSrvr.SetApprovalForAll(goCtx, &nft.MsgSetApprovalForAll{
+		Owner:    owner.String(),
+		Operator: operator.String(),
+		ClassId:  testClassID,
+	})
+	s.Require().NoError(err)
+	_, err = msgSrvr.Send(goCtx, &nft.MsgSend{ClassId: testClassID, Id: testID, Sender: operator.String(), Receiver: receiver.String()})
+	s.Require().NoError(err, "still approved for all the classes")
+}
@@ -14,6 +14,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *nft.GenesisState) {
 			panic(err)
 		}
 	}
+	for _, policy := range data.RoyaltyPolicies {
+		if err := k.SetRoyaltyPolicy(ctx, *policy); err != nil {
+			panic(err)
+		}
+	}
 	for _, entry := range data.Entries {
 		for _, nft := range entry.Nfts {
 			owner := sdk.MustAccAddressFromBech32(entry.Owner)
@@ -23,6 +28,17 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *nft.GenesisState) {
 			}
 		}
 	}
+	for _, approval := range data.Approvals {
+		owner := k.GetOwner(ctx, approval.ClassId, approval.Id)
+		if err := k.Approve(ctx, owner, *approval); err != nil {
+			panic(err)
+		}
+	}
+	for _, approval := range data.OperatorApprovals {
+		if err := k.SetApprovalForAll(ctx, *approval); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context.
@@ -55,7 +71,10 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *nft.GenesisState {
 		})
 	}
 	return &nft.GenesisState{
-		Classes: classes,
-		Entries: entries,
+		Classes:           classes,
+		Entries:           entries,
+		RoyaltyPolicies:   k.GetRoyaltyPolicies(ctx),
+		Approvals:         k.GetApprovals(ctx),
+		OperatorApprovals: k.GetOperatorApprovals(ctx),
 	}
 }
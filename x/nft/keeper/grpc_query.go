@@ -198,3 +198,88 @@ func (k Keeper) Classes(goCtx context.Context, r *nft.QueryClassesRequest) (*nft
 		Pagination: pageRes,
 	}, nil
 }
+
+// RoyaltyPolicy return the royalty policy of an NFT class, if any
+func (k Keeper) RoyaltyPolicy(goCtx context.Context, r *nft.QueryRoyaltyPolicyRequest) (*nft.QueryRoyaltyPolicyResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if err := nft.ValidateClassID(r.ClassId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.HasClass(ctx, r.ClassId) {
+		return nil, nft.ErrClassNotExists.Wrapf("not found class: %s", r.ClassId)
+	}
+
+	policy, has := k.GetRoyaltyPolicy(ctx, r.ClassId)
+	if !has {
+		return &nft.QueryRoyaltyPolicyResponse{}, nil
+	}
+	return &nft.QueryRoyaltyPolicyResponse{RoyaltyPolicy: &policy}, nil
+}
+
+// Approval return the approval of an NFT, if any
+func (k Keeper) Approval(goCtx context.Context, r *nft.QueryApprovalRequest) (*nft.QueryApprovalResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if err := nft.ValidateClassID(r.ClassId); err != nil {
+		return nil, err
+	}
+	if err := nft.ValidateNFTID(r.Id); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.HasNFT(ctx, r.ClassId, r.Id) {
+		return nil, nft.ErrNFTNotExists.Wrapf("not found nft: class: %s, id: %s", r.ClassId, r.Id)
+	}
+
+	approval, has := k.GetApproval(ctx, r.ClassId, r.Id)
+	if !has {
+		return &nft.QueryApprovalResponse{}, nil
+	}
+	return &nft.QueryApprovalResponse{Approval: &approval}, nil
+}
+
+// OperatorApprovals return the operator approvals of an owner, expired approvals are ignored
+func (k Keeper) OperatorApprovals(goCtx context.Context, r *nft.QueryOperatorApprovalsRequest) (*nft.QueryOperatorApprovalsResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(r.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := ctx.KVStore(k.storeKey)
+	approvalStore := prefix.NewStore(store, prefixOperatorApprovalStoreKey(owner))
+
+	var approvals []*nft.OperatorApproval
+	pageRes, err := query.FilteredPaginate(approvalStore, r.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var approval nft.OperatorApproval
+		if err := k.cdc.Unmarshal(value, &approval); err != nil {
+			return false, err
+		}
+		if approval.IsExpired(ctx.BlockTime()) {
+			return false, nil
+		}
+		if accumulate {
+			approvals = append(approvals, &approval)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &nft.QueryOperatorApprovalsResponse{
+		OperatorApprovals: approvals,
+		Pagination:        pageRes,
+	}, nil
+}
@@ -11,6 +11,8 @@ type Keeper struct {
 	cdc      codec.BinaryCodec
 	storeKey storetypes.StoreKey
 	bk       nft.BankKeeper
+
+	transferRestriction *transferRestriction
 }
 
 // NewKeeper creates a new nft Keeper instance
@@ -26,5 +28,7 @@ func NewKeeper(key storetypes.StoreKey,
 		cdc:      cdc,
 		storeKey: key,
 		bk:       bk,
+
+		transferRestriction: newTransferRestriction(),
 	}
 }
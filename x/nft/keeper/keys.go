@@ -15,6 +15,9 @@ var (
 	NFTOfClassByOwnerKey = []byte{0x03}
 	OwnerKey             = []byte{0x04}
 	ClassTotalSupply     = []byte{0x05}
+	RoyaltyPolicyKey     = []byte{0x06}
+	ApprovalKey          = []byte{0x07}
+	OperatorApprovalKey  = []byte{0x08}
 
 	Delimiter   = []byte{0x00}
 	Placeholder = []byte{0x01}
@@ -48,6 +51,55 @@ func classTotalSupply(classID string) []byte {
 	return key
 }
 
+// royaltyPolicyStoreKey returns the byte representation of the royalty policy key
+func royaltyPolicyStoreKey(classID string) []byte {
+	key := make([]byte, len(RoyaltyPolicyKey)+len(classID))
+	copy(key, RoyaltyPolicyKey)
+	copy(key[len(RoyaltyPolicyKey):], classID)
+	return key
+}
+
+// approvalStoreKey returns the byte representation of the nft approval key
+// Items are stored with the following key: values
+// 0x07<classID><Delimiter(1 Byte)><nftID>
+func approvalStoreKey(classID, nftID string) []byte {
+	classIDBz := conv.UnsafeStrToBytes(classID)
+	nftIDBz := conv.UnsafeStrToBytes(nftID)
+
+	key := make([]byte, len(ApprovalKey)+len(classIDBz)+len(Delimiter)+len(nftIDBz))
+	copy(key, ApprovalKey)
+	copy(key[len(ApprovalKey):], classIDBz)
+	copy(key[len(ApprovalKey)+len(classIDBz):], Delimiter)
+	copy(key[len(ApprovalKey)+len(classIDBz)+len(Delimiter):], nftIDBz)
+	return key
+}
+
+// operatorApprovalStoreKey returns the byte representation of the operator approval key
+// Items are stored with the following key: values
+// 0x08<owner><operator><classID>, the classID being empty for the approvals of all the classes
+func operatorApprovalStoreKey(owner, operator sdk.AccAddress, classID string) []byte {
+	prefix := prefixOperatorApprovalStoreKey(owner)
+	operator = address.MustLengthPrefix(operator)
+
+	key := make([]byte, len(prefix)+len(operator)+len(classID))
+	copy(key, prefix)
+	copy(key[len(prefix):], operator)
+	copy(key[len(prefix)+len(operator):], classID)
+	return key
+}
+
+// prefixOperatorApprovalStoreKey returns the prefix of the result of the method operatorApprovalStoreKey
+// Items are stored with the following key: values
+// 0x08<owner>
+func prefixOperatorApprovalStoreKey(owner sdk.AccAddress) []byte {
+	owner = address.MustLengthPrefix(owner)
+
+	key := make([]byte, len(OperatorApprovalKey)+len(owner))
+	copy(key, OperatorApprovalKey)
+	copy(key[len(OperatorApprovalKey):], owner)
+	return key
+}
+
 // nftOfClassByOwnerStoreKey returns the byte representation of the nft owner
 // Items are stored with the following key: values
 // 0x03<owner><Delimiter(1 Byte)><classID><Delimiter(1 Byte)>
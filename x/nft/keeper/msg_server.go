@@ -8,19 +8,186 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
-var _ nft.MsgServer = Keeper{}
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the nft MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(k Keeper) nft.MsgServer {
+	return &msgServer{Keeper: k}
+}
+
+var _ nft.MsgServer = msgServer{}
 
 // Send implement Send method of the types.MsgServer.
-func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendResponse, error) {
+func (k msgServer) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	sender, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
 		return nil, err
 	}
 
-	owner := k.GetOwner(ctx, msg.ClassId, msg.Id)
-	if !owner.Equals(sender) {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", sender, msg.Id)
+	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.TransferFrom(ctx, sender, msg.ClassId, msg.Id, receiver); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventSend{
+		ClassId:  msg.ClassId,
+		Id:       msg.Id,
+		Sender:   msg.Sender,
+		Receiver: msg.Receiver,
+	})
+	return &nft.MsgSendResponse{}, nil
+}
+
+// CreateClass implement CreateClass method of the types.MsgServer.
+func (k msgServer) CreateClass(goCtx context.Context, msg *nft.MsgCreateClass) (*nft.MsgCreateClassResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	class := *msg.Class
+	class.Issuer = msg.Issuer
+	if err := k.SaveClass(ctx, class); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventCreateClass{
+		ClassId: class.Id,
+		Issuer:  class.Issuer,
+	})
+	return &nft.MsgCreateClassResponse{}, nil
+}
+
+// Mint implement Mint method of the types.MsgServer.
+func (k msgServer) Mint(goCtx context.Context, msg *nft.MsgMint) (*nft.MsgMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	class, has := k.GetClass(ctx, msg.Nft.ClassId)
+	if !has {
+		return nil, sdkerrors.Wrap(nft.ErrClassNotExists, msg.Nft.ClassId)
+	}
+
+	if class.Issuer == "" || (!class.OpenMint && class.Issuer != msg.Minter) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s cannot mint nfts of class %s", msg.Minter, class.Id)
+	}
+
+	receiver := msg.Receiver
+	if receiver == "" {
+		receiver = msg.Minter
+	}
+	receiverAddr, err := sdk.AccAddressFromBech32(receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.Mint(ctx, *msg.Nft, receiverAddr); err != nil {
+		return nil, err
+	}
+	return &nft.MsgMintResponse{}, nil
+}
+
+// Burn implement Burn method of the types.MsgServer.
+func (k msgServer) Burn(goCtx context.Context, msg *nft.MsgBurn) (*nft.MsgBurnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	class, has := k.GetClass(ctx, msg.ClassId)
+	if !has {
+		return nil, sdkerrors.Wrap(nft.ErrClassNotExists, msg.ClassId)
+	}
+
+	if !class.Burnable {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "nfts of class %s are not burnable", msg.ClassId)
+	}
+
+	if !k.GetOwner(ctx, msg.ClassId, msg.Id).Equals(owner) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", owner, msg.Id)
+	}
+
+	if err := k.Keeper.Burn(ctx, msg.ClassId, msg.Id); err != nil {
+		return nil, err
+	}
+	return &nft.MsgBurnResponse{}, nil
+}
+
+// UpdateNFT implement UpdateNFT method of the types.MsgServer.
+func (k msgServer) UpdateNFT(goCtx context.Context, msg *nft.MsgUpdateNFT) (*nft.MsgUpdateNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	class, has := k.GetClass(ctx, msg.Nft.ClassId)
+	if !has {
+		return nil, sdkerrors.Wrap(nft.ErrClassNotExists, msg.Nft.ClassId)
+	}
+
+	if class.Issuer == "" || class.Issuer != msg.Issuer {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the issuer of class %s", msg.Issuer, class.Id)
+	}
+
+	if err := k.Update(ctx, *msg.Nft); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventUpdateNFT{
+		ClassId: msg.Nft.ClassId,
+		Id:      msg.Nft.Id,
+	})
+	return &nft.MsgUpdateNFTResponse{}, nil
+}
+
+// SetRoyaltyPolicy implement SetRoyaltyPolicy method of the types.MsgServer.
+func (k msgServer) SetRoyaltyPolicy(goCtx context.Context, msg *nft.MsgSetRoyaltyPolicy) (*nft.MsgSetRoyaltyPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	class, has := k.GetClass(ctx, msg.ClassId)
+	if !has {
+		return nil, sdkerrors.Wrap(nft.ErrClassNotExists, msg.ClassId)
+	}
+
+	if class.Issuer == "" || class.Issuer != msg.Issuer {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the issuer of class %s", msg.Issuer, class.Id)
+	}
+
+	if msg.BasisPoints == 0 {
+		k.DeleteRoyaltyPolicy(ctx, msg.ClassId)
+	} else {
+		err := k.Keeper.SetRoyaltyPolicy(ctx, nft.RoyaltyPolicy{
+			ClassId:     msg.ClassId,
+			Recipient:   msg.Recipient,
+			BasisPoints: msg.BasisPoints,
+			Denom:       msg.Denom,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventSetRoyaltyPolicy{
+		ClassId:     msg.ClassId,
+		Recipient:   msg.Recipient,
+		BasisPoints: msg.BasisPoints,
+		Denom:       msg.Denom,
+	})
+	return &nft.MsgSetRoyaltyPolicyResponse{}, nil
+}
+
+// SendWithPayment implement SendWithPayment method of the types.MsgServer.
+func (k msgServer) SendWithPayment(goCtx context.Context, msg *nft.MsgSendWithPayment) (*nft.MsgSendWithPaymentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if !k.IsApprovedOrOwner(ctx, sender, msg.ClassId, msg.Id) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s nor approved to transfer it", sender, msg.Id)
 	}
 
 	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
@@ -28,7 +195,8 @@ func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespo
 		return nil, err
 	}
 
-	if err := k.Transfer(ctx, msg.ClassId, msg.Id, receiver); err != nil {
+	royalty, err := k.TransferWithPayment(ctx, msg.ClassId, msg.Id, receiver, msg.Payment)
+	if err != nil {
 		return nil, err
 	}
 
@@ -38,5 +206,95 @@ func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespo
 		Sender:   msg.Sender,
 		Receiver: msg.Receiver,
 	})
-	return &nft.MsgSendResponse{}, nil
+	return &nft.MsgSendWithPaymentResponse{Royalty: royalty}, nil
+}
+
+// Approve implement Approve method of the types.MsgServer.
+func (k msgServer) Approve(goCtx context.Context, msg *nft.MsgApprove) (*nft.MsgApproveResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Expiration != nil && !msg.Expiration.After(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrap(nft.ErrInvalidApproval, "expiration must be after the current block time")
+	}
+
+	err = k.Keeper.Approve(ctx, owner, nft.Approval{
+		ClassId:    msg.ClassId,
+		Id:         msg.Id,
+		Spender:    msg.Spender,
+		Expiration: msg.Expiration,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventApprove{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Owner:   msg.Owner,
+		Spender: msg.Spender,
+	})
+	return &nft.MsgApproveResponse{}, nil
+}
+
+// SetApprovalForAll implement SetApprovalForAll method of the types.MsgServer.
+func (k msgServer) SetApprovalForAll(goCtx context.Context, msg *nft.MsgSetApprovalForAll) (*nft.MsgSetApprovalForAllResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Approved {
+		if msg.Expiration != nil && !msg.Expiration.After(ctx.BlockTime()) {
+			return nil, sdkerrors.Wrap(nft.ErrInvalidApproval, "expiration must be after the current block time")
+		}
+
+		err = k.Keeper.SetApprovalForAll(ctx, nft.OperatorApproval{
+			Owner:      msg.Owner,
+			Operator:   msg.Operator,
+			ClassId:    msg.ClassId,
+			Expiration: msg.Expiration,
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		k.DeleteApprovalForAll(ctx, owner, operator, msg.ClassId)
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventSetApprovalForAll{
+		Owner:    msg.Owner,
+		Operator: msg.Operator,
+		ClassId:  msg.ClassId,
+		Approved: msg.Approved,
+	})
+	return &nft.MsgSetApprovalForAllResponse{}, nil
+}
+
+// Revoke implement Revoke method of the types.MsgServer.
+func (k msgServer) Revoke(goCtx context.Context, msg *nft.MsgRevoke) (*nft.MsgRevokeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.Revoke(ctx, owner, msg.ClassId, msg.Id); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventRevoke{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Owner:   msg.Owner,
+	})
+	return &nft.MsgRevokeResponse{}, nil
 }
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/cosmos/cosmos-sdk/x/nft/keeper"
+)
+
+func (s *TestSuite) TestMsgCreateClassAndMint() {
+	msgSrvr := keeper.NewMsgServerImpl(s.app.NFTKeeper)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	issuer, minter, receiver := s.addrs[0], s.addrs[1], s.addrs[2]
+
+	_, err := msgSrvr.CreateClass(goCtx, &nft.MsgCreateClass{
+		Issuer: issuer.String(),
+		Class:  &nft.Class{Id: testClassID, Name: testClassName},
+	})
+	s.Require().NoError(err)
+	class, has := s.app.NFTKeeper.GetClass(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().Equal(issuer.String(), class.Issuer)
+
+	_, err = msgSrvr.CreateClass(goCtx, &nft.MsgCreateClass{
+		Issuer: minter.String(),
+		Class:  &nft.Class{Id: testClassID},
+	})
+	s.Require().ErrorIs(err, nft.ErrClassExists)
+
+	// only the issuer can mint
+	token := &nft.NFT{ClassId: testClassID, Id: testID, Uri: testURI}
+	_, err = msgSrvr.Mint(goCtx, &nft.MsgMint{Minter: minter.String(), Nft: token})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgSrvr.Mint(goCtx, &nft.MsgMint{Minter: issuer.String(), Nft: token, Receiver: receiver.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(receiver, s.app.NFTKeeper.GetOwner(s.ctx, testClassID, testID))
+
+	// anyone can mint in open mint classes, to themselves by default
+	_, err = msgSrvr.CreateClass(goCtx, &nft.MsgCreateClass{
+		Issuer: issuer.String(),
+		Class:  &nft.Class{Id: "open", OpenMint: true},
+	})
+	s.Require().NoError(err)
+	_, err = msgSrvr.Mint(goCtx, &nft.MsgMint{Minter: minter.String(), Nft: &nft.NFT{ClassId: "open", Id: testID}})
+	s.Require().NoError(err)
+	s.Require().Equal(minter, s.app.NFTKeeper.GetOwner(s.ctx, "open", testID))
+
+	// classes without issuer can't be minted with Msg/Mint
+	err = s.app.NFTKeeper.SaveClass(s.ctx, nft.Class{Id: "module", OpenMint: true})
+	s.Require().NoError(err)
+	_, err = msgSrvr.Mint(goCtx, &nft.MsgMint{Minter: minter.String(), Nft: &nft.NFT{ClassId: "module", Id: testID}})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgSrvr.Mint(goCtx, &nft.MsgMint{Minter: issuer.String(), Nft: &nft.NFT{ClassId: "unknown", Id: testID}})
+	s.Require().ErrorIs(err, nft.ErrClassNotExists)
+}
+
+func (s *TestSuite) TestMsgBurnUpdateAndSend() {
+	msgSrvr := keeper.NewMsgServerImpl(s.app.NFTKeeper)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	issuer, owner, other := s.addrs[0], s.addrs[1], s.addrs[2]
+
+	for _, class := range []*nft.Class{
+		{Id: "burnable", Burnable: true, NonTransferable: true},
+		{Id: "locked"},
+	} {
+		_, err := msgSrvr.CreateClass(goCtx, &nft.MsgCreateClass{Issuer: issuer.String(), Class: class})
+		s.Require().NoError(err)
+		_, err = msgSrvr.Mint(goCtx, &nft.MsgMint{
+			Minter:   issuer.String(),
+			Nft:      &nft.NFT{ClassId: class.Id, Id: testID},
+			Receiver: owner.String(),
+		})
+		s.Require().NoError(err)
+	}
+
+	// transfers
+	_, err := msgSrvr.Send(goCtx, &nft.MsgSend{ClassId: "burnable", Id: testID, Sender: owner.String(), Receiver: other.String()})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = msgSrvr.Send(goCtx, &nft.MsgSend{ClassId: "locked", Id: testID, Sender: owner.String(), Receiver: other.String()})
+	s.Require().NoError(err)
+	_, err = msgSrvr.Send(goCtx, &nft.MsgSend{ClassId: "locked", Id: testID, Sender: other.String(), Receiver: owner.String()})
+	s.Require().NoError(err)
+
+	// updates by the issuer only
+	_, err = msgSrvr.UpdateNFT(goCtx, &nft.MsgUpdateNFT{Issuer: owner.String(), Nft: &nft.NFT{ClassId: "locked", Id: testID, Uri: testURI}})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = msgSrvr.UpdateNFT(goCtx, &nft.MsgUpdateNFT{Issuer: issuer.String(), Nft: &nft.NFT{ClassId: "locked", Id: testID, Uri: testURI}})
+	s.Require().NoError(err)
+	token, has := s.app.NFTKeeper.GetNFT(s.ctx, "locked", testID)
+	s.Require().True(has)
+	s.Require().Equal(testURI, token.Uri)
+	_, err = msgSrvr.UpdateNFT(goCtx, &nft.MsgUpdateNFT{Issuer: issuer.String(), Nft: &nft.NFT{ClassId: "locked", Id: "kitty2"}})
+	s.Require().ErrorIs(err, nft.ErrNFTNotExists)
+
+	// burns by the owner of burnable nfts only
+	_, err = msgSrvr.Burn(goCtx, &nft.MsgBurn{ClassId: "locked", Id: testID, Owner: owner.String()})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = msgSrvr.Burn(goCtx, &nft.MsgBurn{ClassId: "burnable", Id: testID, Owner: issuer.String()})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = msgSrvr.Burn(goCtx, &nft.MsgBurn{ClassId: "burnable", Id: testID, Owner: owner.String()})
+	s.Require().NoError(err)
+	s.Require().False(s.app.NFTKeeper.HasNFT(s.ctx, "burnable", testID))
+	s.Require().Equal(uint64(0), s.app.NFTKeeper.GetTotalSupply(s.ctx, "burnable"))
+}
@@ -45,6 +45,7 @@ func (k Keeper) Burn(ctx sdk.Context, classID string, nftID string) error {
 	nftStore.Delete([]byte(nftID))
 
 	k.deleteOwner(ctx, classID, nftID, owner)
+	k.deleteApproval(ctx, classID, nftID)
 	k.decrTotalSupply(ctx, classID)
 	ctx.EventManager().EmitTypedEvent(&nft.EventBurn{
 		ClassId: classID,
@@ -69,22 +70,34 @@ func (k Keeper) Update(ctx sdk.Context, token nft.NFT) error {
 }
 
 // Transfer defines a method for sending a nft from one account to another account.
+// The nfts of non transferable classes can't be transferred, and the transfer restrictions are applied
+// before the transfer. The approval of the nft is cleared by the transfer.
 // Note: When the upper module uses this method, it needs to authenticate nft
 func (k Keeper) Transfer(ctx sdk.Context,
 	classID string,
 	nftID string,
 	receiver sdk.AccAddress,
 ) error {
-	if !k.HasClass(ctx, classID) {
+	class, has := k.GetClass(ctx, classID)
+	if !has {
 		return sdkerrors.Wrap(nft.ErrClassNotExists, classID)
 	}
 
+	if class.NonTransferable {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "nfts of class %s are not transferable", classID)
+	}
+
 	if !k.HasNFT(ctx, classID, nftID) {
 		return sdkerrors.Wrap(nft.ErrNFTNotExists, nftID)
 	}
 
 	owner := k.GetOwner(ctx, classID, nftID)
+	if err := k.transferRestriction.apply(ctx, classID, nftID, owner, receiver); err != nil {
+		return err
+	}
+
 	k.deleteOwner(ctx, classID, nftID, owner)
+	k.deleteApproval(ctx, classID, nftID)
 	k.setOwner(ctx, classID, nftID, receiver)
 	return nil
 }
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+// AppendTransferRestriction adds the provided TransferRestrictionFn to run after previously provided restrictions.
+func (k Keeper) AppendTransferRestriction(restriction nft.TransferRestrictionFn) {
+	k.transferRestriction.append(restriction)
+}
+
+// PrependTransferRestriction adds the provided TransferRestrictionFn to run before previously provided restrictions.
+func (k Keeper) PrependTransferRestriction(restriction nft.TransferRestrictionFn) {
+	k.transferRestriction.prepend(restriction)
+}
+
+// ClearTransferRestriction removes the transfer restriction (if there is one).
+// The class transfer restrictions are kept.
+func (k Keeper) ClearTransferRestriction() {
+	k.transferRestriction.clear()
+}
+
+// SetClassTransferRestriction sets the TransferRestrictionFn run for the transfers of the nfts of a class,
+// after the other transfer restrictions. A nil restriction removes it.
+func (k Keeper) SetClassTransferRestriction(classID string, restriction nft.TransferRestrictionFn) {
+	if restriction == nil {
+		delete(k.transferRestriction.classes, classID)
+		return
+	}
+	k.transferRestriction.classes[classID] = restriction
+}
+
+// transferRestriction is a struct that houses the TransferRestrictionFn of all the classes and of each class.
+// It exists so that the restrictions can be updated in the Keeper without needing to have a pointer receiver.
+type transferRestriction struct {
+	fn      nft.TransferRestrictionFn
+	classes map[string]nft.TransferRestrictionFn
+}
+
+// newTransferRestriction creates a new transferRestriction without restrictions.
+func newTransferRestriction() *transferRestriction {
+	return &transferRestriction{
+		classes: make(map[string]nft.TransferRestrictionFn),
+	}
+}
+
+// append adds the provided restriction to this, to be run after the existing function.
+func (r *transferRestriction) append(restriction nft.TransferRestrictionFn) {
+	r.fn = r.fn.Then(restriction)
+}
+
+// prepend adds the provided restriction to this, to be run before the existing function.
+func (r *transferRestriction) prepend(restriction nft.TransferRestrictionFn) {
+	r.fn = restriction.Then(r.fn)
+}
+
+// clear removes the transfer restriction (sets it to nil).
+func (r *transferRestriction) clear() {
+	r.fn = nil
+}
+
+var _ nft.TransferRestrictionFn = transferRestriction{}.apply
+
+// apply applies the transfer restriction, then the restriction of the class, if there are any.
+func (r transferRestriction) apply(ctx sdk.Context, classID, nftID string, fromAddr, toAddr sdk.AccAddress) error {
+	if r.fn != nil {
+		if err := r.fn(ctx, classID, nftID, fromAddr, toAddr); err != nil {
+			return err
+		}
+	}
+	if fn, ok := r.classes[classID]; ok {
+		return fn(ctx, classID, nftID, fromAddr, toAddr)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+// SetRoyaltyPolicy defines a method for setting the royalty policy of an exist nft class
+func (k Keeper) SetRoyaltyPolicy(ctx sdk.Context, policy nft.RoyaltyPolicy) error {
+	if !k.HasClass(ctx, policy.ClassId) {
+		return sdkerrors.Wrap(nft.ErrClassNotExists, policy.ClassId)
+	}
+	if err := policy.ValidateBasic(); err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&policy)
+	if err != nil {
+		return sdkerrors.Wrap(err, "Marshal nft.RoyaltyPolicy failed")
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(royaltyPolicyStoreKey(policy.ClassId), bz)
+	return nil
+}
+
+// DeleteRoyaltyPolicy defines a method for removing the royalty policy of a nft class
+func (k Keeper) DeleteRoyaltyPolicy(ctx sdk.Context, classID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(royaltyPolicyStoreKey(classID))
+}
+
+// GetRoyaltyPolicy returns the royalty policy of the specified class
+func (k Keeper) GetRoyaltyPolicy(ctx sdk.Context, classID string) (nft.RoyaltyPolicy, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(royaltyPolicyStoreKey(classID))
+
+	var policy nft.RoyaltyPolicy
+	if len(bz) == 0 {
+		return policy, false
+	}
+	k.cdc.MustUnmarshal(bz, &policy)
+	return policy, true
+}
+
+// GetRoyaltyPolicies returns the royalty policies of all classes
+func (k Keeper) GetRoyaltyPolicies(ctx sdk.Context) (policies []*nft.RoyaltyPolicy) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, RoyaltyPolicyKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var policy nft.RoyaltyPolicy
+		k.cdc.MustUnmarshal(iterator.Value(), &policy)
+		policies = append(policies, &policy)
+	}
+	return
+}
+
+// TransferWithPayment defines a method for sending a nft to the receiver in exchange for a payment from it.
+// The royalty of the class, if any, is paid to the royalty recipient and the rest of the payment to the owner.
+// Note: When the upper module uses this method, it needs to authenticate nft and payer
+func (k Keeper) TransferWithPayment(ctx sdk.Context,
+	classID string,
+	nftID string,
+	receiver sdk.AccAddress,
+	payment sdk.Coin,
+) (sdk.Coin, error) {
+	if !k.HasNFT(ctx, classID, nftID) {
+		return sdk.Coin{}, sdkerrors.Wrap(nft.ErrNFTNotExists, nftID)
+	}
+
+	owner := k.GetOwner(ctx, classID, nftID)
+	royalty := sdk.NewCoin(payment.Denom, sdk.ZeroInt())
+	if policy, has := k.GetRoyaltyPolicy(ctx, classID); has {
+		var err error
+		if royalty, err = policy.Royalty(payment); err != nil {
+			return sdk.Coin{}, err
+		}
+
+		if royalty.IsPositive() {
+			recipient := sdk.MustAccAddressFromBech32(policy.Recipient)
+			if err := k.bk.SendCoins(ctx, receiver, recipient, sdk.NewCoins(royalty)); err != nil {
+				return sdk.Coin{}, err
+			}
+
+			ctx.EventManager().EmitTypedEvent(&nft.EventPayRoyalty{
+				ClassId:   classID,
+				Id:        nftID,
+				Payer:     receiver.String(),
+				Recipient: policy.Recipient,
+				Amount:    royalty.String(),
+			})
+		}
+	}
+
+	if err := k.bk.SendCoins(ctx, receiver, owner, sdk.NewCoins(payment.Sub(royalty))); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.Transfer(ctx, classID, nftID, receiver); err != nil {
+		return sdk.Coin{}, err
+	}
+	return royalty, nil
+}
@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/cosmos/cosmos-sdk/x/nft/keeper"
+)
+
+func (s *TestSuite) TestTransferRestrictions() {
+	k := s.app.NFTKeeper
+	defer k.ClearTransferRestriction()
+
+	for _, classID := range []string{testClassID, "puppy"} {
+		s.Require().NoError(k.SaveClass(s.ctx, nft.Class{Id: classID}))
+		s.Require().NoError(k.Mint(s.ctx, nft.NFT{ClassId: classID, Id: testID}, s.addrs[0]))
+	}
+
+	var calls []string
+	restriction := func(name string, err error) nft.TransferRestrictionFn {
+		return func(_ sdk.Context, classID, nftID string, fromAddr, toAddr sdk.AccAddress) error {
+			s.Require().Equal(testID, nftID)
+			s.Require().Equal(s.addrs[0], fromAddr)
+			s.Require().Equal(s.addrs[1], toAddr)
+			calls = append(calls, name)
+			return err
+		}
+	}
+
+	k.AppendTransferRestriction(restriction("second", nil))
+	k.PrependTransferRestriction(restriction("first", nil))
+	k.SetClassTransferRestriction(testClassID, restriction("class", errors.New("soulbound")))
+	defer k.SetClassTransferRestriction(testClassID, nil)
+
+	err := k.Transfer(s.ctx, testClassID, testID, s.addrs[1])
+	s.Require().EqualError(err, "soulbound")
+	s.Require().Equal([]string{"first", "second", "class"}, calls)
+	s.Require().Equal(s.addrs[0], k.GetOwner(s.ctx, testClassID, testID))
+
+	// the class restriction doesn't apply to other classes
+	calls = nil
+	err = k.Transfer(s.ctx, "puppy", testID, s.addrs[1])
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"first", "second"}, calls)
+	s.Require().Equal(s.addrs[1], k.GetOwner(s.ctx, "puppy", testID))
+
+	// non transferable classes can't be transferred at all
+	s.Require().NoError(k.SaveClass(s.ctx, nft.Class{Id: "soulbound", NonTransferable: true}))
+	s.Require().NoError(k.Mint(s.ctx, nft.NFT{ClassId: "soulbound", Id: testID}, s.addrs[0]))
+	err = k.Transfer(s.ctx, "soulbound", testID, s.addrs[1])
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+}
+
+func (s *TestSuite) TestRoyalties() {
+	msgSrvr := keeper.NewMsgServerImpl(s.app.NFTKeeper)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	issuer, seller, buyer := s.addrs[0], s.addrs[1], s.addrs[2]
+	bk := s.app.BankKeeper
+
+	_, err := msgSrvr.CreateClass(goCtx, &nft.MsgCreateClass{Issuer: issuer.String(), Class: &nft.Class{Id: testClassID}})
+	s.Require().NoError(err)
+	_, err = msgSrvr.Mint(goCtx, &nft.MsgMint{Minter: issuer.String(), Nft: &nft.NFT{ClassId: testClassID, Id: testID}, Receiver: seller.String()})
+	s.Require().NoError(err)
+
+	// only the issuer can set the royalty policy
+	setPolicy := &nft.MsgSetRoyaltyPolicy{
+		Issuer:      seller.String(),
+		ClassId:     testClassID,
+		Recipient:   issuer.String(),
+		BasisPoints: 250,
+		Denom:       sdk.DefaultBondDenom,
+	}
+	_, err = msgSrvr.SetRoyaltyPolicy(goCtx, setPolicy)
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	setPolicy.Issuer = issuer.String()
+	_, err = msgSrvr.SetRoyaltyPolicy(goCtx, setPolicy)
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.RoyaltyPolicy(goCtx, &nft.QueryRoyaltyPolicyRequest{ClassId: testClassID})
+	s.Require().NoError(err)
+	s.Require().Equal(&nft.RoyaltyPolicy{
+		ClassId:     testClassID,
+		Recipient:   issuer.String(),
+		BasisPoints: 250,
+		Denom:       sdk.DefaultBondDenom,
+	}, res.RoyaltyPolicy)
+
+	// payments must be made in the royalty denom
+	_, err = msgSrvr.SendWithPayment(goCtx, &nft.MsgSendWithPayment{
+		ClassId:  testClassID,
+		Id:       testID,
+		Sender:   seller.String(),
+		Receiver: buyer.String(),
+		Payment:  sdk.NewInt64Coin("atom", 1000),
+	})
+	s.Require().ErrorIs(err, nft.ErrInvalidPayment)
+
+	// only the owner can sell
+	_, err = msgSrvr.SendWithPayment(goCtx, &nft.MsgSendWithPayment{
+		ClassId:  testClassID,
+		Id:       testID,
+		Sender:   issuer.String(),
+		Receiver: buyer.String(),
+		Payment:  sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000),
+	})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	issuerBalance := bk.GetBalance(s.ctx, issuer, sdk.DefaultBondDenom)
+	sellerBalance := bk.GetBalance(s.ctx, seller, sdk.DefaultBondDenom)
+	buyerBalance := bk.GetBalance(s.ctx, buyer, sdk.DefaultBondDenom)
+	sendRes, err := msgSrvr.SendWithPayment(goCtx, &nft.MsgSendWithPayment{
+		ClassId:  testClassID,
+		Id:       testID,
+		Sender:   seller.String(),
+		Receiver: buyer.String(),
+		Payment:  sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 25), sendRes.Royalty)
+	s.Require().Equal(buyer, s.app.NFTKeeper.GetOwner(s.ctx, testClassID, testID))
+	s.Require().Equal(issuerBalance.AddAmount(sdk.NewInt(25)), bk.GetBalance(s.ctx, issuer, sdk.DefaultBondDenom))
+	s.Require().Equal(sellerBalance.AddAmount(sdk.NewInt(975)), bk.GetBalance(s.ctx, seller, sdk.DefaultBondDenom))
+	s.Require().Equal(buyerBalance.SubAmount(sdk.NewInt(1000)), bk.GetBalance(s.ctx, buyer, sdk.DefaultBondDenom))
+
+	// the royalty policy is exported and imported
+	genesis := s.app.NFTKeeper.ExportGenesis(s.ctx)
+	s.Require().Len(genesis.RoyaltyPolicies, 1)
+	s.Require().NoError(nft.ValidateGenesis(*genesis))
+
+	// zero basis points remove the royalty policy, any denom can then be used
+	_, err = msgSrvr.SetRoyaltyPolicy(goCtx, &nft.MsgSetRoyaltyPolicy{Issuer: issuer.String(), ClassId: testClassID})
+	s.Require().NoError(err)
+	res, err = s.queryClient.RoyaltyPolicy(goCtx, &nft.QueryRoyaltyPolicyRequest{ClassId: testClassID})
+	s.Require().NoError(err)
+	s.Require().Nil(res.RoyaltyPolicy)
+
+	sendRes, err = msgSrvr.SendWithPayment(goCtx, &nft.MsgSendWithPayment{
+		ClassId:  testClassID,
+		Id:       testID,
+		Sender:   buyer.String(),
+		Receiver: seller.String(),
+		Payment:  sdk.NewInt64Coin(sdk.DefaultBondDenom, 500),
+	})
+	s.Require().NoError(err)
+	s.Require().True(sendRes.Royalty.IsZero())
+}
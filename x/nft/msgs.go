@@ -10,7 +10,18 @@ const (
 	TypeMsgSend = "send"
 )
 
-var _ sdk.Msg = &MsgSend{}
+var (
+	_ sdk.Msg = &MsgSend{}
+	_ sdk.Msg = &MsgCreateClass{}
+	_ sdk.Msg = &MsgMint{}
+	_ sdk.Msg = &MsgBurn{}
+	_ sdk.Msg = &MsgUpdateNFT{}
+	_ sdk.Msg = &MsgSetRoyaltyPolicy{}
+	_ sdk.Msg = &MsgSendWithPayment{}
+	_ sdk.Msg = &MsgApprove{}
+	_ sdk.Msg = &MsgSetApprovalForAll{}
+	_ sdk.Msg = &MsgRevoke{}
+)
 
 // ValidateBasic implements the Msg.ValidateBasic method.
 func (m MsgSend) ValidateBasic() error {
@@ -39,3 +50,228 @@ func (m MsgSend) GetSigners() []sdk.AccAddress {
 	signer, _ := sdk.AccAddressFromBech32(m.Sender)
 	return []sdk.AccAddress{signer}
 }
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgCreateClass) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Issuer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid issuer address (%s)", m.Issuer)
+	}
+
+	if m.Class == nil {
+		return sdkerrors.Wrap(ErrInvalidClass, "class cannot be empty")
+	}
+
+	if err := ValidateClassID(m.Class.Id); err != nil {
+		return err
+	}
+
+	if m.Class.Issuer != "" && m.Class.Issuer != m.Issuer {
+		return sdkerrors.Wrapf(ErrInvalidClass, "class issuer (%s) is not the signer (%s)", m.Class.Issuer, m.Issuer)
+	}
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgCreateClass) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Issuer)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgMint) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Minter)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid minter address (%s)", m.Minter)
+	}
+
+	if m.Receiver != "" {
+		_, err = sdk.AccAddressFromBech32(m.Receiver)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid receiver address (%s)", m.Receiver)
+		}
+	}
+
+	return validateNFT(m.Nft)
+}
+
+// GetSigners implements Msg
+func (m MsgMint) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Minter)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgBurn) ValidateBasic() error {
+	if err := ValidateClassID(m.ClassId); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidID, "Invalid class id (%s)", m.ClassId)
+	}
+
+	if err := ValidateNFTID(m.Id); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidID, "Invalid nft id (%s)", m.Id)
+	}
+
+	_, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", m.Owner)
+	}
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgBurn) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgUpdateNFT) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Issuer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid issuer address (%s)", m.Issuer)
+	}
+
+	return validateNFT(m.Nft)
+}
+
+// GetSigners implements Msg
+func (m MsgUpdateNFT) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Issuer)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgSetRoyaltyPolicy) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Issuer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid issuer address (%s)", m.Issuer)
+	}
+
+	if m.BasisPoints == 0 {
+		return ValidateClassID(m.ClassId)
+	}
+
+	policy := RoyaltyPolicy{
+		ClassId:     m.ClassId,
+		Recipient:   m.Recipient,
+		BasisPoints: m.BasisPoints,
+		Denom:       m.Denom,
+	}
+	return policy.ValidateBasic()
+}
+
+// GetSigners implements Msg
+func (m MsgSetRoyaltyPolicy) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Issuer)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgSendWithPayment) ValidateBasic() error {
+	send := MsgSend{
+		ClassId:  m.ClassId,
+		Id:       m.Id,
+		Sender:   m.Sender,
+		Receiver: m.Receiver,
+	}
+	if err := send.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if m.Sender == m.Receiver {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "sender and receiver cannot be the same")
+	}
+
+	if !m.Payment.IsValid() || !m.Payment.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidPayment, "Invalid payment (%s)", m.Payment)
+	}
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgSendWithPayment) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	receiver, _ := sdk.AccAddressFromBech32(m.Receiver)
+	return []sdk.AccAddress{sender, receiver}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgApprove) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", m.Owner)
+	}
+
+	if m.Owner == m.Spender {
+		return sdkerrors.Wrap(ErrInvalidApproval, "owner and spender cannot be the same")
+	}
+
+	approval := Approval{
+		ClassId:    m.ClassId,
+		Id:         m.Id,
+		Spender:    m.Spender,
+		Expiration: m.Expiration,
+	}
+	return approval.ValidateBasic()
+}
+
+// GetSigners implements Msg
+func (m MsgApprove) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgSetApprovalForAll) ValidateBasic() error {
+	approval := OperatorApproval{
+		Owner:      m.Owner,
+		Operator:   m.Operator,
+		ClassId:    m.ClassId,
+		Expiration: m.Expiration,
+	}
+	return approval.ValidateBasic()
+}
+
+// GetSigners implements Msg
+func (m MsgSetApprovalForAll) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgRevoke) ValidateBasic() error {
+	if err := ValidateClassID(m.ClassId); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidID, "Invalid class id (%s)", m.ClassId)
+	}
+
+	if err := ValidateNFTID(m.Id); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidID, "Invalid nft id (%s)", m.Id)
+	}
+
+	_, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", m.Owner)
+	}
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgRevoke) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{signer}
+}
+
+func validateNFT(token *NFT) error {
+	if token == nil {
+		return sdkerrors.Wrap(ErrInvalidNFT, "nft cannot be empty")
+	}
+
+	if err := ValidateClassID(token.ClassId); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidID, "Invalid class id (%s)", token.ClassId)
+	}
+
+	if err := ValidateNFTID(token.Id); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidID, "Invalid nft id (%s)", token.Id)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package nft
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// A TransferRestrictionFn can restrict the transfers of nfts, e.g. to make the nfts of a class soulbound.
+type TransferRestrictionFn func(ctx sdk.Context, classID, nftID string, fromAddr, toAddr sdk.AccAddress) error
+
+var _ TransferRestrictionFn = NoOpTransferRestrictionFn
+
+// NoOpTransferRestrictionFn is a no-op TransferRestrictionFn.
+func NoOpTransferRestrictionFn(_ sdk.Context, _, _ string, _, _ sdk.AccAddress) error {
+	return nil
+}
+
+// Then creates a composite restriction that runs this one then the provided second one.
+func (r TransferRestrictionFn) Then(second TransferRestrictionFn) TransferRestrictionFn {
+	return ComposeTransferRestrictions(r, second)
+}
+
+// ComposeTransferRestrictions combines multiple TransferRestrictionFn into one.
+// nil entries are ignored.
+// If all entries are nil, nil is returned.
+// If exactly one entry is not nil, it is returned.
+// Otherwise, a new TransferRestrictionFn is returned that runs the non-nil restrictions in the order they are given.
+// The composition runs each transfer restriction until an error is encountered and returns that error.
+func ComposeTransferRestrictions(restrictions ...TransferRestrictionFn) TransferRestrictionFn {
+	toRun := make([]TransferRestrictionFn, 0, len(restrictions))
+	for _, r := range restrictions {
+		if r != nil {
+			toRun = append(toRun, r)
+		}
+	}
+	switch len(toRun) {
+	case 0:
+		return nil
+	case 1:
+		return toRun[0]
+	}
+	return func(ctx sdk.Context, classID, nftID string, fromAddr, toAddr sdk.AccAddress) error {
+		for _, r := range toRun {
+			if err := r(ctx, classID, nftID, fromAddr, toAddr); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,39 @@
+package nft
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxBasisPoints is the number of basis points of a whole payment.
+const MaxBasisPoints = 10_000
+
+// ValidateBasic performs basic validation of the royalty policy.
+func (p RoyaltyPolicy) ValidateBasic() error {
+	if err := ValidateClassID(p.ClassId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(p.Recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid recipient address (%s)", p.Recipient)
+	}
+
+	if p.BasisPoints == 0 || p.BasisPoints > MaxBasisPoints {
+		return sdkerrors.Wrapf(ErrInvalidRoyaltyPolicy, "basis points must be between 1 and %d", MaxBasisPoints)
+	}
+
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return sdkerrors.Wrap(ErrInvalidRoyaltyPolicy, err.Error())
+	}
+	return nil
+}
+
+// Royalty returns the royalty charged on the payment, rounded down.
+func (p RoyaltyPolicy) Royalty(payment sdk.Coin) (sdk.Coin, error) {
+	if payment.Denom != p.Denom {
+		return sdk.Coin{}, sdkerrors.Wrapf(ErrInvalidPayment, "payments for class %s must be made in %s", p.ClassId, p.Denom)
+	}
+
+	amount := payment.Amount.MulRaw(int64(p.BasisPoints)).QuoRaw(MaxBasisPoints)
+	return sdk.NewCoin(p.Denom, amount), nil
+}
@@ -50,7 +50,8 @@ func (suite *HandlerTestSuite) TestProposalHandler() {
 			"all fields",
 			testProposal(proposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), "1")),
 			func() {
-				maxVals := suite.app.StakingKeeper.MaxValidators(suite.ctx)
+				var maxVals uint32
+				suite.app.GetSubspace(stakingtypes.ModuleName).Get(suite.ctx, stakingtypes.KeyMaxValidators, &maxVals)
 				suite.Require().Equal(uint32(1), maxVals)
 			},
 			false,
@@ -69,7 +70,8 @@ func (suite *HandlerTestSuite) TestProposalHandler() {
 				Value:    `{"min_deposit": [{"denom": "uatom","amount": "64000000"}], "max_deposit_period": "172800000000000"}`,
 			}),
 			func() {
-				depositParams := suite.app.GovKeeper.GetDepositParams(suite.ctx)
+				var depositParams govv1.DepositParams
+				suite.app.GetSubspace(govtypes.ModuleName).Get(suite.ctx, govv1.ParamStoreKeyDepositParams, &depositParams)
 				defaultPeriod := govv1.DefaultPeriod
 				suite.Require().Equal(govv1.DepositParams{
 					MinDeposit:       sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(64000000))),
@@ -74,10 +74,10 @@ func TestIntegrationTestSuite(t *testing.T) {
 	if len(govGenBz) > 0 {
 		cfg.Codec.MustUnmarshalJSON(govGenBz, &govGen)
 	}
-	govGen.DepositParams.MinDeposit = sdk.NewCoins(sdk.NewInt64Coin(cfg.BondDenom, 6))
+	govGen.Params.MinDeposit = sdk.NewCoins(sdk.NewInt64Coin(cfg.BondDenom, 6))
 	fiveSeconds := time.Second * 5
-	govGen.DepositParams.MaxDepositPeriod = &fiveSeconds
-	govGen.VotingParams.VotingPeriod = &fiveSeconds
+	govGen.Params.MaxDepositPeriod = &fiveSeconds
+	govGen.Params.VotingPeriod = &fiveSeconds
 	cfg.GenesisState[gov.ModuleName] = cfg.Codec.MustMarshalJSON(&govGen)
 
 	suite.Run(t, NewIntegrationTestSuite(cfg, &sanctionGen))
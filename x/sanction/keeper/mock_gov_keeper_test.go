@@ -30,11 +30,11 @@ func (k *MockGovKeeper) GetProposal(_ sdk.Context, proposalID uint64) (govv1.Pro
 }
 
 func (k *MockGovKeeper) GetDepositParams(_ sdk.Context) govv1.DepositParams {
-	return govv1.DefaultParams().DepositParams
+	return govv1.DefaultDepositParams()
 }
 
 func (k *MockGovKeeper) GetVotingParams(_ sdk.Context) govv1.VotingParams {
-	return govv1.DefaultParams().VotingParams
+	return govv1.DefaultVotingParams()
 }
 
 func (k *MockGovKeeper) GetProposalID(_ sdk.Context) (uint64, error) {
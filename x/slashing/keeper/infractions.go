@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -76,6 +77,13 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 	if height > minHeight && signInfo.MissedBlocksCounter > maxMissed {
 		validator := k.sk.ValidatorByConsAddr(ctx, consAddr)
 		if validator != nil && !validator.IsJailed() {
+			// Repeat offenders within the infraction window are punished harder
+			repeats := k.countRepeatInfractions(ctx, signInfo)
+			slashFraction := k.SlashFractionDowntime(ctx)
+			if repeatFraction := k.SlashFractionDowntimeRepeat(ctx); repeats > 0 && repeatFraction.IsPositive() {
+				slashFraction = repeatFraction
+			}
+
 			// Downtime confirmed: slash and jail the validator
 			// We need to retrieve the stake distribution which signed the block, so we subtract ValidatorUpdateDelay from the evidence height,
 			// and subtract an additional 1 since this is the LastCommit.
@@ -84,7 +92,7 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 			// That's fine since this is just used to filter unbonding delegations & redelegations.
 			distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
-			coinsBurned := k.sk.Slash(ctx, consAddr, distributionHeight, power, k.SlashFractionDowntime(ctx))
+			coinsBurned := k.sk.Slash(ctx, consAddr, distributionHeight, power, slashFraction)
 			ctx.EventManager().EmitEvent(
 				sdk.NewEvent(
 					types.EventTypeSlash,
@@ -97,7 +105,8 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 			)
 			k.sk.Jail(ctx, consAddr)
 
-			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.DowntimeJailDuration(ctx))
+			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.escalatedDowntimeJailDuration(ctx, repeats))
+			k.recordInfraction(ctx, &signInfo, types.InfractionDowntime, "")
 
 			// We need to reset the counter & array so that the validator won't be immediately slashed for downtime upon rebonding.
 			signInfo.MissedBlocksCounter = 0
@@ -110,8 +119,9 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 				"validator", consAddr.String(),
 				"min_height", minHeight,
 				"threshold", minSignedPerWindow,
-				"slashed", k.SlashFractionDowntime(ctx).String(),
+				"slashed", slashFraction.String(),
 				"jailed_until", signInfo.JailedUntil,
+				"repeats", repeats,
 			)
 		} else {
 			// validator was (a) not found or (b) already jailed so we do not slash
@@ -125,3 +135,69 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 	// Set the updated signing info
 	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
 }
+
+// countRepeatInfractions returns the number of infractions of a validator
+// within the infraction window.
+func (k Keeper) countRepeatInfractions(ctx sdk.Context, signInfo types.ValidatorSigningInfo) int {
+	window := k.InfractionWindow(ctx)
+	if window == 0 {
+		return 0
+	}
+
+	cutoff := ctx.BlockTime().Add(-window)
+	repeats := 0
+	for _, infraction := range signInfo.Infractions {
+		if !infraction.Time.Before(cutoff) {
+			repeats++
+		}
+	}
+	return repeats
+}
+
+// escalatedDowntimeJailDuration returns the downtime jail duration of a
+// validator with the given number of previous infractions within the
+// infraction window. The duration is multiplied by the escalation factor for
+// each of them, up to the max downtime jail duration.
+func (k Keeper) escalatedDowntimeJailDuration(ctx sdk.Context, repeats int) time.Duration {
+	duration := k.DowntimeJailDuration(ctx)
+	maxDuration := k.MaxDowntimeJailDuration(ctx)
+	if repeats == 0 || duration >= maxDuration {
+		return duration
+	}
+
+	factor := k.DowntimeJailEscalationFactor(ctx)
+	maxEscalated := sdk.NewDec(int64(maxDuration))
+	escalated := sdk.NewDec(int64(duration))
+	for i := 0; i < repeats && escalated.LT(maxEscalated); i++ {
+		escalated = escalated.Mul(factor)
+	}
+	if escalated.GT(maxEscalated) {
+		return maxDuration
+	}
+	return time.Duration(escalated.TruncateInt64())
+}
+
+// recordInfraction records an infraction in the history of a validator, set as
+// the reason it is jailed for, and prunes the infractions out of the
+// infraction window.
+func (k Keeper) recordInfraction(ctx sdk.Context, signInfo *types.ValidatorSigningInfo, infractionType types.InfractionType, evidenceType string) {
+	infraction := types.Infraction{
+		Type:         infractionType,
+		EvidenceType: evidenceType,
+		Height:       ctx.BlockHeight(),
+		Time:         ctx.BlockTime(),
+	}
+
+	infractions := []types.Infraction{}
+	if window := k.InfractionWindow(ctx); window > 0 {
+		cutoff := ctx.BlockTime().Add(-window)
+		for _, previous := range signInfo.Infractions {
+			if !previous.Time.Before(cutoff) {
+				infractions = append(infractions, previous)
+			}
+		}
+	}
+
+	signInfo.Infractions = append(infractions, infraction)
+	signInfo.JailReason = &infraction
+}
@@ -79,7 +79,8 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdk.De
 }
 
 // Jail attempts to jail a validator. The slash is delegated to the staking module
-// to make the necessary validator changes.
+// to make the necessary validator changes. No infraction is recorded, use
+// JailWithReason to record the infraction the validator is jailed for.
 func (k Keeper) Jail(ctx sdk.Context, consAddr sdk.ConsAddress) {
 	k.sk.Jail(ctx, consAddr)
 	ctx.EventManager().EmitEvent(
@@ -90,6 +91,27 @@ func (k Keeper) Jail(ctx sdk.Context, consAddr sdk.ConsAddress) {
 	)
 }
 
+// JailWithReason attempts to jail a validator for an infraction, proven by
+// evidence of the given type if any, and records the infraction in the signing
+// info of the validator. The jail is delegated to the staking module to make
+// the necessary validator changes.
+func (k Keeper) JailWithReason(ctx sdk.Context, consAddr sdk.ConsAddress, infractionType types.InfractionType, evidenceType string) {
+	k.sk.Jail(ctx, consAddr)
+
+	if signInfo, found := k.GetValidatorSigningInfo(ctx, consAddr); found {
+		k.recordInfraction(ctx, &signInfo, infractionType, evidenceType)
+		k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlash,
+			sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, infractionType.String()),
+		),
+	)
+}
+
 func (k Keeper) deleteAddrPubkeyRelation(ctx sdk.Context, addr cryptotypes.Address) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.AddrPubkeyRelationKey(addr))
@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/testslashing"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -283,3 +284,133 @@ func TestValidatorDippingInAndOut(t *testing.T) {
 	staking.EndBlocker(ctx, app.StakingKeeper)
 	tstaking.CheckValidator(valAddr, stakingtypes.Unbonding, true)
 }
+
+// Test that repeat downtime infractions within the infraction window escalate
+// the jail duration up to the max downtime jail duration
+func TestDowntimeJailEscalation(t *testing.T) {
+	// initial setup
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(1000, 0)})
+
+	params := testslashing.TestParams()
+	params.SignedBlocksWindow = 100
+	params.DowntimeJailDuration = time.Hour
+	params.InfractionWindow = 24 * time.Hour
+	params.MaxDowntimeJailDuration = 3 * time.Hour
+	params.SlashFractionDowntimeRepeat = sdk.NewDecWithPrec(2, 2)
+	app.SlashingKeeper.SetParams(ctx, params)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(1)
+	addr, val := valAddrs[0], pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	power := int64(100)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	tstaking.CreateValidatorWithValPower(addr, val, power, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	height := int64(0)
+	jailForDowntime := func() {
+		// a full window of blocks signed, then enough blocks missed to be jailed
+		start := height
+		for ; height < start+params.SignedBlocksWindow; height++ {
+			ctx = ctx.WithBlockHeight(height)
+			app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, true)
+		}
+		for ; height < start+params.SignedBlocksWindow+params.SignedBlocksWindow/2+1; height++ {
+			ctx = ctx.WithBlockHeight(height)
+			app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+		}
+		staking.EndBlocker(ctx, app.StakingKeeper)
+		tstaking.CheckValidator(addr, stakingtypes.Unbonding, true)
+	}
+	unjail := func() {
+		info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+		require.True(t, found)
+		ctx = ctx.WithBlockTime(info.JailedUntil)
+		require.NoError(t, app.SlashingKeeper.Unjail(ctx, addr))
+		staking.EndBlocker(ctx, app.StakingKeeper)
+		tstaking.CheckValidator(addr, stakingtypes.Bonded, false)
+	}
+
+	// first infraction is jailed for the downtime jail duration
+	jailForDowntime()
+	info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime().Add(time.Hour), info.JailedUntil)
+	require.Len(t, info.Infractions, 1)
+	require.NotNil(t, info.JailReason)
+	require.Equal(t, types.InfractionDowntime, info.JailReason.Type)
+
+	// the jail reason is cleared on unjail but the infraction is kept
+	unjail()
+	info, _ = app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.Nil(t, info.JailReason)
+	require.Len(t, info.Infractions, 1)
+
+	// second infraction is jailed for twice as long and slashed harder
+	tokensBefore := app.StakingKeeper.Validator(ctx, addr).GetTokens()
+	jailForDowntime()
+	info, _ = app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.Equal(t, ctx.BlockTime().Add(2*time.Hour), info.JailedUntil)
+	require.Len(t, info.Infractions, 2)
+	tokensAfter := app.StakingKeeper.Validator(ctx, addr).GetTokens()
+	require.Equal(t, tokensBefore.Sub(app.StakingKeeper.TokensFromConsensusPower(ctx, 2)), tokensAfter)
+
+	// third infraction is capped to the max downtime jail duration
+	unjail()
+	jailForDowntime()
+	info, _ = app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.Equal(t, ctx.BlockTime().Add(3*time.Hour), info.JailedUntil)
+	require.Len(t, info.Infractions, 3)
+
+	// infractions out of the infraction window are pruned and not escalated
+	unjail()
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(params.InfractionWindow + time.Second))
+	jailForDowntime()
+	info, _ = app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.Equal(t, ctx.BlockTime().Add(time.Hour), info.JailedUntil)
+	require.Len(t, info.Infractions, 1)
+}
+
+// Test that jailing a validator for an infraction proven by evidence records
+// the evidence type as the jail reason
+func TestJailWithReason(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(1000, 0)})
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(1)
+	addr, val := valAddrs[0], pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	tstaking.CreateValidatorWithValPower(addr, val, 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// jailing without a reason records no infraction
+	app.SlashingKeeper.Jail(ctx, consAddr)
+	tstaking.CheckValidator(addr, -1, true)
+	info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Empty(t, info.Infractions)
+	require.Nil(t, info.JailReason)
+	app.StakingKeeper.Unjail(ctx, consAddr)
+
+	ctx = ctx.WithBlockHeight(10)
+	app.SlashingKeeper.JailWithReason(ctx, consAddr, types.InfractionEvidence, "light_client_attack")
+	tstaking.CheckValidator(addr, -1, true)
+
+	info, found = app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, []types.Infraction{{
+		Type:         types.InfractionEvidence,
+		EvidenceType: "light_client_attack",
+		Height:       10,
+		Time:         ctx.BlockTime(),
+	}}, info.Infractions)
+	require.Equal(t, &info.Infractions[0], info.JailReason)
+}
@@ -42,6 +42,32 @@ func (k Keeper) SlashFractionDowntime(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+// InfractionWindow - duration the infractions of a validator are remembered for
+func (k Keeper) InfractionWindow(ctx sdk.Context) (res time.Duration) {
+	k.paramspace.Get(ctx, types.KeyInfractionWindow, &res)
+	return
+}
+
+// DowntimeJailEscalationFactor - factor of the downtime jail duration per
+// previous infraction of a repeat offender
+func (k Keeper) DowntimeJailEscalationFactor(ctx sdk.Context) (res sdk.Dec) {
+	k.paramspace.Get(ctx, types.KeyDowntimeJailEscalationFactor, &res)
+	return
+}
+
+// MaxDowntimeJailDuration - maximum escalated downtime jail duration
+func (k Keeper) MaxDowntimeJailDuration(ctx sdk.Context) (res time.Duration) {
+	k.paramspace.Get(ctx, types.KeyMaxDowntimeJailDuration, &res)
+	return
+}
+
+// SlashFractionDowntimeRepeat - fraction of power slashed for the downtime of
+// a repeat offender
+func (k Keeper) SlashFractionDowntimeRepeat(ctx sdk.Context) (res sdk.Dec) {
+	k.paramspace.Get(ctx, types.KeySlashFractionDowntimeRepeat, &res)
+	return
+}
+
 // GetParams returns the total set of slashing parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramspace.GetParamSet(ctx, &params)
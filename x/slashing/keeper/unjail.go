@@ -56,6 +56,10 @@ func (k Keeper) Unjail(ctx sdk.Context, validatorAddr sdk.ValAddress) error {
 		if ctx.BlockHeader().Time.Before(info.JailedUntil) {
 			return types.ErrValidatorJailed
 		}
+
+		// the infraction stays in the history of the validator
+		info.JailReason = nil
+		k.SetValidatorSigningInfo(ctx, consAddr, info)
 	}
 
 	k.sk.Unjail(ctx, consAddr)
@@ -0,0 +1,20 @@
+package v047
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// MigrateParams performs in-place params migrations from v2 (v0.46) to v3
+// (v0.47). The migration includes:
+//
+// - Set the repeat offender escalation params to their default values, the
+// escalation being disabled by the default infraction window.
+func MigrateParams(ctx sdk.Context, paramspace types.ParamSubspace) error {
+	paramspace.Set(ctx, types.KeyInfractionWindow, types.DefaultInfractionWindow)
+	paramspace.Set(ctx, types.KeyDowntimeJailEscalationFactor, types.DefaultDowntimeJailEscalationFactor)
+	paramspace.Set(ctx, types.KeyMaxDowntimeJailDuration, types.DefaultMaxDowntimeJailDuration)
+	paramspace.Set(ctx, types.KeySlashFractionDowntimeRepeat, types.DefaultSlashFractionDowntimeRepeat)
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	v047slashing "github.com/cosmos/cosmos-sdk/x/slashing/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	storeKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	subspace := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, storeKey, tKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// Set the v0.46 params only.
+	oldParams := types.DefaultParams()
+	oldParams.SignedBlocksWindow = 1000
+	subspace.Set(ctx, types.KeySignedBlocksWindow, oldParams.SignedBlocksWindow)
+	subspace.Set(ctx, types.KeyMinSignedPerWindow, oldParams.MinSignedPerWindow)
+	subspace.Set(ctx, types.KeyDowntimeJailDuration, oldParams.DowntimeJailDuration)
+	subspace.Set(ctx, types.KeySlashFractionDoubleSign, oldParams.SlashFractionDoubleSign)
+	subspace.Set(ctx, types.KeySlashFractionDowntime, oldParams.SlashFractionDowntime)
+	require.False(t, subspace.Has(ctx, types.KeyInfractionWindow))
+
+	// Run migrations.
+	require.NoError(t, v047slashing.MigrateParams(ctx, subspace))
+
+	// Make sure the old params are kept and the new ones are set to defaults.
+	var params types.Params
+	subspace.GetParamSet(ctx, &params)
+	require.Equal(t, oldParams, params)
+}
@@ -82,7 +82,8 @@ func RandomizedGenState(simState *module.SimulationState) {
 
 	params := types.NewParams(
 		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
-		slashFractionDoubleSign, slashFractionDowntime,
+		slashFractionDoubleSign, slashFractionDowntime, types.DefaultInfractionWindow,
+		types.DefaultDowntimeJailEscalationFactor, types.DefaultMaxDowntimeJailDuration, types.DefaultSlashFractionDowntimeRepeat,
 	)
 
 	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{})
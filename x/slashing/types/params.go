@@ -10,14 +10,19 @@ import (
 
 // Default parameter namespace
 const (
-	DefaultSignedBlocksWindow   = int64(100)
-	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+	DefaultSignedBlocksWindow      = int64(100)
+	DefaultDowntimeJailDuration    = 60 * 10 * time.Second
+	DefaultInfractionWindow        = time.Duration(0)
+	DefaultMaxDowntimeJailDuration = 7 * 24 * time.Hour
 )
 
 var (
 	DefaultMinSignedPerWindow      = sdk.NewDecWithPrec(5, 1)
 	DefaultSlashFractionDoubleSign = sdk.NewDec(1).Quo(sdk.NewDec(20))
 	DefaultSlashFractionDowntime   = sdk.NewDec(1).Quo(sdk.NewDec(100))
+
+	DefaultDowntimeJailEscalationFactor = sdk.NewDec(2)
+	DefaultSlashFractionDowntimeRepeat  = sdk.ZeroDec()
 )
 
 // Parameter store keys
@@ -27,6 +32,11 @@ var (
 	KeyDowntimeJailDuration    = []byte("DowntimeJailDuration")
 	KeySlashFractionDoubleSign = []byte("SlashFractionDoubleSign")
 	KeySlashFractionDowntime   = []byte("SlashFractionDowntime")
+
+	KeyInfractionWindow             = []byte("InfractionWindow")
+	KeyDowntimeJailEscalationFactor = []byte("DowntimeJailEscalationFactor")
+	KeyMaxDowntimeJailDuration      = []byte("MaxDowntimeJailDuration")
+	KeySlashFractionDowntimeRepeat  = []byte("SlashFractionDowntimeRepeat")
 )
 
 // ParamKeyTable for slashing module
@@ -37,14 +47,19 @@ func ParamKeyTable() paramtypes.KeyTable {
 // NewParams creates a new Params object
 func NewParams(
 	signedBlocksWindow int64, minSignedPerWindow sdk.Dec, downtimeJailDuration time.Duration,
-	slashFractionDoubleSign, slashFractionDowntime sdk.Dec,
+	slashFractionDoubleSign, slashFractionDowntime sdk.Dec, infractionWindow time.Duration,
+	downtimeJailEscalationFactor sdk.Dec, maxDowntimeJailDuration time.Duration, slashFractionDowntimeRepeat sdk.Dec,
 ) Params {
 	return Params{
-		SignedBlocksWindow:      signedBlocksWindow,
-		MinSignedPerWindow:      minSignedPerWindow,
-		DowntimeJailDuration:    downtimeJailDuration,
-		SlashFractionDoubleSign: slashFractionDoubleSign,
-		SlashFractionDowntime:   slashFractionDowntime,
+		SignedBlocksWindow:           signedBlocksWindow,
+		MinSignedPerWindow:           minSignedPerWindow,
+		DowntimeJailDuration:         downtimeJailDuration,
+		SlashFractionDoubleSign:      slashFractionDoubleSign,
+		SlashFractionDowntime:        slashFractionDowntime,
+		InfractionWindow:             infractionWindow,
+		DowntimeJailEscalationFactor: downtimeJailEscalationFactor,
+		MaxDowntimeJailDuration:      maxDowntimeJailDuration,
+		SlashFractionDowntimeRepeat:  slashFractionDowntimeRepeat,
 	}
 }
 
@@ -56,6 +71,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyDowntimeJailDuration, &p.DowntimeJailDuration, validateDowntimeJailDuration),
 		paramtypes.NewParamSetPair(KeySlashFractionDoubleSign, &p.SlashFractionDoubleSign, validateSlashFractionDoubleSign),
 		paramtypes.NewParamSetPair(KeySlashFractionDowntime, &p.SlashFractionDowntime, validateSlashFractionDowntime),
+		paramtypes.NewParamSetPair(KeyInfractionWindow, &p.InfractionWindow, validateInfractionWindow),
+		paramtypes.NewParamSetPair(KeyDowntimeJailEscalationFactor, &p.DowntimeJailEscalationFactor, validateDowntimeJailEscalationFactor),
+		paramtypes.NewParamSetPair(KeyMaxDowntimeJailDuration, &p.MaxDowntimeJailDuration, validateMaxDowntimeJailDuration),
+		paramtypes.NewParamSetPair(KeySlashFractionDowntimeRepeat, &p.SlashFractionDowntimeRepeat, validateSlashFractionDowntimeRepeat),
 	}
 }
 
@@ -63,7 +82,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 func DefaultParams() Params {
 	return NewParams(
 		DefaultSignedBlocksWindow, DefaultMinSignedPerWindow, DefaultDowntimeJailDuration,
-		DefaultSlashFractionDoubleSign, DefaultSlashFractionDowntime,
+		DefaultSlashFractionDoubleSign, DefaultSlashFractionDowntime, DefaultInfractionWindow,
+		DefaultDowntimeJailEscalationFactor, DefaultMaxDowntimeJailDuration, DefaultSlashFractionDowntimeRepeat,
 	)
 }
 
@@ -140,3 +160,58 @@ func validateSlashFractionDowntime(i interface{}) error {
 
 	return nil
 }
+
+func validateInfractionWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("infraction window cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateDowntimeJailEscalationFactor(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.LT(sdk.OneDec()) {
+		return fmt.Errorf("downtime jail escalation factor must be at least one: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxDowntimeJailDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("max downtime jail duration must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateSlashFractionDowntimeRepeat(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("repeat downtime slash fraction cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("repeat downtime slash fraction too large: %s", v)
+	}
+
+	return nil
+}
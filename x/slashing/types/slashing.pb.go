@@ -30,6 +30,43 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// InfractionType defines the type of an infraction committed by a validator.
+type InfractionType int32
+
+const (
+	// INFRACTION_TYPE_UNSPECIFIED defines an unspecified infraction.
+	InfractionUnspecified InfractionType = 0
+	// INFRACTION_TYPE_DOWNTIME defines a liveness fault.
+	InfractionDowntime InfractionType = 1
+	// INFRACTION_TYPE_DOUBLE_SIGN defines a double sign.
+	InfractionDoubleSign InfractionType = 2
+	// INFRACTION_TYPE_EVIDENCE defines a misbehaviour proven by another type of
+	// evidence submitted to the evidence module.
+	InfractionEvidence InfractionType = 3
+)
+
+var InfractionType_name = map[int32]string{
+	0: "INFRACTION_TYPE_UNSPECIFIED",
+	1: "INFRACTION_TYPE_DOWNTIME",
+	2: "INFRACTION_TYPE_DOUBLE_SIGN",
+	3: "INFRACTION_TYPE_EVIDENCE",
+}
+
+var InfractionType_value = map[string]int32{
+	"INFRACTION_TYPE_UNSPECIFIED": 0,
+	"INFRACTION_TYPE_DOWNTIME":    1,
+	"INFRACTION_TYPE_DOUBLE_SIGN": 2,
+	"INFRACTION_TYPE_EVIDENCE":    3,
+}
+
+func (x InfractionType) String() string {
+	return proto.EnumName(InfractionType_name, int32(x))
+}
+
+func (InfractionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{0}
+}
+
 // ValidatorSigningInfo defines a validator's signing info for monitoring their
 // liveness activity.
 type ValidatorSigningInfo struct {
@@ -48,6 +85,13 @@ type ValidatorSigningInfo struct {
 	// A counter kept to avoid unnecessary array reads.
 	// Note that `Sum(MissedBlocksBitArray)` always equals `MissedBlocksCounter`.
 	MissedBlocksCounter int64 `protobuf:"varint,6,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// The infractions of the validator within the infraction window, the last
+	// infraction being always kept. They are used to escalate the punishment of
+	// repeat offenders.
+	Infractions []Infraction `protobuf:"bytes,7,rep,name=infractions,proto3" json:"infractions"`
+	// The infraction the validator is jailed for, if jailed by the slashing
+	// module.
+	JailReason *Infraction `protobuf:"bytes,8,opt,name=jail_reason,json=jailReason,proto3" json:"jail_reason,omitempty"`
 }
 
 func (m *ValidatorSigningInfo) Reset()      { *m = ValidatorSigningInfo{} }
@@ -124,6 +168,93 @@ func (m *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
 	return 0
 }
 
+func (m *ValidatorSigningInfo) GetInfractions() []Infraction {
+	if m != nil {
+		return m.Infractions
+	}
+	return nil
+}
+
+func (m *ValidatorSigningInfo) GetJailReason() *Infraction {
+	if m != nil {
+		return m.JailReason
+	}
+	return nil
+}
+
+// Infraction defines an infraction committed by a validator.
+type Infraction struct {
+	Type InfractionType `protobuf:"varint,1,opt,name=type,proto3,enum=cosmos.slashing.v1beta1.InfractionType" json:"type,omitempty"`
+	// evidence_type is the type of the evidence of the infraction, for the
+	// infractions proven by evidence.
+	EvidenceType string `protobuf:"bytes,2,opt,name=evidence_type,json=evidenceType,proto3" json:"evidence_type,omitempty"`
+	// height is the block height at which the infraction was punished.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which the infraction was punished.
+	Time time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *Infraction) Reset()         { *m = Infraction{} }
+func (m *Infraction) String() string { return proto.CompactTextString(m) }
+func (*Infraction) ProtoMessage()    {}
+func (*Infraction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{1}
+}
+func (m *Infraction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Infraction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Infraction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Infraction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Infraction.Merge(m, src)
+}
+func (m *Infraction) XXX_Size() int {
+	return m.Size()
+}
+func (m *Infraction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Infraction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Infraction proto.InternalMessageInfo
+
+func (m *Infraction) GetType() InfractionType {
+	if m != nil {
+		return m.Type
+	}
+	return InfractionUnspecified
+}
+
+func (m *Infraction) GetEvidenceType() string {
+	if m != nil {
+		return m.EvidenceType
+	}
+	return ""
+}
+
+func (m *Infraction) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Infraction) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
 // Params represents the parameters used for by the slashing module.
 type Params struct {
 	SignedBlocksWindow      int64                                  `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
@@ -131,13 +262,25 @@ type Params struct {
 	DowntimeJailDuration    time.Duration                          `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
 	SlashFractionDoubleSign github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime"`
+	// infraction_window is the duration the infractions of a validator are
+	// remembered for, a validator punished for downtime with previous infractions
+	// within the window being a repeat offender. Zero disables the escalation.
+	InfractionWindow time.Duration `protobuf:"bytes,6,opt,name=infraction_window,json=infractionWindow,proto3,stdduration" json:"infraction_window"`
+	// downtime_jail_escalation_factor multiplies the downtime jail duration of a
+	// repeat offender for each of its previous infractions within the window.
+	DowntimeJailEscalationFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=downtime_jail_escalation_factor,json=downtimeJailEscalationFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"downtime_jail_escalation_factor"`
+	// max_downtime_jail_duration caps the escalated downtime jail duration.
+	MaxDowntimeJailDuration time.Duration `protobuf:"bytes,8,opt,name=max_downtime_jail_duration,json=maxDowntimeJailDuration,proto3,stdduration" json:"max_downtime_jail_duration"`
+	// slash_fraction_downtime_repeat is the fraction slashed for the downtime of
+	// a repeat offender, slash_fraction_downtime being used if zero.
+	SlashFractionDowntimeRepeat github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=slash_fraction_downtime_repeat,json=slashFractionDowntimeRepeat,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime_repeat"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1078e5d96a74cc52, []int{1}
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -180,8 +323,24 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetInfractionWindow() time.Duration {
+	if m != nil {
+		return m.InfractionWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDowntimeJailDuration() time.Duration {
+	if m != nil {
+		return m.MaxDowntimeJailDuration
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.slashing.v1beta1.InfractionType", InfractionType_name, InfractionType_value)
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
+	proto.RegisterType((*Infraction)(nil), "cosmos.slashing.v1beta1.Infraction")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
 }
 
@@ -190,43 +349,65 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 576 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x1c, 0x8d, 0x1b, 0x08, 0xe1, 0x92, 0xe9, 0x48, 0x89, 0x9b, 0xc1, 0x0e, 0x1d, 0xaa, 0x2c, 0xb1,
-	0x69, 0xd8, 0xd8, 0x08, 0x11, 0x7f, 0x07, 0x2a, 0x87, 0x3f, 0x82, 0xc5, 0x3a, 0xfb, 0xce, 0xce,
-	0x11, 0xfb, 0x2e, 0xf2, 0x9d, 0x49, 0xf9, 0x16, 0x1d, 0x3b, 0x76, 0xe4, 0x03, 0x20, 0xf1, 0x15,
-	0xba, 0x20, 0x55, 0x4c, 0x88, 0xa1, 0xa0, 0x64, 0xe1, 0x63, 0x20, 0xdf, 0xd9, 0x2d, 0x6a, 0xa5,
-	0x4a, 0x9d, 0x12, 0xbf, 0xf7, 0x7e, 0xef, 0xf9, 0xf7, 0xee, 0x0c, 0x76, 0x42, 0x2e, 0x52, 0x2e,
-	0x5c, 0x91, 0x20, 0x31, 0xa3, 0x2c, 0x76, 0x3f, 0xed, 0x06, 0x44, 0xa2, 0xdd, 0x33, 0xc0, 0x59,
-	0x64, 0x5c, 0x72, 0xd8, 0xd5, 0x3a, 0xe7, 0x0c, 0x2e, 0x75, 0xbd, 0x4e, 0xcc, 0x63, 0xae, 0x34,
-	0x6e, 0xf1, 0x4f, 0xcb, 0x7b, 0x56, 0xcc, 0x79, 0x9c, 0x10, 0x57, 0x3d, 0x05, 0x79, 0xe4, 0xe2,
-	0x3c, 0x43, 0x92, 0x72, 0x56, 0xf2, 0xf6, 0x45, 0x5e, 0xd2, 0x94, 0x08, 0x89, 0xd2, 0x45, 0x29,
-	0xd8, 0xd2, 0x79, 0xbe, 0x76, 0x2e, 0xc3, 0xd5, 0xc3, 0xf6, 0xb7, 0x0d, 0xd0, 0x79, 0x8b, 0x12,
-	0x8a, 0x91, 0xe4, 0xd9, 0x94, 0xc6, 0x8c, 0xb2, 0xf8, 0x39, 0x8b, 0x38, 0x1c, 0x81, 0x5b, 0x08,
-	0xe3, 0x8c, 0x08, 0x61, 0x1a, 0x7d, 0x63, 0x70, 0x7b, 0x6c, 0xfe, 0xf8, 0x3a, 0xec, 0x94, 0xb3,
-	0x8f, 0x34, 0x33, 0x95, 0x19, 0x65, 0xb1, 0x57, 0x09, 0xe1, 0x3d, 0xd0, 0x16, 0x12, 0x65, 0xd2,
-	0x9f, 0x11, 0x1a, 0xcf, 0xa4, 0xb9, 0xd1, 0x37, 0x06, 0x75, 0xaf, 0xa5, 0xb0, 0x67, 0x0a, 0x2a,
-	0x24, 0x94, 0x61, 0xb2, 0xef, 0xf3, 0x28, 0x12, 0x44, 0x9a, 0x75, 0x2d, 0x51, 0xd8, 0x2b, 0x05,
-	0xc1, 0xa7, 0xa0, 0xfd, 0x11, 0xd1, 0x84, 0x60, 0x3f, 0x67, 0x92, 0x26, 0xe6, 0x8d, 0xbe, 0x31,
-	0x68, 0x8d, 0x7a, 0x8e, 0xde, 0xd2, 0xa9, 0xb6, 0x74, 0x5e, 0x57, 0x5b, 0x8e, 0x9b, 0xc7, 0xa7,
-	0x76, 0xed, 0xe0, 0xb7, 0x6d, 0x78, 0x2d, 0x3d, 0xf9, 0xa6, 0x18, 0x84, 0x16, 0x00, 0x92, 0xa7,
-	0x81, 0x90, 0x9c, 0x11, 0x6c, 0xde, 0xec, 0x1b, 0x83, 0xa6, 0xf7, 0x1f, 0x02, 0x47, 0x60, 0x33,
-	0xa5, 0x42, 0x10, 0xec, 0x07, 0x09, 0x0f, 0xe7, 0xc2, 0x0f, 0x79, 0xce, 0x24, 0xc9, 0xcc, 0x86,
-	0x7a, 0xa9, 0x3b, 0x9a, 0x1c, 0x2b, 0xee, 0xb1, 0xa6, 0x1e, 0x36, 0x0f, 0x8f, 0xec, 0xda, 0xdf,
-	0x23, 0xdb, 0xd8, 0xfe, 0x5e, 0x07, 0x8d, 0x3d, 0x94, 0xa1, 0x54, 0xc0, 0xfb, 0xa0, 0x23, 0x68,
-	0xcc, 0xce, 0x8d, 0x96, 0x94, 0x61, 0xbe, 0x54, 0xc5, 0xd5, 0x3d, 0xa8, 0x39, 0xed, 0xf3, 0x4e,
-	0x31, 0x10, 0x15, 0xd1, 0xcc, 0x2f, 0xa7, 0x16, 0x24, 0xab, 0x46, 0x8a, 0xca, 0xda, 0x63, 0xa7,
-	0x58, 0xe8, 0xd7, 0xa9, 0xbd, 0x13, 0x53, 0x39, 0xcb, 0x03, 0x27, 0xe4, 0x69, 0x79, 0x6c, 0xe5,
-	0xcf, 0x50, 0xe0, 0xb9, 0x2b, 0x3f, 0x2f, 0x88, 0x70, 0x26, 0x24, 0xf4, 0x60, 0x4a, 0xd9, 0x54,
-	0x79, 0xed, 0x91, 0xac, 0x8c, 0x78, 0x0f, 0xee, 0x62, 0xbe, 0x64, 0xc5, 0x5d, 0xf0, 0x8b, 0x56,
-	0xfc, 0xea, 0xd6, 0xa8, 0xce, 0x5b, 0xa3, 0xad, 0x4b, 0x85, 0x4e, 0x4a, 0x81, 0xee, 0xf3, 0xb0,
-	0xe8, 0xb3, 0x53, 0x59, 0xbc, 0x40, 0x34, 0xa9, 0x78, 0x38, 0x07, 0x3d, 0x75, 0x75, 0xfd, 0x28,
-	0x43, 0x61, 0x81, 0xf8, 0x98, 0xe7, 0x41, 0x42, 0xd4, 0x3e, 0xea, 0xbc, 0xae, 0xbf, 0x42, 0x57,
-	0x39, 0x3e, 0x29, 0x0d, 0x27, 0xca, 0xaf, 0x58, 0x09, 0x46, 0xa0, 0x7b, 0x29, 0x4c, 0xbf, 0x93,
-	0x3a, 0xd2, 0xeb, 0x27, 0x6d, 0x5e, 0x48, 0xd2, 0x66, 0xe3, 0x97, 0x5f, 0x56, 0x96, 0x71, 0xbc,
-	0xb2, 0x8c, 0x93, 0x95, 0x65, 0xfc, 0x59, 0x59, 0xc6, 0xc1, 0xda, 0xaa, 0x9d, 0xac, 0xad, 0xda,
-	0xcf, 0xb5, 0x55, 0xfb, 0x30, 0xbc, 0xd2, 0x7c, 0xff, 0xfc, 0x93, 0x57, 0x39, 0x41, 0x43, 0x95,
-	0xfa, 0xe0, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc7, 0xbe, 0xf2, 0xba, 0x12, 0x04, 0x00, 0x00,
+	// 919 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
+	0x1c, 0xf5, 0xd6, 0xae, 0x93, 0x8c, 0x43, 0x65, 0x06, 0x27, 0xd9, 0xb8, 0x68, 0x6d, 0x52, 0xa9,
+	0x58, 0x48, 0x59, 0x53, 0xc3, 0x01, 0xca, 0xa9, 0x8e, 0x37, 0x65, 0x29, 0x38, 0xd6, 0xda, 0x69,
+	0x55, 0x2e, 0xc3, 0x78, 0x77, 0xbc, 0x19, 0xb2, 0x3b, 0x63, 0xed, 0x8c, 0x9b, 0xf4, 0x1b, 0xa0,
+	0x9c, 0x7a, 0xec, 0x25, 0x52, 0x25, 0x2e, 0x7c, 0x00, 0x3e, 0x00, 0xc7, 0x8a, 0x53, 0xc5, 0x09,
+	0x71, 0x28, 0x28, 0xb9, 0x70, 0xe1, 0x03, 0x70, 0x43, 0x3b, 0xbb, 0x6b, 0xa7, 0xf9, 0x83, 0xd2,
+	0x9c, 0xec, 0xfd, 0xfd, 0xde, 0x7b, 0xbf, 0xdf, 0x9b, 0x37, 0x6b, 0x83, 0xdb, 0x2e, 0x17, 0x21,
+	0x17, 0x4d, 0x11, 0x60, 0xb1, 0x43, 0x99, 0xdf, 0x7c, 0x72, 0x67, 0x48, 0x24, 0xbe, 0x33, 0x2d,
+	0x98, 0xe3, 0x88, 0x4b, 0x0e, 0x57, 0x12, 0x9c, 0x39, 0x2d, 0xa7, 0xb8, 0x6a, 0xc5, 0xe7, 0x3e,
+	0x57, 0x98, 0x66, 0xfc, 0x2d, 0x81, 0x57, 0x0d, 0x9f, 0x73, 0x3f, 0x20, 0x4d, 0xf5, 0x34, 0x9c,
+	0x8c, 0x9a, 0xde, 0x24, 0xc2, 0x92, 0x72, 0x96, 0xf6, 0x6b, 0xa7, 0xfb, 0x92, 0x86, 0x44, 0x48,
+	0x1c, 0x8e, 0x53, 0xc0, 0x6a, 0x32, 0x0f, 0x25, 0xca, 0xe9, 0x70, 0xf5, 0xb0, 0xf6, 0x6b, 0x1e,
+	0x54, 0x1e, 0xe2, 0x80, 0x7a, 0x58, 0xf2, 0xa8, 0x4f, 0x7d, 0x46, 0x99, 0x6f, 0xb3, 0x11, 0x87,
+	0x2d, 0x30, 0x87, 0x3d, 0x2f, 0x22, 0x42, 0xe8, 0x5a, 0x5d, 0x6b, 0x2c, 0xb4, 0xf5, 0xdf, 0x7e,
+	0x5e, 0xaf, 0xa4, 0xdc, 0x7b, 0x49, 0xa7, 0x2f, 0x23, 0xca, 0x7c, 0x27, 0x03, 0xc2, 0x0f, 0xc0,
+	0xa2, 0x90, 0x38, 0x92, 0x68, 0x87, 0x50, 0x7f, 0x47, 0xea, 0xd7, 0xea, 0x5a, 0x23, 0xef, 0x94,
+	0x54, 0xed, 0x4b, 0x55, 0x8a, 0x21, 0x94, 0x79, 0x64, 0x1f, 0xf1, 0xd1, 0x48, 0x10, 0xa9, 0xe7,
+	0x13, 0x88, 0xaa, 0x6d, 0xa9, 0x12, 0xbc, 0x0f, 0x16, 0xbf, 0xc7, 0x34, 0x20, 0x1e, 0x9a, 0x30,
+	0x49, 0x03, 0xbd, 0x50, 0xd7, 0x1a, 0xa5, 0x56, 0xd5, 0x4c, 0x5c, 0x9a, 0x99, 0x4b, 0x73, 0x90,
+	0xb9, 0x6c, 0xcf, 0xbf, 0x7c, 0x5d, 0xcb, 0x3d, 0xfb, 0xb3, 0xa6, 0x39, 0xa5, 0x84, 0xb9, 0x1d,
+	0x13, 0xa1, 0x01, 0x80, 0xe4, 0xe1, 0x50, 0x48, 0xce, 0x88, 0xa7, 0x5f, 0xaf, 0x6b, 0x8d, 0x79,
+	0xe7, 0x44, 0x05, 0xb6, 0xc0, 0x52, 0x48, 0x85, 0x20, 0x1e, 0x1a, 0x06, 0xdc, 0xdd, 0x15, 0xc8,
+	0xe5, 0x13, 0x26, 0x49, 0xa4, 0x17, 0xd5, 0x52, 0xef, 0x25, 0xcd, 0xb6, 0xea, 0x6d, 0x24, 0x2d,
+	0xf8, 0x00, 0x94, 0x28, 0x1b, 0x45, 0xd8, 0x8d, 0xcf, 0x5f, 0xe8, 0x73, 0xf5, 0x7c, 0xa3, 0xd4,
+	0xba, 0x65, 0x5e, 0x10, 0xa8, 0x69, 0x4f, 0xb1, 0xed, 0x42, 0xbc, 0xa4, 0x73, 0x92, 0x0d, 0x3b,
+	0x40, 0xed, 0x8b, 0x22, 0x82, 0x05, 0x67, 0xfa, 0x7c, 0x5d, 0xbb, 0xa4, 0x98, 0x03, 0x62, 0x9e,
+	0xa3, 0x68, 0x77, 0xe7, 0x9f, 0xbf, 0xa8, 0xe5, 0xfe, 0x7e, 0x51, 0xd3, 0xd6, 0x7e, 0xd1, 0x00,
+	0x98, 0x81, 0xe0, 0x17, 0xa0, 0x20, 0x9f, 0x8e, 0x89, 0xca, 0xef, 0x46, 0xeb, 0xc3, 0x4b, 0xe8,
+	0x0e, 0x9e, 0x8e, 0x89, 0xa3, 0x48, 0xf0, 0x16, 0x78, 0x87, 0x3c, 0xa1, 0x1e, 0x61, 0x2e, 0x41,
+	0x4a, 0x25, 0x0e, 0x73, 0xc1, 0x59, 0xcc, 0x8a, 0x31, 0x14, 0x2e, 0x83, 0x62, 0x1a, 0x75, 0x92,
+	0x63, 0xfa, 0x04, 0x3f, 0x03, 0x85, 0xf8, 0x0e, 0xbe, 0x55, 0x74, 0x8a, 0xb1, 0xf6, 0x4f, 0x11,
+	0x14, 0x7b, 0x38, 0xc2, 0xa1, 0x80, 0x1f, 0x83, 0x8a, 0xa0, 0x3e, 0x9b, 0xc5, 0xb3, 0x47, 0x99,
+	0xc7, 0xf7, 0x94, 0x9d, 0xbc, 0x03, 0x93, 0x5e, 0x92, 0xce, 0x23, 0xd5, 0x81, 0x38, 0x0e, 0x94,
+	0xa1, 0x94, 0x35, 0x26, 0x51, 0x46, 0x89, 0x77, 0x5f, 0x6c, 0x9b, 0xf1, 0xac, 0x3f, 0x5e, 0xd7,
+	0x6e, 0xfb, 0x54, 0xee, 0x4c, 0x86, 0xa6, 0xcb, 0xc3, 0xf4, 0x65, 0x48, 0x3f, 0xd6, 0x85, 0xb7,
+	0xdb, 0x8c, 0xcd, 0x0a, 0xb3, 0x43, 0x5c, 0x07, 0x86, 0x94, 0xf5, 0x95, 0x56, 0x8f, 0x44, 0xe9,
+	0x88, 0xc7, 0x60, 0xd9, 0xe3, 0x7b, 0x2c, 0xde, 0x15, 0xa9, 0xec, 0xb2, 0x77, 0x51, 0x9d, 0x40,
+	0xa9, 0xb5, 0x7a, 0xc6, 0x6b, 0x27, 0x05, 0x24, 0x56, 0x9f, 0xc7, 0x56, 0x2b, 0x99, 0xc4, 0x57,
+	0x98, 0x06, 0x59, 0x1f, 0xee, 0x82, 0xaa, 0x8a, 0x06, 0x65, 0x69, 0x20, 0x8f, 0x4f, 0x86, 0x01,
+	0x51, 0x7e, 0xf4, 0xc2, 0x95, 0x2c, 0xac, 0x28, 0xc5, 0xcd, 0x54, 0xb0, 0xa3, 0xf4, 0x62, 0x4b,
+	0x70, 0x04, 0x56, 0xce, 0x0c, 0x4b, 0x76, 0xd2, 0xaf, 0x5f, 0x69, 0xd2, 0xd2, 0xa9, 0x49, 0x89,
+	0x18, 0xec, 0x81, 0x77, 0x67, 0x37, 0x3e, 0x8b, 0xa3, 0x78, 0xf9, 0xa3, 0x2a, 0xcf, 0xd8, 0x69,
+	0x02, 0x13, 0x50, 0x7b, 0x33, 0x01, 0x22, 0x5c, 0x1c, 0x28, 0x1e, 0x1a, 0x61, 0x57, 0xf2, 0x48,
+	0x9f, 0xbb, 0x92, 0x83, 0xf7, 0x4f, 0xa6, 0x62, 0x4d, 0x45, 0x37, 0x95, 0x26, 0xfc, 0x0e, 0x54,
+	0x43, 0xbc, 0x8f, 0x2e, 0x08, 0x7f, 0xfe, 0xf2, 0x8e, 0x56, 0x42, 0xbc, 0xdf, 0x39, 0x2f, 0x7f,
+	0x01, 0x8c, 0x0b, 0x22, 0x41, 0x11, 0x19, 0x13, 0x2c, 0xf5, 0x85, 0x2b, 0xf9, 0xba, 0x79, 0x6e,
+	0x32, 0x8e, 0x92, 0xfc, 0xe8, 0x5f, 0x0d, 0xdc, 0x78, 0xf3, 0xfd, 0x87, 0x77, 0xc1, 0x4d, 0xbb,
+	0xbb, 0xe9, 0xdc, 0xdb, 0x18, 0xd8, 0x5b, 0x5d, 0x34, 0x78, 0xdc, 0xb3, 0xd0, 0x76, 0xb7, 0xdf,
+	0xb3, 0x36, 0xec, 0x4d, 0xdb, 0xea, 0x94, 0x73, 0xd5, 0xd5, 0x83, 0xc3, 0xfa, 0xd2, 0x8c, 0xb4,
+	0xcd, 0xc4, 0x98, 0xb8, 0x74, 0x44, 0x89, 0x07, 0x3f, 0x05, 0xfa, 0x69, 0x6e, 0x67, 0xeb, 0x51,
+	0x77, 0x60, 0x7f, 0x63, 0x95, 0xb5, 0xea, 0xf2, 0xc1, 0x61, 0x1d, 0xce, 0x88, 0xd3, 0x4b, 0xf2,
+	0xf9, 0xd9, 0x89, 0x9d, 0xad, 0xed, 0xf6, 0xd7, 0x16, 0xea, 0xdb, 0xf7, 0xbb, 0xe5, 0x6b, 0x55,
+	0xfd, 0xe0, 0xb0, 0x5e, 0x39, 0x49, 0x9c, 0xde, 0xe3, 0x73, 0x06, 0x5a, 0x0f, 0xed, 0x8e, 0xd5,
+	0xdd, 0xb0, 0xca, 0xf9, 0xd3, 0x03, 0xad, 0xf4, 0xb7, 0xab, 0x5a, 0xf8, 0xe1, 0x47, 0x23, 0xd7,
+	0x7e, 0xf0, 0xd3, 0x91, 0xa1, 0xbd, 0x3c, 0x32, 0xb4, 0x57, 0x47, 0x86, 0xf6, 0xd7, 0x91, 0xa1,
+	0x3d, 0x3b, 0x36, 0x72, 0xaf, 0x8e, 0x8d, 0xdc, 0xef, 0xc7, 0x46, 0xee, 0xdb, 0xf5, 0xff, 0x3d,
+	0xde, 0xfd, 0xd9, 0x9f, 0xbc, 0x3a, 0xe9, 0x61, 0x51, 0x65, 0xfe, 0xc9, 0x7f, 0x03, 0x00, 0x29,
+	0x23, 0xe1, 0xe0, 0x04, 0x08, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -266,6 +447,50 @@ func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
 	if this.MissedBlocksCounter != that1.MissedBlocksCounter {
 		return false
 	}
+	if len(this.Infractions) != len(that1.Infractions) {
+		return false
+	}
+	for i := range this.Infractions {
+		if !this.Infractions[i].Equal(&that1.Infractions[i]) {
+			return false
+		}
+	}
+	if !this.JailReason.Equal(that1.JailReason) {
+		return false
+	}
+	return true
+}
+func (this *Infraction) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Infraction)
+	if !ok {
+		that2, ok := that.(Infraction)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.EvidenceType != that1.EvidenceType {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
 	return true
 }
 func (this *Params) Equal(that interface{}) bool {
@@ -302,6 +527,18 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if this.InfractionWindow != that1.InfractionWindow {
+		return false
+	}
+	if !this.DowntimeJailEscalationFactor.Equal(that1.DowntimeJailEscalationFactor) {
+		return false
+	}
+	if this.MaxDowntimeJailDuration != that1.MaxDowntimeJailDuration {
+		return false
+	}
+	if !this.SlashFractionDowntimeRepeat.Equal(that1.SlashFractionDowntimeRepeat) {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -324,6 +561,32 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.JailReason != nil {
+		{
+			size, err := m.JailReason.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSlashing(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Infractions) > 0 {
+		for iNdEx := len(m.Infractions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Infractions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSlashing(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.MissedBlocksCounter != 0 {
 		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
 		i--
@@ -339,12 +602,12 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x28
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintSlashing(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintSlashing(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x22
 	if m.IndexOffset != 0 {
@@ -367,6 +630,54 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Infraction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Infraction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Infraction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.EvidenceType) > 0 {
+		i -= len(m.EvidenceType)
+		copy(dAtA[i:], m.EvidenceType)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.EvidenceType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -387,6 +698,42 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.SlashFractionDowntimeRepeat.Size()
+		i -= size
+		if _, err := m.SlashFractionDowntimeRepeat.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxDowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDowntimeJailDuration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintSlashing(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.DowntimeJailEscalationFactor.Size()
+		i -= size
+		if _, err := m.DowntimeJailEscalationFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.InfractionWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.InfractionWindow):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintSlashing(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x32
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -407,12 +754,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
-	if err2 != nil {
-		return 0, err2
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n2
-	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i -= n6
+	i = encodeVarintSlashing(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -468,6 +815,37 @@ func (m *ValidatorSigningInfo) Size() (n int) {
 	if m.MissedBlocksCounter != 0 {
 		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
 	}
+	if len(m.Infractions) > 0 {
+		for _, e := range m.Infractions {
+			l = e.Size()
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
+	if m.JailReason != nil {
+		l = m.JailReason.Size()
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	return n
+}
+
+func (m *Infraction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovSlashing(uint64(m.Type))
+	}
+	l = len(m.EvidenceType)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSlashing(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovSlashing(uint64(l))
 	return n
 }
 
@@ -488,6 +866,14 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.InfractionWindow)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.DowntimeJailEscalationFactor.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDowntimeJailDuration)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFractionDowntimeRepeat.Size()
+	n += 1 + l + sovSlashing(uint64(l))
 	return n
 }
 
@@ -668,41 +1054,264 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSlashing(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infractions", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthSlashing
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSlashing
+			m.Infractions = append(m.Infractions, Infraction{})
+			if err := m.Infractions[len(m.Infractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailReason", wireType)
 			}
-			b := dAtA[iNdEx]
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JailReason == nil {
+				m.JailReason = &Infraction{}
+			}
+			if err := m.JailReason.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Infraction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Infraction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Infraction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= InfractionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvidenceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
@@ -869,6 +1478,138 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.InfractionWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailEscalationFactor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DowntimeJailEscalationFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDowntimeJailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxDowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntimeRepeat", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDowntimeRepeat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
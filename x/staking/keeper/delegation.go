@@ -304,18 +304,87 @@ func (k Keeper) SetUnbondingDelegationEntry(
 	ctx sdk.Context, delegatorAddr sdk.AccAddress, validatorAddr sdk.ValAddress,
 	creationHeight int64, minTime time.Time, balance math.Int,
 ) types.UnbondingDelegation {
+	id := k.IncrementUnbondingID(ctx)
+
 	ubd, found := k.GetUnbondingDelegation(ctx, delegatorAddr, validatorAddr)
 	if found {
-		ubd.AddEntry(creationHeight, minTime, balance)
+		ubd.AddEntry(creationHeight, minTime, balance, id)
 	} else {
-		ubd = types.NewUnbondingDelegation(delegatorAddr, validatorAddr, creationHeight, minTime, balance)
+		ubd = types.NewUnbondingDelegation(delegatorAddr, validatorAddr, creationHeight, minTime, balance, id)
 	}
 
 	k.SetUnbondingDelegation(ctx, ubd)
 
+	// add to the index to look up the unbonding delegation by the id of its entry
+	k.SetUnbondingDelegationByUnbondingID(ctx, ubd, id)
+
+	if err := k.AfterUnbondingInitiated(ctx, id); err != nil {
+		k.Logger(ctx).Error("failed to call after unbonding initiated hook", "error", err)
+	}
+
 	return ubd
 }
 
+// TransferUnbonding moves up to wantAmt tokens of the unbonding delegation
+// entries of fromAddr at valAddr to toAddr and returns the moved amount. The
+// moved entries keep their creation height, completion time and unbonding id,
+// so AfterUnbondingInitiated is not called again. Entries put on hold are not
+// moved, and no entry is moved once toAddr reached the maximum number of
+// entries. An entry moved partially is split: its remainder keeps the
+// unbonding id and the moved part gets a new one.
+func (k Keeper) TransferUnbonding(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantAmt math.Int,
+) math.Int {
+	transferred := sdk.ZeroInt()
+	ubdFrom, found := k.GetUnbondingDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return transferred
+	}
+	ubdTo, found := k.GetUnbondingDelegation(ctx, toAddr, valAddr)
+	if !found {
+		ubdTo = types.UnbondingDelegation{DelegatorAddress: toAddr.String(), ValidatorAddress: valAddr.String()}
+	}
+	maxEntries := int(k.MaxEntries(ctx))
+
+	for i := 0; i < len(ubdFrom.Entries) && wantAmt.IsPositive() && len(ubdTo.Entries) < maxEntries; i++ {
+		entry := ubdFrom.Entries[i]
+		amt := sdk.MinInt(entry.Balance, wantAmt)
+		if !amt.IsPositive() || entry.OnHold() {
+			continue
+		}
+
+		if amt.Equal(entry.Balance) {
+			ubdFrom.RemoveEntry(int64(i))
+			i--
+			ubdTo.Entries = append(ubdTo.Entries, entry)
+			k.SetUnbondingDelegationByUnbondingID(ctx, ubdTo, entry.UnbondingId)
+		} else {
+			ubdFrom.Entries[i].Balance = entry.Balance.Sub(amt)
+			ubdFrom.Entries[i].InitialBalance = sdk.MaxInt(entry.InitialBalance.Sub(amt), sdk.ZeroInt())
+
+			id := k.IncrementUnbondingID(ctx)
+			ubdTo.AddEntry(entry.CreationHeight, entry.CompletionTime, amt, id)
+			k.SetUnbondingDelegationByUnbondingID(ctx, ubdTo, id)
+		}
+		k.InsertUBDQueue(ctx, ubdTo, entry.CompletionTime)
+
+		wantAmt = wantAmt.Sub(amt)
+		transferred = transferred.Add(amt)
+	}
+
+	if !transferred.IsPositive() {
+		return transferred
+	}
+	if len(ubdFrom.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubdFrom)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubdFrom)
+	}
+	k.SetUnbondingDelegation(ctx, ubdTo)
+
+	return transferred
+}
+
 // unbonding delegation queue timeslice operations
 
 // GetUBDQueueTimeSlice gets a specific unbonding queue timeslice. A timeslice
@@ -487,16 +556,25 @@ func (k Keeper) SetRedelegationEntry(ctx sdk.Context,
 	minTime time.Time, balance math.Int,
 	sharesSrc, sharesDst sdk.Dec,
 ) types.Redelegation {
+	id := k.IncrementUnbondingID(ctx)
+
 	red, found := k.GetRedelegation(ctx, delegatorAddr, validatorSrcAddr, validatorDstAddr)
 	if found {
-		red.AddEntry(creationHeight, minTime, balance, sharesDst)
+		red.AddEntry(creationHeight, minTime, balance, sharesDst, id)
 	} else {
 		red = types.NewRedelegation(delegatorAddr, validatorSrcAddr,
-			validatorDstAddr, creationHeight, minTime, balance, sharesDst)
+			validatorDstAddr, creationHeight, minTime, balance, sharesDst, id)
 	}
 
 	k.SetRedelegation(ctx, red)
 
+	// add to the index to look up the redelegation by the id of its entry
+	k.SetRedelegationByUnbondingID(ctx, red, id)
+
+	if err := k.AfterUnbondingInitiated(ctx, id); err != nil {
+		k.Logger(ctx).Error("failed to call after unbonding initiated hook", "error", err)
+	}
+
 	return red
 }
 
@@ -843,12 +921,14 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, valAd
 		return nil, err
 	}
 
-	// loop through all the entries and complete unbonding mature entries
+	// loop through all the entries and complete unbonding mature entries,
+	// unless they are put on hold by an external module
 	for i := 0; i < len(ubd.Entries); i++ {
 		entry := ubd.Entries[i]
-		if entry.IsMature(ctxTime) {
+		if entry.IsMature(ctxTime) && !entry.OnHold() {
 			ubd.RemoveEntry(int64(i))
 			i--
+			k.DeleteUnbondingIndex(ctx, entry.UnbondingId)
 
 			// track undelegation only when remaining or truncated shares are non-zero
 			if !entry.Balance.IsZero() {
@@ -947,12 +1027,14 @@ func (k Keeper) CompleteRedelegation(
 	balances := sdk.NewCoins()
 	ctxTime := ctx.BlockHeader().Time
 
-	// loop through all the entries and complete mature redelegation entries
+	// loop through all the entries and complete mature redelegation entries,
+	// unless they are put on hold by an external module
 	for i := 0; i < len(red.Entries); i++ {
 		entry := red.Entries[i]
-		if entry.IsMature(ctxTime) {
+		if entry.IsMature(ctxTime) && !entry.OnHold() {
 			red.RemoveEntry(int64(i))
 			i--
+			k.DeleteUnbondingIndex(ctx, entry.UnbondingId)
 
 			if !entry.InitialBalance.IsZero() {
 				balances = balances.Add(sdk.NewCoin(bondDenom, entry.InitialBalance))
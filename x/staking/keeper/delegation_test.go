@@ -158,6 +158,7 @@ func TestUnbondingDelegation(t *testing.T) {
 		0,
 		time.Unix(0, 0).UTC(),
 		sdk.NewInt(5),
+		0,
 	)
 
 	// set and retrieve a record
@@ -196,6 +197,48 @@ func TestUnbondingDelegation(t *testing.T) {
 	require.Equal(t, 0, len(resUnbonds))
 }
 
+func TestTransferUnbonding(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(delAddrs)
+	completionTime := time.Unix(100, 0).UTC()
+
+	app.StakingKeeper.SetUnbondingDelegationEntry(ctx, delAddrs[0], valAddrs[0], 1, completionTime, sdk.NewInt(5))
+	app.StakingKeeper.SetUnbondingDelegationEntry(ctx, delAddrs[0], valAddrs[0], 2, completionTime, sdk.NewInt(10))
+	require.Equal(t, uint64(2), app.StakingKeeper.GetLastUnbondingID(ctx))
+
+	// require the first entry to be moved with its unbonding id and the second
+	// one to be split
+	transferred := app.StakingKeeper.TransferUnbonding(ctx, delAddrs[0], delAddrs[1], valAddrs[0], sdk.NewInt(8))
+	require.Equal(t, sdk.NewInt(8), transferred)
+
+	ubdFrom, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, ubdFrom.Entries, 1)
+	require.Equal(t, uint64(2), ubdFrom.Entries[0].UnbondingId)
+	require.Equal(t, sdk.NewInt(7), ubdFrom.Entries[0].Balance)
+
+	ubdTo, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[1], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, ubdTo.Entries, 2)
+	require.Equal(t, uint64(1), ubdTo.Entries[0].UnbondingId)
+	require.Equal(t, sdk.NewInt(5), ubdTo.Entries[0].Balance)
+	require.Equal(t, uint64(3), ubdTo.Entries[1].UnbondingId)
+	require.Equal(t, sdk.NewInt(3), ubdTo.Entries[1].Balance)
+	require.Equal(t, completionTime, ubdTo.Entries[1].CompletionTime)
+
+	for _, id := range []uint64{1, 3} {
+		ubd, found := app.StakingKeeper.GetUnbondingDelegationByUnbondingID(ctx, id)
+		require.True(t, found)
+		require.Equal(t, delAddrs[1].String(), ubd.DelegatorAddress)
+	}
+	require.Equal(t, []types.DVPair{
+		{DelegatorAddress: delAddrs[1].String(), ValidatorAddress: valAddrs[0].String()},
+		{DelegatorAddress: delAddrs[1].String(), ValidatorAddress: valAddrs[0].String()},
+	}, app.StakingKeeper.GetUBDQueueTimeSlice(ctx, completionTime))
+}
+
 func TestUnbondDelegation(t *testing.T) {
 	_, app, ctx := createTestInput(t)
 
@@ -625,7 +668,7 @@ func TestGetRedelegationsFromSrcValidator(t *testing.T) {
 
 	rd := types.NewRedelegation(addrDels[0], addrVals[0], addrVals[1], 0,
 		time.Unix(0, 0), sdk.NewInt(5),
-		sdk.NewDec(5))
+		sdk.NewDec(5), 0)
 
 	// set and retrieve a record
 	app.StakingKeeper.SetRedelegation(ctx, rd)
@@ -652,7 +695,7 @@ func TestRedelegation(t *testing.T) {
 
 	rd := types.NewRedelegation(addrDels[0], addrVals[0], addrVals[1], 0,
 		time.Unix(0, 0).UTC(), sdk.NewInt(5),
-		sdk.NewDec(5))
+		sdk.NewDec(5), 0)
 
 	// test shouldn't have and redelegations
 	has := app.StakingKeeper.HasReceivingRedelegation(ctx, addrDels[0], addrVals[1])
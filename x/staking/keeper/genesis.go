@@ -28,9 +28,20 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 	k.SetParams(ctx, data.Params)
 	k.SetLastTotalPower(ctx, data.LastTotalPower)
 
+	// the unbonding indexes and the last unbonding id are rebuilt from the
+	// unbonding ids of the unbonding operations
+	var lastUnbondingID uint64
+
 	for _, validator := range data.Validators {
 		k.SetValidator(ctx, validator)
 
+		for _, id := range validator.UnbondingIds {
+			k.SetValidatorByUnbondingID(ctx, validator, id)
+			if id > lastUnbondingID {
+				lastUnbondingID = id
+			}
+		}
+
 		// Manually set indices for the first time
 		k.SetValidatorByConsAddr(ctx, validator)
 		k.SetValidatorByPowerIndex(ctx, validator)
@@ -79,12 +90,34 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 		}
 	}
 
+	k.SetLastTokenizeShareRecordID(ctx, data.LastTokenizeShareRecordId)
+
+	for _, record := range data.TokenizeShareRecords {
+		if err := k.AddTokenizeShareRecord(ctx, record); err != nil {
+			panic(err)
+		}
+
+		// the liquid shares of the validators are the shares of the tokenized delegations
+		delegation, found := k.GetDelegation(ctx, record.GetModuleAddress(), record.GetValidatorAddr())
+		if !found {
+			panic(fmt.Sprintf("tokenize share record %d has no delegation", record.Id))
+		}
+		k.AddValidatorLiquidShares(ctx, record.GetValidatorAddr(), delegation.Shares)
+	}
+
 	for _, ubd := range data.UnbondingDelegations {
 		k.SetUnbondingDelegation(ctx, ubd)
 
 		for _, entry := range ubd.Entries {
 			k.InsertUBDQueue(ctx, ubd, entry.CompletionTime)
 			notBondedTokens = notBondedTokens.Add(entry.Balance)
+
+			if entry.UnbondingId != 0 {
+				k.SetUnbondingDelegationByUnbondingID(ctx, ubd, entry.UnbondingId)
+				if entry.UnbondingId > lastUnbondingID {
+					lastUnbondingID = entry.UnbondingId
+				}
+			}
 		}
 	}
 
@@ -93,9 +126,18 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 
 		for _, entry := range red.Entries {
 			k.InsertRedelegationQueue(ctx, red, entry.CompletionTime)
+
+			if entry.UnbondingId != 0 {
+				k.SetRedelegationByUnbondingID(ctx, red, entry.UnbondingId)
+				if entry.UnbondingId > lastUnbondingID {
+					lastUnbondingID = entry.UnbondingId
+				}
+			}
 		}
 	}
 
+	k.SetLastUnbondingID(ctx, lastUnbondingID)
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -197,5 +239,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		UnbondingDelegations: unbondingDelegations,
 		Redelegations:        redelegations,
 		Exported:             true,
+
+		TokenizeShareRecords:      k.GetAllTokenizeShareRecords(ctx),
+		LastTokenizeShareRecordId: k.GetLastTokenizeShareRecordID(ctx),
 	}
 }
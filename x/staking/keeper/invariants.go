@@ -3,6 +3,7 @@ package keeper
 import (
 	"bytes"
 	"fmt"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -18,6 +19,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		PositiveDelegationInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "delegator-shares",
 		DelegatorSharesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "tokenize-shares",
+		TokenizeSharesInvariant(k))
 }
 
 // AllInvariants runs all invariants of the staking module.
@@ -38,7 +41,12 @@ func AllInvariants(k Keeper) sdk.Invariant {
 			return res, stop
 		}
 
-		return DelegatorSharesInvariant(k)(ctx)
+		res, stop = DelegatorSharesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return TokenizeSharesInvariant(k)(ctx)
 	}
 }
 
@@ -194,3 +202,63 @@ func DelegatorSharesInvariant(k Keeper) sdk.Invariant {
 		return sdk.FormatInvariant(types.ModuleName, "delegator shares", msg), broken
 	}
 }
+
+// TokenizeSharesInvariant checks that the share tokens of each tokenize share
+// record match the shares of its delegation, and that the liquid shares of
+// each validator add up to the shares of its tokenized delegations.
+func TokenizeSharesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		validatorsLiquidShares := map[string]sdk.Dec{}
+
+		for _, record := range k.GetAllTokenizeShareRecords(ctx) {
+			delegation, found := k.GetDelegation(ctx, record.GetModuleAddress(), record.GetValidatorAddr())
+			if !found {
+				broken = true
+				msg += fmt.Sprintf("\tno delegation for tokenize share record %d\n", record.Id)
+				continue
+			}
+
+			supply := k.bankKeeper.GetSupply(ctx, record.GetShareTokenDenom())
+			if !supply.Amount.Equal(delegation.Shares.TruncateInt()) {
+				broken = true
+				msg += fmt.Sprintf("\tshare tokens of tokenize share record %d: %v, delegation shares: %v\n",
+					record.Id, supply, delegation.Shares)
+			}
+
+			liquidShares, ok := validatorsLiquidShares[record.Validator]
+			if !ok {
+				liquidShares = sdk.ZeroDec()
+			}
+			validatorsLiquidShares[record.Validator] = liquidShares.Add(delegation.Shares)
+		}
+
+		k.IterateValidatorLiquidShares(ctx, func(valAddr sdk.ValAddress, shares sdk.Dec) bool {
+			expected, ok := validatorsLiquidShares[valAddr.String()]
+			if !ok || !expected.Equal(shares) {
+				broken = true
+				msg += fmt.Sprintf("\tliquid shares of validator %s: %v, sum of tokenized delegation shares: %v\n",
+					valAddr, shares, expected)
+			}
+			delete(validatorsLiquidShares, valAddr.String())
+			return false
+		})
+
+		missing := make([]string, 0, len(validatorsLiquidShares))
+		for valAddr := range validatorsLiquidShares {
+			missing = append(missing, valAddr)
+		}
+		sort.Strings(missing)
+		for _, valAddr := range missing {
+			broken = true
+			msg += fmt.Sprintf("\tno liquid shares for validator %s, sum of tokenized delegation shares: %v\n",
+				valAddr, validatorsLiquidShares[valAddr])
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "tokenize shares", msg), broken
+	}
+}
@@ -45,6 +45,7 @@ func TestCancelUnbondingDelegation(t *testing.T) {
 		delegatorAddr, validatorAddr, 10,
 		ctx.BlockTime().Add(time.Minute*10),
 		unbondingAmount.Amount,
+		0,
 	)
 
 	// set and retrieve a record
@@ -144,3 +145,65 @@ func TestCancelUnbondingDelegation(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgUpdateParams(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	authority := app.StakingKeeper.GetAuthority()
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgUpdateParams
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "valid params",
+			input: &types.MsgUpdateParams{
+				Authority: authority,
+				Params:    types.DefaultParams(),
+			},
+			expErr: false,
+		},
+		{
+			name: "invalid authority",
+			input: &types.MsgUpdateParams{
+				Authority: "invalid",
+				Params:    types.DefaultParams(),
+			},
+			expErr:    true,
+			expErrMsg: "expected gov account as only signer for proposal message",
+		},
+		{
+			name: "max validators equal to zero",
+			input: &types.MsgUpdateParams{
+				Authority: authority,
+				Params: types.Params{
+					MinCommissionRate: types.DefaultMinCommissionRate,
+					UnbondingTime:     time.Duration(time.Hour * 24 * 7 * 3),
+					MaxValidators:     0,
+					MaxEntries:        10,
+					HistoricalEntries: 10000,
+					BondDenom:         "denom",
+				},
+			},
+			expErr:    true,
+			expErrMsg: "max validators must be positive",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), tc.input)
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.input.Params, app.StakingKeeper.GetParams(ctx))
+			}
+		})
+	}
+}
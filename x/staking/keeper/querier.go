@@ -511,6 +511,7 @@ func RedelegationsToRedelegationResponses(
 				entry.SharesDst,
 				entry.InitialBalance,
 				val.TokensFromShares(entry.SharesDst).TruncateInt(),
+				entry.UnbondingId,
 			)
 		}
 
@@ -0,0 +1,248 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetLastTokenizeShareRecordID returns the id of the last tokenize share record
+// created.
+func (k Keeper) GetLastTokenizeShareRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastTokenizeShareRecordIDKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastTokenizeShareRecordID sets the id of the last tokenize share record
+// created.
+func (k Keeper) SetLastTokenizeShareRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastTokenizeShareRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetTokenizeShareRecord returns a tokenize share record by its id.
+func (k Keeper) GetTokenizeShareRecord(ctx sdk.Context, id uint64) (record types.TokenizeShareRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetTokenizeShareRecordByIndexKey(id))
+	if bz == nil {
+		return record, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// GetTokenizeShareRecordByDenom returns a tokenize share record by the denom of
+// its share tokens.
+func (k Keeper) GetTokenizeShareRecordByDenom(ctx sdk.Context, denom string) (record types.TokenizeShareRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetTokenizeShareRecordIDByDenomKey(denom))
+	if bz == nil {
+		return record, false
+	}
+
+	return k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(bz))
+}
+
+// GetTokenizeShareRecordsByOwner returns the tokenize share records owned by an
+// address.
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx sdk.Context, owner sdk.AccAddress) (records []types.TokenizeShareRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetTokenizeShareRecordIDsByOwnerPrefix(owner))
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		record, found := k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(iterator.Key()))
+		if !found {
+			panic(fmt.Sprintf("tokenize share record %d indexed by owner %s not found", sdk.BigEndianToUint64(iterator.Key()), owner))
+		}
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// GetAllTokenizeShareRecords returns all the tokenize share records.
+func (k Keeper) GetAllTokenizeShareRecords(ctx sdk.Context) (records []types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.TokenizeShareRecordPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TokenizeShareRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// AddTokenizeShareRecord adds a new tokenize share record, it fails if a record
+// with the same id already exists.
+func (k Keeper) AddTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) error {
+	if _, found := k.GetTokenizeShareRecord(ctx, record.Id); found {
+		return fmt.Errorf("tokenize share record %d already exists", record.Id)
+	}
+
+	k.setTokenizeShareRecord(ctx, record)
+	return nil
+}
+
+// DeleteTokenizeShareRecord deletes a tokenize share record and its indexes.
+func (k Keeper) DeleteTokenizeShareRecord(ctx sdk.Context, id uint64) error {
+	record, found := k.GetTokenizeShareRecord(ctx, id)
+	if !found {
+		return types.ErrTokenizeShareRecordNotExists
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenizeShareRecordByIndexKey(record.Id))
+	store.Delete(types.GetTokenizeShareRecordIDByOwnerAndIDKey(sdk.MustAccAddressFromBech32(record.Owner), record.Id))
+	store.Delete(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()))
+
+	return nil
+}
+
+// TransferTokenizeShareRecord changes the owner of a tokenize share record.
+func (k Keeper) TransferTokenizeShareRecord(ctx sdk.Context, id uint64, newOwner sdk.AccAddress) error {
+	record, found := k.GetTokenizeShareRecord(ctx, id)
+	if !found {
+		return types.ErrTokenizeShareRecordNotExists
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenizeShareRecordIDByOwnerAndIDKey(sdk.MustAccAddressFromBech32(record.Owner), record.Id))
+
+	record.Owner = newOwner.String()
+	k.setTokenizeShareRecord(ctx, record)
+
+	return nil
+}
+
+func (k Keeper) setTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTokenizeShareRecordByIndexKey(record.Id), k.cdc.MustMarshal(&record))
+	store.Set(types.GetTokenizeShareRecordIDByOwnerAndIDKey(sdk.MustAccAddressFromBech32(record.Owner), record.Id), []byte{})
+	store.Set(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()), sdk.Uint64ToBigEndian(record.Id))
+}
+
+// GetValidatorLiquidShares returns the tokenized shares of a validator.
+func (k Keeper) GetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorLiquidSharesKey(valAddr))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	var shares sdk.DecProto
+	k.cdc.MustUnmarshal(bz, &shares)
+	return shares.Dec
+}
+
+// AddValidatorLiquidShares adds to the tokenized shares of a validator, the
+// shares are removed when negative.
+func (k Keeper) AddValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetValidatorLiquidSharesKey(valAddr)
+
+	total := k.GetValidatorLiquidShares(ctx, valAddr).Add(shares)
+	switch {
+	case total.IsNegative():
+		panic(fmt.Sprintf("negative liquid shares for validator %s: %s", valAddr, total))
+	case total.IsZero():
+		store.Delete(key)
+	default:
+		store.Set(key, k.cdc.MustMarshal(&sdk.DecProto{Dec: total}))
+	}
+}
+
+// IterateValidatorLiquidShares iterates over the tokenized shares of the
+// validators, until cb returns true.
+func (k Keeper) IterateValidatorLiquidShares(ctx sdk.Context, cb func(valAddr sdk.ValAddress, shares sdk.Dec) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorLiquidSharesKey)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var shares sdk.DecProto
+		k.cdc.MustUnmarshal(iterator.Value(), &shares)
+
+		// remove the length prefix of the address
+		if cb(sdk.ValAddress(iterator.Key()[1:]), shares.Dec) {
+			break
+		}
+	}
+}
+
+// GetTotalLiquidStakedTokens returns the amount of tokens of all the tokenized
+// delegations, at the current exchange rate of their validators.
+func (k Keeper) GetTotalLiquidStakedTokens(ctx sdk.Context) math.Int {
+	total := sdk.ZeroDec()
+	k.IterateValidatorLiquidShares(ctx, func(valAddr sdk.ValAddress, shares sdk.Dec) bool {
+		if validator, found := k.GetValidator(ctx, valAddr); found {
+			total = total.Add(validator.TokensFromShares(shares))
+		}
+		return false
+	})
+
+	return total.TruncateInt()
+}
+
+// GetBondedLiquidStakedTokens returns the amount of tokens of the tokenized
+// delegations to bonded validators, at the current exchange rate of their
+// validators.
+func (k Keeper) GetBondedLiquidStakedTokens(ctx sdk.Context) math.Int {
+	total := sdk.ZeroDec()
+	k.IterateValidatorLiquidShares(ctx, func(valAddr sdk.ValAddress, shares sdk.Dec) bool {
+		if validator, found := k.GetValidator(ctx, valAddr); found && validator.IsBonded() {
+			total = total.Add(validator.TokensFromShares(shares))
+		}
+		return false
+	})
+
+	return total.TruncateInt()
+}
+
+// checkLiquidStakingCaps returns an error if tokenizing the shares of a
+// validator worth tokens would exceed the global or validator liquid staking
+// caps. The global cap is a fraction of the bonded tokens, so only the
+// tokenized delegations to bonded validators count toward it.
+func (k Keeper) checkLiquidStakingCaps(ctx sdk.Context, validator types.Validator, shares sdk.Dec, tokens math.Int) error {
+	params := k.GetParams(ctx)
+
+	if params.GlobalLiquidStakingCap.LT(sdk.OneDec()) {
+		totalStaked := k.TotalBondedTokens(ctx)
+		liquidStaked := k.GetBondedLiquidStakedTokens(ctx)
+		if validator.IsBonded() {
+			liquidStaked = liquidStaked.Add(tokens)
+		}
+		if !totalStaked.IsPositive() || sdk.NewDecFromInt(liquidStaked).QuoInt(totalStaked).GT(params.GlobalLiquidStakingCap) {
+			return types.ErrGlobalLiquidCapExceeded
+		}
+	}
+
+	if params.ValidatorLiquidStakingCap.LT(sdk.OneDec()) {
+		liquidShares := k.GetValidatorLiquidShares(ctx, validator.GetOperator()).Add(shares)
+		if liquidShares.Quo(validator.DelegatorShares).GT(params.ValidatorLiquidStakingCap) {
+			return types.ErrValidatorLiquidCapExceeded
+		}
+	}
+
+	return nil
+}
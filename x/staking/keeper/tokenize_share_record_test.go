@@ -0,0 +1,287 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func setupTokenizeShares(t *testing.T) (*simapp.SimApp, sdk.Context, types.MsgServer, []sdk.AccAddress, sdk.ValAddress) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, app.StakingKeeper.TokensFromConsensusPower(ctx, 10))
+
+	// create a validator with a 1:1 exchange rate
+	valAddr := sdk.ValAddress(addrs[2])
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddr, PKs[0], 1, true)
+
+	return app, ctx, msgServer, addrs, valAddr
+}
+
+func requireTokenizeSharesInvariant(t *testing.T, app *simapp.SimApp, ctx sdk.Context) {
+	msg, broken := keeper.TokenizeSharesInvariant(app.StakingKeeper)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestTokenizeSharesAndRedeemTokens(t *testing.T) {
+	app, ctx, msgServer, addrs, valAddr := setupTokenizeShares(t)
+	delAddr, ownerAddr := addrs[0], addrs[1]
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	delegated := sdk.NewInt(1000)
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(delAddr, valAddr, sdk.NewCoin(bondDenom, delegated)))
+	require.NoError(t, err)
+
+	res, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewInt64Coin(bondDenom, 400), ownerAddr))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.RecordId)
+
+	record, found := app.StakingKeeper.GetTokenizeShareRecord(ctx, res.RecordId)
+	require.True(t, found)
+	require.Equal(t, types.NewTokenizeShareRecord(1, ownerAddr, valAddr), record)
+	require.Equal(t, []types.TokenizeShareRecord{record}, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, ownerAddr))
+	require.Equal(t, sdk.NewInt64Coin(record.GetShareTokenDenom(), 400), res.Amount)
+	require.Equal(t, res.Amount, app.BankKeeper.GetBalance(ctx, delAddr, record.GetShareTokenDenom()))
+
+	// the tokenized shares are delegated by the module account of the record
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(600), delegation.Shares)
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(400), delegation.Shares)
+	require.Equal(t, sdk.NewDec(400), app.StakingKeeper.GetValidatorLiquidShares(ctx, valAddr))
+	require.Equal(t, sdk.NewInt(400), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+	requireTokenizeSharesInvariant(t, app, ctx)
+
+	// the other modules cannot mint share tokens
+	err = app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(record.GetShareTokenDenom(), 100)))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// share tokens are transferable and can be redeemed by any holder
+	shareCoins := sdk.NewCoins(sdk.NewInt64Coin(record.GetShareTokenDenom(), 100))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, delAddr, ownerAddr, shareCoins))
+
+	redeemRes, err := msgServer.RedeemTokensForShares(sdk.WrapSDKContext(ctx), types.NewMsgRedeemTokensForShares(ownerAddr, shareCoins[0]))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(bondDenom, 100), redeemRes.Amount)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, ownerAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(100), delegation.Shares)
+	require.Equal(t, sdk.NewDec(300), app.StakingKeeper.GetValidatorLiquidShares(ctx, valAddr))
+	requireTokenizeSharesInvariant(t, app, ctx)
+
+	// redeeming the last share tokens deletes the record
+	_, err = msgServer.RedeemTokensForShares(sdk.WrapSDKContext(ctx), types.NewMsgRedeemTokensForShares(delAddr, sdk.NewInt64Coin(record.GetShareTokenDenom(), 300)))
+	require.NoError(t, err)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(900), delegation.Shares)
+	_, found = app.StakingKeeper.GetTokenizeShareRecord(ctx, res.RecordId)
+	require.False(t, found)
+	require.Empty(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, ownerAddr))
+	require.True(t, app.StakingKeeper.GetValidatorLiquidShares(ctx, valAddr).IsZero())
+	require.True(t, app.BankKeeper.GetSupply(ctx, record.GetShareTokenDenom()).IsZero())
+	requireTokenizeSharesInvariant(t, app, ctx)
+
+	// record ids are not reused
+	res, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewInt64Coin(bondDenom, 100), ownerAddr))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.RecordId)
+}
+
+func TestTokenizeSharesFailures(t *testing.T) {
+	app, ctx, msgServer, addrs, valAddr := setupTokenizeShares(t)
+	delAddr := addrs[0]
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(delAddr, valAddr, sdk.NewInt64Coin(bondDenom, 1000)))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgTokenizeShares
+		expErr error
+	}{
+		{
+			"unknown validator",
+			types.NewMsgTokenizeShares(delAddr, sdk.ValAddress(delAddr), sdk.NewInt64Coin(bondDenom, 100), delAddr),
+			types.ErrNoValidatorFound,
+		},
+		{
+			"invalid denom",
+			types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewInt64Coin("other", 100), delAddr),
+			nil,
+		},
+		{
+			"no delegation",
+			types.NewMsgTokenizeShares(addrs[1], valAddr, sdk.NewInt64Coin(bondDenom, 100), delAddr),
+			types.ErrNoDelegation,
+		},
+		{
+			"exceeding delegation",
+			types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewInt64Coin(bondDenom, 1001), delAddr),
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), tc.msg)
+			require.Error(t, err)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+
+	// only the owner can transfer a record
+	res, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewInt64Coin(bondDenom, 100), delAddr))
+	require.NoError(t, err)
+
+	_, err = msgServer.TransferTokenizeShareRecord(sdk.WrapSDKContext(ctx), types.NewMsgTransferTokenizeShareRecord(res.RecordId, addrs[1], addrs[1]))
+	require.ErrorIs(t, err, types.ErrNotTokenizeShareRecordOwner)
+	_, err = msgServer.TransferTokenizeShareRecord(sdk.WrapSDKContext(ctx), types.NewMsgTransferTokenizeShareRecord(res.RecordId+1, delAddr, addrs[1]))
+	require.ErrorIs(t, err, types.ErrTokenizeShareRecordNotExists)
+
+	_, err = msgServer.TransferTokenizeShareRecord(sdk.WrapSDKContext(ctx), types.NewMsgTransferTokenizeShareRecord(res.RecordId, delAddr, addrs[1]))
+	require.NoError(t, err)
+	require.Empty(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, delAddr))
+	require.Len(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, addrs[1]), 1)
+
+	// share tokens of unknown records cannot be redeemed
+	_, err = msgServer.RedeemTokensForShares(sdk.WrapSDKContext(ctx), types.NewMsgRedeemTokensForShares(delAddr, sdk.NewInt64Coin(bondDenom, 100)))
+	require.ErrorIs(t, err, types.ErrTokenizeShareRecordNotExists)
+}
+
+func TestTokenizeSharesVestingAccount(t *testing.T) {
+	app, ctx, msgServer, _, valAddr := setupTokenizeShares(t)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	addr := sdk.AccAddress("vesting_delegator___")
+	coins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))
+	baseAcc := authtypes.NewBaseAccountWithAddress(addr)
+	vestingAcc := vestingtypes.NewContinuousVestingAccount(baseAcc, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 800)), ctx.BlockTime().Unix()+1000, ctx.BlockTime().Unix()+2000)
+	app.AccountKeeper.SetAccount(ctx, vestingAcc)
+	require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, addr, coins))
+
+	// the vesting coins are delegated first
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(addr, valAddr, sdk.NewInt64Coin(bondDenom, 1000)))
+	require.NoError(t, err)
+
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(addr, valAddr, sdk.NewInt64Coin(bondDenom, 201), addr))
+	require.ErrorIs(t, err, types.ErrExceedingFreeVestingDelegations)
+
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(addr, valAddr, sdk.NewInt64Coin(bondDenom, 200), addr))
+	require.NoError(t, err)
+	requireTokenizeSharesInvariant(t, app, ctx)
+}
+
+func TestTokenizeSharesLiquidStakingCaps(t *testing.T) {
+	app, ctx, msgServer, addrs, valAddr := setupTokenizeShares(t)
+	delAddr := addrs[0]
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	delegated := app.StakingKeeper.TokensFromConsensusPower(ctx, 5)
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(delAddr, valAddr, sdk.NewCoin(bondDenom, delegated)))
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.True(t, validator.IsBonded())
+	totalBonded := app.StakingKeeper.TotalBondedTokens(ctx)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorLiquidStakingCap = sdk.NewDecWithPrec(5, 1)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// more than half of the validator shares cannot be tokenized
+	overCap := validator.Tokens.QuoRaw(2).Add(validator.Tokens.QuoRaw(100))
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewCoin(bondDenom, overCap), delAddr))
+	require.ErrorIs(t, err, types.ErrValidatorLiquidCapExceeded)
+
+	params.ValidatorLiquidStakingCap = sdk.OneDec()
+	params.GlobalLiquidStakingCap = sdk.NewDecWithPrec(1, 2)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// more than 1% of the bonded tokens cannot be tokenized
+	overCap = totalBonded.QuoRaw(100).Add(totalBonded.QuoRaw(1000))
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewCoin(bondDenom, overCap), delAddr))
+	require.ErrorIs(t, err, types.ErrGlobalLiquidCapExceeded)
+
+	underCap := totalBonded.QuoRaw(100)
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewCoin(bondDenom, underCap), delAddr))
+	require.NoError(t, err)
+
+	// the tokenized stake counts toward the cap
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewCoin(bondDenom, totalBonded.QuoRaw(1000)), delAddr))
+	require.ErrorIs(t, err, types.ErrGlobalLiquidCapExceeded)
+
+	// the stake tokenized from unbonded validators does not count toward the cap
+	unbondedValAddr := sdk.ValAddress(addrs[1])
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(unbondedValAddr, PKs[1], 1, true)
+	validator, found = app.StakingKeeper.GetValidator(ctx, unbondedValAddr)
+	require.True(t, found)
+	require.False(t, validator.IsBonded())
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(delAddr, unbondedValAddr, sdk.NewCoin(bondDenom, delegated)))
+	require.NoError(t, err)
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(delAddr, unbondedValAddr, sdk.NewCoin(bondDenom, delegated), delAddr))
+	require.NoError(t, err)
+	require.Equal(t, underCap, app.StakingKeeper.GetBondedLiquidStakedTokens(ctx))
+	require.Equal(t, underCap.Add(delegated), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+}
+
+func TestTokenizeSharesGenesis(t *testing.T) {
+	app, ctx, msgServer, addrs, valAddr := setupTokenizeShares(t)
+	delAddr := addrs[0]
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(delAddr, valAddr, sdk.NewInt64Coin(bondDenom, 1000)))
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewInt64Coin(bondDenom, 100), addrs[i]))
+		require.NoError(t, err)
+	}
+
+	genesis := app.StakingKeeper.ExportGenesis(ctx)
+	require.NoError(t, staking.ValidateGenesis(genesis))
+	require.Len(t, genesis.TokenizeShareRecords, 2)
+	require.Equal(t, uint64(2), genesis.LastTokenizeShareRecordId)
+
+	// the exported records are restored as is
+	for _, record := range genesis.TokenizeShareRecords {
+		require.NoError(t, app.StakingKeeper.DeleteTokenizeShareRecord(ctx, record.Id))
+	}
+	for _, record := range genesis.TokenizeShareRecords {
+		require.NoError(t, app.StakingKeeper.AddTokenizeShareRecord(ctx, record))
+	}
+	require.Equal(t, genesis.TokenizeShareRecords, app.StakingKeeper.GetAllTokenizeShareRecords(ctx))
+	require.Len(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, addrs[1]), 1)
+	requireTokenizeSharesInvariant(t, app, ctx)
+
+	// a record must be unique
+	require.Error(t, app.StakingKeeper.AddTokenizeShareRecord(ctx, genesis.TokenizeShareRecords[0]))
+
+	genesis.LastTokenizeShareRecordId = 1
+	require.Error(t, staking.ValidateGenesis(genesis))
+}
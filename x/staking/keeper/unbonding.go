@@ -0,0 +1,391 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// IncrementUnbondingID increments and returns a unique id for an unbonding
+// operation.
+func (k Keeper) IncrementUnbondingID(ctx sdk.Context) (unbondingID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.UnbondingIDKey)
+	if bz != nil {
+		unbondingID = sdk.BigEndianToUint64(bz)
+	}
+
+	unbondingID++
+	store.Set(types.UnbondingIDKey, sdk.Uint64ToBigEndian(unbondingID))
+
+	return unbondingID
+}
+
+// GetLastUnbondingID returns the id of the last unbonding operation.
+func (k Keeper) GetLastUnbondingID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.UnbondingIDKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastUnbondingID sets the id of the last unbonding operation.
+func (k Keeper) SetLastUnbondingID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.UnbondingIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// DeleteUnbondingIndex removes the mapping from an unbonding id to its
+// unbonding operation.
+func (k Keeper) DeleteUnbondingIndex(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetUnbondingIndexKey(id))
+	store.Delete(types.GetUnbondingTypeKey(id))
+}
+
+// GetUnbondingType returns the type of the unbonding operation with the given
+// id.
+func (k Keeper) GetUnbondingType(ctx sdk.Context, id uint64) (unbondingType types.UnbondingType, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetUnbondingTypeKey(id))
+	if bz == nil {
+		return unbondingType, false
+	}
+
+	return types.UnbondingType(sdk.BigEndianToUint64(bz)), true
+}
+
+// SetUnbondingType sets the type of the unbonding operation with the given id.
+func (k Keeper) SetUnbondingType(ctx sdk.Context, id uint64, unbondingType types.UnbondingType) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetUnbondingTypeKey(id), sdk.Uint64ToBigEndian(uint64(unbondingType)))
+}
+
+// GetUnbondingDelegationByUnbondingID returns the unbonding delegation holding
+// the entry with the given unbonding id.
+func (k Keeper) GetUnbondingDelegationByUnbondingID(ctx sdk.Context, id uint64) (ubd types.UnbondingDelegation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	ubdKey := store.Get(types.GetUnbondingIndexKey(id))
+	if ubdKey == nil {
+		return ubd, false
+	}
+
+	value := store.Get(ubdKey)
+	if value == nil {
+		return ubd, false
+	}
+
+	ubd, err := types.UnmarshalUBD(k.cdc, value)
+	// an error here means that the index points to another type
+	if err != nil {
+		return ubd, false
+	}
+
+	return ubd, true
+}
+
+// GetRedelegationByUnbondingID returns the redelegation holding the entry with
+// the given unbonding id.
+func (k Keeper) GetRedelegationByUnbondingID(ctx sdk.Context, id uint64) (red types.Redelegation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	redKey := store.Get(types.GetUnbondingIndexKey(id))
+	if redKey == nil {
+		return red, false
+	}
+
+	value := store.Get(redKey)
+	if value == nil {
+		return red, false
+	}
+
+	red, err := types.UnmarshalRED(k.cdc, value)
+	// an error here means that the index points to another type
+	if err != nil {
+		return red, false
+	}
+
+	return red, true
+}
+
+// GetValidatorByUnbondingID returns the validator whose unbonding has the
+// given unbonding id.
+func (k Keeper) GetValidatorByUnbondingID(ctx sdk.Context, id uint64) (val types.Validator, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	valKey := store.Get(types.GetUnbondingIndexKey(id))
+	if valKey == nil {
+		return val, false
+	}
+
+	value := store.Get(valKey)
+	if value == nil {
+		return val, false
+	}
+
+	val, err := types.UnmarshalValidator(k.cdc, value)
+	// an error here means that the index points to another type
+	if err != nil {
+		return val, false
+	}
+
+	return val, true
+}
+
+// SetUnbondingDelegationByUnbondingID sets an index to look up an unbonding
+// delegation by the unbonding id of one of its entries. It does not set the
+// unbonding delegation itself.
+func (k Keeper) SetUnbondingDelegationByUnbondingID(ctx sdk.Context, ubd types.UnbondingDelegation, id uint64) {
+	delAddr := sdk.MustAccAddressFromBech32(ubd.DelegatorAddress)
+	valAddr, err := sdk.ValAddressFromBech32(ubd.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetUnbondingIndexKey(id), types.GetUBDKey(delAddr, valAddr))
+
+	k.SetUnbondingType(ctx, id, types.UnbondingType_UnbondingDelegation)
+}
+
+// SetRedelegationByUnbondingID sets an index to look up a redelegation by the
+// unbonding id of one of its entries. It does not set the redelegation itself.
+func (k Keeper) SetRedelegationByUnbondingID(ctx sdk.Context, red types.Redelegation, id uint64) {
+	delAddr := sdk.MustAccAddressFromBech32(red.DelegatorAddress)
+	valSrcAddr, err := sdk.ValAddressFromBech32(red.ValidatorSrcAddress)
+	if err != nil {
+		panic(err)
+	}
+	valDstAddr, err := sdk.ValAddressFromBech32(red.ValidatorDstAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetUnbondingIndexKey(id), types.GetREDKey(delAddr, valSrcAddr, valDstAddr))
+
+	k.SetUnbondingType(ctx, id, types.UnbondingType_Redelegation)
+}
+
+// SetValidatorByUnbondingID sets an index to look up a validator by the
+// unbonding id of its unbonding. It does not set the validator itself.
+func (k Keeper) SetValidatorByUnbondingID(ctx sdk.Context, val types.Validator, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetUnbondingIndexKey(id), types.GetValidatorKey(val.GetOperator()))
+
+	k.SetUnbondingType(ctx, id, types.UnbondingType_ValidatorUnbonding)
+}
+
+// PutUnbondingOnHold allows an external module to stop an unbonding operation,
+// i.e. an unbonding delegation entry, a redelegation entry or a validator
+// unbonding, from completing. Every call to PutUnbondingOnHold must be matched
+// by a call to UnbondingCanComplete for the unbonding operation to eventually
+// complete.
+func (k Keeper) PutUnbondingOnHold(ctx sdk.Context, id uint64) error {
+	unbondingType, found := k.GetUnbondingType(ctx, id)
+	if !found {
+		return types.ErrNoUnbondingType
+	}
+
+	switch unbondingType {
+	case types.UnbondingType_UnbondingDelegation:
+		return k.putUnbondingDelegationEntryOnHold(ctx, id)
+	case types.UnbondingType_Redelegation:
+		return k.putRedelegationEntryOnHold(ctx, id)
+	case types.UnbondingType_ValidatorUnbonding:
+		return k.putValidatorOnHold(ctx, id)
+	default:
+		return types.ErrUnbondingNotFound
+	}
+}
+
+// UnbondingCanComplete allows an unbonding operation put on hold by
+// PutUnbondingOnHold to complete. Unbonding delegation and redelegation
+// entries that are already mature complete immediately once they are no
+// longer on hold, while validators complete their unbonding at the end of the
+// next block.
+func (k Keeper) UnbondingCanComplete(ctx sdk.Context, id uint64) error {
+	unbondingType, found := k.GetUnbondingType(ctx, id)
+	if !found {
+		return types.ErrNoUnbondingType
+	}
+
+	switch unbondingType {
+	case types.UnbondingType_UnbondingDelegation:
+		return k.unbondingDelegationEntryCanComplete(ctx, id)
+	case types.UnbondingType_Redelegation:
+		return k.redelegationEntryCanComplete(ctx, id)
+	case types.UnbondingType_ValidatorUnbonding:
+		return k.validatorUnbondingCanComplete(ctx, id)
+	default:
+		return types.ErrUnbondingNotFound
+	}
+}
+
+func (k Keeper) putUnbondingDelegationEntryOnHold(ctx sdk.Context, id uint64) error {
+	ubd, found := k.GetUnbondingDelegationByUnbondingID(ctx, id)
+	if !found {
+		return types.ErrUnbondingNotFound
+	}
+
+	i, found := unbondingDelegationEntryArrayIndex(ubd, id)
+	if !found {
+		return types.ErrUnbondingNotFound
+	}
+
+	ubd.Entries[i].UnbondingOnHoldRefCount++
+	k.SetUnbondingDelegation(ctx, ubd)
+
+	return nil
+}
+
+func (k Keeper) putRedelegationEntryOnHold(ctx sdk.Context, id uint64) error {
+	red, found := k.GetRedelegationByUnbondingID(ctx, id)
+	if !found {
+		return types.ErrUnbondingNotFound
+	}
+
+	i, found := redelegationEntryArrayIndex(red, id)
+	if !found {
+		return types.ErrUnbondingNotFound
+	}
+
+	red.Entries[i].UnbondingOnHoldRefCount++
+	k.SetRedelegation(ctx, red)
+
+	return nil
+}
+
+func (k Keeper) putValidatorOnHold(ctx sdk.Context, id uint64) error {
+	val, found := k.GetValidatorByUnbondingID(ctx, id)
+	if !found {
+		return types.ErrUnbondingNotFound
+	}
+
+	val.UnbondingOnHoldRefCount++
+	k.SetValidator(ctx, val)
+
+	return nil
+}
+
+func (k Keeper) unbondingDelegationEntryCanComplete(ctx sdk.Context, id uint64) error {
+	ubd, found := k.GetUnbondingDelegationByUnbondingID(ctx, id)
+	if !found {
+		return types.ErrUnbondingNotFound
+	}
+
+	i, found := unbondingDelegationEntryArrayIndex(ubd, id)
+	if !found {
+		return types.ErrUnbondingNotFound
+	}
+
+	entry := ubd.Entries[i]
+	if !entry.OnHold() {
+		return types.ErrUnbondingOnHoldRefCountNegative.Wrapf("unbonding id %d", id)
+	}
+
+	entry.UnbondingOnHoldRefCount--
+	ubd.Entries[i] = entry
+
+	// the entry was already dequeued if it is mature, so complete it here
+	if !entry.OnHold() && entry.IsMature(ctx.BlockHeader().Time) {
+		if !entry.Balance.IsZero() {
+			delegatorAddress := sdk.MustAccAddressFromBech32(ubd.DelegatorAddress)
+			amt := sdk.NewCoin(k.BondDenom(ctx), entry.Balance)
+			if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(
+				ctx, types.NotBondedPoolName, delegatorAddress, sdk.NewCoins(amt),
+			); err != nil {
+				return err
+			}
+		}
+
+		ubd.RemoveEntry(int64(i))
+		k.DeleteUnbondingIndex(ctx, id)
+	}
+
+	// set the unbonding delegation or remove it if there are no more entries
+	if len(ubd.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubd)
+	}
+
+	return nil
+}
+
+func (k Keeper) redelegationEntryCanComplete(ctx sdk.Context, id uint64) error {
+	red, found := k.GetRedelegationByUnbondingID(ctx, id)
+	if !found {
+		return types.ErrUnbondingNotFound
+	}
+
+	i, found := redelegationEntryArrayIndex(red, id)
+	if !found {
+		return types.ErrUnbondingNotFound
+	}
+
+	entry := red.Entries[i]
+	if !entry.OnHold() {
+		return types.ErrUnbondingOnHoldRefCountNegative.Wrapf("unbonding id %d", id)
+	}
+
+	entry.UnbondingOnHoldRefCount--
+	red.Entries[i] = entry
+
+	// the entry was already dequeued if it is mature, so complete it here
+	if !entry.OnHold() && entry.IsMature(ctx.BlockHeader().Time) {
+		red.RemoveEntry(int64(i))
+		k.DeleteUnbondingIndex(ctx, id)
+	}
+
+	// set the redelegation or remove it if there are no more entries
+	if len(red.Entries) == 0 {
+		k.RemoveRedelegation(ctx, red)
+	} else {
+		k.SetRedelegation(ctx, red)
+	}
+
+	return nil
+}
+
+func (k Keeper) validatorUnbondingCanComplete(ctx sdk.Context, id uint64) error {
+	val, found := k.GetValidatorByUnbondingID(ctx, id)
+	if !found {
+		return types.ErrUnbondingNotFound
+	}
+
+	if val.UnbondingOnHoldRefCount <= 0 {
+		return types.ErrUnbondingOnHoldRefCountNegative.Wrapf("unbonding id %d", id)
+	}
+
+	// the validator stays in the unbonding queue, it completes its unbonding
+	// in UnbondAllMatureValidators
+	val.UnbondingOnHoldRefCount--
+	k.SetValidator(ctx, val)
+
+	return nil
+}
+
+func unbondingDelegationEntryArrayIndex(ubd types.UnbondingDelegation, id uint64) (index int, found bool) {
+	for i, entry := range ubd.Entries {
+		if entry.UnbondingId == id {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+func redelegationEntryArrayIndex(red types.Redelegation, id uint64) (index int, found bool) {
+	for i, entry := range red.Entries {
+		if entry.UnbondingId == id {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
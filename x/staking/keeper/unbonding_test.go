@@ -0,0 +1,225 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func setupUnbondingOnHold(t *testing.T) (*simapp.SimApp, *teststaking.Helper, []sdk.AccAddress, []sdk.ValAddress) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(0, 0).UTC()})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, app.StakingKeeper.TokensFromConsensusPower(ctx, 10))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs[1:])
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddrs[0], PKs[0], 1, true)
+	tstaking.CreateValidatorWithValPower(valAddrs[1], PKs[1], 1, true)
+	tstaking.Delegate(addrs[0], valAddrs[0], sdk.NewInt(1000))
+	tstaking.TurnBlock(ctx.BlockTime())
+
+	return app, tstaking, addrs, valAddrs
+}
+
+func TestUnbondingDelegationOnHold(t *testing.T) {
+	app, tstaking, addrs, valAddrs := setupUnbondingOnHold(t)
+	delAddr, valAddr := addrs[0], valAddrs[0]
+	bondDenom := app.StakingKeeper.BondDenom(tstaking.Ctx)
+
+	completionTime, err := app.StakingKeeper.Undelegate(tstaking.Ctx, delAddr, valAddr, sdk.NewDec(400))
+	require.NoError(t, err)
+
+	id := app.StakingKeeper.GetLastUnbondingID(tstaking.Ctx)
+	unbondingType, found := app.StakingKeeper.GetUnbondingType(tstaking.Ctx, id)
+	require.True(t, found)
+	require.Equal(t, types.UnbondingType_UnbondingDelegation, unbondingType)
+
+	ubd, found := app.StakingKeeper.GetUnbondingDelegationByUnbondingID(tstaking.Ctx, id)
+	require.True(t, found)
+	require.Equal(t, id, ubd.Entries[0].UnbondingId)
+
+	require.NoError(t, app.StakingKeeper.PutUnbondingOnHold(tstaking.Ctx, id))
+	require.NoError(t, app.StakingKeeper.PutUnbondingOnHold(tstaking.Ctx, id))
+
+	// the mature unbonding delegation is not completed while on hold
+	balance := app.BankKeeper.GetBalance(tstaking.Ctx, delAddr, bondDenom)
+	ctx := tstaking.TurnBlock(completionTime)
+	require.Equal(t, balance, app.BankKeeper.GetBalance(ctx, delAddr, bondDenom))
+
+	ubd, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, int64(2), ubd.Entries[0].UnbondingOnHoldRefCount)
+
+	// it completes once every hold is released
+	require.NoError(t, app.StakingKeeper.UnbondingCanComplete(ctx, id))
+	require.Equal(t, balance, app.BankKeeper.GetBalance(ctx, delAddr, bondDenom))
+
+	require.NoError(t, app.StakingKeeper.UnbondingCanComplete(ctx, id))
+	require.Equal(t, balance.AddAmount(sdk.NewInt(400)), app.BankKeeper.GetBalance(ctx, delAddr, bondDenom))
+
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetUnbondingDelegationByUnbondingID(ctx, id)
+	require.False(t, found)
+
+	require.ErrorIs(t, app.StakingKeeper.UnbondingCanComplete(ctx, id), types.ErrNoUnbondingType)
+	require.ErrorIs(t, app.StakingKeeper.PutUnbondingOnHold(ctx, id), types.ErrNoUnbondingType)
+}
+
+func TestUnbondingDelegationCanCompleteBeforeMaturity(t *testing.T) {
+	app, tstaking, addrs, valAddrs := setupUnbondingOnHold(t)
+	delAddr, valAddr := addrs[0], valAddrs[0]
+	bondDenom := app.StakingKeeper.BondDenom(tstaking.Ctx)
+
+	completionTime, err := app.StakingKeeper.Undelegate(tstaking.Ctx, delAddr, valAddr, sdk.NewDec(400))
+	require.NoError(t, err)
+
+	id := app.StakingKeeper.GetLastUnbondingID(tstaking.Ctx)
+	require.ErrorIs(t, app.StakingKeeper.UnbondingCanComplete(tstaking.Ctx, id), types.ErrUnbondingOnHoldRefCountNegative)
+
+	require.NoError(t, app.StakingKeeper.PutUnbondingOnHold(tstaking.Ctx, id))
+	require.NoError(t, app.StakingKeeper.UnbondingCanComplete(tstaking.Ctx, id))
+
+	// the entry is not mature yet, it completes with the unbonding queue
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(tstaking.Ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.False(t, ubd.Entries[0].OnHold())
+
+	balance := app.BankKeeper.GetBalance(tstaking.Ctx, delAddr, bondDenom)
+	ctx := tstaking.TurnBlock(completionTime)
+	require.Equal(t, balance.AddAmount(sdk.NewInt(400)), app.BankKeeper.GetBalance(ctx, delAddr, bondDenom))
+
+	_, found = app.StakingKeeper.GetUnbondingType(ctx, id)
+	require.False(t, found)
+}
+
+func TestSlashUnbondingDelegationOnHold(t *testing.T) {
+	app, tstaking, addrs, valAddrs := setupUnbondingOnHold(t)
+	delAddr, valAddr := addrs[0], valAddrs[0]
+
+	completionTime, err := app.StakingKeeper.Undelegate(tstaking.Ctx, delAddr, valAddr, sdk.NewDec(400))
+	require.NoError(t, err)
+	id := app.StakingKeeper.GetLastUnbondingID(tstaking.Ctx)
+	require.NoError(t, app.StakingKeeper.PutUnbondingOnHold(tstaking.Ctx, id))
+
+	// the mature entry is still slashable while on hold
+	ctx := tstaking.TurnBlock(completionTime)
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.True(t, ubd.Entries[0].IsMature(ctx.BlockTime()))
+
+	slashed := app.StakingKeeper.SlashUnbondingDelegation(ctx, ubd, 0, sdk.NewDecWithPrec(5, 1))
+	require.Equal(t, sdk.NewInt(200), slashed)
+
+	ubd, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(200), ubd.Entries[0].Balance)
+}
+
+func TestRedelegationOnHold(t *testing.T) {
+	app, tstaking, addrs, valAddrs := setupUnbondingOnHold(t)
+	delAddr := addrs[0]
+
+	completionTime, err := app.StakingKeeper.BeginRedelegation(tstaking.Ctx, delAddr, valAddrs[0], valAddrs[1], sdk.NewDec(400))
+	require.NoError(t, err)
+
+	id := app.StakingKeeper.GetLastUnbondingID(tstaking.Ctx)
+	unbondingType, found := app.StakingKeeper.GetUnbondingType(tstaking.Ctx, id)
+	require.True(t, found)
+	require.Equal(t, types.UnbondingType_Redelegation, unbondingType)
+
+	require.NoError(t, app.StakingKeeper.PutUnbondingOnHold(tstaking.Ctx, id))
+
+	ctx := tstaking.TurnBlock(completionTime)
+	red, found := app.StakingKeeper.GetRedelegation(ctx, delAddr, valAddrs[0], valAddrs[1])
+	require.True(t, found)
+	require.True(t, red.Entries[0].OnHold())
+
+	require.NoError(t, app.StakingKeeper.UnbondingCanComplete(ctx, id))
+	_, found = app.StakingKeeper.GetRedelegation(ctx, delAddr, valAddrs[0], valAddrs[1])
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetRedelegationByUnbondingID(ctx, id)
+	require.False(t, found)
+}
+
+func TestValidatorUnbondingOnHold(t *testing.T) {
+	app, tstaking, _, valAddrs := setupUnbondingOnHold(t)
+	valAddr := valAddrs[0]
+
+	validator, found := app.StakingKeeper.GetValidator(tstaking.Ctx, valAddr)
+	require.True(t, found)
+	require.True(t, validator.IsBonded())
+
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	app.StakingKeeper.Jail(tstaking.Ctx, consAddr)
+	ctx := tstaking.TurnBlock(tstaking.Ctx.BlockTime())
+
+	validator, found = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.True(t, validator.IsUnbonding())
+	require.Len(t, validator.UnbondingIds, 1)
+
+	id := validator.UnbondingIds[0]
+	require.Equal(t, app.StakingKeeper.GetLastUnbondingID(ctx), id)
+	unbondingType, found := app.StakingKeeper.GetUnbondingType(ctx, id)
+	require.True(t, found)
+	require.Equal(t, types.UnbondingType_ValidatorUnbonding, unbondingType)
+
+	require.NoError(t, app.StakingKeeper.PutUnbondingOnHold(ctx, id))
+
+	// the validator stays unbonding while on hold
+	ctx = tstaking.TurnBlock(validator.UnbondingTime)
+	validator, found = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.True(t, validator.IsUnbonding())
+	require.Equal(t, int64(1), validator.UnbondingOnHoldRefCount)
+
+	// and completes its unbonding with the next block once released
+	require.NoError(t, app.StakingKeeper.UnbondingCanComplete(ctx, id))
+	validator, found = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.True(t, validator.IsUnbonding())
+
+	ctx = tstaking.TurnBlockTimeDiff(time.Second)
+	validator, found = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.True(t, validator.IsUnbonded())
+	require.Empty(t, validator.UnbondingIds)
+
+	_, found = app.StakingKeeper.GetValidatorByUnbondingID(ctx, id)
+	require.False(t, found)
+}
+
+func TestUnbondingIndexesGenesis(t *testing.T) {
+	app, tstaking, addrs, valAddrs := setupUnbondingOnHold(t)
+
+	_, err := app.StakingKeeper.Undelegate(tstaking.Ctx, addrs[0], valAddrs[0], sdk.NewDec(100))
+	require.NoError(t, err)
+	ubdID := app.StakingKeeper.GetLastUnbondingID(tstaking.Ctx)
+	_, err = app.StakingKeeper.BeginRedelegation(tstaking.Ctx, addrs[0], valAddrs[0], valAddrs[1], sdk.NewDec(100))
+	require.NoError(t, err)
+	redID := app.StakingKeeper.GetLastUnbondingID(tstaking.Ctx)
+
+	genesis := app.StakingKeeper.ExportGenesis(tstaking.Ctx)
+
+	// the indexes are rebuilt from the unbonding ids of the genesis
+	app.StakingKeeper.DeleteUnbondingIndex(tstaking.Ctx, ubdID)
+	app.StakingKeeper.DeleteUnbondingIndex(tstaking.Ctx, redID)
+	app.StakingKeeper.SetLastUnbondingID(tstaking.Ctx, 0)
+	app.StakingKeeper.InitGenesis(tstaking.Ctx, genesis)
+
+	require.Equal(t, redID, app.StakingKeeper.GetLastUnbondingID(tstaking.Ctx))
+	_, found := app.StakingKeeper.GetUnbondingDelegationByUnbondingID(tstaking.Ctx, ubdID)
+	require.True(t, found)
+	_, found = app.StakingKeeper.GetRedelegationByUnbondingID(tstaking.Ctx, redID)
+	require.True(t, found)
+}
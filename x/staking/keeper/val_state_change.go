@@ -316,9 +316,12 @@ func (k Keeper) beginUnbondingValidator(ctx sdk.Context, validator types.Validat
 
 	validator = validator.UpdateStatus(types.Unbonding)
 
+	id := k.IncrementUnbondingID(ctx)
+
 	// set the unbonding completion time and completion height appropriately
 	validator.UnbondingTime = ctx.BlockHeader().Time.Add(params.UnbondingTime)
 	validator.UnbondingHeight = ctx.BlockHeader().Height
+	validator.UnbondingIds = append(validator.UnbondingIds, id)
 
 	// save the now unbonded validator record and power index
 	k.SetValidator(ctx, validator)
@@ -327,6 +330,9 @@ func (k Keeper) beginUnbondingValidator(ctx sdk.Context, validator types.Validat
 	// Adds to unbonding validator queue
 	k.InsertUnbondingValidatorQueue(ctx, validator)
 
+	// add to the index to look up the validator by the unbonding id
+	k.SetValidatorByUnbondingID(ctx, validator, id)
+
 	// trigger hook
 	consAddr, err := validator.GetConsAddr()
 	if err != nil {
@@ -334,6 +340,10 @@ func (k Keeper) beginUnbondingValidator(ctx sdk.Context, validator types.Validat
 	}
 	k.AfterValidatorBeginUnbonding(ctx, consAddr, validator.GetOperator())
 
+	if err := k.AfterUnbondingInitiated(ctx, id); err != nil {
+		k.Logger(ctx).Error("failed to call after unbonding initiated hook", "error", err)
+	}
+
 	return validator, nil
 }
 
@@ -430,6 +430,8 @@ func (k Keeper) UnbondAllMatureValidators(ctx sdk.Context) {
 			addrs := types.ValAddresses{}
 			k.cdc.MustUnmarshal(unbondingValIterator.Value(), &addrs)
 
+			// validators put on hold by an external module remain in the queue
+			onHold := []string{}
 			for _, valAddr := range addrs.Addresses {
 				addr, err := sdk.ValAddressFromBech32(valAddr)
 				if err != nil {
@@ -444,13 +446,27 @@ func (k Keeper) UnbondAllMatureValidators(ctx sdk.Context) {
 					panic("unexpected validator in unbonding queue; status was not unbonding")
 				}
 
+				if val.UnbondingOnHoldRefCount > 0 {
+					onHold = append(onHold, valAddr)
+					continue
+				}
+
+				for _, id := range val.UnbondingIds {
+					k.DeleteUnbondingIndex(ctx, id)
+				}
+				val.UnbondingIds = nil
+
 				val = k.UnbondingToUnbonded(ctx, val)
 				if val.GetDelegatorShares().IsZero() {
 					k.RemoveValidator(ctx, val.GetOperator())
 				}
 			}
 
-			store.Delete(key)
+			if len(onHold) == 0 {
+				store.Delete(key)
+			} else {
+				store.Set(key, k.cdc.MustMarshal(&types.ValAddresses{Addresses: onHold}))
+			}
 		}
 	}
 }
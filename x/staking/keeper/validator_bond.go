@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetValidatorSelfBond returns the amount of tokens self-delegated by the
+// operator of a validator.
+func (k Keeper) GetValidatorSelfBond(ctx sdk.Context, validator types.Validator) math.Int {
+	delegation, found := k.GetDelegation(ctx, sdk.AccAddress(validator.GetOperator()), validator.GetOperator())
+	if !found {
+		return sdk.ZeroInt()
+	}
+
+	return validator.TokensFromShares(delegation.Shares).TruncateInt()
+}
+
+// IsValidatorBondHealthy returns true if the self-bond of a validator meets the
+// minimum self-bond params, with additional tokens delegated to the validator by
+// other delegators.
+func (k Keeper) IsValidatorBondHealthy(ctx sdk.Context, validator types.Validator, additionalTokens math.Int) bool {
+	params := k.GetParams(ctx)
+
+	selfBond := k.GetValidatorSelfBond(ctx, validator)
+	if selfBond.LT(params.MinSelfBond) {
+		return false
+	}
+
+	minSelfBond := params.MinSelfBondRatio.MulInt(validator.Tokens.Add(additionalTokens))
+	return sdk.NewDecFromInt(selfBond).GTE(minSelfBond)
+}
+
+// checkValidatorBond returns an error if a validator cannot accept a new
+// delegation of tokens from a delegator because its self-bond is below the
+// minimum. The self-delegations of the operator are always accepted, so that the
+// validator can recover.
+func (k Keeper) checkValidatorBond(ctx sdk.Context, validator types.Validator, delAddr sdk.AccAddress, tokens math.Int) error {
+	if delAddr.Equals(sdk.AccAddress(validator.GetOperator())) {
+		return nil
+	}
+
+	if !k.IsValidatorBondHealthy(ctx, validator, tokens) {
+		return types.ErrInsufficientValidatorBond.Wrapf(
+			"validator %s, self-bond %s", validator.GetOperator(), k.GetValidatorSelfBond(ctx, validator),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMinSelfBond(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	querier := keeper.Querier{Keeper: app.StakingKeeper}
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, app.StakingKeeper.TokensFromConsensusPower(ctx, 10))
+	valAddr, delAddr := sdk.ValAddress(addrs[0]), addrs[1]
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	selfBond := tstaking.CreateValidatorWithValPower(valAddr, PKs[0], 1, true)
+
+	res, err := querier.ValidatorBond(sdk.WrapSDKContext(ctx), &types.QueryValidatorBondRequest{ValidatorAddr: valAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, selfBond, res.SelfBond)
+	require.Equal(t, selfBond, res.Tokens)
+	require.True(t, res.Healthy)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MinSelfBond = selfBond.MulRaw(2)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// the validator no longer accepts new delegations, but is not jailed
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(delAddr, valAddr, sdk.NewCoin(bondDenom, selfBond)))
+	require.ErrorIs(t, err, types.ErrInsufficientValidatorBond)
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.False(t, validator.IsJailed())
+
+	res, err = querier.ValidatorBond(sdk.WrapSDKContext(ctx), &types.QueryValidatorBondRequest{ValidatorAddr: valAddr.String()})
+	require.NoError(t, err)
+	require.False(t, res.Healthy)
+
+	// the operator can always self-delegate to recover
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(sdk.AccAddress(valAddr), valAddr, sdk.NewCoin(bondDenom, selfBond)))
+	require.NoError(t, err)
+
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(delAddr, valAddr, sdk.NewCoin(bondDenom, selfBond)))
+	require.NoError(t, err)
+
+	res, err = querier.ValidatorBond(sdk.WrapSDKContext(ctx), &types.QueryValidatorBondRequest{ValidatorAddr: valAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, selfBond.MulRaw(2), res.SelfBond)
+	require.Equal(t, selfBond.MulRaw(3), res.Tokens)
+	require.True(t, res.Healthy)
+
+	_, err = querier.ValidatorBond(sdk.WrapSDKContext(ctx), &types.QueryValidatorBondRequest{ValidatorAddr: sdk.ValAddress(delAddr).String()})
+	require.Error(t, err)
+}
+
+func TestMinSelfBondRatio(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, app.StakingKeeper.TokensFromConsensusPower(ctx, 10))
+	valAddr, srcValAddr, delAddr := sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1]), addrs[2]
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	selfBond := tstaking.CreateValidatorWithValPower(valAddr, PKs[0], 1, true)
+	tstaking.CreateValidatorWithValPower(srcValAddr, PKs[1], 1, true)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MinSelfBondRatio = sdk.NewDecWithPrec(5, 1)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// the self-bond must remain at least half of the validator tokens
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(delAddr, valAddr, sdk.NewCoin(bondDenom, selfBond.AddRaw(1))))
+	require.ErrorIs(t, err, types.ErrInsufficientValidatorBond)
+
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(delAddr, valAddr, sdk.NewCoin(bondDenom, selfBond)))
+	require.NoError(t, err)
+	require.True(t, app.StakingKeeper.IsValidatorBondHealthy(ctx, app.StakingKeeper.Validator(ctx, valAddr).(types.Validator), sdk.ZeroInt()))
+
+	// redelegations are checked against the destination validator
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(delAddr, srcValAddr, sdk.NewCoin(bondDenom, selfBond)))
+	require.NoError(t, err)
+
+	_, err = msgServer.BeginRedelegate(sdk.WrapSDKContext(ctx), types.NewMsgBeginRedelegate(delAddr, srcValAddr, valAddr, sdk.NewInt64Coin(bondDenom, 1)))
+	require.ErrorIs(t, err, types.ErrInsufficientValidatorBond)
+}
@@ -0,0 +1,39 @@
+package v047
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/exported"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from v3 (v0.46) to v4 (v0.47). The
+// migration includes:
+//
+// - Migrate params from the x/params module to the x/staking module store.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, legacySubspace exported.Subspace, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var currParams types.Params
+	legacySubspace.GetParamSet(ctx, &currParams)
+
+	// the liquid staking caps and the minimum self-bond are not part of the
+	// legacy params
+	currParams.GlobalLiquidStakingCap = types.DefaultGlobalLiquidStakingCap
+	currParams.ValidatorLiquidStakingCap = types.DefaultValidatorLiquidStakingCap
+	currParams.MinSelfBond = types.DefaultMinSelfBond
+	currParams.MinSelfBondRatio = types.DefaultMinSelfBondRatio
+
+	if err := currParams.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&currParams)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+	return nil
+}
@@ -50,4 +50,15 @@ var (
 	ErrNoHistoricalInfo                = sdkerrors.Register(ModuleName, 38, "no historical info found")
 	ErrEmptyValidatorPubKey            = sdkerrors.Register(ModuleName, 39, "empty validator public key")
 	ErrCommissionLTMinRate             = sdkerrors.Register(ModuleName, 40, "commission cannot be less than min rate")
+	ErrTokenizeShareRecordNotExists    = sdkerrors.Register(ModuleName, 41, "tokenize share record not exists")
+	ErrNotTokenizeShareRecordOwner     = sdkerrors.Register(ModuleName, 42, "not tokenize share record owner")
+	ErrExceedingFreeVestingDelegations = sdkerrors.Register(ModuleName, 43, "trying to tokenize more than the free delegations of a vesting account")
+	ErrRedelegationInProgress          = sdkerrors.Register(ModuleName, 44, "delegator is not allowed to tokenize shares from a validator with a redelegation in progress")
+	ErrTinyTokenizeShareAmount         = sdkerrors.Register(ModuleName, 45, "too few shares to tokenize or redeem (truncates to zero)")
+	ErrGlobalLiquidCapExceeded         = sdkerrors.Register(ModuleName, 46, "tokenizing the shares would exceed the global liquid staking cap")
+	ErrValidatorLiquidCapExceeded      = sdkerrors.Register(ModuleName, 47, "tokenizing the shares would exceed the validator liquid staking cap")
+	ErrInsufficientValidatorBond       = sdkerrors.Register(ModuleName, 48, "validator self-bond is below the minimum self-bond, it cannot accept new delegations")
+	ErrNoUnbondingType                 = sdkerrors.Register(ModuleName, 49, "unbonding type not found")
+	ErrUnbondingNotFound               = sdkerrors.Register(ModuleName, 50, "unbonding operation not found")
+	ErrUnbondingOnHoldRefCountNegative = sdkerrors.Register(ModuleName, 51, "cannot un-hold unbonding operation that is not on hold")
 )
@@ -44,13 +44,35 @@ var (
 	RedelegationByValSrcIndexKey     = []byte{0x35} // prefix for each key for an redelegation, by source validator operator
 	RedelegationByValDstIndexKey     = []byte{0x36} // prefix for each key for an redelegation, by destination validator operator
 
+	UnbondingIDKey    = []byte{0x37} // key for the counter for the incrementing id for UnbondingOperations
+	UnbondingIndexKey = []byte{0x38} // prefix for an index for looking up unbonding operations by their IDs
+	UnbondingTypeKey  = []byte{0x39} // prefix for an index containing the type of unbonding operations
+
 	UnbondingQueueKey    = []byte{0x41} // prefix for the timestamps in unbonding queue
 	RedelegationQueueKey = []byte{0x42} // prefix for the timestamps in redelegations queue
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
 
 	HistoricalInfoKey = []byte{0x50} // prefix for the historical info
+
+	ParamsKey = []byte{0x51} // prefix for parameters for module x/staking
+
+	TokenizeShareRecordPrefix          = []byte{0x61} // key for a tokenize share record
+	TokenizeShareRecordIDByOwnerPrefix = []byte{0x62} // prefix for each key to a tokenize share record id, by owner
+	TokenizeShareRecordIDByDenomPrefix = []byte{0x63} // prefix for each key to a tokenize share record id, by share denom
+	LastTokenizeShareRecordIDKey       = []byte{0x64} // key for the last tokenize share record id
+	ValidatorLiquidSharesKey           = []byte{0x65} // prefix for the tokenized shares of each validator
 )
 
+// GetUnbondingTypeKey returns a key for an index containing the type of unbonding operations
+func GetUnbondingTypeKey(id uint64) []byte {
+	return append(UnbondingTypeKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetUnbondingIndexKey returns a key for the index for looking up UnbondingDelegations by the UnbondingDelegationEntries they contain
+func GetUnbondingIndexKey(id uint64) []byte {
+	return append(UnbondingIndexKey, sdk.Uint64ToBigEndian(id)...)
+}
+
 // GetValidatorKey creates the key for the validator with address
 // VALUE: staking/Validator
 func GetValidatorKey(operatorAddr sdk.ValAddress) []byte {
@@ -348,3 +370,36 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
 }
+
+// GetTokenizeShareRecordByIndexKey returns the key of a tokenize share record.
+// VALUE: staking/TokenizeShareRecord
+func GetTokenizeShareRecordByIndexKey(id uint64) []byte {
+	return append(TokenizeShareRecordPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDsByOwnerPrefix returns the key prefix of the
+// tokenize share records owned by an address.
+func GetTokenizeShareRecordIDsByOwnerPrefix(owner sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordIDByOwnerPrefix, address.MustLengthPrefix(owner)...)
+}
+
+// GetTokenizeShareRecordIDByOwnerAndIDKey returns the key indexing a tokenize
+// share record by its owner.
+// VALUE: none (key rearrangement used)
+func GetTokenizeShareRecordIDByOwnerAndIDKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(GetTokenizeShareRecordIDsByOwnerPrefix(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDByDenomKey returns the key indexing a tokenize share
+// record by the denom of its share tokens.
+// VALUE: tokenize share record id (big-endian uint64)
+func GetTokenizeShareRecordIDByDenomKey(denom string) []byte {
+	return append(TokenizeShareRecordIDByDenomPrefix, []byte(denom)...)
+}
+
+// GetValidatorLiquidSharesKey returns the key of the tokenized shares of a
+// validator.
+// VALUE: sdk.Dec
+func GetValidatorLiquidSharesKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorLiquidSharesKey, address.MustLengthPrefix(valAddr)...)
+}
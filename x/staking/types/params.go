@@ -33,8 +33,24 @@ const (
 	DefaultHistoricalEntries uint32 = 10000
 )
 
-// DefaultMinCommissionRate is set to 0%
-var DefaultMinCommissionRate = sdk.ZeroDec()
+var (
+	// DefaultMinCommissionRate is set to 0%
+	DefaultMinCommissionRate = sdk.ZeroDec()
+
+	// DefaultGlobalLiquidStakingCap is set to 100%, all the bonded tokens can
+	// be tokenized.
+	DefaultGlobalLiquidStakingCap = sdk.OneDec()
+
+	// DefaultValidatorLiquidStakingCap is set to 100%, all the delegator shares
+	// of a validator can be tokenized.
+	DefaultValidatorLiquidStakingCap = sdk.OneDec()
+
+	// DefaultMinSelfBond is set to 0, no minimum self-bond is required.
+	DefaultMinSelfBond = sdk.ZeroInt()
+
+	// DefaultMinSelfBondRatio is set to 0%, no minimum self-bond is required.
+	DefaultMinSelfBondRatio = sdk.ZeroDec()
+)
 
 var (
 	KeyUnbondingTime     = []byte("UnbondingTime")
@@ -53,18 +69,29 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string, minCommissionRate sdk.Dec) Params {
+func NewParams(
+	unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string, minCommissionRate sdk.Dec,
+	globalLiquidStakingCap, validatorLiquidStakingCap sdk.Dec, minSelfBond math.Int, minSelfBondRatio sdk.Dec,
+) Params {
 	return Params{
-		UnbondingTime:     unbondingTime,
-		MaxValidators:     maxValidators,
-		MaxEntries:        maxEntries,
-		HistoricalEntries: historicalEntries,
-		BondDenom:         bondDenom,
-		MinCommissionRate: minCommissionRate,
+		UnbondingTime:             unbondingTime,
+		MaxValidators:             maxValidators,
+		MaxEntries:                maxEntries,
+		HistoricalEntries:         historicalEntries,
+		BondDenom:                 bondDenom,
+		MinCommissionRate:         minCommissionRate,
+		GlobalLiquidStakingCap:    globalLiquidStakingCap,
+		ValidatorLiquidStakingCap: validatorLiquidStakingCap,
+		MinSelfBond:               minSelfBond,
+		MinSelfBondRatio:          minSelfBondRatio,
 	}
 }
 
 // Implements params.ParamSet
+//
+// NOTE: The liquid staking caps and the minimum self-bond were introduced after
+// the migration of the params to the x/staking module store and are not part of
+// the legacy param set.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyUnbondingTime, &p.UnbondingTime, validateUnbondingTime),
@@ -85,6 +112,10 @@ func DefaultParams() Params {
 		DefaultHistoricalEntries,
 		sdk.DefaultBondDenom,
 		DefaultMinCommissionRate,
+		DefaultGlobalLiquidStakingCap,
+		DefaultValidatorLiquidStakingCap,
+		DefaultMinSelfBond,
+		DefaultMinSelfBondRatio,
 	)
 }
 
@@ -136,6 +167,22 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateLiquidStakingCap(p.GlobalLiquidStakingCap); err != nil {
+		return fmt.Errorf("global liquid staking cap: %w", err)
+	}
+
+	if err := validateLiquidStakingCap(p.ValidatorLiquidStakingCap); err != nil {
+		return fmt.Errorf("validator liquid staking cap: %w", err)
+	}
+
+	if err := validateMinSelfBond(p.MinSelfBond); err != nil {
+		return err
+	}
+
+	if err := validateMinSelfBondRatio(p.MinSelfBondRatio); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -232,3 +279,57 @@ func validateMinCommissionRate(i interface{}) error {
 
 	return nil
 }
+
+func validateLiquidStakingCap(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("liquid staking cap cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("liquid staking cap cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("liquid staking cap cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
+
+func validateMinSelfBond(i interface{}) error {
+	v, ok := i.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("minimum self-bond cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("minimum self-bond cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateMinSelfBondRatio(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("minimum self-bond ratio cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("minimum self-bond ratio cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("minimum self-bond ratio cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
@@ -35,4 +35,31 @@ func Test_validateParams(t *testing.T) {
 
 	params.MinCommissionRate = sdk.NewDec(2)
 	require.Error(t, params.Validate())
+
+	// validate liquid staking caps
+	params = types.DefaultParams()
+	params.GlobalLiquidStakingCap = sdk.NewDec(-1)
+	require.Error(t, params.Validate())
+
+	params.GlobalLiquidStakingCap = sdk.NewDec(2)
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.ValidatorLiquidStakingCap = sdk.NewDec(-1)
+	require.Error(t, params.Validate())
+
+	params.ValidatorLiquidStakingCap = sdk.NewDecWithPrec(5, 1)
+	require.NoError(t, params.Validate())
+
+	// validate minimum self-bond
+	params = types.DefaultParams()
+	params.MinSelfBond = sdk.NewInt(-1)
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.MinSelfBondRatio = sdk.NewDec(-1)
+	require.Error(t, params.Validate())
+
+	params.MinSelfBondRatio = sdk.NewDec(2)
+	require.Error(t, params.Validate())
 }
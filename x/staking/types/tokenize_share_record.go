@@ -0,0 +1,115 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// TokenizeShareModuleAccountPrefix is the prefix of the names of the module
+// accounts holding the tokenized delegations.
+const TokenizeShareModuleAccountPrefix = "tokenizeshare_"
+
+// NewTokenizeShareRecord creates a new TokenizeShareRecord instance.
+//
+//nolint:interfacer
+func NewTokenizeShareRecord(id uint64, owner sdk.AccAddress, validator sdk.ValAddress) TokenizeShareRecord {
+	return TokenizeShareRecord{
+		Id:            id,
+		Owner:         owner.String(),
+		ModuleAccount: fmt.Sprintf("%s%d", TokenizeShareModuleAccountPrefix, id),
+		Validator:     validator.String(),
+	}
+}
+
+// GetModuleAddress returns the address of the module account holding the
+// tokenized delegation.
+func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(r.ModuleAccount)
+}
+
+// GetValidatorAddr returns the operator address of the validator of the
+// tokenized delegation.
+func (r TokenizeShareRecord) GetValidatorAddr() sdk.ValAddress {
+	valAddr, err := sdk.ValAddressFromBech32(r.Validator)
+	if err != nil {
+		panic(err)
+	}
+	return valAddr
+}
+
+// GetShareTokenDenom returns the denom of the share tokens of the record, which
+// is the validator operator address followed by the record id.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return fmt.Sprintf("%s/%d", r.Validator, r.Id)
+}
+
+// Validate performs a stateless validation of the record.
+func (r TokenizeShareRecord) Validate() error {
+	if r.Id == 0 {
+		return fmt.Errorf("tokenize share record id cannot be zero")
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Owner); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid tokenize share record owner: %s", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(r.Validator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid tokenize share record validator: %s", err)
+	}
+	if expected := fmt.Sprintf("%s%d", TokenizeShareModuleAccountPrefix, r.Id); r.ModuleAccount != expected {
+		return fmt.Errorf("invalid tokenize share record module account: expected %s, got %s", expected, r.ModuleAccount)
+	}
+
+	return nil
+}
+
+// ParseShareTokenDenom returns the validator and record id of a share token
+// denom.
+func ParseShareTokenDenom(denom string) (sdk.ValAddress, uint64, error) {
+	i := strings.LastIndex(denom, "/")
+	if i < 0 {
+		return nil, 0, fmt.Errorf("invalid share token denom: %s", denom)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(denom[:i])
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid share token denom %s: %w", denom, err)
+	}
+
+	id, err := strconv.ParseUint(denom[i+1:], 10, 64)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid share token denom %s: %w", denom, err)
+	}
+
+	return valAddr, id, nil
+}
+
+// TokenizeShareMintRestriction is a x/bank minting restriction only allowing the
+// share tokens of tokenized delegations to be minted. It is meant to restrict
+// the bank keeper given to the x/staking keeper.
+func TokenizeShareMintRestriction(_ sdk.Context, coins sdk.Coins) error {
+	for _, coin := range coins {
+		if _, _, err := ParseShareTokenDenom(coin.Denom); err != nil {
+			return sdkerrors.ErrUnauthorized.Wrapf("x/staking can only mint share tokens: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// NonShareTokenMintRestriction is a x/bank minting restriction preventing the
+// share tokens of tokenized delegations from being minted. It is meant to
+// restrict the bank keeper shared by the other modules, so that only the x/staking
+// keeper can mint share tokens.
+func NonShareTokenMintRestriction(_ sdk.Context, coins sdk.Coins) error {
+	for _, coin := range coins {
+		if _, _, err := ParseShareTokenDenom(coin.Denom); err == nil {
+			return sdkerrors.ErrUnauthorized.Wrapf("only x/staking can mint share tokens: %s", coin.Denom)
+		}
+	}
+
+	return nil
+}
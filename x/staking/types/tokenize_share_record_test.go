@@ -0,0 +1,57 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestTokenizeShareRecord(t *testing.T) {
+	record := types.NewTokenizeShareRecord(3, sdk.AccAddress(pk1.Address()), valAddr1)
+	require.NoError(t, record.Validate())
+	require.Equal(t, "tokenizeshare_3", record.ModuleAccount)
+	require.Equal(t, valAddr1, record.GetValidatorAddr())
+
+	valAddr, id, err := types.ParseShareTokenDenom(record.GetShareTokenDenom())
+	require.NoError(t, err)
+	require.Equal(t, valAddr1, valAddr)
+	require.Equal(t, uint64(3), id)
+
+	invalid := record
+	invalid.Id = 0
+	require.Error(t, invalid.Validate())
+
+	invalid = record
+	invalid.ModuleAccount = "tokenizeshare_4"
+	require.Error(t, invalid.Validate())
+
+	invalid = record
+	invalid.Owner = ""
+	require.Error(t, invalid.Validate())
+}
+
+func TestParseShareTokenDenom(t *testing.T) {
+	for _, denom := range []string{"stake", "stake/1", valAddr1.String(), valAddr1.String() + "/", valAddr1.String() + "/a"} {
+		_, _, err := types.ParseShareTokenDenom(denom)
+		require.Error(t, err, denom)
+	}
+}
+
+func TestTokenizeShareMintRestriction(t *testing.T) {
+	ctx := sdk.Context{}
+	shareToken := sdk.NewInt64Coin(valAddr1.String()+"/1", 10)
+
+	require.NoError(t, types.TokenizeShareMintRestriction(ctx, sdk.NewCoins(shareToken)))
+	require.Error(t, types.TokenizeShareMintRestriction(ctx, sdk.NewCoins(shareToken, sdk.NewInt64Coin("stake", 10))))
+}
+
+func TestNonShareTokenMintRestriction(t *testing.T) {
+	ctx := sdk.Context{}
+	shareToken := sdk.NewInt64Coin(valAddr1.String()+"/1", 10)
+
+	require.NoError(t, types.NonShareTokenMintRestriction(ctx, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))))
+	require.Error(t, types.NonShareTokenMintRestriction(ctx, sdk.NewCoins(shareToken, sdk.NewInt64Coin("stake", 10))))
+}